@@ -16,6 +16,8 @@ env "gorm" {
   migration {
     dir = "file://migrations"
   }
+  # the full-text index is maintained by hand in the migrations, it has no gorm model
+  exclude = ["events_search*", "communities_search*"]
   format {
     migrate {
       diff = "{{ sql . \"  \" }}"
@@ -38,10 +40,10 @@ env "e2e" {
 
 env "staging" {
   url     = "libsql://zenao-staging-3-samourai-coop.turso.io?authToken=${var.token}"
-  exclude = ["_litestream*"]
+  exclude = ["_litestream*", "events_search*", "communities_search*"]
 }
 
 env "prod" {
   url     = "libsql://zenao-prod-samourai-coop.turso.io?authToken=${var.token}"
-  exclude = ["_litestream*"]
+  exclude = ["_litestream*", "events_search*", "communities_search*"]
 }
\ No newline at end of file
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ApproveEventApplication(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ApproveEventApplicationRequest],
+) (*connect.Response[zenaov1.ApproveEventApplicationResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("approve-event-application", zap.String("application-id", req.Msg.ApplicationId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	var (
+		evt         *zeni.Event
+		application *zeni.EventApplication
+		ticket      *zeni.Ticket
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.ApproveEventApplication", func(tx zeni.DB) error {
+		application, evt, err = reviewEventApplication(tx, actor.ID(), req.Msg.ApplicationId)
+		if err != nil {
+			return err
+		}
+
+		application, err = tx.ReviewEventApplication(application.ID, zeni.EventApplicationStatusApproved, "", time.Now().Unix())
+		if err != nil {
+			return err
+		}
+
+		// applicants of paid events are only allowed to checkout, the ticket is issued once they pay
+		paid, err := eventHasPaidPrices(tx, evt.ID)
+		if err != nil {
+			return err
+		}
+		if paid {
+			return nil
+		}
+
+		ticket, err = zeni.NewTicket()
+		if err != nil {
+			return err
+		}
+		// the applicant gave the password when applying
+		if err := tx.Participate(evt.ID, application.BuyerID, application.UserID, ticket.Secret(), "", false); err != nil {
+			return err
+		}
+
+		communities, err := tx.CommunitiesByEvent(evt.ID)
+		if err != nil {
+			return err
+		}
+		for _, cmt := range communities {
+			roles, err := tx.EntityRoles(zeni.EntityTypeUser, application.UserID, zeni.EntityTypeCommunity, cmt.ID)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(roles, zeni.RoleMember) {
+				continue
+			}
+			if err := tx.AddMemberToCommunity(cmt.ID, application.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.sendEventApplicationEmail(ctx, evt, application, ticket); err != nil {
+		s.Logger.Error("send-event-application-email", zap.Error(err), zap.String("application-id", application.ID))
+	}
+
+	return connect.NewResponse(&zenaov1.ApproveEventApplicationResponse{
+		Application: eventApplicationToProto(application),
+	}), nil
+}
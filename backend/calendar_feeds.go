@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	srand "crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	calendarFeedPathPrefix          = "/calendar/"
+	userCalendarFeedPathPrefix      = calendarFeedPathPrefix + "users/"
+	communityCalendarFeedPathPrefix = calendarFeedPathPrefix + "communities/"
+	calendarFeedExtension           = ".ics"
+	calendarFeedTokenSize           = 32
+	// calendarFeedHistory is how long ended events stay in the feeds
+	calendarFeedHistory = 90 * 24 * time.Hour
+)
+
+// newCalendarFeedToken returns a random url-safe token, it is the only credential of a user feed.
+func newCalendarFeedToken() (string, error) {
+	token := make([]byte, calendarFeedTokenSize)
+	if _, err := srand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func userCalendarFeedPath(token string) string {
+	return userCalendarFeedPathPrefix + token + calendarFeedExtension
+}
+
+// CalendarFeedHandler returns an http handler serving the subscribable calendars of communities and users.
+// Calendar apps can't authenticate, user feeds are protected by the unguessable token in their path.
+func (s *ZenaoServer) CalendarFeedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		title, events, err := s.calendarFeedEvents(r.Context(), r.URL.Path)
+		if errors.Is(err, errCalendarFeedNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			s.Logger.Error("calendar-feed", zap.Error(err), zap.String("path", r.URL.Path))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		feed := GenerateCalendarFeed(title, events, s.MailSender, s.Logger)
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, max-age=900")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := w.Write(feed); err != nil {
+			s.Logger.Error("calendar-feed", zap.Error(err), zap.String("path", r.URL.Path))
+		}
+	})
+}
+
+var errCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// calendarFeedEvents returns the title and events of the feed at path.
+func (s *ZenaoServer) calendarFeedEvents(ctx context.Context, path string) (string, []*zeni.Event, error) {
+	name, ok := strings.CutSuffix(path, calendarFeedExtension)
+	if !ok {
+		return "", nil, errCalendarFeedNotFound
+	}
+
+	db := s.DB.WithContext(ctx)
+	since := time.Now().Add(-calendarFeedHistory)
+
+	if token, ok := strings.CutPrefix(name, userCalendarFeedPathPrefix); ok {
+		user, err := db.GetUserByCalendarFeedToken(token)
+		if err != nil {
+			return "", nil, err
+		}
+		if user == nil {
+			return "", nil, errCalendarFeedNotFound
+		}
+		events, err := db.ListUserCalendarEvents(user.ID, []string{zeni.RoleOrganizer, zeni.RoleParticipant}, since)
+		return "Zenao - My events", events, err
+	}
+
+	if communityID, ok := strings.CutPrefix(name, communityCalendarFeedPathPrefix); ok {
+		if _, err := strconv.ParseUint(communityID, 10, 64); err != nil {
+			return "", nil, errCalendarFeedNotFound
+		}
+		cmt, err := db.GetCommunity(communityID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil, errCalendarFeedNotFound
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		events, err := db.ListCommunityCalendarEvents(communityID, since)
+		return cmt.DisplayName, events, err
+	}
+
+	return "", nil, errCalendarFeedNotFound
+}
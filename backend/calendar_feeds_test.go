@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFeedsIncludeCancelledEvents(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+	require.NotNil(t, community)
+
+	start := time.Now().Add(96 * time.Hour).Truncate(time.Hour)
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Cancelled meetup",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(start.Unix()),
+		EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		CommunityId:  community.ID,
+		Discoverable: true,
+	}))
+	require.NoError(t, err)
+	cancelledID := created.Msg.Id
+	_, err = f.server.CancelEvent(context.Background(), connect.NewRequest(&zenaov1.CancelEventRequest{EventId: cancelledID}))
+	require.NoError(t, err)
+
+	feed, err := f.server.GetUserCalendarFeed(context.Background(), connect.NewRequest(&zenaov1.GetUserCalendarFeedRequest{}))
+	require.NoError(t, err)
+	require.NotEmpty(t, feed.Msg.Token)
+	require.Equal(t, "/calendar/users/"+feed.Msg.Token+".ics", feed.Msg.Path)
+	again, err := f.server.GetUserCalendarFeed(context.Background(), connect.NewRequest(&zenaov1.GetUserCalendarFeedRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, feed.Msg.Token, again.Msg.Token)
+
+	handler := f.server.CalendarFeedHandler()
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+	requireFeed := func(rec *httptest.ResponseRecorder) {
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, "METHOD:PUBLISH")
+		require.Contains(t, body, fmt.Sprintf("UID:evt_%s@zenao.io", f.eventID))
+		require.Contains(t, body, fmt.Sprintf("UID:evt_%s@zenao.io", cancelledID))
+		require.Contains(t, body, "STATUS:CANCELLED")
+		require.Contains(t, body, "STATUS:CONFIRMED")
+		require.Contains(t, body, "SEQUENCE:1")
+	}
+
+	requireFeed(get(feed.Msg.Path))
+	requireFeed(get(fmt.Sprintf("/calendar/communities/%s.ics", community.ID)))
+	require.Equal(t, http.StatusNotFound, get("/calendar/communities/424242.ics").Code)
+	require.Equal(t, http.StatusNotFound, get("/calendar/users/unknown.ics").Code)
+
+	rotated, err := f.server.GetUserCalendarFeed(context.Background(), connect.NewRequest(&zenaov1.GetUserCalendarFeedRequest{Rotate: true}))
+	require.NoError(t, err)
+	require.NotEqual(t, feed.Msg.Token, rotated.Msg.Token)
+	require.Equal(t, http.StatusNotFound, get(feed.Msg.Path).Code)
+	requireFeed(get(rotated.Msg.Path))
+}
+
+func TestCommunityCalendarFeedHidesPrivateEvents(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	start := time.Now().Add(96 * time.Hour).Truncate(time.Hour)
+	createEvent := func(title string, edit func(req *zenaov1.CreateEventRequest)) string {
+		t.Helper()
+		req := &zenaov1.CreateEventRequest{
+			Title:       title,
+			Description: "test description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(start.Unix()),
+			EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+			Capacity:    100,
+			Location: &zenaov1.EventLocation{
+				Address: &zenaov1.EventLocation_Custom{Custom: &zenaov1.AddressCustom{Address: "12 secret street", Timezone: "Europe/Paris"}},
+			},
+			CommunityId:  community.ID,
+			Discoverable: true,
+		}
+		edit(req)
+		created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+		return created.Msg.Id
+	}
+	guardedID := createEvent("Guarded meetup", func(req *zenaov1.CreateEventRequest) { req.Password = "secret" })
+	inviteOnlyID := createEvent("Invite-only meetup", func(req *zenaov1.CreateEventRequest) { req.InviteOnly = true })
+	hiddenID := createEvent("Hidden meetup", func(req *zenaov1.CreateEventRequest) { req.Discoverable = false })
+
+	rec := httptest.NewRecorder()
+	f.server.CalendarFeedHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/calendar/communities/%s.ics", community.ID), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	require.Contains(t, body, fmt.Sprintf("UID:evt_%s@zenao.io", f.eventID))
+	for _, id := range []string{guardedID, inviteOnlyID, hiddenID} {
+		require.NotContains(t, body, fmt.Sprintf("UID:evt_%s@zenao.io", id))
+	}
+	require.NotContains(t, body, "secret street")
+}
@@ -27,6 +27,7 @@ func (s *ZenaoServer) CancelEvent(
 
 	var users []*zeni.User
 	var evt *zeni.Event
+	var orders []*zeni.Order
 	if err := s.DB.TxWithSpan(ctx, "db.CancelEvent", func(db zeni.DB) error {
 		evt, err = db.GetEvent(req.Msg.EventId)
 		if err != nil {
@@ -46,53 +47,70 @@ func (s *ZenaoServer) CancelEvent(
 		if !slices.Contains(roles, zeni.RoleOrganizer) {
 			return errors.New("only organizers can cancel an event")
 		}
+		if req.Msg.RefundOrders {
+			orders, err = db.ListOrdersByEvent(req.Msg.EventId, zeni.OrderStatusSuccess)
+			if err != nil {
+				return err
+			}
+		}
 		return db.CancelEvent(req.Msg.EventId)
 	}); err != nil {
 		return nil, err
 	}
 
-	if s.MailClient != nil {
-		idsList := make([]string, 0, len(users))
-		for _, u := range users {
-			// Skip teams which don't have AuthID
-			if u.AuthID == "" {
-				continue
-			}
-			idsList = append(idsList, u.AuthID)
+	// refunds are not retried here, organizers can use RefundOrder on the failed orders
+	res := &zenaov1.CancelEventResponse{}
+	for _, order := range orders {
+		if order.RefundStatus == zeni.OrderRefundStatusRefunded {
+			continue
 		}
-		if len(idsList) == 0 {
-			return connect.NewResponse(&zenaov1.CancelEventResponse{}), nil
+		if _, err := s.refundOrder(ctx, order, nil); err != nil {
+			s.Logger.Error("cancel-event-refund", zap.Error(err), zap.String("event-id", req.Msg.EventId), zap.String("order-id", order.ID))
+			res.RefundFailedOrderIds = append(res.RefundFailedOrderIds, order.ID)
 		}
-		authUsers, err := s.Auth.GetUsersFromIDs(ctx, idsList)
+	}
+
+	if s.MailClient != nil {
+		emails, err := s.usersEmails(ctx, users)
 		if err != nil {
 			return nil, err
 		}
+		if len(emails) == 0 {
+			return connect.NewResponse(res), nil
+		}
 		htmlStr, textStr, err := eventCancelledMailContent(evt)
 		if err != nil {
 			return nil, err
 		}
-		var requests []*resend.SendEmailRequest
-		for _, authUser := range authUsers {
-			requests = append(requests, &resend.SendEmailRequest{
+		// db.CancelEvent bumped the sequence so calendars apply the cancellation
+		evt.ICSSequenceNumber++
+		icsData := s.eventCancelICS(ctx, evt)
+
+		// cannot batch send w/ attachments: https://resend.com/docs/api-reference/emails/send-batch-emails
+		count := 0
+		for _, email := range emails {
+			if _, err := s.MailClient.Emails.SendWithContext(context.Background(), &resend.SendEmailRequest{
 				From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
-				To:      []string{authUser.Email},
+				To:      []string{email},
 				Subject: "Event cancelled: " + evt.Title,
 				Html:    htmlStr,
 				Text:    textStr,
-			})
-		}
-
-		count := 0
-		for i := 0; i < len(requests); i += 100 {
-			batch := requests[i:min(i+100, len(requests))]
-			if _, err := s.MailClient.Batch.SendWithContext(context.Background(), batch); err != nil {
-				s.Logger.Error("send-event-broadcast-email", zap.Error(err))
+				Attachments: []*resend.Attachment{{
+					Content:     icsData,
+					Filename:    fmt.Sprintf("zenao_events_%s.ics", evt.ID),
+					ContentType: "text/calendar",
+				}},
+			}); err != nil {
+				s.Logger.Error("send-event-cancellation-email", zap.Error(err))
 				continue
 			}
-			count += len(batch)
-			s.Logger.Info("send-event-cancellation-email", zap.Int("already-sent", count), zap.Int("total", len(requests)))
+			count++
+			if count%50 == 0 {
+				s.Logger.Info("send-event-cancellation-email", zap.Int("already-sent", count), zap.Int("total", len(emails)))
+			}
 		}
+		s.Logger.Info("send-event-cancellation-email", zap.Int("total-sent", count), zap.Int("total", len(emails)))
 	}
 
-	return connect.NewResponse(&zenaov1.CancelEventResponse{}), nil
+	return connect.NewResponse(res), nil
 }
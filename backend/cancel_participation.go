@@ -45,5 +45,9 @@ func (s *ZenaoServer) CancelParticipation(ctx context.Context, req *connect.Requ
 		return nil, err
 	}
 
+	if err := s.offerWaitlistSpots(ctx, evt.ID, time.Now()); err != nil {
+		s.Logger.Error("offer-waitlist-spots", zap.Error(err), zap.String("event-id", evt.ID))
+	}
+
 	return connect.NewResponse(&zenaov1.CancelParticipationResponse{}), nil
 }
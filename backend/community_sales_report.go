@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) GetCommunitySalesReport(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetCommunitySalesReportRequest],
+) (*connect.Response[zenaov1.GetCommunitySalesReportResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("get-community-sales-report", zap.String("community-id", req.Msg.CommunityId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	rows, err := s.communitySalesReport(ctx, actor.ID(), req.Msg.CommunityId, req.Msg.From, req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &zenaov1.GetCommunitySalesReportResponse{
+		Rows:   make([]*zenaov1.SalesReportRow, 0, len(rows)),
+		Totals: salesReportTotals(rows),
+	}
+	for _, row := range rows {
+		res.Rows = append(res.Rows, &zenaov1.SalesReportRow{
+			EventId:                     row.EventID,
+			EventTitle:                  row.EventTitle,
+			PriceGroupId:                row.PriceGroupID,
+			CurrencyCode:                row.CurrencyCode,
+			TicketsSold:                 row.TicketsSold,
+			TicketsRefunded:             row.TicketsRefunded,
+			GrossAmountMinor:            row.GrossAmountMinor,
+			RefundedAmountMinor:         row.RefundedAmountMinor,
+			NetAmountMinor:              salesReportRowNet(row),
+			DonationAmountMinor:         row.DonationAmountMinor,
+			RefundedDonationAmountMinor: row.RefundedDonationAmountMinor,
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *ZenaoServer) ExportCommunitySalesReport(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ExportCommunitySalesReportRequest],
+) (*connect.Response[zenaov1.ExportCommunitySalesReportResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("export-community-sales-report", zap.String("community-id", req.Msg.CommunityId), zap.String("format", req.Msg.Format), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	format := strings.ToLower(strings.TrimSpace(req.Msg.Format))
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "xlsx" {
+		return nil, fmt.Errorf("unsupported export format %q", req.Msg.Format)
+	}
+
+	rows, err := s.communitySalesReport(ctx, actor.ID(), req.Msg.CommunityId, req.Msg.From, req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	table := [][]any{{"Event ID", "Event", "Price group ID", "Currency", "Tickets sold", "Tickets refunded", "Gross", "Refunds", "Donations", "Donation refunds", "Net"}}
+	for _, row := range rows {
+		table = append(table, []any{
+			row.EventID,
+			row.EventTitle,
+			row.PriceGroupID,
+			row.CurrencyCode,
+			row.TicketsSold,
+			row.TicketsRefunded,
+			xlsxNumber(formatMinorAmount(row.GrossAmountMinor, row.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(row.RefundedAmountMinor, row.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(row.DonationAmountMinor, row.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(row.RefundedDonationAmountMinor, row.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(salesReportRowNet(row), row.CurrencyCode)),
+		})
+	}
+	for _, total := range salesReportTotals(rows) {
+		table = append(table, []any{
+			"Total",
+			"",
+			"",
+			total.CurrencyCode,
+			total.TicketsSold,
+			total.TicketsRefunded,
+			xlsxNumber(formatMinorAmount(total.GrossAmountMinor, total.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(total.RefundedAmountMinor, total.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(total.DonationAmountMinor, total.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(total.RefundedDonationAmountMinor, total.CurrencyCode)),
+			xlsxNumber(formatMinorAmount(total.NetAmountMinor, total.CurrencyCode)),
+		})
+	}
+
+	var buffer bytes.Buffer
+	mimeType := "text/csv"
+	if format == "xlsx" {
+		mimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		if err := writeXLSX(&buffer, "Sales", table); err != nil {
+			return nil, err
+		}
+	} else {
+		writer := csv.NewWriter(&buffer)
+		for _, row := range table {
+			if err := writer.Write(mapsl.Map(row, func(cell any) string { return fmt.Sprint(cell) })); err != nil {
+				return nil, err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&zenaov1.ExportCommunitySalesReportResponse{
+		Content:  buffer.Bytes(),
+		Filename: fmt.Sprintf("sales-community-%s.%s", req.Msg.CommunityId, format),
+		MimeType: mimeType,
+	}), nil
+}
+
+// communitySalesReport checks that the user administrates the community and returns its sales rows.
+func (s *ZenaoServer) communitySalesReport(ctx context.Context, userID string, communityID string, from int64, to int64) ([]*zeni.SalesReportRow, error) {
+	if communityID == "" {
+		return nil, errors.New("community id is required")
+	}
+	if from < 0 || to < 0 || (to != 0 && to <= from) {
+		return nil, errors.New("invalid date range")
+	}
+
+	var rows []*zeni.SalesReportRow
+	if err := s.DB.TxWithSpan(ctx, "db.GetCommunitySalesReport", func(tx zeni.DB) error {
+		roles, err := tx.EntityRoles(zeni.EntityTypeUser, userID, zeni.EntityTypeCommunity, communityID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleAdministrator) {
+			return errors.New("user is not administrator of the community")
+		}
+
+		rows, err = tx.GetCommunitySalesReport(communityID, from, to)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// salesReportTotals sums the report rows per currency, in the order currencies first appear.
+func salesReportTotals(rows []*zeni.SalesReportRow) []*zenaov1.SalesReportTotal {
+	totals := []*zenaov1.SalesReportTotal{}
+	byCurrency := map[string]*zenaov1.SalesReportTotal{}
+	for _, row := range rows {
+		total, ok := byCurrency[row.CurrencyCode]
+		if !ok {
+			total = &zenaov1.SalesReportTotal{CurrencyCode: row.CurrencyCode}
+			byCurrency[row.CurrencyCode] = total
+			totals = append(totals, total)
+		}
+		total.TicketsSold += row.TicketsSold
+		total.TicketsRefunded += row.TicketsRefunded
+		total.GrossAmountMinor += row.GrossAmountMinor
+		total.RefundedAmountMinor += row.RefundedAmountMinor
+		total.DonationAmountMinor += row.DonationAmountMinor
+		total.RefundedDonationAmountMinor += row.RefundedDonationAmountMinor
+		total.NetAmountMinor += salesReportRowNet(row)
+	}
+	return totals
+}
+
+// salesReportRowNet returns what the row earned once its refunds are deducted, donations included.
+func salesReportRowNet(row *zeni.SalesReportRow) int64 {
+	return row.GrossAmountMinor - row.RefundedAmountMinor + row.DonationAmountMinor - row.RefundedDonationAmountMinor
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommunitySalesReport(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 1000, CurrencyCode: "EUR"},
+	)
+	confirmedAt := time.Now().Unix()
+
+	first, err := f.startCheckout(f.priceIDs[0], "", "alice@example.com", "bob@example.com")
+	require.NoError(t, err)
+	second, err := f.startCheckout(f.priceIDs[1], "", "carol@example.com")
+	require.NoError(t, err)
+	_, err = f.startCheckout(f.priceIDs[0], "", "pending@example.com")
+	require.NoError(t, err)
+	for _, orderID := range []string{first.OrderId, second.OrderId} {
+		require.NoError(t, f.db.UpdateOrderConfirmation(orderID, zeni.OrderStatusSuccess, "pi_"+orderID, confirmedAt))
+	}
+	_, err = f.sqlDB.Exec("UPDATE orders SET donation_amount_minor = 500, amount_minor = amount_minor + 500 WHERE id = ?", second.OrderId)
+	require.NoError(t, err)
+
+	attendees, err := f.db.GetOrderAttendees(first.OrderId)
+	require.NoError(t, err)
+	_, err = f.db.RefundOrderAttendees(first.OrderId, []string{attendees[0].ID}, "re_test", confirmedAt)
+	require.NoError(t, err)
+
+	account, err := f.db.GetOrderPaymentAccount(first.OrderId)
+	require.NoError(t, err)
+
+	resp, err := f.server.GetCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.GetCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Rows, 2)
+	row := resp.Msg.Rows[0]
+	require.Equal(t, f.eventID, row.EventId)
+	require.Equal(t, "EUR", row.CurrencyCode)
+	require.Equal(t, int64(3), row.TicketsSold)
+	require.Equal(t, int64(1), row.TicketsRefunded)
+	require.Equal(t, int64(6000), row.GrossAmountMinor)
+	require.Equal(t, int64(2500), row.RefundedAmountMinor)
+	require.Equal(t, int64(3500), row.NetAmountMinor)
+	donations := resp.Msg.Rows[1]
+	require.Equal(t, f.eventID, donations.EventId)
+	require.Empty(t, donations.PriceGroupId)
+	require.Zero(t, donations.TicketsSold)
+	require.Equal(t, int64(500), donations.DonationAmountMinor)
+	require.Equal(t, int64(500), donations.NetAmountMinor)
+	require.Len(t, resp.Msg.Totals, 1)
+	require.Equal(t, int64(500), resp.Msg.Totals[0].DonationAmountMinor)
+	require.Equal(t, int64(4000), resp.Msg.Totals[0].NetAmountMinor)
+
+	resp, err = f.server.GetCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.GetCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+		From:        confirmedAt + 1,
+	}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Rows)
+
+	csvResp, err := f.server.ExportCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.ExportCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", csvResp.Msg.MimeType)
+	require.Contains(t, string(csvResp.Msg.Content), "Paid event,"+row.PriceGroupId+",EUR,3,1,60.00,25.00,0.00,0.00,35.00")
+	require.Contains(t, string(csvResp.Msg.Content), "Paid event,,EUR,0,0,0.00,0.00,5.00,0.00,5.00")
+	require.Contains(t, string(csvResp.Msg.Content), "Total,,,EUR,3,1,60.00,25.00,5.00,0.00,40.00")
+
+	xlsxResp, err := f.server.ExportCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.ExportCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+		Format:      "xlsx",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "sales-community-"+account.CommunityID+".xlsx", xlsxResp.Msg.Filename)
+	zr, err := zip.NewReader(bytes.NewReader(xlsxResp.Msg.Content), int64(len(xlsxResp.Msg.Content)))
+	require.NoError(t, err)
+	sheetFile, err := zr.Open("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	sheet, err := io.ReadAll(sheetFile)
+	require.NoError(t, err)
+	require.Contains(t, string(sheet), `<c r="B2" t="inlineStr"><is><t>Paid event</t></is></c>`)
+	require.Contains(t, string(sheet), `<c r="G2"><v>60.00</v></c>`)
+
+	_, err = f.server.ExportCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.ExportCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+		Format:      "pdf",
+	}))
+	require.ErrorContains(t, err, "unsupported export format")
+
+	f.auth.user = f.auth.ensureAuthUser("alice@example.com")
+	_, err = f.server.GetCommunitySalesReport(context.Background(), connect.NewRequest(&zenaov1.GetCommunitySalesReportRequest{
+		CommunityId: account.CommunityID,
+	}))
+	require.ErrorContains(t, err, "not administrator")
+}
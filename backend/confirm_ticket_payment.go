@@ -38,15 +38,13 @@ func (s *ZenaoServer) ConfirmTicketPayment(
 	if sessionID == "" {
 		sessionID = strings.TrimSpace(order.PaymentSessionID)
 	}
-	if sessionID == "" {
-		return nil, errors.New("checkout session id is required")
-	}
 	if strings.TrimSpace(req.Msg.CheckoutSessionId) != "" &&
 		strings.TrimSpace(order.PaymentSessionID) != "" &&
 		sessionID != strings.TrimSpace(order.PaymentSessionID) {
 		return nil, errors.New("checkout session mismatch")
 	}
 
+	// free orders are confirmed without a checkout session
 	if order.Status == zeni.OrderStatusSuccess && order.ConfirmedAt != nil && *order.ConfirmedAt > 0 {
 		s.issueTicketsAfterConfirmation(ctx, order)
 		return connect.NewResponse(&zenaov1.ConfirmTicketPaymentResponse{
@@ -56,6 +54,10 @@ func (s *ZenaoServer) ConfirmTicketPayment(
 		}), nil
 	}
 
+	if sessionID == "" {
+		return nil, errors.New("checkout session id is required")
+	}
+
 	account, err := s.DB.WithContext(ctx).GetOrderPaymentAccount(orderID)
 	if err != nil {
 		s.Logger.Error("confirm-ticket-payment", zap.Error(err), zap.String("order-id", orderID))
@@ -89,18 +91,12 @@ func (s *ZenaoServer) ConfirmTicketPayment(
 	}
 
 	if status == zeni.OrderStatusSuccess {
-		confirmedAt := time.Now().Unix()
-		updated, err := s.DB.WithContext(ctx).UpdateOrderConfirmationOnce(order.ID, status, session.PaymentIntentID, confirmedAt)
-		if err != nil {
+		if err := s.confirmOrderPayment(ctx, order, session.PaymentIntentID); err != nil {
 			s.Logger.Error("confirm-ticket-payment", zap.Error(err), zap.String("order-id", orderID))
 			return nil, err
 		}
-		if updated {
-			if err := s.sendPurchaseConfirmationEmail(ctx, order); err != nil {
-				s.Logger.Error("confirm-ticket-payment", zap.Error(err), zap.String("order-id", orderID))
-			}
-		}
-		s.issueTicketsAfterConfirmation(ctx, order)
+	} else if status == zeni.OrderStatusFailed && order.Status == zeni.OrderStatusPending {
+		failOrderAndReleaseHolds(ctx, s.DB, order.ID)
 	} else if status == zeni.OrderStatusPending && order.Status != zeni.OrderStatusSuccess && order.Status != zeni.OrderStatusPending {
 		if err := s.DB.WithContext(ctx).UpdateOrderSetStatus(order.ID, status); err != nil {
 			s.Logger.Error("confirm-ticket-payment", zap.Error(err), zap.String("order-id", orderID))
@@ -110,6 +106,35 @@ func (s *ZenaoServer) ConfirmTicketPayment(
 	return connect.NewResponse(response), nil
 }
 
+// confirmOrderPayment marks the order as paid, numbers its invoice and sends the purchase
+// confirmation mail the first time the order transitions and issues the tickets.
+// It is safe to call multiple times for the same order.
+func (s *ZenaoServer) confirmOrderPayment(ctx context.Context, order *zeni.Order, paymentIntentID string) error {
+	confirmedAt := time.Now().Unix()
+	updated, err := s.DB.WithContext(ctx).UpdateOrderConfirmationOnce(order.ID, zeni.OrderStatusSuccess, paymentIntentID, confirmedAt)
+	if err != nil {
+		return err
+	}
+	if updated {
+		if invoiced, err := s.assignOrderInvoice(ctx, order); err != nil {
+			s.Logger.Error("assign-order-invoice", zap.Error(err), zap.String("order-id", order.ID))
+		} else {
+			order = invoiced
+		}
+		if err := s.sendPurchaseConfirmationEmail(ctx, order); err != nil {
+			s.Logger.Error("purchase-confirmation-mail", zap.Error(err), zap.String("order-id", order.ID))
+		}
+	}
+	if err := s.DB.WithContext(ctx).ClaimOrderWaitlistOffers(order.ID); err != nil {
+		s.Logger.Error("claim-order-waitlist-offers", zap.Error(err), zap.String("order-id", order.ID))
+	}
+	s.issueTicketsAfterConfirmation(ctx, order)
+	return nil
+}
+
+// maxTicketIssueAttempts is how many times the tickets of a paid order are issued before giving up
+const maxTicketIssueAttempts = 5
+
 func (s *ZenaoServer) issueTicketsAfterConfirmation(ctx context.Context, order *zeni.Order) {
 	if order == nil || s.DB == nil || s.Logger == nil {
 		return
@@ -125,8 +150,14 @@ func (s *ZenaoServer) issueTicketsAfterConfirmation(ctx context.Context, order *
 
 	issuedCount, err := s.issueOrderTickets(issueCtx, order)
 	if err != nil {
-		s.Logger.Error("ticket-issuance-failed", zap.Error(err), zap.String("order-id", order.ID))
-		if updateErr := s.DB.WithContext(issueCtx).UpdateOrderTicketIssue(order.ID, zeni.TicketIssueStatusFailed, trimTicketIssueError(err)); updateErr != nil {
+		s.Logger.Error("ticket-issuance-failed", zap.Error(err), zap.String("order-id", order.ID), zap.Uint32("attempt", order.TicketIssueAttempts+1))
+		status := zeni.TicketIssueStatusFailed
+		if order.TicketIssueAttempts+1 >= maxTicketIssueAttempts {
+			// the reconciler stops retrying, the buyer paid so the order must not be forgotten
+			status = zeni.TicketIssueStatusNeedsAttention
+			s.Logger.Error("ticket-issuance-needs-attention", zap.String("order-id", order.ID), zap.String("event-id", order.EventID))
+		}
+		if updateErr := s.DB.WithContext(issueCtx).UpdateOrderTicketIssue(order.ID, status, trimTicketIssueError(err)); updateErr != nil {
 			s.Logger.Error("ticket-issuance-update-failed", zap.Error(updateErr), zap.String("order-id", order.ID))
 		}
 		return
@@ -154,66 +185,73 @@ func (s *ZenaoServer) issueOrderTickets(ctx context.Context, order *zeni.Order)
 	defer span.End()
 
 	err := s.DB.WithContext(txCtx).Tx(func(tx zeni.DB) error {
-		attendees, err := tx.GetOrderAttendees(order.ID)
-		if err != nil {
-			return err
+		var err error
+		issuedCount, err = issueOrderTicketsTx(tx, order)
+		return err
+	})
+	return issuedCount, err
+}
+
+// issueOrderTicketsTx creates the tickets of the order attendees that do not have one yet
+// and returns how many were created.
+func issueOrderTicketsTx(tx zeni.DB, order *zeni.Order) (int, error) {
+	attendees, err := tx.GetOrderAttendees(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	if len(attendees) == 0 {
+		return 0, errors.New("order attendees not found")
+	}
+
+	existingIDs, err := tx.ListOrderAttendeeTicketIDs(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	existing := map[string]struct{}{}
+	for _, id := range existingIDs {
+		existing[id] = struct{}{}
+	}
+
+	newTickets := make([]*zeni.SoldTicket, 0)
+	for _, attendee := range attendees {
+		if attendee == nil {
+			return 0, errors.New("order attendee is nil")
 		}
-		if len(attendees) == 0 {
-			return errors.New("order attendees not found")
+		attendeeID := attendee.ID
+		if attendeeID == "" {
+			return 0, errors.New("order attendee id is required")
+		}
+		if _, ok := existing[attendeeID]; ok {
+			continue
 		}
 
-		existingIDs, err := tx.ListOrderAttendeeTicketIDs(order.ID)
+		ticket, err := zeni.NewTicket()
 		if err != nil {
-			return err
-		}
-		existing := map[string]struct{}{}
-		for _, id := range existingIDs {
-			existing[id] = struct{}{}
+			return 0, err
 		}
 
-		newTickets := make([]*zeni.SoldTicket, 0)
-		for _, attendee := range attendees {
-			if attendee == nil {
-				return errors.New("order attendee is nil")
-			}
-			attendeeID := attendee.ID
-			if attendeeID == "" {
-				return errors.New("order attendee id is required")
-			}
-			if _, ok := existing[attendeeID]; ok {
-				continue
-			}
-
-			ticket, err := zeni.NewTicket()
-			if err != nil {
-				return err
-			}
-
-			newTickets = append(newTickets, &zeni.SoldTicket{
-				Ticket:          ticket,
-				EventID:         order.EventID,
-				BuyerID:         order.BuyerID,
-				UserID:          attendee.UserID,
-				OrderID:         order.ID,
-				PriceID:         attendee.PriceID,
-				PriceGroupID:    attendee.PriceGroupID,
-				OrderAttendeeID: attendeeID,
-				AmountMinor:     attendee.AmountMinor,
-				CurrencyCode:    attendee.CurrencyCode,
-			})
-		}
+		newTickets = append(newTickets, &zeni.SoldTicket{
+			Ticket:          ticket,
+			EventID:         order.EventID,
+			BuyerID:         order.BuyerID,
+			UserID:          attendee.UserID,
+			OrderID:         order.ID,
+			PriceID:         attendee.PriceID,
+			PriceGroupID:    attendee.PriceGroupID,
+			OrderAttendeeID: attendeeID,
+			AmountMinor:     attendee.AmountMinor,
+			CurrencyCode:    attendee.CurrencyCode,
+		})
+	}
 
-		if len(newTickets) == 0 {
-			return nil
-		}
+	if len(newTickets) == 0 {
+		return 0, nil
+	}
 
-		if err := tx.CreateSoldTickets(newTickets); err != nil {
-			return err
-		}
-		issuedCount = len(newTickets)
-		return nil
-	})
-	return issuedCount, err
+	if err := tx.CreateSoldTickets(newTickets); err != nil {
+		return 0, err
+	}
+	return len(newTickets), nil
 }
 
 func trimTicketIssueError(err error) string {
@@ -247,6 +285,8 @@ func mapCheckoutPaymentStatus(status payment.PaymentStatus) zeni.OrderStatus {
 		return zeni.OrderStatusSuccess
 	case payment.PaymentStatusUnpaid:
 		return zeni.OrderStatusPending
+	case payment.PaymentStatusFailed:
+		return zeni.OrderStatusFailed
 	default:
 		return zeni.OrderStatusPending
 	}
@@ -281,7 +321,27 @@ func (s *ZenaoServer) sendPurchaseConfirmationEmail(ctx context.Context, order *
 		return errors.New("event not found")
 	}
 
-	htmlStr, text, err := purchaseConfirmationMailContent(evt, "Purchase confirmed! Your tickets will arrive in a separate email.")
+	var attachments []*resend.Attachment
+	if order.InvoiceID != "" {
+		invoicePDF, err := s.generateOrderInvoicePDF(ctx, order)
+		if err != nil {
+			s.Logger.Error("generate-invoice-pdf", zap.Error(err), zap.String("order-id", order.ID))
+		} else {
+			attachments = append(attachments, &resend.Attachment{
+				Content:     invoicePDF,
+				Filename:    invoiceFilename(order),
+				ContentType: "application/pdf",
+			})
+		}
+	}
+
+	message := "Purchase confirmed! Your tickets will arrive in a separate email."
+	if order.AmountMinor == 0 {
+		message = "Registration confirmed! Your tickets are available on the event page."
+	} else if len(attachments) != 0 {
+		message = "Purchase confirmed! Your invoice is attached and your tickets will arrive in a separate email."
+	}
+	htmlStr, text, err := purchaseConfirmationMailContent(evt, message)
 	if err != nil {
 		return err
 	}
@@ -292,11 +352,12 @@ func (s *ZenaoServer) sendPurchaseConfirmationEmail(ctx context.Context, order *
 
 	_, err = s.MailClient.Emails.SendWithContext(mailCtx, &resend.SendEmailRequest{
 		// XXX: Replace sender name with organizer name
-		From:    "Zenao <" + s.MailSender + ">",
-		To:      []string{authUsers[0].Email},
-		Subject: evt.Title + " - Purchase confirmed",
-		Html:    htmlStr,
-		Text:    text,
+		From:        "Zenao <" + s.MailSender + ">",
+		To:          []string{authUsers[0].Email},
+		Subject:     evt.Title + " - Purchase confirmed",
+		Html:        htmlStr,
+		Text:        text,
+		Attachments: attachments,
 	})
 	return err
 }
@@ -26,6 +26,9 @@ func (s *ZenaoServer) CreateCommunity(
 	if err := validateCommunity(req.Msg.DisplayName, req.Msg.Description, req.Msg.AvatarUri, req.Msg.BannerUri); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
 	}
+	if req.Msg.Tags, err = validateTaxonomy(req.Msg.Category, req.Msg.Tags); err != nil {
+		return nil, fmt.Errorf("invalid tags: %w", err)
+	}
 
 	authAdmins, err := s.Auth.EnsureUsersExists(ctx, req.Msg.Administrators)
 	if err != nil {
@@ -12,10 +12,10 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/resend/resend-go/v2"
-	"github.com/samouraiworld/zenao/backend/webhook"
 	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
 	"github.com/samouraiworld/zenao/backend/zeni"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *ZenaoServer) CreateEvent(
@@ -29,16 +29,47 @@ func (s *ZenaoServer) CreateEvent(
 
 	s.Logger.Info("create-event", zap.String("title", req.Msg.Title), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
 
+	if req.Msg.TemplateId != "" {
+		template, err := s.DB.WithContext(ctx).GetEventTemplate(req.Msg.TemplateId)
+		if err != nil {
+			return nil, err
+		}
+		if template == nil {
+			return nil, errors.New("template not found")
+		}
+		if err := administeredCommunity(s.DB.WithContext(ctx), actor.ID(), template.CommunityID); err != nil {
+			return nil, err
+		}
+		applyEventTemplate(req.Msg, template)
+	}
+
 	if err := validateEvent(req.Msg.StartDate, req.Msg.EndDate, req.Msg.Title, req.Msg.Description, req.Msg.Location, req.Msg.ImageUri, req.Msg.Capacity, req.Msg.TicketPrice, req.Msg.Password); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
 	}
 	if err := validatePriceGroups(req.Msg.PricesGroups); err != nil {
 		return nil, fmt.Errorf("invalid price groups: %w", err)
 	}
+	if err := validateRegistrationQuestions(req.Msg.RegistrationQuestions); err != nil {
+		return nil, fmt.Errorf("invalid registration questions: %w", err)
+	}
+	if err := validateReminderOffsets(req.Msg.ReminderOffsets); err != nil {
+		return nil, fmt.Errorf("invalid reminders: %w", err)
+	}
+	if req.Msg.Tags, err = validateTaxonomy(req.Msg.Category, req.Msg.Tags); err != nil {
+		return nil, fmt.Errorf("invalid tags: %w", err)
+	}
+	if req.Msg.PublishAt != 0 {
+		if err := validatePublishAt(req.Msg.PublishAt, req.Msg.EndDate, time.Now()); err != nil {
+			return nil, err
+		}
+	}
 
 	if hasPaidPrices(req.Msg.PricesGroups) && req.Msg.CommunityId == "" {
 		return nil, errors.New("community is required for paid events")
 	}
+	if err := validateInviteOnly(req.Msg.InviteOnly, req.Msg.Password, req.Msg.PricesGroups); err != nil {
+		return nil, err
+	}
 
 	//XXX: refactor the logic to avoid duplicate w/ gkps ?
 	authOrgas, err := s.Auth.EnsureUsersExists(ctx, req.Msg.Organizers)
@@ -82,18 +113,22 @@ func (s *ZenaoServer) CreateEvent(
 		gatekeepersIDs = append(gatekeepersIDs, zGkp.ID)
 	}
 
+	var (
+		rule        *zeni.RRule
+		occurrences []time.Time
+	)
+	if req.Msg.Rrule != "" {
+		rule, occurrences, err = seriesOccurrences(req.Msg.Rrule, time.Unix(int64(req.Msg.StartDate), 0), req.Msg.Location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+		}
+	}
+
 	evt := (*zeni.Event)(nil)
 	cmt := (*zeni.Community)(nil)
-	targets := []*zeni.User{}
+	series := (*zeni.EventSeries)(nil)
+	occurrenceIDs := []string{}
 	if err := s.DB.TxWithSpan(ctx, "db.CreateEvent", func(db zeni.DB) error {
-		if evt, err = db.CreateEvent(actor.ID(), organizersIDs, gatekeepersIDs, req.Msg); err != nil {
-			return err
-		}
-
-		if _, err = db.CreateFeed(zeni.EntityTypeEvent, evt.ID, "main"); err != nil {
-			return err
-		}
-
 		if req.Msg.CommunityId != "" {
 			cmt, err = db.GetCommunity(req.Msg.CommunityId)
 			if err != nil {
@@ -106,70 +141,60 @@ func (s *ZenaoServer) CreateEvent(
 			if !slices.Contains(roles, zeni.RoleAdministrator) {
 				return errors.New("user is not an admin of the community and cannot add event to it")
 			}
-			err = db.AddEventToCommunity(evt.ID, cmt.ID)
+		}
+
+		var paymentAccount *zeni.PaymentAccount
+		if hasPaidPrices(req.Msg.PricesGroups) {
+			paymentAccount, err = db.GetPaymentAccountByCommunityPlatform(req.Msg.CommunityId, zeni.PaymentPlatformStripeConnect)
 			if err != nil {
-				return err
+				return errors.New("payment account is required for paid events")
 			}
-			targets, err = db.GetOrgUsersWithRoles(zeni.EntityTypeCommunity, req.Msg.CommunityId, []string{zeni.RoleMember})
+		}
+
+		if len(occurrences) == 0 {
+			evt, err = createEventOccurrence(db, actor.ID(), organizersIDs, gatekeepersIDs, req.Msg, cmt, paymentAccount)
 			if err != nil {
 				return err
 			}
+			occurrenceIDs = append(occurrenceIDs, evt.ID)
+			return nil
 		}
 
-		if len(req.Msg.PricesGroups) > 0 {
-			var (
-				paymentAccount   *zeni.PaymentAccount
-				paymentAccountID string
-			)
-			if hasPaidPrices(req.Msg.PricesGroups) {
-				paymentAccount, err = db.GetPaymentAccountByCommunityPlatform(req.Msg.CommunityId, zeni.PaymentPlatformStripeConnect)
-				if err != nil {
-					return errors.New("payment account is required for paid events")
-				}
-				paymentAccountID = paymentAccount.ID
+		duration := time.Duration(req.Msg.EndDate-req.Msg.StartDate) * time.Second
+		if series, err = db.CreateEventSeries(&zeni.EventSeries{
+			RRule:     rule.String(),
+			StartDate: occurrences[0],
+			EndDate:   occurrences[0].Add(duration),
+		}); err != nil {
+			return err
+		}
+		for _, occurrenceStart := range occurrences {
+			occurrence, err := createEventOccurrence(db, actor.ID(), organizersIDs, gatekeepersIDs, shiftCreateEventRequest(req.Msg, occurrenceStart.Sub(occurrences[0])), cmt, paymentAccount)
+			if err != nil {
+				return err
 			}
-
-			for _, group := range req.Msg.PricesGroups {
-				if len(group.Prices) == 0 {
-					continue
-				}
-				priceGroup, err := db.CreatePriceGroup(evt.ID, req.Msg.Capacity)
-				if err != nil {
-					return err
-				}
-				for _, price := range group.Prices {
-					amountMinor := price.AmountMinor
-					currency := strings.ToUpper(strings.TrimSpace(price.CurrencyCode))
-					if amountMinor == 0 {
-						currency = ""
-					}
-
-					var (
-						pricePaymentAccount *zeni.PaymentAccount
-						priceAccountID      string
-					)
-					if amountMinor > 0 {
-						priceAccountID = paymentAccountID
-						pricePaymentAccount = paymentAccount
-					}
-
-					if _, err := db.CreatePrice(pricePaymentAccount, &zeni.Price{
-						PriceGroupID:     priceGroup.ID,
-						AmountMinor:      amountMinor,
-						CurrencyCode:     currency,
-						PaymentAccountID: priceAccountID,
-					}); err != nil {
-						return err
-					}
-				}
+			if err := db.SetEventSeries(occurrence.ID, series.ID, occurrenceStart); err != nil {
+				return err
+			}
+			occurrence.SeriesID = series.ID
+			occurrence.SeriesRecurrenceAt = occurrenceStart
+			if evt == nil {
+				evt = occurrence
 			}
+			occurrenceIDs = append(occurrenceIDs, occurrence.ID)
 		}
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
-	webhook.TrySendDiscordMessage(s.Logger, s.DiscordToken, evt)
+	res := &zenaov1.CreateEventResponse{
+		Id:            evt.ID,
+		OccurrenceIds: occurrenceIDs,
+	}
+	if series != nil {
+		res.SeriesId = series.ID
+	}
 
 	if s.MailClient != nil {
 		htmlStr, text, err := ticketsConfirmationMailContent(evt, "Event created!")
@@ -189,60 +214,119 @@ func (s *ZenaoServer) CreateEvent(
 		}
 	}
 
-	if cmt != nil && len(targets) > 0 && req.Msg.CommunityEmail && s.MailClient != nil {
-		var authIDs []string
-		for _, target := range targets {
-			// Skip teams which don't have AuthID
-			if target.AuthID == "" {
-				continue
-			}
-			authIDs = append(authIDs, target.AuthID)
+	// drafts are announced once published
+	if !evt.Draft {
+		if err := s.announceEvent(ctx, evt, cmt, req.Msg.CommunityEmail); err != nil {
+			return nil, err
 		}
-		if len(authIDs) == 0 {
-			return connect.NewResponse(&zenaov1.CreateEventResponse{
-				Id: evt.ID,
-			}), nil
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// createEventOccurrence creates the event with its feed, community link, price groups and registration form.
+func createEventOccurrence(db zeni.DB, creatorID string, organizersIDs []string, gatekeepersIDs []string, req *zenaov1.CreateEventRequest, cmt *zeni.Community, paymentAccount *zeni.PaymentAccount) (*zeni.Event, error) {
+	evt, err := db.CreateEvent(creatorID, organizersIDs, gatekeepersIDs, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = db.CreateFeed(zeni.EntityTypeEvent, evt.ID, "main"); err != nil {
+		return nil, err
+	}
+
+	if cmt != nil {
+		if err = db.AddEventToCommunity(evt.ID, cmt.ID); err != nil {
+			return nil, err
 		}
-		authTargets, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
-		if err != nil {
+	}
+
+	if len(req.RegistrationQuestions) > 0 {
+		if err := db.SetRegistrationQuestions(evt.ID, registrationQuestionsFromProto(req.RegistrationQuestions)); err != nil {
 			return nil, err
 		}
+	}
 
-		htmlStr, text, err := communityNewEventMailContent(evt, cmt)
+	for _, group := range req.PricesGroups {
+		if len(group.Prices) == 0 {
+			continue
+		}
+		priceGroup, err := db.CreatePriceGroup(evt.ID, req.Capacity)
 		if err != nil {
 			return nil, err
 		}
+		for _, price := range group.Prices {
+			amountMinor := price.AmountMinor
+			currency := strings.ToUpper(strings.TrimSpace(price.CurrencyCode))
+			if amountMinor == 0 {
+				currency = ""
+			}
 
-		var requests []*resend.SendEmailRequest
-		for _, authTarget := range authTargets {
-			if authTarget.Email == "" {
-				s.Logger.Error("create-event", zap.String("target-id", authTarget.ID), zap.String("target-email", authTarget.Email), zap.Error(errors.New("target has no email")))
-				continue
+			var (
+				pricePaymentAccount *zeni.PaymentAccount
+				priceAccountID      string
+			)
+			if amountMinor > 0 {
+				priceAccountID = paymentAccount.ID
+				pricePaymentAccount = paymentAccount
 			}
-			requests = append(requests, &resend.SendEmailRequest{
-				From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
-				To:      []string{authTarget.Email},
-				Subject: "New event by " + cmt.DisplayName + "!",
-				Html:    htmlStr,
-				Text:    text,
-			})
-		}
-		count := 0
-		s.Logger.Info("send-community-new-event-emails", zap.Int("count", len(requests)))
-		for i := 0; i < len(requests); i += 100 {
-			batch := requests[i:min(i+100, len(requests))]
-			if _, err := s.MailClient.Batch.SendWithContext(context.Background(), batch); err != nil {
-				s.Logger.Error("send-community-new-event-emails", zap.Error(err), zap.Int("batch-size", len(batch)))
-				continue
+
+			saleStartsAt, saleEndsAt := priceSaleWindow(price)
+			if _, err := db.CreatePrice(pricePaymentAccount, &zeni.Price{
+				PriceGroupID:       priceGroup.ID,
+				AmountMinor:        amountMinor,
+				CurrencyCode:       currency,
+				PaymentAccountID:   priceAccountID,
+				SaleStartsAt:       saleStartsAt,
+				SaleEndsAt:         saleEndsAt,
+				Kind:               zeni.PriceKind(price.Kind),
+				MinimumAmountMinor: price.MinimumAmountMinor,
+			}); err != nil {
+				return nil, err
 			}
-			count += len(batch)
-			s.Logger.Info("send-community-new-event-emails", zap.Int("already-sent-count", count), zap.Int("total", len(requests)))
 		}
 	}
 
-	return connect.NewResponse(&zenaov1.CreateEventResponse{
-		Id: evt.ID,
-	}), nil
+	return evt, nil
+}
+
+// seriesOccurrences parses and expands the recurrence rule in the timezone of the event location.
+func seriesOccurrences(rrule string, start time.Time, location *zenaov1.EventLocation) (*zeni.RRule, []time.Time, error) {
+	loc, err := (&zeni.Event{Location: location}).Timezone()
+	if err != nil {
+		return nil, nil, err
+	}
+	rule, err := zeni.ParseRRule(rrule, loc)
+	if err != nil {
+		return nil, nil, err
+	}
+	occurrences, err := rule.Occurrences(start.In(loc))
+	if err != nil {
+		return nil, nil, err
+	}
+	return rule, occurrences, nil
+}
+
+// shiftCreateEventRequest returns a copy of the request moved by offset, price sale windows included.
+func shiftCreateEventRequest(req *zenaov1.CreateEventRequest, offset time.Duration) *zenaov1.CreateEventRequest {
+	shifted := proto.Clone(req).(*zenaov1.CreateEventRequest)
+	shifted.StartDate = uint64(int64(shifted.StartDate) + int64(offset.Seconds()))
+	shifted.EndDate = uint64(int64(shifted.EndDate) + int64(offset.Seconds()))
+	shiftPriceSaleWindows(shifted.PricesGroups, offset)
+	return shifted
+}
+
+func shiftPriceSaleWindows(groups []*zenaov1.EventPriceGroup, offset time.Duration) {
+	for _, group := range groups {
+		for _, price := range group.Prices {
+			if price.SaleStartsAt != 0 {
+				price.SaleStartsAt += int64(offset.Seconds())
+			}
+			if price.SaleEndsAt != 0 {
+				price.SaleEndsAt += int64(offset.Seconds())
+			}
+		}
+	}
 }
 
 func validateEvent(startDate, endDate uint64, title, description string, location *zenaov1.EventLocation, imageURI string, capacity uint32, ticketPrice float64, password string) error {
@@ -335,9 +419,30 @@ func validateEvent(startDate, endDate uint64, title, description string, locatio
 func validatePriceGroups(groups []*zenaov1.EventPriceGroup) error {
 	for _, group := range groups {
 		for _, price := range group.Prices {
+			if price.SaleStartsAt < 0 || price.SaleEndsAt < 0 {
+				return errors.New("price sale window must be positive")
+			}
+			if price.SaleStartsAt != 0 && price.SaleEndsAt != 0 && price.SaleEndsAt <= price.SaleStartsAt {
+				return errors.New("price sale window must end after it starts")
+			}
 			if price.AmountMinor < 0 {
 				return errors.New("amount must be greater or equal to 0")
 			}
+			switch zeni.PriceKind(price.Kind) {
+			case zeni.PriceKindFixed:
+				if price.MinimumAmountMinor != 0 {
+					return errors.New("minimum amount is only allowed for pay-what-you-want prices")
+				}
+			case zeni.PriceKindPayWhatYouWant:
+				if price.AmountMinor == 0 {
+					return errors.New("suggested amount is required for pay-what-you-want prices")
+				}
+				if price.MinimumAmountMinor < 0 || price.MinimumAmountMinor > price.AmountMinor {
+					return errors.New("minimum amount must be between 0 and the suggested amount")
+				}
+			default:
+				return fmt.Errorf("unknown price kind %q", price.Kind)
+			}
 			currency := strings.ToUpper(strings.TrimSpace(price.CurrencyCode))
 			if price.AmountMinor == 0 {
 				if currency != "" {
@@ -356,10 +461,45 @@ func validatePriceGroups(groups []*zenaov1.EventPriceGroup) error {
 	return nil
 }
 
+// priceSaleWindow converts the optional sale window of a price, zero bounds mean unbounded.
+func priceSaleWindow(price *zenaov1.EventPrice) (*time.Time, *time.Time) {
+	var startsAt, endsAt *time.Time
+	if price.SaleStartsAt != 0 {
+		t := time.Unix(price.SaleStartsAt, 0).UTC()
+		startsAt = &t
+	}
+	if price.SaleEndsAt != 0 {
+		t := time.Unix(price.SaleEndsAt, 0).UTC()
+		endsAt = &t
+	}
+	return startsAt, endsAt
+}
+
+var (
+	errInviteOnlyPassword  = errors.New("invite-only events cannot be guarded by a password")
+	errInviteOnlyPaidPrice = errors.New("invite-only events cannot have paid tickets")
+)
+
+// validateInviteOnly checks that an invite-only event is only joined through its invitations,
+// a password or a checkout would let anyone in.
+func validateInviteOnly(inviteOnly bool, password string, groups []*zenaov1.EventPriceGroup) error {
+	if !inviteOnly {
+		return nil
+	}
+	if password != "" {
+		return errInviteOnlyPassword
+	}
+	if hasPaidPrices(groups) {
+		return errInviteOnlyPaidPrice
+	}
+	return nil
+}
+
+// hasPaidPrices reports whether buyers can pay for one of the prices, pay what you want prices included.
 func hasPaidPrices(groups []*zenaov1.EventPriceGroup) bool {
 	for _, group := range groups {
 		for _, price := range group.Prices {
-			if price.AmountMinor > 0 {
+			if !isFreePrice(&zeni.Price{Kind: zeni.PriceKind(price.Kind), AmountMinor: price.AmountMinor}) {
 				return true
 			}
 		}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) CreateEventSession(
+	ctx context.Context,
+	req *connect.Request[zenaov1.CreateEventSessionRequest],
+) (*connect.Response[zenaov1.CreateEventSessionResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("create-event-session", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	var session *zeni.EventSession
+	if err := s.DB.TxWithSpan(ctx, "db.CreateEventSession", func(tx zeni.DB) error {
+		evt, err := organizedEvent(tx, actor.ID(), req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+
+		session, err = eventSessionFromProto(evt, req.Msg.Session)
+		if err != nil {
+			return err
+		}
+
+		session, err = tx.CreateEventSession(session)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.CreateEventSessionResponse{
+		Session: eventSessionToProto(session, false),
+	}), nil
+}
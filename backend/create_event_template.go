@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) CreateEventTemplate(
+	ctx context.Context,
+	req *connect.Request[zenaov1.CreateEventTemplateRequest],
+) (*connect.Response[zenaov1.CreateEventTemplateResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("create-event-template", zap.String("community-id", req.Msg.CommunityId), zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if req.Msg.CommunityId == "" {
+		return nil, errors.New("community id is required")
+	}
+	if req.Msg.EventId == "" && req.Msg.Event == nil {
+		return nil, errors.New("event id or event settings are required")
+	}
+	name, err := validateEventTemplate(req.Msg.Name, req.Msg.DurationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	var template *zeni.EventTemplate
+	if err := s.DB.TxWithSpan(ctx, "db.CreateEventTemplate", func(tx zeni.DB) error {
+		if err := administeredCommunity(tx, actor.ID(), req.Msg.CommunityId); err != nil {
+			return err
+		}
+
+		settings := req.Msg.Event
+		duration := time.Duration(req.Msg.DurationSeconds) * time.Second
+		if req.Msg.EventId != "" {
+			evt, err := organizedEvent(tx, actor.ID(), req.Msg.EventId)
+			if err != nil {
+				return err
+			}
+			if settings, err = eventCreateRequest(tx, evt); err != nil {
+				return err
+			}
+			duration = evt.EndDate.Sub(evt.StartDate)
+		}
+		if err := validatePriceGroups(settings.PricesGroups); err != nil {
+			return fmt.Errorf("invalid price groups: %w", err)
+		}
+		if err := validateRegistrationQuestions(settings.RegistrationQuestions); err != nil {
+			return fmt.Errorf("invalid registration questions: %w", err)
+		}
+		if err := validateReminderOffsets(settings.ReminderOffsets); err != nil {
+			return fmt.Errorf("invalid reminders: %w", err)
+		}
+
+		template, err = tx.CreateEventTemplate(&zeni.EventTemplate{
+			CommunityID: req.Msg.CommunityId,
+			CreatorID:   actor.ID(),
+			Name:        name,
+			Event:       eventTemplateSettings(settings, req.Msg.CommunityId),
+			Duration:    duration,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.CreateEventTemplateResponse{
+		Template: eventTemplateToProto(template),
+	}), nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+var promoCodeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+func (s *ZenaoServer) CreatePromoCode(
+	ctx context.Context,
+	req *connect.Request[zenaov1.CreatePromoCodeRequest],
+) (*connect.Response[zenaov1.CreatePromoCodeResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("create-promo-code", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	promoCode, err := promoCodeFromCreateRequest(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *zeni.PromoCode
+	if err := s.DB.TxWithSpan(ctx, "db.CreatePromoCode", func(db zeni.DB) error {
+		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleOrganizer) {
+			return errors.New("only organizers can create promo codes")
+		}
+
+		priceGroups, err := db.GetPriceGroupsByEvent(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		prices := mapPricesFromGroups(priceGroups)
+		if promoCode.PriceID != "" {
+			price, ok := prices[promoCode.PriceID]
+			if !ok {
+				return fmt.Errorf("price %s not found", promoCode.PriceID)
+			}
+			if promoCode.DiscountType == zeni.PromoCodeDiscountFixed && !strings.EqualFold(price.CurrencyCode, promoCode.CurrencyCode) {
+				return errors.New("promo code currency does not match the price currency")
+			}
+		}
+
+		created, err = db.CreatePromoCode(promoCode)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.CreatePromoCodeResponse{
+		PromoCode: promoCodeToProto(created, 0),
+	}), nil
+}
+
+func promoCodeFromCreateRequest(msg *zenaov1.CreatePromoCodeRequest) (*zeni.PromoCode, error) {
+	if msg.EventId == "" {
+		return nil, errors.New("event id is required")
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(msg.Code))
+	if !promoCodeRegexp.MatchString(code) {
+		return nil, errors.New("promo code must be 3 to 32 letters, digits, dashes or underscores")
+	}
+
+	promoCode := &zeni.PromoCode{
+		EventID:        msg.EventId,
+		Code:           code,
+		DiscountType:   zeni.PromoCodeDiscountType(msg.DiscountType),
+		PriceID:        strings.TrimSpace(msg.PriceId),
+		MaxRedemptions: msg.MaxRedemptions,
+	}
+
+	switch promoCode.DiscountType {
+	case zeni.PromoCodeDiscountPercent:
+		if msg.PercentOff == 0 || msg.PercentOff > 100 {
+			return nil, errors.New("percent off must be between 1 and 100")
+		}
+		if msg.AmountOffMinor != 0 {
+			return nil, errors.New("amount off is not allowed for percent discounts")
+		}
+		promoCode.PercentOff = msg.PercentOff
+	case zeni.PromoCodeDiscountFixed:
+		if msg.AmountOffMinor <= 0 {
+			return nil, errors.New("amount off must be positive")
+		}
+		if msg.PercentOff != 0 {
+			return nil, errors.New("percent off is not allowed for fixed discounts")
+		}
+		currency := strings.ToUpper(strings.TrimSpace(msg.CurrencyCode))
+		if !zeni.IsSupportedStripeCurrency(currency) {
+			return nil, fmt.Errorf("unsupported currency code %q", msg.CurrencyCode)
+		}
+		promoCode.AmountOffMinor = msg.AmountOffMinor
+		promoCode.CurrencyCode = currency
+	default:
+		return nil, fmt.Errorf("unknown discount type %q", msg.DiscountType)
+	}
+
+	if msg.StartsAt < 0 || msg.EndsAt < 0 {
+		return nil, errors.New("validity dates must be positive")
+	}
+	if msg.StartsAt != 0 {
+		promoCode.StartsAt = &msg.StartsAt
+	}
+	if msg.EndsAt != 0 {
+		promoCode.EndsAt = &msg.EndsAt
+	}
+	if msg.StartsAt != 0 && msg.EndsAt != 0 && msg.EndsAt <= msg.StartsAt {
+		return nil, errors.New("promo code must end after it starts")
+	}
+
+	return promoCode, nil
+}
+
+func promoCodeToProto(promoCode *zeni.PromoCode, redemptions uint32) *zenaov1.PromoCode {
+	result := &zenaov1.PromoCode{
+		Id:             promoCode.ID,
+		EventId:        promoCode.EventID,
+		Code:           promoCode.Code,
+		DiscountType:   string(promoCode.DiscountType),
+		PercentOff:     promoCode.PercentOff,
+		AmountOffMinor: promoCode.AmountOffMinor,
+		CurrencyCode:   promoCode.CurrencyCode,
+		PriceId:        promoCode.PriceID,
+		MaxRedemptions: promoCode.MaxRedemptions,
+		Redemptions:    redemptions,
+	}
+	if promoCode.StartsAt != nil {
+		result.StartsAt = *promoCode.StartsAt
+	}
+	if promoCode.EndsAt != nil {
+		result.EndsAt = *promoCode.EndsAt
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoCodePercentDiscountAppliedToCheckout(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	_, err := f.server.CreatePromoCode(context.Background(), connect.NewRequest(&zenaov1.CreatePromoCodeRequest{
+		EventId:      f.eventID,
+		Code:         "early-bird",
+		DiscountType: "percent",
+		PercentOff:   20,
+	}))
+	require.NoError(t, err)
+
+	resp, err := f.startCheckout(f.priceIDs[0], "Early-Bird", "buyer@example.com", "guest@example.com")
+	require.NoError(t, err)
+
+	require.Len(t, *f.sessions, 1)
+	lineItems := (*f.sessions)[0].LineItems
+	require.Len(t, lineItems, 1)
+	require.Equal(t, int64(2000), *lineItems[0].PriceData.UnitAmount)
+	require.Equal(t, int64(2), *lineItems[0].Quantity)
+
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, int64(4000), order.AmountMinor)
+	require.Equal(t, int64(1000), order.DiscountAmountMinor)
+	require.Equal(t, "EARLY-BIRD", order.PromoCode)
+
+	attendees, err := f.db.GetOrderAttendees(resp.OrderId)
+	require.NoError(t, err)
+	for _, attendee := range attendees {
+		require.Equal(t, int64(2000), attendee.AmountMinor)
+	}
+
+	list, err := f.server.ListPromoCodes(context.Background(), connect.NewRequest(&zenaov1.ListPromoCodesRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.PromoCodes, 1)
+	require.Equal(t, uint32(1), list.Msg.PromoCodes[0].Redemptions)
+}
+
+func TestPromoCodeFixedDiscountRestrictedToPrice(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 5000, CurrencyCode: "EUR"},
+	)
+
+	_, err := f.server.CreatePromoCode(context.Background(), connect.NewRequest(&zenaov1.CreatePromoCodeRequest{
+		EventId:        f.eventID,
+		Code:           "PARTNER",
+		DiscountType:   "fixed",
+		AmountOffMinor: 1500,
+		CurrencyCode:   "EUR",
+		PriceId:        f.priceIDs[1],
+	}))
+	require.NoError(t, err)
+
+	_, err = f.startCheckout(f.priceIDs[0], "PARTNER", "buyer@example.com")
+	require.ErrorContains(t, err, "does not apply")
+
+	resp, err := f.startCheckout(f.priceIDs[1], "PARTNER", "buyer@example.com")
+	require.NoError(t, err)
+
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, int64(3500), order.AmountMinor)
+	require.Equal(t, int64(1500), order.DiscountAmountMinor)
+}
+
+func TestPromoCodeLimits(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	now := time.Now().Unix()
+	for _, req := range []*zenaov1.CreatePromoCodeRequest{
+		{EventId: f.eventID, Code: "ONCE", DiscountType: "percent", PercentOff: 10, MaxRedemptions: 1},
+		{EventId: f.eventID, Code: "LATER", DiscountType: "percent", PercentOff: 10, StartsAt: now + 3600},
+		{EventId: f.eventID, Code: "GONE", DiscountType: "percent", PercentOff: 10, StartsAt: now - 7200, EndsAt: now - 3600},
+		{EventId: f.eventID, Code: "FREE", DiscountType: "percent", PercentOff: 100},
+	} {
+		_, err := f.server.CreatePromoCode(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+	}
+
+	_, err := f.server.CreatePromoCode(context.Background(), connect.NewRequest(&zenaov1.CreatePromoCodeRequest{
+		EventId: f.eventID, Code: "once", DiscountType: "percent", PercentOff: 10,
+	}))
+	require.Error(t, err)
+
+	_, err = f.startCheckout(f.priceIDs[0], "ONCE", "buyer@example.com")
+	require.NoError(t, err)
+	_, err = f.startCheckout(f.priceIDs[0], "ONCE", "guest@example.com")
+	require.ErrorContains(t, err, "usage limit")
+
+	_, err = f.startCheckout(f.priceIDs[0], "LATER", "guest@example.com")
+	require.ErrorContains(t, err, "not active yet")
+	_, err = f.startCheckout(f.priceIDs[0], "GONE", "guest@example.com")
+	require.ErrorContains(t, err, "expired")
+	_, err = f.startCheckout(f.priceIDs[0], "FREE", "guest@example.com")
+	require.Error(t, err)
+	_, err = f.startCheckout(f.priceIDs[0], "UNKNOWN", "guest@example.com")
+	require.ErrorContains(t, err, "invalid promo code")
+}
+
+func TestPromoCodeRejectsDiscountBelowMinimumCharge(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	for _, req := range []*zenaov1.CreatePromoCodeRequest{
+		{EventId: f.eventID, Code: "ALMOST", DiscountType: "percent", PercentOff: 99},
+		{EventId: f.eventID, Code: "BIGCUT", DiscountType: "fixed", AmountOffMinor: 2460, CurrencyCode: "EUR"},
+		{EventId: f.eventID, Code: "CUT", DiscountType: "fixed", AmountOffMinor: 2450 - 1, CurrencyCode: "EUR"},
+	} {
+		_, err := f.server.CreatePromoCode(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+	}
+
+	_, err := f.startCheckout(f.priceIDs[0], "ALMOST", "buyer@example.com")
+	require.ErrorContains(t, err, "below the minimum")
+	_, err = f.startCheckout(f.priceIDs[0], "BIGCUT", "buyer@example.com")
+	require.ErrorContains(t, err, "below the minimum")
+	require.Empty(t, *f.sessions)
+
+	resp, err := f.startCheckout(f.priceIDs[0], "CUT", "buyer@example.com")
+	require.NoError(t, err)
+	require.Equal(t, int64(51), *(*f.sessions)[0].LineItems[0].PriceData.UnitAmount)
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, int64(51), order.AmountMinor)
+}
+
+func TestCreatePromoCodeRequiresOrganizer(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	f.auth.user = f.auth.ensureAuthUser("buyer@example.com")
+	_, err := f.db.CreateUser(f.auth.user.ID)
+	require.NoError(t, err)
+
+	_, err = f.server.CreatePromoCode(context.Background(), connect.NewRequest(&zenaov1.CreatePromoCodeRequest{
+		EventId:      f.eventID,
+		Code:         "EARLY",
+		DiscountType: "percent",
+		PercentOff:   20,
+	}))
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+// DeclineInvitation consumes the token of an invitation the recipient can't attend.
+// Signing in is optional, the declining user is recorded when there is one.
+func (s *ZenaoServer) DeclineInvitation(
+	ctx context.Context,
+	req *connect.Request[zenaov1.DeclineInvitationRequest],
+) (*connect.Response[zenaov1.DeclineInvitationResponse], error) {
+	actor, err := s.GetOptionalActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+	userID := ""
+	if actor != nil {
+		userID = actor.ID()
+	}
+
+	var invitation *zeni.EventInvitation
+	if err := s.DB.TxWithSpan(ctx, "db.DeclineInvitation", func(tx zeni.DB) error {
+		invitation, err = s.verifyInvitationToken(tx, req.Msg.Token)
+		if err != nil {
+			return err
+		}
+		declined, err := tx.RespondEventInvitation(invitation.ID, zeni.EventInvitationStatusDeclined, userID, time.Now().Unix())
+		if err != nil {
+			return err
+		}
+		if !declined {
+			return errors.New("invitation was already answered")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("decline-invitation", zap.String("invitation-id", invitation.ID), zap.String("event-id", invitation.EventID), zap.String("user-id", userID))
+
+	return connect.NewResponse(&zenaov1.DeclineInvitationResponse{}), nil
+}
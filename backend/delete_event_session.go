@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) DeleteEventSession(
+	ctx context.Context,
+	req *connect.Request[zenaov1.DeleteEventSessionRequest],
+) (*connect.Response[zenaov1.DeleteEventSessionResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("delete-event-session", zap.String("session-id", req.Msg.SessionId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if err := s.DB.TxWithSpan(ctx, "db.DeleteEventSession", func(tx zeni.DB) error {
+		session, _, err := organizedEventSession(tx, actor.ID(), req.Msg.SessionId)
+		if err != nil {
+			return err
+		}
+		return tx.DeleteEventSession(session.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.DeleteEventSessionResponse{}), nil
+}
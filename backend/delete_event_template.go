@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) DeleteEventTemplate(
+	ctx context.Context,
+	req *connect.Request[zenaov1.DeleteEventTemplateRequest],
+) (*connect.Response[zenaov1.DeleteEventTemplateResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("delete-event-template", zap.String("template-id", req.Msg.TemplateId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if err := s.DB.TxWithSpan(ctx, "db.DeleteEventTemplate", func(tx zeni.DB) error {
+		template, err := tx.GetEventTemplate(req.Msg.TemplateId)
+		if err != nil {
+			return err
+		}
+		if template == nil {
+			return errors.New("template not found")
+		}
+		if err := administeredCommunity(tx, actor.ID(), template.CommunityID); err != nil {
+			return err
+		}
+		return tx.DeleteEventTemplate(template.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.DeleteEventTemplateResponse{}), nil
+}
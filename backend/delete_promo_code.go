@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) DeletePromoCode(
+	ctx context.Context,
+	req *connect.Request[zenaov1.DeletePromoCodeRequest],
+) (*connect.Response[zenaov1.DeletePromoCodeResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("delete-promo-code", zap.String("promo-code-id", req.Msg.PromoCodeId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if err := s.DB.TxWithSpan(ctx, "db.DeletePromoCode", func(db zeni.DB) error {
+		promoCode, err := db.GetPromoCode(req.Msg.PromoCodeId)
+		if err != nil {
+			return err
+		}
+		if promoCode == nil {
+			return errors.New("promo code not found")
+		}
+
+		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, promoCode.EventID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleOrganizer) {
+			return errors.New("only organizers can delete promo codes")
+		}
+
+		// orders keep the code they redeemed, deleting only stops new redemptions
+		return db.DeletePromoCode(promoCode.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.DeletePromoCodeResponse{}), nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) DuplicateEvent(
+	ctx context.Context,
+	req *connect.Request[zenaov1.DuplicateEventRequest],
+) (*connect.Response[zenaov1.DuplicateEventResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("duplicate-event", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if req.Msg.StartDate == 0 {
+		return nil, errors.New("start date is required")
+	}
+
+	var evt *zeni.Event
+	if err := s.DB.TxWithSpan(ctx, "db.DuplicateEvent", func(tx zeni.DB) error {
+		src, err := organizedEvent(tx, actor.ID(), req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+
+		createReq, err := eventCreateRequest(tx, src)
+		if err != nil {
+			return err
+		}
+		createReq = shiftCreateEventRequest(createReq, time.Unix(int64(req.Msg.StartDate), 0).Sub(src.StartDate))
+		// the copy is a draft until its organizers review and publish it
+		createReq.Draft = true
+		if err := validateEvent(createReq.StartDate, createReq.EndDate, createReq.Title, createReq.Description, createReq.Location, createReq.ImageUri, createReq.Capacity, createReq.TicketPrice, ""); err != nil {
+			return fmt.Errorf("invalid input: %w", err)
+		}
+
+		organizers, err := tx.GetOrgUsersWithRoles(zeni.EntityTypeEvent, src.ID, []string{zeni.RoleOrganizer})
+		if err != nil {
+			return err
+		}
+		organizersIDs := []string{actor.ID()}
+		for _, organizer := range organizers {
+			if !slices.Contains(organizersIDs, organizer.ID) {
+				organizersIDs = append(organizersIDs, organizer.ID)
+			}
+		}
+		gatekeepers, err := tx.GetOrgUsersWithRoles(zeni.EntityTypeEvent, src.ID, []string{zeni.RoleGatekeeper})
+		if err != nil {
+			return err
+		}
+		gatekeepersIDs := make([]string, 0, len(gatekeepers))
+		for _, gatekeeper := range gatekeepers {
+			gatekeepersIDs = append(gatekeepersIDs, gatekeeper.ID)
+		}
+
+		var cmt *zeni.Community
+		if createReq.CommunityId != "" {
+			if err := administeredCommunity(tx, actor.ID(), createReq.CommunityId); err != nil {
+				return err
+			}
+			if cmt, err = tx.GetCommunity(createReq.CommunityId); err != nil {
+				return err
+			}
+		}
+
+		var paymentAccount *zeni.PaymentAccount
+		if hasPaidPrices(createReq.PricesGroups) {
+			paymentAccount, err = tx.GetPaymentAccountByCommunityPlatform(createReq.CommunityId, zeni.PaymentPlatformStripeConnect)
+			if err != nil {
+				return errors.New("payment account is required for paid events")
+			}
+		}
+
+		evt, err = createEventOccurrence(tx, actor.ID(), organizersIDs, gatekeepersIDs, createReq, cmt, paymentAccount)
+		if err != nil {
+			return err
+		}
+
+		if src.PasswordHash != "" {
+			if err := tx.SetEventPasswordHash(evt.ID, src.PasswordHash); err != nil {
+				return err
+			}
+			evt.PasswordHash = src.PasswordHash
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.DuplicateEventResponse{Id: evt.ID}), nil
+}
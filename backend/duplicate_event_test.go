@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateEventShiftsDates(t *testing.T) {
+	saleEndsAt := time.Now().Add(48 * time.Hour).Unix()
+	f := setupPaidEventFixture(t, &zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR", SaleEndsAt: saleEndsAt})
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	src, err := f.db.GetEvent(f.eventID)
+	require.NoError(t, err)
+	offset := 7 * 24 * time.Hour
+	duplicated, err := f.server.DuplicateEvent(context.Background(), connect.NewRequest(&zenaov1.DuplicateEventRequest{
+		EventId:   f.eventID,
+		StartDate: uint64(src.StartDate.Add(offset).Unix()),
+	}))
+	require.NoError(t, err)
+	require.NotEqual(t, f.eventID, duplicated.Msg.Id)
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: duplicated.Msg.Id}))
+	require.NoError(t, err)
+	evt := got.Msg.Event
+	require.Equal(t, src.Title, evt.Title)
+	require.Equal(t, src.Description, evt.Description)
+	require.Equal(t, src.StartDate.Add(offset).Unix(), evt.StartDate)
+	require.Equal(t, src.EndDate.Add(offset).Unix(), evt.EndDate)
+	require.True(t, evt.Discoverable)
+	require.True(t, evt.Draft)
+	require.Len(t, evt.Organizers, 1)
+	require.Len(t, evt.PricesGroups, 1)
+	require.Len(t, evt.PricesGroups[0].Prices, 1)
+	require.Equal(t, int64(2500), evt.PricesGroups[0].Prices[0].AmountMinor)
+	require.Equal(t, saleEndsAt+int64(offset.Seconds()), evt.PricesGroups[0].Prices[0].SaleEndsAt)
+
+	duplicatedCommunity, err := f.db.GetEventCommunity(duplicated.Msg.Id)
+	require.NoError(t, err)
+	require.Equal(t, community.ID, duplicatedCommunity.ID)
+
+	_, err = f.server.DuplicateEvent(context.Background(), connect.NewRequest(&zenaov1.DuplicateEventRequest{EventId: f.eventID}))
+	require.Error(t, err)
+}
+
+func TestCreateEventFromTemplate(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	created, err := f.server.CreateEventTemplate(context.Background(), connect.NewRequest(&zenaov1.CreateEventTemplateRequest{
+		CommunityId: community.ID,
+		Name:        "Monthly meetup",
+		EventId:     f.eventID,
+	}))
+	require.NoError(t, err)
+	template := created.Msg.Template
+	require.Equal(t, uint32(3*3600), template.DurationSeconds)
+	require.Zero(t, template.Event.StartDate)
+	require.Equal(t, community.ID, template.Event.CommunityId)
+
+	_, err = f.server.CreateEventTemplate(context.Background(), connect.NewRequest(&zenaov1.CreateEventTemplateRequest{
+		CommunityId: community.ID,
+		Name:        " ",
+		EventId:     f.eventID,
+	}))
+	require.Error(t, err)
+
+	listed, err := f.server.ListEventTemplates(context.Background(), connect.NewRequest(&zenaov1.ListEventTemplatesRequest{CommunityId: community.ID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Templates, 1)
+	require.Equal(t, "Monthly meetup", listed.Msg.Templates[0].Name)
+
+	start := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Hour)
+	fromTemplate, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		TemplateId: template.Id,
+		Title:      "October meetup",
+		StartDate:  uint64(start.Unix()),
+	}))
+	require.NoError(t, err)
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: fromTemplate.Msg.Id}))
+	require.NoError(t, err)
+	require.Equal(t, "October meetup", got.Msg.Event.Title)
+	require.Equal(t, "test description", got.Msg.Event.Description)
+	require.Equal(t, start.Add(3*time.Hour).Unix(), got.Msg.Event.EndDate)
+	require.Len(t, got.Msg.Event.PricesGroups, 1)
+	fromTemplateCommunity, err := f.db.GetEventCommunity(fromTemplate.Msg.Id)
+	require.NoError(t, err)
+	require.Equal(t, community.ID, fromTemplateCommunity.ID)
+
+	_, err = f.server.DeleteEventTemplate(context.Background(), connect.NewRequest(&zenaov1.DeleteEventTemplateRequest{TemplateId: template.Id}))
+	require.NoError(t, err)
+	listed, err = f.server.ListEventTemplates(context.Background(), connect.NewRequest(&zenaov1.ListEventTemplatesRequest{CommunityId: community.ID}))
+	require.NoError(t, err)
+	require.Empty(t, listed.Msg.Templates)
+
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		TemplateId: template.Id,
+		StartDate:  uint64(start.Unix()),
+	}))
+	require.Error(t, err)
+}
@@ -88,7 +88,7 @@ func execE2EInfra() error {
 	}
 
 	{
-		args := []string{"go", "run", "./backend", "fakegen", "--events", "10", "--communities", "5", "--db", dbPath}
+		args := []string{"go", "run", "-tags", "sqlite_fts5", "./backend", "fakegen", "--events", "10", "--communities", "5", "--payment-accounts", "--db", dbPath}
 		if err := runCommand(ctx, "fakegen", "#317738", args); err != nil {
 			return err
 		}
@@ -102,7 +102,7 @@ func execE2EInfra() error {
 				return err
 			}
 
-			args := []string{"go", "run", "./backend", "start", "--db", dbCopyPath}
+			args := []string{"go", "run", "-tags", "sqlite_fts5", "./backend", "start", "--db", dbCopyPath, "--payment-provider", "fake"}
 			wg.Add(1)
 			go func() {
 				defer func() { wg.Done(); backendDone <- struct{}{} }()
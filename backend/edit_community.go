@@ -24,6 +24,11 @@ func (s *ZenaoServer) EditCommunity(ctx context.Context, req *connect.Request[ze
 	if err := validateCommunity(req.Msg.DisplayName, req.Msg.Description, req.Msg.AvatarUri, req.Msg.BannerUri); err != nil {
 		return nil, fmt.Errorf("invalid input: %w", err)
 	}
+	if req.Msg.UpdateTags {
+		if req.Msg.Tags, err = validateTaxonomy(req.Msg.Category, req.Msg.Tags); err != nil {
+			return nil, fmt.Errorf("invalid tags: %w", err)
+		}
+	}
 
 	authAdmins, err := s.Auth.EnsureUsersExists(ctx, req.Msg.Administrators)
 	if err != nil {
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+// maxTaxRateBps caps the tax rate of a community at 100%.
+const maxTaxRateBps = 10000
+
+func (s *ZenaoServer) EditCommunityLegalDetails(
+	ctx context.Context,
+	req *connect.Request[zenaov1.EditCommunityLegalDetailsRequest],
+) (*connect.Response[zenaov1.EditCommunityLegalDetailsResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("edit-community-legal-details", zap.String("community-id", req.Msg.CommunityId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if req.Msg.CommunityId == "" {
+		return nil, errors.New("community id is required")
+	}
+	details := req.Msg.Details
+	if details == nil {
+		return nil, errors.New("legal details are required")
+	}
+	if err := validateCommunityLegalDetails(details); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	if err := s.DB.TxWithSpan(ctx, "db.EditCommunityLegalDetails", func(tx zeni.DB) error {
+		roles, err := tx.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeCommunity, req.Msg.CommunityId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleAdministrator) {
+			return errors.New("you must be an administrator of the community to edit it")
+		}
+
+		return tx.EditCommunityLegalDetails(
+			req.Msg.CommunityId,
+			strings.TrimSpace(details.LegalName),
+			strings.TrimSpace(details.LegalAddress),
+			strings.TrimSpace(details.TaxId),
+			details.TaxRateBps,
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.EditCommunityLegalDetailsResponse{}), nil
+}
+
+func validateCommunityLegalDetails(details *zenaov1.CommunityLegalDetails) error {
+	if len(details.LegalName) > 140 {
+		return errors.New("legal name must be length lte 140")
+	}
+	if len(details.LegalAddress) > 400 {
+		return errors.New("legal address must be length lte 400")
+	}
+	if len(details.TaxId) > 64 {
+		return errors.New("tax id must be length lte 64")
+	}
+	if details.TaxRateBps > maxTaxRateBps {
+		return errors.New("tax rate must be lte 100%")
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
 	"github.com/samouraiworld/zenao/backend/zeni"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *ZenaoServer) EditEvent(
@@ -74,200 +75,98 @@ func (s *ZenaoServer) EditEvent(
 	if err := validatePriceGroups(req.Msg.PricesGroups); err != nil {
 		return nil, fmt.Errorf("invalid price groups: %w", err)
 	}
+	if err := validateRegistrationQuestions(req.Msg.RegistrationQuestions); err != nil {
+		return nil, fmt.Errorf("invalid registration questions: %w", err)
+	}
+	if req.Msg.UpdateReminderOffsets {
+		if err := validateReminderOffsets(req.Msg.ReminderOffsets); err != nil {
+			return nil, fmt.Errorf("invalid reminders: %w", err)
+		}
+	}
+	if req.Msg.UpdateTags {
+		if req.Msg.Tags, err = validateTaxonomy(req.Msg.Category, req.Msg.Tags); err != nil {
+			return nil, fmt.Errorf("invalid tags: %w", err)
+		}
+	}
 	if hasPaidPrices(req.Msg.PricesGroups) && req.Msg.CommunityId == "" {
 		return nil, errors.New("community is required for paid events")
 	}
-
 	var (
-		targets      []*zeni.User
-		participants []*zeni.User
-		targetIDs    = make(map[string]bool)
-		cmt          *zeni.Community
-		newCmt       *zeni.Community
-		evt          *zeni.Event
+		edited    *editEventResult
+		seriesID  string
+		editedIDs []string
+		before    []*zeni.Event
 	)
 	if err := s.DB.TxWithSpan(ctx, "db.EditEvent", func(db zeni.DB) error {
-		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+		current, err := db.GetEvent(req.Msg.EventId)
 		if err != nil {
 			return err
 		}
-		if !slices.Contains(roles, zeni.RoleOrganizer) {
-			return errors.New("user is not organizer of the event")
+		before = []*zeni.Event{current}
+		inviteOnly := current.InviteOnly
+		if req.Msg.UpdateInviteOnly {
+			inviteOnly = req.Msg.InviteOnly
 		}
-
-		if cmt, err = db.GetEventCommunity(req.Msg.EventId); err != nil {
+		if err := validateInviteOnly(inviteOnly, req.Msg.Password, req.Msg.PricesGroups); err != nil {
 			return err
 		}
-
-		if cmt != nil && cmt.ID != req.Msg.CommunityId {
-			if err = db.RemoveEventFromCommunity(req.Msg.EventId, cmt.ID); err != nil {
-				return err
-			}
+		if inviteOnly && !req.Msg.UpdatePassword && current.PasswordHash != "" {
+			return errInviteOnlyPassword
 		}
-
-		if req.Msg.CommunityId != "" && (cmt == nil || req.Msg.CommunityId != cmt.ID) {
-			newCmt, err = db.GetCommunity(req.Msg.CommunityId)
-			if err != nil {
-				return err
-			}
-			entityRoles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeCommunity, req.Msg.CommunityId)
-			if err != nil {
-				return err
-			}
-			if !slices.Contains(entityRoles, zeni.RoleAdministrator) {
-				return errors.New("user is not administrator of the community")
-			}
-			if err = db.AddEventToCommunity(req.Msg.EventId, req.Msg.CommunityId); err != nil {
-				return err
-			}
-			targets, err = db.GetOrgUsersWithRoles(zeni.EntityTypeCommunity, req.Msg.CommunityId, []string{zeni.RoleMember})
+		// the current prices are kept when the request has none, they must not let anyone buy their way in
+		if inviteOnly && len(req.Msg.PricesGroups) == 0 {
+			groups, err := db.GetPriceGroupsByEvent(current.ID)
 			if err != nil {
 				return err
 			}
-			participants, err = db.GetOrgUsersWithRoles(zeni.EntityTypeEvent, req.Msg.EventId, []string{zeni.RoleParticipant})
-			if err != nil {
-				return err
-			}
-
-			for _, target := range targets {
-				targetIDs[target.ID] = true
-			}
-
-			for _, participant := range participants {
-				if !targetIDs[participant.ID] {
-					if err := db.AddMemberToCommunity(req.Msg.CommunityId, participant.ID); err != nil {
-						return err
+			for _, group := range groups {
+				for _, price := range group.Prices {
+					if !isFreePrice(price) {
+						return errInviteOnlyPaidPrice
 					}
 				}
 			}
 		}
-
-		if evt, err = db.EditEvent(req.Msg.EventId, organizersIDs, gatekeepersIDs, req.Msg); err != nil {
-			return err
+		if current.SeriesID == "" || req.Msg.SeriesScope == zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS {
+			edited, err = editEventTx(db, actor.ID(), organizersIDs, gatekeepersIDs, req.Msg)
+			if err != nil {
+				return err
+			}
+			seriesID = current.SeriesID
+			editedIDs = []string{req.Msg.EventId}
+			return nil
 		}
 
-		priceGroups, err := db.GetPriceGroupsByEvent(req.Msg.EventId)
-		if err != nil {
+		if before, err = db.ListSeriesEvents(current.SeriesID); err != nil {
 			return err
 		}
+		edited, seriesID, editedIDs, err = editSeriesTx(db, actor.ID(), organizersIDs, gatekeepersIDs, current, req.Msg)
+		return err
+	}); err != nil {
+		return nil, err
+	}
 
-		priceGroupsByID := make(map[string]*zeni.PriceGroup, len(priceGroups))
-		for _, group := range priceGroups {
-			priceGroupsByID[group.ID] = group
+	for _, previous := range before {
+		if !slices.Contains(editedIDs, previous.ID) {
+			continue
 		}
-
-		for _, group := range priceGroups {
-			// FIXME: capacity should not be tied to the event capacity
-			if err := db.UpdatePriceGroupCapacity(group.ID, req.Msg.Capacity); err != nil {
-				return err
-			}
+		if err := s.notifyEventChanges(ctx, previous); err != nil {
+			s.Logger.Error("notify-event-changes", zap.Error(err), zap.String("event-id", previous.ID))
 		}
+	}
 
-		if len(req.Msg.PricesGroups) > 0 {
-			var (
-				paymentAccount   *zeni.PaymentAccount
-				paymentAccountID string
-			)
-
-			if hasPaidPrices(req.Msg.PricesGroups) {
-				paymentAccount, err = db.GetPaymentAccountByCommunityPlatform(req.Msg.CommunityId, zeni.PaymentPlatformStripeConnect)
-				if err != nil {
-					return errors.New("payment account is required for paid events")
-				}
-				paymentAccountID = paymentAccount.ID
-			}
-
-			for idx, group := range req.Msg.PricesGroups {
-				var targetGroup *zeni.PriceGroup
-				groupID := strings.TrimSpace(group.Id)
-				if groupID != "" {
-					var ok bool
-					targetGroup, ok = priceGroupsByID[groupID]
-					if !ok {
-						return errors.New("price group not found")
-					}
-				} else if idx < len(priceGroups) {
-					targetGroup = priceGroups[idx]
-				} else {
-					targetGroup, err = db.CreatePriceGroup(req.Msg.EventId, req.Msg.Capacity)
-					if err != nil {
-						return err
-					}
-					priceGroups = append(priceGroups, targetGroup)
-					priceGroupsByID[targetGroup.ID] = targetGroup
-				}
-
-				if len(group.Prices) == 0 {
-					continue
-				}
-
-				existingPrices := targetGroup.Prices
-				existingPricesByID := make(map[string]*zeni.Price, len(existingPrices))
-				for _, existingPrice := range existingPrices {
-					existingPricesByID[existingPrice.ID] = existingPrice
-				}
-				for priceIdx, price := range group.Prices {
-					amountMinor := price.AmountMinor
-					currency := strings.ToUpper(strings.TrimSpace(price.CurrencyCode))
-					if amountMinor == 0 {
-						currency = ""
-					}
-
-					var (
-						pricePaymentAccount *zeni.PaymentAccount
-						priceAccountID      string
-					)
-					if amountMinor > 0 {
-						priceAccountID = paymentAccountID
-						pricePaymentAccount = paymentAccount
-					}
-
-					priceID := strings.TrimSpace(price.Id)
-					if priceID != "" {
-						existingPrice, ok := existingPricesByID[priceID]
-						if !ok {
-							return errors.New("price not found")
-						}
-						if err := db.UpdatePrice(pricePaymentAccount, &zeni.Price{
-							ID:               existingPrice.ID,
-							PriceGroupID:     targetGroup.ID,
-							AmountMinor:      amountMinor,
-							CurrencyCode:     currency,
-							PaymentAccountID: priceAccountID,
-						}); err != nil {
-							return err
-						}
-					} else if priceIdx < len(existingPrices) {
-						if err := db.UpdatePrice(pricePaymentAccount, &zeni.Price{
-							ID:               existingPrices[priceIdx].ID,
-							PriceGroupID:     targetGroup.ID,
-							AmountMinor:      amountMinor,
-							CurrencyCode:     currency,
-							PaymentAccountID: priceAccountID,
-						}); err != nil {
-							return err
-						}
-					} else {
-						if _, err := db.CreatePrice(pricePaymentAccount, &zeni.Price{
-							PriceGroupID:     targetGroup.ID,
-							AmountMinor:      amountMinor,
-							CurrencyCode:     currency,
-							PaymentAccountID: priceAccountID,
-						}); err != nil {
-							return err
-						}
-					}
-
-					// TODO: delete unused prices
-				}
-				// TODO: delete unused price groups
-			}
+	// a raised capacity frees spots for the waitlist
+	for _, eventID := range editedIDs {
+		if err := s.offerWaitlistSpots(ctx, eventID, time.Now()); err != nil {
+			s.Logger.Error("offer-waitlist-spots", zap.Error(err), zap.String("event-id", eventID))
 		}
+	}
 
-		return nil
-	}); err != nil {
-		return nil, err
+	res := &zenaov1.EditEventResponse{
+		Id:       req.Msg.EventId,
+		SeriesId: seriesID,
 	}
+	evt, newCmt, targets, participants := edited.evt, edited.newCmt, edited.targets, edited.participants
 
 	if newCmt != nil && time.Now().Add(24*time.Hour).Before(evt.StartDate) && req.Msg.CommunityEmail && s.MailClient != nil {
 		participantsIDS := make(map[string]bool)
@@ -286,9 +185,7 @@ func (s *ZenaoServer) EditEvent(
 			}
 		}
 		if len(authIDs) == 0 {
-			return connect.NewResponse(&zenaov1.EditEventResponse{
-				Id: req.Msg.EventId,
-			}), nil
+			return connect.NewResponse(res), nil
 		}
 		authTargets, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
 		if err != nil {
@@ -328,7 +225,396 @@ func (s *ZenaoServer) EditEvent(
 
 	}
 
-	return connect.NewResponse(&zenaov1.EditEventResponse{
-		Id: req.Msg.EventId,
-	}), nil
+	return connect.NewResponse(res), nil
+}
+
+// editEventResult holds what the community emails of an edit need.
+type editEventResult struct {
+	evt          *zeni.Event
+	newCmt       *zeni.Community
+	targets      []*zeni.User
+	participants []*zeni.User
+}
+
+// editEventTx applies the edit to a single event, its community link and its price groups.
+func editEventTx(db zeni.DB, actorID string, organizersIDs []string, gatekeepersIDs []string, req *zenaov1.EditEventRequest) (*editEventResult, error) {
+	res := &editEventResult{}
+	roles, err := db.EntityRoles(zeni.EntityTypeUser, actorID, zeni.EntityTypeEvent, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("user is not organizer of the event")
+	}
+
+	cmt, err := db.GetEventCommunity(req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmt != nil && cmt.ID != req.CommunityId {
+		if err = db.RemoveEventFromCommunity(req.EventId, cmt.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.CommunityId != "" && (cmt == nil || req.CommunityId != cmt.ID) {
+		res.newCmt, err = db.GetCommunity(req.CommunityId)
+		if err != nil {
+			return nil, err
+		}
+		entityRoles, err := db.EntityRoles(zeni.EntityTypeUser, actorID, zeni.EntityTypeCommunity, req.CommunityId)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(entityRoles, zeni.RoleAdministrator) {
+			return nil, errors.New("user is not administrator of the community")
+		}
+		if err = db.AddEventToCommunity(req.EventId, req.CommunityId); err != nil {
+			return nil, err
+		}
+		res.targets, err = db.GetOrgUsersWithRoles(zeni.EntityTypeCommunity, req.CommunityId, []string{zeni.RoleMember})
+		if err != nil {
+			return nil, err
+		}
+		res.participants, err = db.GetOrgUsersWithRoles(zeni.EntityTypeEvent, req.EventId, []string{zeni.RoleParticipant})
+		if err != nil {
+			return nil, err
+		}
+
+		targetIDs := make(map[string]bool, len(res.targets))
+		for _, target := range res.targets {
+			targetIDs[target.ID] = true
+		}
+
+		for _, participant := range res.participants {
+			if !targetIDs[participant.ID] {
+				if err := db.AddMemberToCommunity(req.CommunityId, participant.ID); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if res.evt, err = db.EditEvent(req.EventId, organizersIDs, gatekeepersIDs, req); err != nil {
+		return nil, err
+	}
+
+	if req.UpdateRegistrationQuestions {
+		if err := db.SetRegistrationQuestions(req.EventId, registrationQuestionsFromProto(req.RegistrationQuestions)); err != nil {
+			return nil, err
+		}
+	}
+
+	priceGroups, err := db.GetPriceGroupsByEvent(req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	priceGroupsByID := make(map[string]*zeni.PriceGroup, len(priceGroups))
+	for _, group := range priceGroups {
+		priceGroupsByID[group.ID] = group
+	}
+
+	for _, group := range priceGroups {
+		// FIXME: capacity should not be tied to the event capacity
+		if err := db.UpdatePriceGroupCapacity(group.ID, req.Capacity); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.PricesGroups) > 0 {
+		var (
+			paymentAccount   *zeni.PaymentAccount
+			paymentAccountID string
+		)
+
+		if hasPaidPrices(req.PricesGroups) {
+			paymentAccount, err = db.GetPaymentAccountByCommunityPlatform(req.CommunityId, zeni.PaymentPlatformStripeConnect)
+			if err != nil {
+				return nil, errors.New("payment account is required for paid events")
+			}
+			paymentAccountID = paymentAccount.ID
+		}
+
+		for idx, group := range req.PricesGroups {
+			var targetGroup *zeni.PriceGroup
+			groupID := strings.TrimSpace(group.Id)
+			if groupID != "" {
+				var ok bool
+				targetGroup, ok = priceGroupsByID[groupID]
+				if !ok {
+					return nil, errors.New("price group not found")
+				}
+			} else if idx < len(priceGroups) {
+				targetGroup = priceGroups[idx]
+			} else {
+				targetGroup, err = db.CreatePriceGroup(req.EventId, req.Capacity)
+				if err != nil {
+					return nil, err
+				}
+				priceGroups = append(priceGroups, targetGroup)
+				priceGroupsByID[targetGroup.ID] = targetGroup
+			}
+
+			if len(group.Prices) == 0 {
+				continue
+			}
+
+			existingPrices := targetGroup.Prices
+			existingPricesByID := make(map[string]*zeni.Price, len(existingPrices))
+			for _, existingPrice := range existingPrices {
+				existingPricesByID[existingPrice.ID] = existingPrice
+			}
+			for priceIdx, price := range group.Prices {
+				amountMinor := price.AmountMinor
+				currency := strings.ToUpper(strings.TrimSpace(price.CurrencyCode))
+				if amountMinor == 0 {
+					currency = ""
+				}
+
+				var (
+					pricePaymentAccount *zeni.PaymentAccount
+					priceAccountID      string
+				)
+				if amountMinor > 0 {
+					priceAccountID = paymentAccountID
+					pricePaymentAccount = paymentAccount
+				}
+
+				saleStartsAt, saleEndsAt := priceSaleWindow(price)
+				priceID := strings.TrimSpace(price.Id)
+				if priceID != "" {
+					existingPrice, ok := existingPricesByID[priceID]
+					if !ok {
+						return nil, errors.New("price not found")
+					}
+					if err := db.UpdatePrice(pricePaymentAccount, &zeni.Price{
+						ID:                 existingPrice.ID,
+						PriceGroupID:       targetGroup.ID,
+						AmountMinor:        amountMinor,
+						CurrencyCode:       currency,
+						PaymentAccountID:   priceAccountID,
+						SaleStartsAt:       saleStartsAt,
+						SaleEndsAt:         saleEndsAt,
+						Kind:               zeni.PriceKind(price.Kind),
+						MinimumAmountMinor: price.MinimumAmountMinor,
+					}); err != nil {
+						return nil, err
+					}
+				} else if priceIdx < len(existingPrices) {
+					if err := db.UpdatePrice(pricePaymentAccount, &zeni.Price{
+						ID:                 existingPrices[priceIdx].ID,
+						PriceGroupID:       targetGroup.ID,
+						AmountMinor:        amountMinor,
+						CurrencyCode:       currency,
+						PaymentAccountID:   priceAccountID,
+						SaleStartsAt:       saleStartsAt,
+						SaleEndsAt:         saleEndsAt,
+						Kind:               zeni.PriceKind(price.Kind),
+						MinimumAmountMinor: price.MinimumAmountMinor,
+					}); err != nil {
+						return nil, err
+					}
+				} else {
+					if _, err := db.CreatePrice(pricePaymentAccount, &zeni.Price{
+						PriceGroupID:       targetGroup.ID,
+						AmountMinor:        amountMinor,
+						CurrencyCode:       currency,
+						PaymentAccountID:   priceAccountID,
+						SaleStartsAt:       saleStartsAt,
+						SaleEndsAt:         saleEndsAt,
+						Kind:               zeni.PriceKind(price.Kind),
+						MinimumAmountMinor: price.MinimumAmountMinor,
+					}); err != nil {
+						return nil, err
+					}
+				}
+
+				// TODO: delete unused prices
+			}
+			// TODO: delete unused price groups
+		}
+	}
+
+	return res, nil
+}
+
+// editSeriesTx applies the edit to the occurrence and to the following ones, or to all the occurrences of its series.
+// Changed dates move the occurrences along with the series rule, a this and following edit from the middle
+// of the series ends the series before the occurrence and continues it in a new series.
+func editSeriesTx(db zeni.DB, actorID string, organizersIDs []string, gatekeepersIDs []string, current *zeni.Event, req *zenaov1.EditEventRequest) (*editEventResult, string, []string, error) {
+	series, err := db.GetEventSeries(current.SeriesID)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	occurrences, err := db.ListSeriesEvents(series.ID)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	oldLoc, err := current.Timezone()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	newLoc, err := (&zeni.Event{Location: req.Location}).Timezone()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	rule, err := zeni.ParseRRule(series.RRule, oldLoc)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	recurrences, err := rule.Occurrences(series.StartDate.In(oldLoc))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	index := slices.IndexFunc(recurrences, func(recurrence time.Time) bool { return recurrence.Equal(current.SeriesRecurrenceAt) })
+	if index < 0 {
+		return nil, "", nil, errors.New("event is not an occurrence of its series")
+	}
+
+	startDate := time.Unix(int64(req.StartDate), 0)
+	duration := time.Duration(req.EndDate-req.StartDate) * time.Second
+	datesChanged := !startDate.Equal(current.StartDate) || int64(req.EndDate) != current.EndDate.Unix()
+	// the occurrences keep their wall clock time in the event timezone
+	from, to := wallClock(current.SeriesRecurrenceAt, oldLoc), wallClock(startDate, newLoc)
+	delta := to.Sub(from)
+	shift := func(t time.Time) time.Time {
+		if !datesChanged {
+			return t
+		}
+		return shiftWallClock(t, oldLoc, newLoc, delta)
+	}
+	if datesChanged && (len(rule.ByDay) != 0 || len(rule.ByMonthDay) != 0) && from.YearDay() != to.YearDay() {
+		return nil, "", nil, errors.New("occurrences of a rule with week or month days can't be moved to another day, edit this occurrence only")
+	}
+	if !datesChanged {
+		duration = series.EndDate.Sub(series.StartDate)
+	}
+
+	split := req.SeriesScope == zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING && index > 0
+	seriesID := series.ID
+	if split {
+		previousRule := *rule
+		if rule.Count != 0 {
+			previousRule.Count = index
+		} else {
+			until := recurrences[index].Add(-time.Second)
+			previousRule.Until = &until
+		}
+		if err := db.UpdateEventSeries(&zeni.EventSeries{
+			ID:        series.ID,
+			RRule:     previousRule.String(),
+			StartDate: series.StartDate,
+			EndDate:   series.EndDate,
+		}); err != nil {
+			return nil, "", nil, err
+		}
+
+		nextRule := *rule
+		if rule.Count != 0 {
+			nextRule.Count = rule.Count - index
+		} else {
+			until := shift(*rule.Until)
+			nextRule.Until = &until
+		}
+		nextStart := shift(recurrences[index])
+		nextSeries, err := db.CreateEventSeries(&zeni.EventSeries{
+			RRule:     nextRule.String(),
+			StartDate: nextStart,
+			EndDate:   nextStart.Add(duration),
+		})
+		if err != nil {
+			return nil, "", nil, err
+		}
+		seriesID = nextSeries.ID
+	} else if datesChanged {
+		nextRule := *rule
+		if rule.Until != nil {
+			until := shift(*rule.Until)
+			nextRule.Until = &until
+		}
+		nextStart := shift(series.StartDate)
+		if err := db.UpdateEventSeries(&zeni.EventSeries{
+			ID:        series.ID,
+			RRule:     nextRule.String(),
+			StartDate: nextStart,
+			EndDate:   nextStart.Add(duration),
+		}); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	var (
+		edited    *editEventResult
+		editedIDs []string
+	)
+	for _, occurrence := range occurrences {
+		if req.SeriesScope == zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING && occurrence.SeriesRecurrenceAt.Before(current.SeriesRecurrenceAt) {
+			continue
+		}
+
+		occurrenceReq := proto.Clone(req).(*zenaov1.EditEventRequest)
+		occurrenceReq.EventId = occurrence.ID
+		recurrenceAt := shift(occurrence.SeriesRecurrenceAt)
+		if datesChanged {
+			occurrenceReq.StartDate = uint64(recurrenceAt.Unix())
+			occurrenceReq.EndDate = uint64(recurrenceAt.Add(duration).Unix())
+		} else {
+			occurrenceReq.StartDate = uint64(occurrence.StartDate.Unix())
+			occurrenceReq.EndDate = uint64(occurrence.EndDate.Unix())
+		}
+		if occurrence.ID != current.ID {
+			// the price groups of the occurrences were created alike, they are matched by position
+			for _, group := range occurrenceReq.PricesGroups {
+				group.Id = ""
+				for _, price := range group.Prices {
+					price.Id = ""
+				}
+			}
+			shiftPriceSaleWindows(occurrenceReq.PricesGroups, time.Duration(int64(occurrenceReq.StartDate)-int64(req.StartDate))*time.Second)
+			if occurrenceReq.UpdateRegistrationQuestions {
+				// so are the registration questions, keeping the answers attached to them
+				questions, err := db.GetRegistrationQuestions(occurrence.ID)
+				if err != nil {
+					return nil, "", nil, err
+				}
+				for i, question := range occurrenceReq.RegistrationQuestions {
+					question.Id = ""
+					if i < len(questions) {
+						question.Id = questions[i].ID
+					}
+				}
+			}
+		}
+
+		res, err := editEventTx(db, actorID, organizersIDs, gatekeepersIDs, occurrenceReq)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		if occurrence.ID == current.ID {
+			edited = res
+		}
+		editedIDs = append(editedIDs, occurrence.ID)
+
+		if split || datesChanged {
+			if err := db.SetEventSeries(occurrence.ID, seriesID, recurrenceAt); err != nil {
+				return nil, "", nil, err
+			}
+		}
+	}
+
+	return edited, seriesID, editedIDs, nil
+}
+
+// wallClock returns the wall clock time of t in loc as an UTC time, to compute durations ignoring offset changes.
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// shiftWallClock moves the wall clock time of t in from by delta and returns it in to.
+func shiftWallClock(t time.Time, from *time.Location, to *time.Location, delta time.Duration) time.Time {
+	w := wallClock(t, from).Add(delta)
+	return time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, to)
 }
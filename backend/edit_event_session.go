@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) EditEventSession(
+	ctx context.Context,
+	req *connect.Request[zenaov1.EditEventSessionRequest],
+) (*connect.Response[zenaov1.EditEventSessionResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("edit-event-session", zap.String("session-id", req.Msg.SessionId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	var (
+		session    *zeni.EventSession
+		registered bool
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.EditEventSession", func(tx zeni.DB) error {
+		current, evt, err := organizedEventSession(tx, actor.ID(), req.Msg.SessionId)
+		if err != nil {
+			return err
+		}
+
+		session, err = eventSessionFromProto(evt, req.Msg.Session)
+		if err != nil {
+			return err
+		}
+		session.ID = current.ID
+
+		session, err = tx.UpdateEventSession(session)
+		if err != nil {
+			return err
+		}
+
+		sessionIDs, err := tx.ListUserSessionIDs(evt.ID, actor.ID())
+		if err != nil {
+			return err
+		}
+		registered = slices.Contains(sessionIDs, session.ID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.EditEventSessionResponse{
+		Session: eventSessionToProto(session, registered),
+	}), nil
+}
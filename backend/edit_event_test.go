@@ -266,3 +266,68 @@ func TestEditEventDoesNotDeletePriceGroups(t *testing.T) {
 	require.Len(t, groups, 1)
 	require.Len(t, groups[0].Prices, 1)
 }
+
+func TestEditEventKeepsSettingsWithoutUpdateFlags(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	start := time.Now().Add(72 * time.Hour)
+	location := &zenaov1.EventLocation{
+		Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+	}
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:                   "Settings test event",
+		Description:             "test description",
+		ImageUri:                "ipfs://image",
+		StartDate:               uint64(start.Unix()),
+		EndDate:                 uint64(start.Add(2 * time.Hour).Unix()),
+		Capacity:                100,
+		Location:                location,
+		TicketTransfersDisabled: true,
+		ApprovalRequired:        true,
+		InviteOnly:              true,
+	}))
+	require.NoError(t, err)
+
+	editReq := func() *zenaov1.EditEventRequest {
+		return &zenaov1.EditEventRequest{
+			EventId:     created.Msg.Id,
+			Title:       "Settings test event",
+			Description: "edited description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(start.Unix()),
+			EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+			Capacity:    100,
+			Location:    location,
+		}
+	}
+
+	// a client unaware of the settings leaves them alone
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(editReq()))
+	require.NoError(t, err)
+	evt, err := f.db.GetEvent(created.Msg.Id)
+	require.NoError(t, err)
+	require.Equal(t, "edited description", evt.Description)
+	require.True(t, evt.TicketTransfersDisabled)
+	require.True(t, evt.ApprovalRequired)
+	require.True(t, evt.InviteOnly)
+
+	req := editReq()
+	req.UpdateApprovalRequired = true
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	evt, err = f.db.GetEvent(created.Msg.Id)
+	require.NoError(t, err)
+	require.True(t, evt.TicketTransfersDisabled)
+	require.False(t, evt.ApprovalRequired)
+	require.True(t, evt.InviteOnly)
+
+	req = editReq()
+	req.UpdateTicketTransfersDisabled = true
+	req.UpdateInviteOnly = true
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	evt, err = f.db.GetEvent(created.Msg.Id)
+	require.NoError(t, err)
+	require.False(t, evt.TicketTransfersDisabled)
+	require.False(t, evt.InviteOnly)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maxApplicationMessageLength = 2000
+
+// eventHasPaidPrices tells whether tickets of the event are sold through checkout,
+// approved applicants of such events buy their ticket instead of getting one right away.
+func eventHasPaidPrices(tx zeni.DB, eventID string) (bool, error) {
+	priceGroups, err := tx.GetPriceGroupsByEvent(eventID)
+	if err != nil {
+		return false, err
+	}
+	for _, price := range mapPricesFromGroups(priceGroups) {
+		if !isFreePrice(price) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ensureApprovedApplicants checks that every attendee of a checkout was approved by the organizers,
+// organizers don't need to apply to their own event.
+func ensureApprovedApplicants(tx zeni.DB, eventID string, emails []string, users map[string]*zeni.User) error {
+	for _, email := range emails {
+		user, ok := users[email]
+		if !ok {
+			return fmt.Errorf("user not found for attendee %s", email)
+		}
+		roles, err := tx.EntityRoles(zeni.EntityTypeUser, user.ID, zeni.EntityTypeEvent, eventID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(roles, zeni.RoleOrganizer) {
+			continue
+		}
+		application, err := tx.GetUserEventApplication(eventID, user.ID)
+		if err != nil {
+			return err
+		}
+		if application == nil || application.Status != zeni.EventApplicationStatusApproved {
+			return fmt.Errorf("attendee %s was not approved by the organizers", email)
+		}
+	}
+	return nil
+}
+
+// reviewEventApplication loads the application and checks that the user organizes its event.
+func reviewEventApplication(tx zeni.DB, userID string, applicationID string) (*zeni.EventApplication, *zeni.Event, error) {
+	application, err := tx.GetEventApplication(applicationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if application == nil {
+		return nil, nil, errors.New("application not found")
+	}
+
+	roles, err := tx.EntityRoles(zeni.EntityTypeUser, userID, zeni.EntityTypeEvent, application.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, nil, errors.New("user is not organizer of the event")
+	}
+
+	evt, err := tx.GetEvent(application.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if evt == nil {
+		return nil, nil, errors.New("event not found")
+	}
+	return application, evt, nil
+}
+
+// applicantContact returns the user of an application and their email address.
+func (s *ZenaoServer) applicantContact(ctx context.Context, userID string) (*zeni.User, string, error) {
+	users, err := s.DB.WithContext(ctx).GetUsersByIDs([]string{userID})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(users) == 0 || users[0] == nil || strings.TrimSpace(users[0].AuthID) == "" {
+		return nil, "", errors.New("applicant auth id not found")
+	}
+
+	authUsers, err := s.Auth.GetUsersFromIDs(ctx, []string{users[0].AuthID})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(authUsers) == 0 || authUsers[0] == nil || strings.TrimSpace(authUsers[0].Email) == "" {
+		return nil, "", errors.New("applicant email not found")
+	}
+	return users[0], authUsers[0].Email, nil
+}
+
+// sendEventApplicationEmail tells the applicant the decision of the organizers.
+// Approved applicants of free events get their ticket attached, the others are invited to checkout.
+func (s *ZenaoServer) sendEventApplicationEmail(ctx context.Context, evt *zeni.Event, application *zeni.EventApplication, ticket *zeni.Ticket) error {
+	if s.MailClient == nil || s.Auth == nil {
+		return nil
+	}
+
+	user, email, err := s.applicantContact(ctx, application.UserID)
+	if err != nil {
+		return err
+	}
+
+	tracer := otel.Tracer("mail")
+	mailCtx, span := tracer.Start(ctx, "mail.EventApplicationReviewed", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	mail := &resend.SendEmailRequest{
+		From: "Zenao <" + s.MailSender + ">",
+		To:   []string{email},
+	}
+	switch {
+	case application.Status == zeni.EventApplicationStatusRejected:
+		message := application.Message
+		if message == "" {
+			message = "The organizers could not accept your application this time."
+		}
+		mail.Subject = evt.Title + " - Application declined"
+		mail.Html, mail.Text, err = eventApplicationReviewedMailContent(evt, "Your application to "+evt.Title+" was declined", message, "")
+	case ticket != nil:
+		mail.Subject = evt.Title + " - Confirmation"
+		mail.Html, mail.Text, err = ticketsConfirmationMailContent(evt, "Your application was approved! Your ticket is attached to this email.")
+		if err == nil {
+			pdfData, pdfErr := GeneratePDFTicket(evt, ticket.Secret(), user.DisplayName, email, time.Now(), s.Logger)
+			if pdfErr != nil {
+				return pdfErr
+			}
+			mail.Attachments = []*resend.Attachment{
+				{
+					Content:     pdfData,
+					Filename:    fmt.Sprintf("ticket_%s_%s.pdf", user.ID, evt.ID),
+					ContentType: "application/pdf",
+				},
+				{
+					Content:     s.eventICS(ctx, evt),
+					Filename:    fmt.Sprintf("zenao_events_%s.ics", evt.ID),
+					ContentType: "text/calendar",
+				},
+			}
+		}
+	default:
+		mail.Subject = evt.Title + " - Application approved"
+		mail.Html, mail.Text, err = eventApplicationReviewedMailContent(evt, "Your application to "+evt.Title+" was approved", "You can now get your ticket on the event page.", "Get my ticket")
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = s.MailClient.Emails.SendWithContext(mailCtx, mail)
+	return err
+}
+
+func eventApplicationToProto(application *zeni.EventApplication) *zenaov1.EventApplication {
+	result := &zenaov1.EventApplication{
+		Id:        application.ID,
+		EventId:   application.EventID,
+		UserId:    application.UserID,
+		BuyerId:   application.BuyerID,
+		Status:    string(application.Status),
+		Message:   application.Message,
+		CreatedAt: application.CreatedAt.Unix(),
+	}
+	if application.ReviewedAt != nil {
+		result.ReviewedAt = *application.ReviewedAt
+	}
+	return result
+}
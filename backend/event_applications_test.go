@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventApplicationsReviewedByOrganizers(t *testing.T) {
+	f := setupPaidEventFixture(t, &zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"})
+	organizer := f.auth.user
+
+	now := time.Now()
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Curated workshop",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		ApprovalRequired: true,
+	}))
+	require.NoError(t, err)
+	eventID := created.Msg.Id
+	ticketsCount := func(eventID string, userID string) int {
+		var count int
+		require.NoError(t, f.sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE event_id = ? AND user_id = ? AND deleted_at IS NULL", eventID, userID).Scan(&count))
+		return count
+	}
+
+	evt, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.True(t, evt.Msg.Event.ApprovalRequired)
+
+	f.auth.user = nil
+	participated, err := f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: eventID,
+		Email:   "bob@example.com",
+		Guests:  []string{"carol@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Len(t, participated.Msg.Applications, 2)
+	bob, carol := participated.Msg.Applications[0], participated.Msg.Applications[1]
+	require.Equal(t, "pending", bob.Status)
+	require.Equal(t, bob.UserId, carol.BuyerId)
+
+	require.Zero(t, ticketsCount(eventID, bob.UserId))
+
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: eventID,
+		Email:   "bob@example.com",
+	}))
+	require.ErrorContains(t, err, "already applied")
+
+	f.auth.user = f.auth.ensureAuthUser("bob@example.com")
+	_, err = f.server.ListEventApplications(context.Background(), connect.NewRequest(&zenaov1.ListEventApplicationsRequest{EventId: eventID}))
+	require.ErrorContains(t, err, "only organizers")
+	_, err = f.server.ApproveEventApplication(context.Background(), connect.NewRequest(&zenaov1.ApproveEventApplicationRequest{ApplicationId: bob.Id}))
+	require.ErrorContains(t, err, "not organizer")
+
+	f.auth.user = organizer
+	pending, err := f.server.ListEventApplications(context.Background(), connect.NewRequest(&zenaov1.ListEventApplicationsRequest{EventId: eventID, Status: "pending"}))
+	require.NoError(t, err)
+	require.Len(t, pending.Msg.Applications, 2)
+
+	approved, err := f.server.ApproveEventApplication(context.Background(), connect.NewRequest(&zenaov1.ApproveEventApplicationRequest{ApplicationId: bob.Id}))
+	require.NoError(t, err)
+	require.Equal(t, "approved", approved.Msg.Application.Status)
+	require.NotZero(t, approved.Msg.Application.ReviewedAt)
+	require.Equal(t, 1, ticketsCount(eventID, bob.UserId))
+
+	rejected, err := f.server.RejectEventApplication(context.Background(), connect.NewRequest(&zenaov1.RejectEventApplicationRequest{
+		ApplicationId: carol.Id,
+		Message:       "  The workshop is for beginners only.  ",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "rejected", rejected.Msg.Application.Status)
+	require.Equal(t, "The workshop is for beginners only.", rejected.Msg.Application.Message)
+	require.Zero(t, ticketsCount(eventID, carol.UserId))
+
+	_, err = f.server.ApproveEventApplication(context.Background(), connect.NewRequest(&zenaov1.ApproveEventApplicationRequest{ApplicationId: carol.Id}))
+	require.ErrorContains(t, err, "already reviewed")
+
+	pending, err = f.server.ListEventApplications(context.Background(), connect.NewRequest(&zenaov1.ListEventApplicationsRequest{EventId: eventID, Status: "pending"}))
+	require.NoError(t, err)
+	require.Empty(t, pending.Msg.Applications)
+
+	// checkout of paid events only opens to approved applicants
+	_, err = f.sqlDB.Exec("UPDATE events SET approval_required = true WHERE id = ?", f.eventID)
+	require.NoError(t, err)
+
+	_, err = f.startCheckout(f.priceIDs[0], "", "dave@example.com")
+	require.ErrorContains(t, err, "dave@example.com was not approved")
+
+	f.auth.user = nil
+	participated, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: f.eventID,
+		Email:   "dave@example.com",
+	}))
+	require.NoError(t, err)
+	require.Len(t, participated.Msg.Applications, 1)
+	dave := participated.Msg.Applications[0]
+
+	f.auth.user = organizer
+	_, err = f.server.ApproveEventApplication(context.Background(), connect.NewRequest(&zenaov1.ApproveEventApplicationRequest{ApplicationId: dave.Id}))
+	require.NoError(t, err)
+	require.Zero(t, ticketsCount(f.eventID, dave.UserId))
+
+	order, err := f.startCheckout(f.priceIDs[0], "", "dave@example.com")
+	require.NoError(t, err)
+	require.NotEmpty(t, order.CheckoutUrl)
+}
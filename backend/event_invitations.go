@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	maxInvitationsPerRequest = 100
+	// maxInvitationSends caps the emails sent for one invitation, the first one included
+	maxInvitationSends = 5
+	// invitationResendInterval is the minimum delay between two emails of the same invitation
+	invitationResendInterval = time.Hour
+)
+
+var errInvalidInvitationToken = errors.New("invalid invitation token")
+
+// invitationSignature binds the token to the invitation, its event and its recipient.
+func invitationSignature(secret string, invitation *zeni.EventInvitation) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{"zenao-invitation", invitation.ID, invitation.EventID, invitation.Email, invitation.Nonce}, "\n")))
+	return mac.Sum(nil)
+}
+
+// invitationToken returns the token mailed to the recipient of the invitation.
+func (s *ZenaoServer) invitationToken(invitation *zeni.EventInvitation) (string, error) {
+	if s.InvitationSecret == "" {
+		return "", errors.New("invitations are not enabled on this server")
+	}
+	return invitation.ID + "." + base64.RawURLEncoding.EncodeToString(invitationSignature(s.InvitationSecret, invitation)), nil
+}
+
+// verifyInvitationToken returns the invitation the token was issued for.
+func (s *ZenaoServer) verifyInvitationToken(tx zeni.DB, token string) (*zeni.EventInvitation, error) {
+	if s.InvitationSecret == "" {
+		return nil, errors.New("invitations are not enabled on this server")
+	}
+	invitationID, signature, ok := strings.Cut(token, ".")
+	if !ok || invitationID == "" {
+		return nil, errInvalidInvitationToken
+	}
+	signatureBz, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, errInvalidInvitationToken
+	}
+	invitation, err := tx.GetEventInvitation(invitationID)
+	if err != nil || invitation == nil {
+		return nil, errInvalidInvitationToken
+	}
+	if !hmac.Equal(signatureBz, invitationSignature(s.InvitationSecret, invitation)) {
+		return nil, errInvalidInvitationToken
+	}
+	return invitation, nil
+}
+
+// acceptEventInvitation consumes the invitation the token was issued for, it must target the event and the email.
+func (s *ZenaoServer) acceptEventInvitation(tx zeni.DB, evt *zeni.Event, token string, email string, userID string) error {
+	invitation, err := s.verifyInvitationToken(tx, token)
+	if err != nil {
+		return err
+	}
+	if invitation.EventID != evt.ID || !strings.EqualFold(invitation.Email, email) {
+		return errors.New("invitation is for another event or recipient")
+	}
+	accepted, err := tx.RespondEventInvitation(invitation.ID, zeni.EventInvitationStatusAccepted, userID, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		return errors.New("invitation was already answered")
+	}
+	return nil
+}
+
+// invitationURL returns the event page url that lets the recipient answer the invitation.
+func (s *ZenaoServer) invitationURL(evt *zeni.Event, token string) string {
+	base := eventPublicURL(evt.ID)
+	if s.AppBaseURL != "" {
+		base = fmt.Sprintf("%s/event/%s", strings.TrimRight(s.AppBaseURL, "/"), evt.ID)
+	}
+	return base + "?invitation=" + url.QueryEscape(token)
+}
+
+// normalizeInvitationEmails validates the emails and returns them lowercased and without duplicates.
+func normalizeInvitationEmails(emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, errors.New("no email to invite")
+	}
+	if len(emails) > maxInvitationsPerRequest {
+		return nil, fmt.Errorf("cannot invite more than %d emails at once", maxInvitationsPerRequest)
+	}
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if err := validateEmailAddress(email); err != nil {
+			return nil, err
+		}
+		if slices.Contains(normalized, email) {
+			continue
+		}
+		normalized = append(normalized, email)
+	}
+	return normalized, nil
+}
+
+// checkInvitationResendable returns why no more email can be sent for the invitation now, nil if one can.
+func checkInvitationResendable(invitation *zeni.EventInvitation, now time.Time) error {
+	if invitation.Status != zeni.EventInvitationStatusInvited && invitation.Status != zeni.EventInvitationStatusOpened {
+		return fmt.Errorf("invitation %s was already %s", invitation.ID, invitation.Status)
+	}
+	if invitation.SentCount >= maxInvitationSends {
+		return fmt.Errorf("invitation %s was already sent %d times", invitation.ID, invitation.SentCount)
+	}
+	if invitation.LastSentAt != nil && now.Sub(time.Unix(*invitation.LastSentAt, 0)) < invitationResendInterval {
+		return fmt.Errorf("invitation %s was sent less than %s ago", invitation.ID, invitationResendInterval)
+	}
+	return nil
+}
+
+// invitedEvent checks that the user organizes the event and that it is invite-only.
+func invitedEvent(tx zeni.DB, userID string, eventID string) (*zeni.Event, error) {
+	evt, err := organizedEvent(tx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !evt.InviteOnly {
+		return nil, errors.New("event is not invite-only")
+	}
+	return evt, nil
+}
+
+// sendEventInvitationEmail mails the personal token of the invitation to its recipient.
+// It returns false without error when mails are disabled.
+func (s *ZenaoServer) sendEventInvitationEmail(ctx context.Context, evt *zeni.Event, invitation *zeni.EventInvitation, reminder bool) (bool, error) {
+	if s.MailClient == nil {
+		return false, nil
+	}
+
+	token, err := s.invitationToken(invitation)
+	if err != nil {
+		return false, err
+	}
+
+	tracer := otel.Tracer("mail")
+	mailCtx, span := tracer.Start(ctx, "mail.EventInvitation", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	title := "You're invited to " + evt.Title
+	subject := evt.Title + " - Invitation"
+	if reminder {
+		title = "Reminder: you're invited to " + evt.Title
+		subject = evt.Title + " - Invitation reminder"
+	}
+	tz, err := evt.Timezone()
+	if err != nil {
+		return false, err
+	}
+	message := "The event starts on " + evt.StartDate.In(tz).Format(time.ANSIC) + ". Let the organizers know if you can make it."
+	htmlStr, text, err := eventInvitationMailContent(evt, title, message, s.invitationURL(evt, token))
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := s.MailClient.Emails.SendWithContext(mailCtx, &resend.SendEmailRequest{
+		From:    "Zenao <" + s.MailSender + ">",
+		To:      []string{invitation.Email},
+		Subject: subject,
+		Html:    htmlStr,
+		Text:    text,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// sendEventInvitations mails the invitations and records the emails that were sent.
+// Failures are logged so one bad address doesn't prevent the others from being invited.
+func (s *ZenaoServer) sendEventInvitations(ctx context.Context, evt *zeni.Event, invitations []*zeni.EventInvitation, reminder bool) {
+	db := s.DB.WithContext(ctx)
+	for _, invitation := range invitations {
+		sent, err := s.sendEventInvitationEmail(ctx, evt, invitation, reminder)
+		if err != nil {
+			s.Logger.Error("send-event-invitation-email", zap.Error(err), zap.String("invitation-id", invitation.ID))
+			continue
+		}
+		if !sent {
+			continue
+		}
+		nowUnix := time.Now().Unix()
+		if err := db.RecordEventInvitationSent(invitation.ID, nowUnix); err != nil {
+			s.Logger.Error("record-event-invitation-sent", zap.Error(err), zap.String("invitation-id", invitation.ID))
+			continue
+		}
+		invitation.SentCount++
+		invitation.LastSentAt = &nowUnix
+	}
+}
+
+func eventInvitationToProto(invitation *zeni.EventInvitation) *zenaov1.EventInvitation {
+	result := &zenaov1.EventInvitation{
+		Id:        invitation.ID,
+		EventId:   invitation.EventID,
+		Email:     invitation.Email,
+		Status:    string(invitation.Status),
+		UserId:    invitation.UserID,
+		SentCount: invitation.SentCount,
+		CreatedAt: invitation.CreatedAt.Unix(),
+	}
+	if invitation.LastSentAt != nil {
+		result.LastSentAt = *invitation.LastSentAt
+	}
+	if invitation.OpenedAt != nil {
+		result.OpenedAt = *invitation.OpenedAt
+	}
+	if invitation.RespondedAt != nil {
+		result.RespondedAt = *invitation.RespondedAt
+	}
+	return result
+}
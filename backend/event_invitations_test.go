@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInviteOnlyEvent(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	f.server.InvitationSecret = "test-invitation-secret"
+
+	now := time.Now()
+	createReq := &zenaov1.CreateEventRequest{
+		Title:       "Private dinner",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		InviteOnly: true,
+		Password:   "secret",
+	}
+	_, err := f.server.CreateEvent(context.Background(), connect.NewRequest(createReq))
+	require.ErrorContains(t, err, "password")
+
+	createReq.Password = ""
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(createReq))
+	require.NoError(t, err)
+	eventID := created.Msg.Id
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.NotNil(t, got.Msg.Event.Privacy.GetInviteOnly())
+
+	_, err = f.server.InviteToEvent(context.Background(), connect.NewRequest(&zenaov1.InviteToEventRequest{
+		EventId: f.eventID,
+		Emails:  []string{"alice@example.com"},
+	}))
+	require.ErrorContains(t, err, "not invite-only")
+
+	invited, err := f.server.InviteToEvent(context.Background(), connect.NewRequest(&zenaov1.InviteToEventRequest{
+		EventId: eventID,
+		Emails:  []string{"Alice@example.com", "bob@example.com", "alice@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Len(t, invited.Msg.Invitations, 2)
+	require.Equal(t, "alice@example.com", invited.Msg.Invitations[0].Email)
+	require.Equal(t, string(zeni.EventInvitationStatusInvited), invited.Msg.Invitations[0].Status)
+
+	invited, err = f.server.InviteToEvent(context.Background(), connect.NewRequest(&zenaov1.InviteToEventRequest{
+		EventId: eventID,
+		Emails:  []string{"alice@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, invited.Msg.Invitations)
+
+	invitations, err := f.db.ListEventInvitations(eventID)
+	require.NoError(t, err)
+	require.Len(t, invitations, 2)
+	aliceToken, err := f.server.invitationToken(invitations[0])
+	require.NoError(t, err)
+	bobToken, err := f.server.invitationToken(invitations[1])
+	require.NoError(t, err)
+
+	_, err = f.server.GetInvitation(context.Background(), connect.NewRequest(&zenaov1.GetInvitationRequest{Token: invitations[0].ID + ".forged"}))
+	require.ErrorIs(t, err, errInvalidInvitationToken)
+	opened, err := f.server.GetInvitation(context.Background(), connect.NewRequest(&zenaov1.GetInvitationRequest{Token: aliceToken}))
+	require.NoError(t, err)
+	require.Equal(t, eventID, opened.Msg.EventId)
+	require.Equal(t, string(zeni.EventInvitationStatusOpened), opened.Msg.Status)
+
+	f.auth.user = f.auth.ensureAuthUser("alice@example.com")
+	_, err = f.db.CreateUser(f.auth.user.ID)
+	require.NoError(t, err)
+
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: eventID}))
+	require.ErrorIs(t, err, errInvalidInvitationToken)
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: eventID, InvitationToken: bobToken}))
+	require.ErrorContains(t, err, "another event or recipient")
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId:         eventID,
+		InvitationToken: aliceToken,
+		Guests:          []string{"carol@example.com"},
+	}))
+	require.ErrorContains(t, err, "guests")
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: eventID, InvitationToken: aliceToken}))
+	require.NoError(t, err)
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: eventID, InvitationToken: aliceToken}))
+	require.ErrorContains(t, err, "already answered")
+
+	_, err = f.server.ListEventInvitations(context.Background(), connect.NewRequest(&zenaov1.ListEventInvitationsRequest{EventId: eventID}))
+	require.ErrorContains(t, err, "not organizer")
+
+	f.auth.user = f.auth.ensureAuthUser("bob@example.com")
+	_, err = f.db.CreateUser(f.auth.user.ID)
+	require.NoError(t, err)
+	_, err = f.server.DeclineInvitation(context.Background(), connect.NewRequest(&zenaov1.DeclineInvitationRequest{Token: bobToken}))
+	require.NoError(t, err)
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: eventID, InvitationToken: bobToken}))
+	require.ErrorContains(t, err, "already answered")
+
+	f.auth.user = f.auth.ensureAuthUser("org@example.com")
+	listed, err := f.server.ListEventInvitations(context.Background(), connect.NewRequest(&zenaov1.ListEventInvitationsRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Invitations, 2)
+	require.Equal(t, string(zeni.EventInvitationStatusAccepted), listed.Msg.Invitations[0].Status)
+	require.NotEmpty(t, listed.Msg.Invitations[0].UserId)
+	require.NotZero(t, listed.Msg.Invitations[0].OpenedAt)
+	require.Equal(t, string(zeni.EventInvitationStatusDeclined), listed.Msg.Invitations[1].Status)
+	require.NotZero(t, listed.Msg.Invitations[1].RespondedAt)
+
+	_, err = f.server.ResendEventInvitations(context.Background(), connect.NewRequest(&zenaov1.ResendEventInvitationsRequest{
+		EventId:       eventID,
+		InvitationIds: []string{invitations[0].ID},
+	}))
+	require.ErrorContains(t, err, "already accepted")
+
+	invited, err = f.server.InviteToEvent(context.Background(), connect.NewRequest(&zenaov1.InviteToEventRequest{
+		EventId: eventID,
+		Emails:  []string{"carol@example.com"},
+	}))
+	require.NoError(t, err)
+	resent, err := f.server.ResendEventInvitations(context.Background(), connect.NewRequest(&zenaov1.ResendEventInvitationsRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.Len(t, resent.Msg.Invitations, 1)
+	require.Equal(t, invited.Msg.Invitations[0].Id, resent.Msg.Invitations[0].Id)
+
+	// rotating the secret revokes the tokens already sent
+	carol, err := f.db.GetEventInvitation(invited.Msg.Invitations[0].Id)
+	require.NoError(t, err)
+	carolToken, err := f.server.invitationToken(carol)
+	require.NoError(t, err)
+	f.server.InvitationSecret = "rotated-invitation-secret"
+	_, err = f.server.GetInvitation(context.Background(), connect.NewRequest(&zenaov1.GetInvitationRequest{Token: carolToken}))
+	require.ErrorIs(t, err, errInvalidInvitationToken)
+}
+
+func TestInviteOnlyEventRejectsPaidPrices(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	location := &zenaov1.EventLocation{
+		Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+	}
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Private dinner",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location:    location,
+		CommunityId: community.ID,
+		InviteOnly:  true,
+		PricesGroups: []*zenaov1.EventPriceGroup{{Prices: []*zenaov1.EventPrice{{
+			Kind:         string(zeni.PriceKindPayWhatYouWant),
+			AmountMinor:  1500,
+			CurrencyCode: "EUR",
+		}}}},
+	}))
+	require.ErrorIs(t, err, errInviteOnlyPaidPrice)
+
+	// the paid prices kept by an edit without prices are checked too
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+		EventId:          f.eventID,
+		Title:            "Paid event",
+		Description:      "test description",
+		ImageUri:         "ipfs://image",
+		StartDate:        uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:          uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:         100,
+		Location:         location,
+		CommunityId:      community.ID,
+		Discoverable:     true,
+		InviteOnly:       true,
+		UpdateInviteOnly: true,
+	}))
+	require.ErrorIs(t, err, errInviteOnlyPaidPrice)
+}
+
+func TestCheckInvitationResendable(t *testing.T) {
+	now := time.Now()
+	lastSentAt := now.Add(-2 * invitationResendInterval).Unix()
+	invitation := &zeni.EventInvitation{ID: "1", Status: zeni.EventInvitationStatusOpened, SentCount: 1, LastSentAt: &lastSentAt}
+	require.NoError(t, checkInvitationResendable(invitation, now))
+
+	recent := now.Add(-time.Minute).Unix()
+	invitation.LastSentAt = &recent
+	require.ErrorContains(t, checkInvitationResendable(invitation, now), "less than")
+
+	invitation.LastSentAt = &lastSentAt
+	invitation.SentCount = maxInvitationSends
+	require.ErrorContains(t, checkInvitationResendable(invitation, now), "times")
+
+	invitation.SentCount = 1
+	invitation.Status = zeni.EventInvitationStatusDeclined
+	require.ErrorContains(t, checkInvitationResendable(invitation, now), "already declined")
+}
+
+func TestInviteOnlyEventCheckoutRequiresInvitation(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 0},
+	)
+	f.server.InvitationSecret = "test-invitation-secret"
+	freeID := f.priceIDs[1]
+	// an event made invite-only before its paid prices were rejected, its free tier is issued in the checkout transaction
+	_, err := f.sqlDB.Exec("UPDATE events SET invite_only = true WHERE id = ?", f.eventID)
+	require.NoError(t, err)
+
+	_, err = f.server.InviteToEvent(context.Background(), connect.NewRequest(&zenaov1.InviteToEventRequest{
+		EventId: f.eventID,
+		Emails:  []string{"alice@example.com"},
+	}))
+	require.NoError(t, err)
+	invitations, err := f.db.ListEventInvitations(f.eventID)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	aliceToken, err := f.server.invitationToken(invitations[0])
+	require.NoError(t, err)
+
+	_, err = f.startCheckout(freeID, "", "alice@example.com")
+	require.ErrorIs(t, err, errInvalidInvitationToken)
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems:       []*zenaov1.StartTicketPaymentLineItem{{PriceId: freeID, AttendeeEmail: "mallory@example.com"}},
+		InvitationToken: aliceToken,
+	})
+	require.ErrorContains(t, err, "another event or recipient")
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{
+			{PriceId: freeID, AttendeeEmail: "alice@example.com"},
+			{PriceId: freeID, AttendeeEmail: "mallory@example.com"},
+		},
+		InvitationToken: aliceToken,
+	})
+	require.ErrorContains(t, err, "guests")
+
+	resp, err := f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems:       []*zenaov1.StartTicketPaymentLineItem{{PriceId: freeID, AttendeeEmail: "alice@example.com"}},
+		InvitationToken: aliceToken,
+	})
+	require.NoError(t, err)
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, zeni.TicketIssueStatusIssued, order.TicketIssueStatus)
+
+	invitation, err := f.db.GetEventInvitation(invitations[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, zeni.EventInvitationStatusAccepted, invitation.Status)
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems:       []*zenaov1.StartTicketPaymentLineItem{{PriceId: freeID, AttendeeEmail: "alice@example.com"}},
+		InvitationToken: aliceToken,
+	})
+	require.ErrorContains(t, err, "already answered")
+}
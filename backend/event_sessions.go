@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+const (
+	maxSessionTitleLength       = 200
+	maxSessionDescriptionLength = 10000
+	maxSessionSpeakers          = 20
+	maxSessionFieldLength       = 200
+)
+
+// eventSessionFromProto validates the session details against the event and converts them.
+func eventSessionFromProto(evt *zeni.Event, session *zenaov1.EventSession) (*zeni.EventSession, error) {
+	if session == nil {
+		return nil, errors.New("session is required")
+	}
+
+	title := strings.TrimSpace(session.Title)
+	if title == "" {
+		return nil, errors.New("session title is required")
+	}
+	if len(title) > maxSessionTitleLength {
+		return nil, fmt.Errorf("session title cannot be longer than %d characters", maxSessionTitleLength)
+	}
+	if len(session.Description) > maxSessionDescriptionLength {
+		return nil, fmt.Errorf("session description cannot be longer than %d characters", maxSessionDescriptionLength)
+	}
+	if len(session.Speakers) > maxSessionSpeakers {
+		return nil, fmt.Errorf("a session cannot have more than %d speakers", maxSessionSpeakers)
+	}
+	speakers := make([]string, 0, len(session.Speakers))
+	for _, speaker := range session.Speakers {
+		speaker = strings.TrimSpace(speaker)
+		if speaker == "" || strings.Contains(speaker, "\n") {
+			return nil, errors.New("invalid session speaker")
+		}
+		if len(speaker) > maxSessionFieldLength {
+			return nil, fmt.Errorf("session speaker cannot be longer than %d characters", maxSessionFieldLength)
+		}
+		speakers = append(speakers, speaker)
+	}
+	room := strings.TrimSpace(session.Room)
+	track := strings.TrimSpace(session.Track)
+	if len(room) > maxSessionFieldLength || len(track) > maxSessionFieldLength {
+		return nil, fmt.Errorf("session room and track cannot be longer than %d characters", maxSessionFieldLength)
+	}
+
+	startDate := time.Unix(session.StartDate, 0)
+	endDate := time.Unix(session.EndDate, 0)
+	if !endDate.After(startDate) {
+		return nil, errors.New("session end date must be after its start date")
+	}
+	if startDate.Before(evt.StartDate) || endDate.After(evt.EndDate) {
+		return nil, errors.New("session must take place during the event")
+	}
+
+	return &zeni.EventSession{
+		EventID:     evt.ID,
+		Title:       title,
+		Description: session.Description,
+		Speakers:    speakers,
+		Room:        room,
+		Track:       track,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Capacity:    session.Capacity,
+	}, nil
+}
+
+func eventSessionToProto(session *zeni.EventSession, registered bool) *zenaov1.EventSession {
+	return &zenaov1.EventSession{
+		Id:            session.ID,
+		EventId:       session.EventID,
+		Title:         session.Title,
+		Description:   session.Description,
+		Speakers:      session.Speakers,
+		Room:          session.Room,
+		Track:         session.Track,
+		StartDate:     session.StartDate.Unix(),
+		EndDate:       session.EndDate.Unix(),
+		Capacity:      session.Capacity,
+		Registrations: session.Registrations,
+		Registered:    registered,
+	}
+}
+
+// organizedEventSession loads the session and its event, and checks that the user organizes the event.
+func organizedEventSession(tx zeni.DB, userID string, sessionID string) (*zeni.EventSession, *zeni.Event, error) {
+	session, err := tx.GetEventSession(sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session == nil {
+		return nil, nil, errors.New("session not found")
+	}
+	evt, err := organizedEvent(tx, userID, session.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return session, evt, nil
+}
+
+// organizedEvent loads the event and checks that the user organizes it.
+func organizedEvent(tx zeni.DB, userID string, eventID string) (*zeni.Event, error) {
+	roles, err := tx.EntityRoles(zeni.EntityTypeUser, userID, zeni.EntityTypeEvent, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("user is not organizer of the event")
+	}
+	evt, err := tx.GetEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt == nil {
+		return nil, errors.New("event not found")
+	}
+	return evt, nil
+}
+
+// sessionTracks returns the distinct tracks of the sessions in the order they first appear.
+func sessionTracks(sessions []*zeni.EventSession) []string {
+	tracks := mapsl.Map(sessions, func(session *zeni.EventSession) string { return session.Track })
+	tracks = slices.DeleteFunc(tracks, func(track string) bool { return track == "" })
+	result := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		if !slices.Contains(result, track) {
+			result = append(result, track)
+		}
+	}
+	return result
+}
+
+// eventICS generates the calendar of the event and its agenda, the agenda is left out if it can't be loaded.
+func (s *ZenaoServer) eventICS(ctx context.Context, evt *zeni.Event) []byte {
+	sessions, err := s.DB.WithContext(ctx).ListEventSessions(evt.ID)
+	if err != nil {
+		s.Logger.Error("list-event-sessions", zap.Error(err), zap.String("event-id", evt.ID))
+		sessions = nil
+	}
+	return GenerateICS(evt, sessions, s.MailSender, s.Logger)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEventSessionsAgendaAndRegistrations(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	organizer := f.auth.user
+
+	start := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Conference",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(start.Unix()),
+		EndDate:     uint64(start.Add(8 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+	}))
+	require.NoError(t, err)
+	eventID := created.Msg.Id
+
+	createSession := func(session *zenaov1.EventSession) (*zenaov1.EventSession, error) {
+		res, err := f.server.CreateEventSession(context.Background(), connect.NewRequest(&zenaov1.CreateEventSessionRequest{
+			EventId: eventID,
+			Session: session,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.Session, nil
+	}
+
+	_, err = createSession(&zenaov1.EventSession{
+		Title:     "Too late",
+		StartDate: start.Add(7 * time.Hour).Unix(),
+		EndDate:   start.Add(9 * time.Hour).Unix(),
+	})
+	require.ErrorContains(t, err, "during the event")
+
+	workshop, err := createSession(&zenaov1.EventSession{
+		Title:     "Hands-on workshop",
+		Speakers:  []string{" Alice ", "Bob"},
+		Room:      "Room A",
+		Track:     "Workshops",
+		StartDate: start.Add(2 * time.Hour).Unix(),
+		EndDate:   start.Add(3 * time.Hour).Unix(),
+		Capacity:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Alice", "Bob"}, workshop.Speakers)
+	keynote, err := createSession(&zenaov1.EventSession{
+		Title:     "Keynote",
+		Room:      "Main stage",
+		Track:     "Talks",
+		StartDate: start.Unix(),
+		EndDate:   start.Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	f.auth.user = nil
+	list, err := f.server.ListEventSessions(context.Background(), connect.NewRequest(&zenaov1.ListEventSessionsRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Sessions, 2)
+	require.Equal(t, keynote.Id, list.Msg.Sessions[0].Id)
+	require.Equal(t, []string{"Talks", "Workshops"}, list.Msg.Tracks)
+
+	list, err = f.server.ListEventSessions(context.Background(), connect.NewRequest(&zenaov1.ListEventSessionsRequest{EventId: eventID, Track: "Workshops"}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Sessions, 1)
+	require.Equal(t, workshop.Id, list.Msg.Sessions[0].Id)
+
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: eventID,
+		Email:   "bob@example.com",
+		Guests:  []string{"carol@example.com"},
+	}))
+	require.NoError(t, err)
+
+	f.auth.user = f.auth.ensureAuthUser("dave@example.com")
+	_, err = f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: workshop.Id}))
+	require.ErrorContains(t, err, "not participant")
+
+	f.auth.user = f.auth.ensureAuthUser("bob@example.com")
+	registered, err := f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: workshop.Id}))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), registered.Msg.Session.Registrations)
+	_, err = f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: workshop.Id}))
+	require.ErrorContains(t, err, "already registered")
+
+	_, err = f.server.EditEventSession(context.Background(), connect.NewRequest(&zenaov1.EditEventSessionRequest{
+		SessionId: workshop.Id,
+		Session:   &zenaov1.EventSession{Title: "Hijacked", StartDate: workshop.StartDate, EndDate: workshop.EndDate},
+	}))
+	require.ErrorContains(t, err, "not organizer")
+
+	list, err = f.server.ListEventSessions(context.Background(), connect.NewRequest(&zenaov1.ListEventSessionsRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.False(t, list.Msg.Sessions[0].Registered)
+	require.True(t, list.Msg.Sessions[1].Registered)
+
+	f.auth.user = f.auth.ensureAuthUser("carol@example.com")
+	_, err = f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: workshop.Id}))
+	require.ErrorContains(t, err, "session is full")
+	_, err = f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: keynote.Id}))
+	require.NoError(t, err)
+
+	// the seat of a cancelled ticket is released
+	f.auth.user = f.auth.ensureAuthUser("bob@example.com")
+	_, err = f.server.CancelParticipation(context.Background(), connect.NewRequest(&zenaov1.CancelParticipationRequest{EventId: eventID}))
+	require.NoError(t, err)
+	f.auth.user = f.auth.ensureAuthUser("carol@example.com")
+	_, err = f.server.RegisterForSession(context.Background(), connect.NewRequest(&zenaov1.RegisterForSessionRequest{SessionId: workshop.Id}))
+	require.NoError(t, err)
+
+	f.auth.user = organizer
+	_, err = f.server.EditEventSession(context.Background(), connect.NewRequest(&zenaov1.EditEventSessionRequest{
+		SessionId: keynote.Id,
+		Session: &zenaov1.EventSession{
+			Title:     "Opening keynote",
+			Room:      "Main stage",
+			Track:     "Talks",
+			StartDate: keynote.StartDate,
+			EndDate:   keynote.EndDate,
+			Capacity:  0,
+		},
+	}))
+	require.NoError(t, err)
+
+	evt, err := f.db.GetEvent(eventID)
+	require.NoError(t, err)
+	sessions, err := f.db.ListEventSessions(eventID)
+	require.NoError(t, err)
+	cal := string(GenerateICS(evt, sessions, "noreply@zenao.io", zap.NewNop()))
+	require.Contains(t, cal, "UID:evt_"+eventID+"@zenao.io")
+	require.Contains(t, cal, "UID:session_"+keynote.Id+"@zenao.io")
+	require.Contains(t, cal, "SUMMARY:Opening keynote")
+	require.Contains(t, cal, "RELATED-TO:evt_"+eventID+"@zenao.io")
+	require.Contains(t, cal, "CATEGORIES:Workshops")
+	require.Contains(t, cal, "LOCATION:Room A")
+
+	_, err = f.server.DeleteEventSession(context.Background(), connect.NewRequest(&zenaov1.DeleteEventSessionRequest{SessionId: workshop.Id}))
+	require.NoError(t, err)
+	list, err = f.server.ListEventSessions(context.Background(), connect.NewRequest(&zenaov1.ListEventSessionsRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Sessions, 1)
+	require.Equal(t, []string{"Talks"}, list.Msg.Tracks)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	maxEventTemplateNameLength = 100
+	maxEventTemplateDuration   = 30 * 24 * time.Hour
+)
+
+// administeredCommunity checks that the user administrates the community.
+func administeredCommunity(tx zeni.DB, userID string, communityID string) error {
+	roles, err := tx.EntityRoles(zeni.EntityTypeUser, userID, zeni.EntityTypeCommunity, communityID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(roles, zeni.RoleAdministrator) {
+		return errors.New("user is not administrator of the community")
+	}
+	return nil
+}
+
+// eventCreateRequest returns the settings of the event as a creation request.
+// Organizers, gatekeepers and the password are left to the caller since the request holds them in clear.
+func eventCreateRequest(tx zeni.DB, evt *zeni.Event) (*zenaov1.CreateEventRequest, error) {
+	req := &zenaov1.CreateEventRequest{
+		Title:                   evt.Title,
+		Description:             evt.Description,
+		ImageUri:                evt.ImageURI,
+		StartDate:               uint64(evt.StartDate.Unix()),
+		EndDate:                 uint64(evt.EndDate.Unix()),
+		TicketPrice:             evt.TicketPrice,
+		Capacity:                evt.Capacity,
+		Discoverable:            evt.Discoverable,
+		CommunityEmail:          evt.CommunityEmail,
+		TicketTransfersDisabled: evt.TicketTransfersDisabled,
+		ApprovalRequired:        evt.ApprovalRequired,
+		InviteOnly:              evt.InviteOnly,
+		ReminderOffsets:         slices.Clone(evt.ReminderOffsets),
+		Category:                evt.Category,
+	}
+	if evt.Location != nil {
+		req.Location = proto.Clone(evt.Location).(*zenaov1.EventLocation)
+	}
+
+	cmt, err := tx.GetEventCommunity(evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	if cmt != nil {
+		req.CommunityId = cmt.ID
+	}
+
+	questions, err := tx.GetRegistrationQuestions(evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	req.RegistrationQuestions = registrationQuestionsToProto(questions)
+
+	if req.Tags, err = tx.GetEntityTags(zeni.EntityTypeEvent, evt.ID); err != nil {
+		return nil, err
+	}
+
+	groups, err := tx.GetPriceGroupsByEvent(evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		eventGroup := &zenaov1.EventPriceGroup{}
+		for _, price := range group.Prices {
+			eventPrice := &zenaov1.EventPrice{
+				AmountMinor:        price.AmountMinor,
+				CurrencyCode:       price.CurrencyCode,
+				Kind:               string(price.Kind),
+				MinimumAmountMinor: price.MinimumAmountMinor,
+			}
+			if price.SaleStartsAt != nil {
+				eventPrice.SaleStartsAt = price.SaleStartsAt.Unix()
+			}
+			if price.SaleEndsAt != nil {
+				eventPrice.SaleEndsAt = price.SaleEndsAt.Unix()
+			}
+			eventGroup.Prices = append(eventGroup.Prices, eventPrice)
+		}
+		req.PricesGroups = append(req.PricesGroups, eventGroup)
+	}
+
+	return req, nil
+}
+
+// eventTemplateSettings strips the fields that are specific to one event from the settings saved in a template.
+func eventTemplateSettings(req *zenaov1.CreateEventRequest, communityID string) *zenaov1.CreateEventRequest {
+	settings := proto.Clone(req).(*zenaov1.CreateEventRequest)
+	settings.StartDate = 0
+	settings.EndDate = 0
+	settings.Password = ""
+	settings.PublishAt = 0
+	settings.TemplateId = ""
+	settings.CommunityId = communityID
+	for _, group := range settings.PricesGroups {
+		group.Id = ""
+		for _, price := range group.Prices {
+			// sale windows are absolute dates, they would not fit the next events
+			price.Id = ""
+			price.PaymentAccountId = ""
+			price.SaleStartsAt = 0
+			price.SaleEndsAt = 0
+		}
+	}
+	return settings
+}
+
+// applyEventTemplate fills the fields left empty in the request with the template settings,
+// the end date defaults to the start date plus the template duration.
+func applyEventTemplate(req *zenaov1.CreateEventRequest, template *zeni.EventTemplate) {
+	dst := req.ProtoReflect()
+	proto.Clone(template.Event).ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !dst.Has(fd) {
+			dst.Set(fd, v)
+		}
+		return true
+	})
+	if req.StartDate != 0 && req.EndDate == 0 && template.Duration > 0 {
+		req.EndDate = req.StartDate + uint64(template.Duration/time.Second)
+	}
+}
+
+func validateEventTemplate(name string, duration uint32) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("template name is required")
+	}
+	if len(name) > maxEventTemplateNameLength {
+		return "", fmt.Errorf("template name cannot be longer than %d characters", maxEventTemplateNameLength)
+	}
+	if time.Duration(duration)*time.Second > maxEventTemplateDuration {
+		return "", fmt.Errorf("template duration cannot be longer than %s", maxEventTemplateDuration)
+	}
+	return name, nil
+}
+
+func eventTemplateToProto(template *zeni.EventTemplate) *zenaov1.EventTemplate {
+	return &zenaov1.EventTemplate{
+		Id:              template.ID,
+		CommunityId:     template.CommunityID,
+		Name:            template.Name,
+		CreatorId:       template.CreatorID,
+		CreatedAt:       template.CreatedAt.Unix(),
+		Event:           template.Event,
+		DurationSeconds: uint32(template.Duration / time.Second),
+	}
+}
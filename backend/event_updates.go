@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+// eventChanges summarizes the edits of the event that make the calendar of its attendees outdated,
+// it is empty if the dates and the location did not change.
+func eventChanges(before *zeni.Event, after *zeni.Event) ([]string, error) {
+	var changes []string
+
+	if !before.StartDate.Equal(after.StartDate) || !before.EndDate.Equal(after.EndDate) {
+		beforeTZ, err := before.Timezone()
+		if err != nil {
+			return nil, err
+		}
+		afterTZ, err := after.Timezone()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("Time changed from %s → %s to %s → %s",
+			before.StartDate.In(beforeTZ).Format(time.ANSIC), before.EndDate.In(beforeTZ).Format(time.ANSIC),
+			after.StartDate.In(afterTZ).Format(time.ANSIC), after.EndDate.In(afterTZ).Format(time.ANSIC)))
+	}
+
+	beforeLocation, err := zeni.LocationToString(before.Location)
+	if err != nil {
+		return nil, err
+	}
+	afterLocation, err := zeni.LocationToString(after.Location)
+	if err != nil {
+		return nil, err
+	}
+	if beforeLocation != afterLocation {
+		changes = append(changes, fmt.Sprintf("Location changed from %s to %s", beforeLocation, afterLocation))
+	}
+
+	return changes, nil
+}
+
+// usersEmails returns the email addresses of the users, teams have none.
+func (s *ZenaoServer) usersEmails(ctx context.Context, users []*zeni.User) ([]string, error) {
+	authIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		// Skip teams which don't have AuthID
+		if user.AuthID == "" {
+			continue
+		}
+		authIDs = append(authIDs, user.AuthID)
+	}
+	if len(authIDs) == 0 {
+		return nil, nil
+	}
+
+	authUsers, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
+	if err != nil {
+		return nil, err
+	}
+	emails := make([]string, 0, len(authUsers))
+	for _, authUser := range authUsers {
+		if authUser.Email == "" {
+			s.Logger.Error("user-email", zap.String("auth-id", authUser.ID), zap.String("email", authUser.Email))
+			continue
+		}
+		emails = append(emails, authUser.Email)
+	}
+	return emails, nil
+}
+
+// sendEventUpdatedEmails sends the participants the summary of the changes with a new invite,
+// the sequence bumped by the edit makes calendars replace the event instead of adding it again.
+func (s *ZenaoServer) sendEventUpdatedEmails(ctx context.Context, evt *zeni.Event, changes []string) error {
+	if s.MailClient == nil || s.Auth == nil {
+		return nil
+	}
+
+	participants, err := s.DB.WithContext(ctx).GetOrgUsersWithRoles(zeni.EntityTypeEvent, evt.ID, []string{zeni.RoleParticipant})
+	if err != nil {
+		return err
+	}
+	emails, err := s.usersEmails(ctx, participants)
+	if err != nil {
+		return err
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+
+	htmlStr, text, err := eventUpdatedMailContent(evt, changes)
+	if err != nil {
+		return err
+	}
+	icsData := s.eventICS(ctx, evt)
+
+	// cannot batch send w/ attachments: https://resend.com/docs/api-reference/emails/send-batch-emails
+	count := 0
+	for _, email := range emails {
+		if _, err := s.MailClient.Emails.SendWithContext(context.Background(), &resend.SendEmailRequest{
+			From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
+			To:      []string{email},
+			Subject: "Event updated: " + evt.Title,
+			Html:    htmlStr,
+			Text:    text,
+			Attachments: []*resend.Attachment{{
+				Content:     icsData,
+				Filename:    fmt.Sprintf("zenao_events_%s.ics", evt.ID),
+				ContentType: "text/calendar",
+			}},
+		}); err != nil {
+			s.Logger.Error("send-event-updated-email", zap.Error(err), zap.String("event-id", evt.ID))
+			continue
+		}
+		count++
+	}
+	s.Logger.Info("send-event-updated-emails", zap.String("event-id", evt.ID), zap.Int("total-sent", count), zap.Int("total-to-send", len(emails)))
+
+	return nil
+}
+
+// eventCancelICS generates the cancellation of the event and its agenda, the agenda is left out if it can't be loaded.
+func (s *ZenaoServer) eventCancelICS(ctx context.Context, evt *zeni.Event) []byte {
+	sessions, err := s.DB.WithContext(ctx).ListEventSessions(evt.ID)
+	if err != nil {
+		s.Logger.Error("list-event-sessions", zap.Error(err), zap.String("event-id", evt.ID))
+		sessions = nil
+	}
+	return GenerateCancelICS(evt, sessions, s.MailSender, s.Logger)
+}
+
+// notifyEventChanges compares the event with its state before an edit and sends the updated invite
+// to the participants if their calendar is outdated.
+func (s *ZenaoServer) notifyEventChanges(ctx context.Context, before *zeni.Event) error {
+	after, err := s.DB.WithContext(ctx).GetEvent(before.ID)
+	if err != nil {
+		return err
+	}
+	changes, err := eventChanges(before, after)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return s.sendEventUpdatedEmails(ctx, after, changes)
+}
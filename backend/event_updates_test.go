@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEventChangesAndCancelICS(t *testing.T) {
+	location := func(address string) *zenaov1.EventLocation {
+		return &zenaov1.EventLocation{Address: &zenaov1.EventLocation_Custom{Custom: &zenaov1.AddressCustom{
+			Address:  address,
+			Timezone: "Europe/Paris",
+		}}}
+	}
+	start := time.Date(2026, 11, 2, 18, 0, 0, 0, time.UTC)
+	before := &zeni.Event{
+		ID:        "7",
+		Title:     "Meetup",
+		StartDate: start,
+		EndDate:   start.Add(2 * time.Hour),
+		Location:  location("Ground Control - Paris"),
+	}
+
+	changes, err := eventChanges(before, before)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	after := *before
+	after.Title = "Renamed meetup"
+	changes, err = eventChanges(before, &after)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	after.StartDate = start.Add(time.Hour)
+	after.EndDate = start.Add(3 * time.Hour)
+	after.Location = location("La Felicità - Paris")
+	changes, err = eventChanges(before, &after)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Time changed from Mon Nov  2 19:00:00 2026 → Mon Nov  2 21:00:00 2026 to Mon Nov  2 20:00:00 2026 → Mon Nov  2 22:00:00 2026",
+		"Location changed from Ground Control - Paris to La Felicità - Paris",
+	}, changes)
+
+	html, text, err := eventUpdatedMailContent(&after, changes)
+	require.NoError(t, err)
+	require.Contains(t, html, "Location changed from Ground Control - Paris to La Felicità - Paris")
+	require.Contains(t, text, changes[0])
+
+	after.ICSSequenceNumber = 3
+	cancel := string(GenerateCancelICS(&after, []*zeni.EventSession{{
+		ID:        "4",
+		EventID:   "7",
+		Title:     "Opening talk",
+		StartDate: after.StartDate,
+		EndDate:   after.StartDate.Add(time.Hour),
+	}}, "contact@mail.zenao.io", zap.NewNop()))
+	require.Contains(t, cancel, "METHOD:CANCEL")
+	require.Contains(t, cancel, "UID:evt_7@zenao.io")
+	require.Contains(t, cancel, "UID:session_4@zenao.io")
+	require.Contains(t, cancel, "SEQUENCE:3")
+	require.NotContains(t, cancel, "STATUS:CONFIRMED")
+}
@@ -25,7 +25,11 @@ func (s *ZenaoServer) ExportParticipants(ctx context.Context, req *connect.Reque
 
 	s.Logger.Info("export-participants", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
 
-	var tickets []*zeni.SoldTicket
+	var (
+		tickets   []*zeni.SoldTicket
+		questions []*zeni.RegistrationQuestion
+		answers   []*zeni.RegistrationAnswer
+	)
 	if err := s.DB.TxWithSpan(ctx, "db.ExportParticipants", func(db zeni.DB) error {
 		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
 		if err != nil {
@@ -38,6 +42,14 @@ func (s *ZenaoServer) ExportParticipants(ctx context.Context, req *connect.Reque
 		if err != nil {
 			return err
 		}
+		questions, err = db.GetRegistrationQuestions(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		answers, err = db.GetEventRegistrationAnswers(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -54,10 +66,20 @@ func (s *ZenaoServer) ExportParticipants(ctx context.Context, req *connect.Reque
 		mailMap[p.ID] = p.Email
 	}
 
+	// answers of each user, keyed by question id
+	answersByUser := make(map[string]map[string]string)
+	for _, answer := range answers {
+		if answersByUser[answer.UserID] == nil {
+			answersByUser[answer.UserID] = make(map[string]string)
+		}
+		answersByUser[answer.UserID][answer.QuestionID] = strings.Join(answer.Values, ", ")
+	}
+
 	type ticketData struct {
 		email       string
 		displayName string
 		createdAt   time.Time
+		answers     []string
 	}
 
 	var ticketDataList []ticketData
@@ -80,6 +102,9 @@ func (s *ZenaoServer) ExportParticipants(ctx context.Context, req *connect.Reque
 			email:       email,
 			displayName: displayName,
 			createdAt:   t.CreatedAt,
+			answers: mapsl.Map(questions, func(question *zeni.RegistrationQuestion) string {
+				return answersByUser[t.UserID][question.ID]
+			}),
 		})
 	}
 
@@ -90,15 +115,19 @@ func (s *ZenaoServer) ExportParticipants(ctx context.Context, req *connect.Reque
 	var buffer bytes.Buffer
 	writer := csv.NewWriter(&buffer)
 
-	if err := writer.Write([]string{"Email", "Name", "Ticket CreatedAt"}); err != nil {
+	header := []string{"Email", "Name", "Ticket CreatedAt"}
+	for _, question := range questions {
+		header = append(header, question.Label)
+	}
+	if err := writer.Write(header); err != nil {
 		return nil, err
 	}
 	for _, data := range ticketDataList {
-		if err := writer.Write([]string{
+		if err := writer.Write(append([]string{
 			data.email,
 			data.displayName,
 			data.createdAt.Format(time.RFC3339),
-		}); err != nil {
+		}, data.answers...)); err != nil {
 			return nil, err
 		}
 	}
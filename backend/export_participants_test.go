@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationAnswersAreExportedWithParticipants(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 0},
+	)
+	questions := []*zenaov1.RegistrationQuestion{
+		{Kind: "text", Label: "Company"},
+		{Kind: "single_choice", Label: "T-shirt size", Options: []string{"S", "M", "L"}, Required: true},
+		{Kind: "multi_choice", Label: "Diet", Options: []string{"Vegan", "No nuts", "Halal"}},
+		{Kind: "checkbox", Label: "Share my email with sponsors"},
+	}
+
+	// free event with the same form, participants register without checkout
+	now := time.Now()
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Free event",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		RegistrationQuestions: questions,
+	}))
+	require.NoError(t, err)
+
+	evt, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: created.Msg.Id}))
+	require.NoError(t, err)
+	form := evt.Msg.Event.RegistrationQuestions
+	require.Len(t, form, 4)
+	require.Equal(t, []string{"S", "M", "L"}, form[1].Options)
+	require.True(t, form[1].Required)
+
+	organizer := f.auth.user
+	f.auth.user = nil
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: created.Msg.Id,
+		Email:   "bob@example.com",
+		Guests:  []string{"carol@example.com"},
+		Answers: []*zenaov1.RegistrationAnswer{{QuestionId: form[1].Id, Values: []string{"M"}}},
+	}))
+	require.ErrorContains(t, err, `answer to "T-shirt size" is required`)
+
+	_, err = f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{
+		EventId: created.Msg.Id,
+		Email:   "bob@example.com",
+		Guests:  []string{"carol@example.com"},
+		Answers: []*zenaov1.RegistrationAnswer{
+			{QuestionId: form[0].Id, Values: []string{"Acme"}},
+			{QuestionId: form[1].Id, Values: []string{"M"}},
+			{QuestionId: form[2].Id, Values: []string{"Vegan", "No nuts"}},
+			{QuestionId: form[3].Id, Values: []string{"true"}},
+		},
+		GuestsAnswers: []*zenaov1.AttendeeRegistrationAnswers{{
+			Email:   "Carol@example.com",
+			Answers: []*zenaov1.RegistrationAnswer{{QuestionId: form[1].Id, Values: []string{"S"}}},
+		}},
+	}))
+	require.NoError(t, err)
+	f.auth.user = organizer
+
+	export, err := f.server.ExportParticipants(context.Background(), connect.NewRequest(&zenaov1.ExportParticipantsRequest{EventId: created.Msg.Id}))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(export.Msg.Content), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, "Email,Name,Ticket CreatedAt,Company,T-shirt size,Diet,Share my email with sponsors", lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "bob@example.com,"))
+	require.True(t, strings.HasSuffix(lines[1], `,Acme,M,"Vegan, No nuts",true`))
+	require.True(t, strings.HasPrefix(lines[2], "carol@example.com,"))
+	require.True(t, strings.HasSuffix(lines[2], ",,S,,"))
+
+	// the form is replaced on edit, answers are collected per attendee at checkout
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+		EventId:     f.eventID,
+		Title:       "Paid event",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		RegistrationQuestions:       questions[1:2],
+		UpdateRegistrationQuestions: true,
+	}))
+	require.NoError(t, err)
+	paidForm, err := f.db.GetRegistrationQuestions(f.eventID)
+	require.NoError(t, err)
+	require.Len(t, paidForm, 1)
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{{
+			PriceId:       f.priceIDs[1],
+			AttendeeEmail: "dave@example.com",
+			Answers:       []*zenaov1.RegistrationAnswer{{QuestionId: paidForm[0].ID, Values: []string{"XXL"}}},
+		}},
+	})
+	require.ErrorContains(t, err, `"XXL" is not an option of "T-shirt size"`)
+
+	order, err := f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{{
+			PriceId:       f.priceIDs[1],
+			AttendeeEmail: "dave@example.com",
+			Answers:       []*zenaov1.RegistrationAnswer{{QuestionId: paidForm[0].ID, Values: []string{"L"}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	attendees, err := f.db.GetOrderAttendees(order.OrderId)
+	require.NoError(t, err)
+	answers, err := f.db.GetEventRegistrationAnswers(f.eventID)
+	require.NoError(t, err)
+	require.Len(t, answers, 1)
+	require.Equal(t, attendees[0].ID, answers[0].OrderAttendeeID)
+	require.Equal(t, []string{"L"}, answers[0].Values)
+
+	export, err = f.server.ExportParticipants(context.Background(), connect.NewRequest(&zenaov1.ExportParticipantsRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+	require.Contains(t, export.Msg.Content, "Email,Name,Ticket CreatedAt,T-shirt size\n")
+	require.Contains(t, export.Msg.Content, ",L\n")
+}
@@ -39,6 +39,7 @@ type fakegenConfig struct {
 	eventsCount      uint
 	postsCount       uint
 	pollsCount       uint
+	paymentAccounts  bool
 }
 
 func (conf *fakegenConfig) RegisterFlags(flset *flag.FlagSet) {
@@ -48,6 +49,7 @@ func (conf *fakegenConfig) RegisterFlags(flset *flag.FlagSet) {
 	flset.UintVar(&fakegenConf.communitiesCount, "communities", 5, "number of fake communities to generate")
 	flset.UintVar(&fakegenConf.postsCount, "posts", 31, "number of fake posts to generate")
 	flset.UintVar(&fakegenConf.pollsCount, "polls", 13, "number of fake polls to generate")
+	flset.BoolVar(&fakegenConf.paymentAccounts, "payment-accounts", false, "create verified fake payment accounts for communities, to use with the fake payment provider")
 }
 
 type fakeEvent struct {
@@ -265,6 +267,22 @@ func execFakegen() (retErr error) {
 			return err
 		}
 
+		if fakegenConf.paymentAccounts {
+			logger.Info("creating payment account")
+			now := time.Now().UTC()
+			if _, err := db.UpsertPaymentAccount(&zeni.PaymentAccount{
+				CommunityID:       zCommunity.ID,
+				PlatformType:      zeni.PaymentPlatformStripeConnect,
+				PlatformAccountID: "acct_fake_" + zCommunity.ID,
+				OnboardingState:   zeni.PaymentOnboardingStateCompleted,
+				StartedAt:         now,
+				VerificationState: zeni.PaymentVerificationStateVerified,
+				LastVerifiedAt:    &now,
+			}); err != nil {
+				return err
+			}
+		}
+
 	}
 
 	return nil
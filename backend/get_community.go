@@ -19,6 +19,7 @@ func (s *ZenaoServer) GetCommunity(ctx context.Context, req *connect.Request[zen
 		cmt   *zeni.Community
 		adm   []*zeni.User
 		count uint32
+		tags  []string
 	)
 	if err := s.DB.TxWithSpan(ctx, "db.GetCommunity", func(db zeni.DB) error {
 		var err error
@@ -34,6 +35,10 @@ func (s *ZenaoServer) GetCommunity(ctx context.Context, req *connect.Request[zen
 		if err != nil {
 			return err
 		}
+		tags, err = db.GetEntityTags(zeni.EntityTypeCommunity, req.Msg.CommunityId)
+		if err != nil {
+			return err
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -51,6 +56,8 @@ func (s *ZenaoServer) GetCommunity(ctx context.Context, req *connect.Request[zen
 		BannerUri:      cmt.BannerURI,
 		Administrators: admIDs,
 		CountMembers:   count,
+		Category:       cmt.Category,
+		Tags:           tags,
 	}
 
 	return connect.NewResponse(&zenaov1.GetCommunityResponse{Community: &info}), nil
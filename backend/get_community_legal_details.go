@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) GetCommunityLegalDetails(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetCommunityLegalDetailsRequest],
+) (*connect.Response[zenaov1.GetCommunityLegalDetailsResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("get-community-legal-details", zap.String("community-id", req.Msg.CommunityId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if req.Msg.CommunityId == "" {
+		return nil, errors.New("community id is required")
+	}
+
+	var cmt *zeni.Community
+	if err := s.DB.TxWithSpan(ctx, "db.GetCommunityLegalDetails", func(tx zeni.DB) error {
+		roles, err := tx.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeCommunity, req.Msg.CommunityId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleAdministrator) {
+			return errors.New("user is not administrator of the community")
+		}
+
+		cmt, err = tx.GetCommunity(req.Msg.CommunityId)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.GetCommunityLegalDetailsResponse{
+		Details: &zenaov1.CommunityLegalDetails{
+			LegalName:    cmt.LegalName,
+			LegalAddress: cmt.LegalAddress,
+			TaxId:        cmt.TaxID,
+			TaxRateBps:   cmt.TaxRateBps,
+		},
+	}), nil
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"errors"
+	"slices"
 	"sort"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/samouraiworld/zenao/backend/mapsl"
@@ -23,6 +25,8 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 		participants uint32
 		checkedIn    uint32
 		priceGroups  []*zeni.PriceGroup
+		questions    []*zeni.RegistrationQuestion
+		tags         []string
 	)
 
 	if err := s.DB.TxWithSpan(ctx, "GetEvent", func(tx zeni.DB) error {
@@ -54,6 +58,16 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 		}
 		priceGroups = groups
 
+		questions, err = tx.GetRegistrationQuestions(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+
+		tags, err = tx.GetEntityTags(zeni.EntityTypeEvent, req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -67,7 +81,18 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 		return u.ID
 	})
 
-	privacy, err := zeni.EventPrivacyFromPasswordHash(evt.PasswordHash)
+	// drafts are only visible to their organizers
+	if evt.Draft {
+		actor, err := s.GetOptionalActor(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		if actor == nil || !slices.Contains(orgIDs, actor.ID()) {
+			return nil, errors.New("event not found")
+		}
+	}
+
+	privacy, err := zeni.EventPrivacyOf(evt)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +112,22 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 		CheckedIn:    checkedIn,
 		Discoverable: evt.Discoverable,
 		Privacy:      privacy,
+		SeriesId:     evt.SeriesID,
+
+		RegistrationQuestions: registrationQuestionsToProto(questions),
+
+		TicketTransfersDisabled: evt.TicketTransfersDisabled,
+		ApprovalRequired:        evt.ApprovalRequired,
+		ReminderOffsets:         evt.ReminderOffsets,
+		Draft:                   evt.Draft,
+		Category:                evt.Category,
+		Tags:                    tags,
+	}
+	if !evt.PublishAt.IsZero() {
+		info.PublishAt = evt.PublishAt.Unix()
 	}
 	if len(priceGroups) > 0 {
+		now := time.Now()
 		info.PricesGroups = make([]*zenaov1.EventPriceGroup, 0, len(priceGroups))
 		for _, group := range priceGroups {
 			groupPrices := group.Prices
@@ -107,16 +146,25 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 				eventGroup.Prices = make([]*zenaov1.EventPrice, 0, len(groupPrices))
 				for _, price := range groupPrices {
 					eventPrice := &zenaov1.EventPrice{
-						AmountMinor:      price.AmountMinor,
-						CurrencyCode:     price.CurrencyCode,
-						PaymentAccountId: price.PaymentAccountID,
-						Id:               price.ID,
+						AmountMinor:        price.AmountMinor,
+						CurrencyCode:       price.CurrencyCode,
+						PaymentAccountId:   price.PaymentAccountID,
+						Id:                 price.ID,
+						Kind:               string(price.Kind),
+						MinimumAmountMinor: price.MinimumAmountMinor,
 					}
 					if price.PaymentAccount != nil {
 						eventPrice.PaymentAccountType = price.PaymentAccount.PlatformType
 					}
+					if price.SaleStartsAt != nil {
+						eventPrice.SaleStartsAt = price.SaleStartsAt.Unix()
+					}
+					if price.SaleEndsAt != nil {
+						eventPrice.SaleEndsAt = price.SaleEndsAt.Unix()
+					}
 					eventGroup.Prices = append(eventGroup.Prices, eventPrice)
 				}
+				eventGroup.ActivePriceId, eventGroup.NextPriceChangeAt = priceGroupSaleState(groupPrices, now)
 			}
 			info.PricesGroups = append(info.PricesGroups, eventGroup)
 		}
@@ -124,3 +172,24 @@ func (s *ZenaoServer) GetEvent(ctx context.Context, req *connect.Request[zenaov1
 
 	return connect.NewResponse(&zenaov1.GetEventResponse{Event: &info}), nil
 }
+
+// priceGroupSaleState returns the first of the prices on sale at now and the unix time of the next
+// sale window bound, which is when the active price may change.
+func priceGroupSaleState(prices []*zeni.Price, now time.Time) (string, int64) {
+	activeID := ""
+	nextChange := int64(0)
+	for _, price := range prices {
+		if activeID == "" && price.OnSaleAt(now) {
+			activeID = price.ID
+		}
+		for _, bound := range []*time.Time{price.SaleStartsAt, price.SaleEndsAt} {
+			if bound == nil || !bound.After(now) {
+				continue
+			}
+			if nextChange == 0 || bound.Unix() < nextChange {
+				nextChange = bound.Unix()
+			}
+		}
+	}
+	return activeID, nextChange
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+func (s *ZenaoServer) GetEventSeries(ctx context.Context, req *connect.Request[zenaov1.GetEventSeriesRequest]) (*connect.Response[zenaov1.GetEventSeriesResponse], error) {
+	if req.Msg.SeriesId == "" {
+		return nil, errors.New("series ID is required")
+	}
+
+	var (
+		series      *zeni.EventSeries
+		occurrences []*zeni.Event
+	)
+	if err := s.DB.TxWithSpan(ctx, "GetEventSeries", func(tx zeni.DB) error {
+		var err error
+		series, err = tx.GetEventSeries(req.Msg.SeriesId)
+		if err != nil {
+			return err
+		}
+		occurrences, err = tx.ListSeriesEvents(req.Msg.SeriesId)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	icsData, err := GenerateSeriesICS(series, occurrences, s.MailSender, s.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.GetEventSeriesResponse{
+		SeriesId: series.ID,
+		Rrule:    series.RRule,
+		EventIds: mapsl.Map(occurrences, func(evt *zeni.Event) string { return evt.ID }),
+		Ics:      icsData,
+	}), nil
+}
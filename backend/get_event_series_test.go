@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/samouraiworld/zenao/backend/ztesting"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEventSeriesEditScopesAndICS(t *testing.T) {
+	db, _ := ztesting.SetupTestDB(t)
+	auth := &priceStubAuth{user: &zeni.AuthUser{ID: "auth-user"}}
+	server := &ZenaoServer{
+		Logger: zap.NewNop(),
+		Auth:   auth,
+		DB:     db,
+	}
+	_, err := db.CreateUser(auth.user.ID)
+	require.NoError(t, err)
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	location := &zenaov1.EventLocation{
+		Address: &zenaov1.EventLocation_Custom{
+			Custom: &zenaov1.AddressCustom{Address: "Paris", Timezone: "Europe/Paris"},
+		},
+	}
+	// daylight saving time ends on the 25th of october
+	start := time.Date(2026, time.October, 13, 19, 0, 0, 0, paris)
+
+	created, err := server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Weekly meetup",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(start.Unix()),
+		EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+		Capacity:    10,
+		Location:    location,
+		Rrule:       "RRULE:FREQ=WEEKLY;COUNT=4",
+		PricesGroups: []*zenaov1.EventPriceGroup{
+			{Prices: []*zenaov1.EventPrice{{AmountMinor: 0}}},
+		},
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Msg.SeriesId)
+	require.Len(t, created.Msg.OccurrenceIds, 4)
+	require.Equal(t, created.Msg.OccurrenceIds[0], created.Msg.Id)
+
+	ids := created.Msg.OccurrenceIds
+	for i, id := range ids {
+		evt, err := db.GetEvent(id)
+		require.NoError(t, err)
+		require.Equal(t, created.Msg.SeriesId, evt.SeriesID)
+		require.Equal(t, 19, evt.StartDate.In(paris).Hour())
+		require.Equal(t, start.AddDate(0, 0, 7*i).Unix(), evt.StartDate.Unix())
+		groups, err := db.GetPriceGroupsByEvent(id)
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+	}
+
+	edit := func(eventID string, title string, startDate time.Time, scope zenaov1.EventSeriesEditScope) *zenaov1.EditEventResponse {
+		t.Helper()
+		res, err := server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+			EventId:     eventID,
+			Title:       title,
+			Description: "test description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(startDate.Unix()),
+			EndDate:     uint64(startDate.Add(2 * time.Hour).Unix()),
+			Capacity:    10,
+			Location:    location,
+			SeriesScope: scope,
+		}))
+		require.NoError(t, err)
+		return res.Msg
+	}
+	title := func(eventID string) string {
+		evt, err := db.GetEvent(eventID)
+		require.NoError(t, err)
+		return evt.Title
+	}
+
+	edit(ids[1], "Special meetup", start.AddDate(0, 0, 7), zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS)
+	require.Equal(t, "Weekly meetup", title(ids[0]))
+	require.Equal(t, "Special meetup", title(ids[1]))
+
+	// moving all the occurrences one hour later keeps their wall clock time across the daylight saving change
+	edit(ids[0], "Weekly meetup", start.Add(time.Hour), zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_ALL)
+	for i, id := range ids {
+		evt, err := db.GetEvent(id)
+		require.NoError(t, err)
+		require.Equal(t, "Weekly meetup", evt.Title)
+		require.Equal(t, 20, evt.StartDate.In(paris).Hour())
+		require.Equal(t, start.AddDate(0, 0, 7*i).Add(time.Hour).Unix(), evt.StartDate.Unix())
+	}
+
+	split := edit(ids[2], "Autumn meetup", start.AddDate(0, 0, 14).Add(time.Hour), zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING)
+	require.NotEqual(t, created.Msg.SeriesId, split.SeriesId)
+	require.Equal(t, "Weekly meetup", title(ids[1]))
+	require.Equal(t, "Autumn meetup", title(ids[2]))
+	require.Equal(t, "Autumn meetup", title(ids[3]))
+
+	// the second occurrence is moved alone and overrides the rule in the calendar
+	edit(ids[1], "Weekly meetup", start.AddDate(0, 0, 7).Add(2*time.Hour), zenaov1.EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS)
+
+	series, err := server.GetEventSeries(context.Background(), connect.NewRequest(&zenaov1.GetEventSeriesRequest{SeriesId: created.Msg.SeriesId}))
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=WEEKLY;COUNT=2", series.Msg.Rrule)
+	require.Equal(t, ids[:2], series.Msg.EventIds)
+	ics := string(series.Msg.Ics)
+	require.Contains(t, ics, "UID:series_"+created.Msg.SeriesId+"@zenao.io")
+	require.Contains(t, ics, "DTSTART;TZID=Europe/Paris:20261013T200000")
+	require.Contains(t, ics, "RRULE:FREQ=WEEKLY;COUNT=2")
+	require.Contains(t, ics, "RECURRENCE-ID;TZID=Europe/Paris:20261020T200000")
+	require.Contains(t, ics, "DTSTART;TZID=Europe/Paris:20261020T210000")
+
+	next, err := server.GetEventSeries(context.Background(), connect.NewRequest(&zenaov1.GetEventSeriesRequest{SeriesId: split.SeriesId}))
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=WEEKLY;COUNT=2", next.Msg.Rrule)
+	require.Equal(t, ids[2:], next.Msg.EventIds)
+	require.Contains(t, string(next.Msg.Ics), "DTSTART;TZID=Europe/Paris:20261027T200000")
+
+	_, err = server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Endless meetup",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(start.Unix()),
+		EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+		Capacity:    10,
+		Location:    location,
+		Rrule:       "FREQ=DAILY",
+	}))
+	require.ErrorContains(t, err, "count or an until date")
+}
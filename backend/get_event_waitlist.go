@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) GetEventWaitlist(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetEventWaitlistRequest],
+) (*connect.Response[zenaov1.GetEventWaitlistResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("get-event-waitlist", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	db := s.DB.WithContext(ctx)
+	roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("only organizers can view the waitlist")
+	}
+
+	entries, err := db.ListWaitlistEntries(req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*zenaov1.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = waitlistEntryToProto(entry)
+	}
+
+	return connect.NewResponse(&zenaov1.GetEventWaitlistResponse{
+		Entries: result,
+	}), nil
+}
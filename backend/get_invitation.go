@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+// GetInvitation returns the invitation of a token and records that its recipient opened it.
+// The token is the only credential, the recipient doesn't need to be signed in.
+func (s *ZenaoServer) GetInvitation(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetInvitationRequest],
+) (*connect.Response[zenaov1.GetInvitationResponse], error) {
+	var invitation *zeni.EventInvitation
+	if err := s.DB.TxWithSpan(ctx, "db.GetInvitation", func(tx zeni.DB) error {
+		var err error
+		invitation, err = s.verifyInvitationToken(tx, req.Msg.Token)
+		if err != nil {
+			return err
+		}
+		if invitation.Status != zeni.EventInvitationStatusInvited {
+			return nil
+		}
+		if err := tx.MarkEventInvitationOpened(invitation.ID, time.Now().Unix()); err != nil {
+			return err
+		}
+		invitation.Status = zeni.EventInvitationStatusOpened
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("get-invitation", zap.String("invitation-id", invitation.ID), zap.String("event-id", invitation.EventID))
+
+	return connect.NewResponse(&zenaov1.GetInvitationResponse{
+		EventId: invitation.EventID,
+		Email:   invitation.Email,
+		Status:  string(invitation.Status),
+	}), nil
+}
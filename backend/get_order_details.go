@@ -81,12 +81,18 @@ func (s *ZenaoServer) GetOrderDetails(
 
 	return connect.NewResponse(&zenaov1.GetOrderDetailsResponse{
 		Order: &zenaov1.OrderSummary{
-			OrderId:      order.ID,
-			EventId:      order.EventID,
-			BuyerId:      order.BuyerID,
-			AmountMinor:  order.AmountMinor,
-			CurrencyCode: order.CurrencyCode,
-			CreatedAt:    order.CreatedAt,
+			OrderId:             order.ID,
+			EventId:             order.EventID,
+			BuyerId:             order.BuyerID,
+			AmountMinor:         order.AmountMinor,
+			CurrencyCode:        order.CurrencyCode,
+			CreatedAt:           order.CreatedAt,
+			RefundStatus:        string(order.RefundStatus),
+			RefundedAmountMinor: order.RefundedAmountMinor,
+			PromoCode:           order.PromoCode,
+			DiscountAmountMinor: order.DiscountAmountMinor,
+			DonationAmountMinor: order.DonationAmountMinor,
+			InvoiceId:           order.InvoiceID,
 		},
 		Tickets: ticketInfos,
 	}), nil
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) GetOrderInvoice(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetOrderInvoiceRequest],
+) (*connect.Response[zenaov1.GetOrderInvoiceResponse], error) {
+	if req == nil || req.Msg == nil || strings.TrimSpace(req.Msg.OrderId) == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	orderID := strings.TrimSpace(req.Msg.OrderId)
+	s.Logger.Info("get-order-invoice",
+		zap.String("order-id", orderID),
+		zap.String("actor-id", actor.ID()),
+		zap.Bool("acting-as-team", actor.IsTeam()),
+	)
+
+	db := s.DB.WithContext(ctx)
+	order, err := db.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, errors.New("order not found")
+	}
+
+	if actor.ID() != order.BuyerID {
+		account, err := db.GetOrderPaymentAccount(order.ID)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			return nil, errors.New("order not found")
+		}
+		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeCommunity, account.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(roles, zeni.RoleAdministrator) {
+			return nil, errors.New("order not found")
+		}
+	}
+
+	if order.Status != zeni.OrderStatusSuccess {
+		return nil, errors.New("order is not paid")
+	}
+	if order.AmountMinor == 0 {
+		return nil, errors.New("free orders have no invoice")
+	}
+
+	// orders confirmed before invoicing existed, or whose numbering failed, get their invoice now
+	order, err = s.assignOrderInvoice(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	pdfData, err := s.generateOrderInvoicePDF(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.GetOrderInvoiceResponse{
+		InvoiceId: order.InvoiceID,
+		Filename:  invoiceFilename(order),
+		Pdf:       pdfData,
+	}), nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderInvoiceNumbersPaidOrders(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	mailClient, sendCount := newTestResendClient(t)
+	f.server.MailClient = mailClient
+	f.server.MailSender = "contact@mail.zenao.io"
+
+	first, err := f.startCheckout(f.priceIDs[0], "", "buyer@example.com", "friend@example.com")
+	require.NoError(t, err)
+	second, err := f.startCheckout(f.priceIDs[0], "", "other@example.com")
+	require.NoError(t, err)
+
+	account, err := f.db.GetOrderPaymentAccount(first.OrderId)
+	require.NoError(t, err)
+
+	_, err = f.server.EditCommunityLegalDetails(context.Background(), connect.NewRequest(&zenaov1.EditCommunityLegalDetailsRequest{
+		CommunityId: account.CommunityID,
+		Details: &zenaov1.CommunityLegalDetails{
+			LegalName:    "Test Community SAS",
+			LegalAddress: "1 rue de la Paix, 75002 Paris",
+			TaxId:        "FR00123456789",
+			TaxRateBps:   2000,
+		},
+	}))
+	require.NoError(t, err)
+
+	for _, orderID := range []string{first.OrderId, second.OrderId} {
+		order, err := f.db.GetOrder(orderID)
+		require.NoError(t, err)
+		require.NoError(t, f.server.confirmOrderPayment(context.Background(), order, "pi_"+orderID))
+	}
+	require.Equal(t, 2, *sendCount)
+
+	firstOrder, err := f.db.GetOrder(first.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("INV-%s-000001", account.CommunityID), firstOrder.InvoiceID)
+	require.Equal(t, "https://zenao.test/order/"+first.OrderId, firstOrder.InvoiceURL)
+	require.Equal(t, uint32(2000), firstOrder.InvoiceTaxRateBps)
+	secondOrder, err := f.db.GetOrder(second.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("INV-%s-000002", account.CommunityID), secondOrder.InvoiceID)
+
+	// confirming again keeps the invoice number
+	require.NoError(t, f.server.confirmOrderPayment(context.Background(), firstOrder, "pi_"+first.OrderId))
+	firstOrder, err = f.db.GetOrder(first.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("INV-%s-000001", account.CommunityID), firstOrder.InvoiceID)
+
+	inv, err := f.server.buildOrderInvoice(context.Background(), firstOrder)
+	require.NoError(t, err)
+	require.Equal(t, "Test Community SAS", inv.SellerName)
+	require.Equal(t, "buyer@example.com", inv.BuyerEmail)
+	require.Len(t, inv.Lines, 1)
+	require.Equal(t, 2, inv.Lines[0].Quantity)
+	require.Equal(t, int64(5000), inv.Lines[0].AmountMinor)
+	require.Equal(t, int64(833), inv.TaxAmountMinor())
+
+	// community administrators can download the invoice
+	resp, err := f.server.GetOrderInvoice(context.Background(), connect.NewRequest(&zenaov1.GetOrderInvoiceRequest{OrderId: first.OrderId}))
+	require.NoError(t, err)
+	require.Equal(t, firstOrder.InvoiceID, resp.Msg.InvoiceId)
+	require.Equal(t, fmt.Sprintf("invoice_%s.pdf", firstOrder.InvoiceID), resp.Msg.Filename)
+	require.True(t, bytes.HasPrefix(resp.Msg.Pdf, []byte("%PDF")))
+
+	f.auth.user = f.auth.ensureAuthUser("buyer@example.com")
+	_, err = f.server.GetOrderInvoice(context.Background(), connect.NewRequest(&zenaov1.GetOrderInvoiceRequest{OrderId: first.OrderId}))
+	require.NoError(t, err)
+	_, err = f.server.GetOrderInvoice(context.Background(), connect.NewRequest(&zenaov1.GetOrderInvoiceRequest{OrderId: second.OrderId}))
+	require.ErrorContains(t, err, "order not found")
+}
+
+func TestGetOrderInvoiceRequiresPaidOrder(t *testing.T) {
+	f := setupPaidEventFixture(t)
+
+	resp, err := f.startCheckout(f.priceIDs[0], "", "buyer@example.com")
+	require.NoError(t, err)
+
+	f.auth.user = f.auth.ensureAuthUser("buyer@example.com")
+	_, err = f.server.GetOrderInvoice(context.Background(), connect.NewRequest(&zenaov1.GetOrderInvoiceRequest{OrderId: resp.OrderId}))
+	require.ErrorContains(t, err, "not paid")
+
+	_, err = f.server.EditCommunityLegalDetails(context.Background(), connect.NewRequest(&zenaov1.EditCommunityLegalDetailsRequest{
+		CommunityId: "1",
+		Details:     &zenaov1.CommunityLegalDetails{LegalName: "Not mine"},
+	}))
+	require.ErrorContains(t, err, "administrator")
+}
+
+func TestInvoiceTaxAmountMinor(t *testing.T) {
+	for _, tc := range []struct {
+		total int64
+		bps   uint32
+		want  int64
+	}{
+		{total: 12000, bps: 2000, want: 2000},
+		{total: 2500, bps: 2000, want: 417},
+		{total: 1000, bps: 550, want: 52},
+		{total: 1000, bps: 0, want: 0},
+	} {
+		inv := &Invoice{TotalAmountMinor: tc.total, TaxRateBps: tc.bps}
+		require.Equal(t, tc.want, inv.TaxAmountMinor(), "total %d at %d bps", tc.total, tc.bps)
+	}
+}
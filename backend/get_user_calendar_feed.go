@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) GetUserCalendarFeed(
+	ctx context.Context,
+	req *connect.Request[zenaov1.GetUserCalendarFeedRequest],
+) (*connect.Response[zenaov1.GetUserCalendarFeedResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("get-user-calendar-feed", zap.Bool("rotate", req.Msg.Rotate), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	token := ""
+	if err := s.DB.TxWithSpan(ctx, "db.GetUserCalendarFeed", func(db zeni.DB) error {
+		if !req.Msg.Rotate {
+			token, err = db.GetCalendarFeedToken(actor.ID())
+			if err != nil || token != "" {
+				return err
+			}
+		}
+		token, err = newCalendarFeedToken()
+		if err != nil {
+			return err
+		}
+		return db.SetCalendarFeedToken(actor.ID(), token)
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.GetUserCalendarFeedResponse{
+		Token: token,
+		Path:  userCalendarFeedPath(token),
+	}), nil
+}
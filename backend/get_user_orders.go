@@ -30,12 +30,18 @@ func (s *ZenaoServer) GetUserOrders(
 			continue
 		}
 		summaries = append(summaries, &zenaov1.OrderSummary{
-			OrderId:      order.ID,
-			EventId:      order.EventID,
-			BuyerId:      order.BuyerID,
-			AmountMinor:  order.AmountMinor,
-			CurrencyCode: order.CurrencyCode,
-			CreatedAt:    order.CreatedAt,
+			OrderId:             order.ID,
+			EventId:             order.EventID,
+			BuyerId:             order.BuyerID,
+			AmountMinor:         order.AmountMinor,
+			CurrencyCode:        order.CurrencyCode,
+			CreatedAt:           order.CreatedAt,
+			RefundStatus:        string(order.RefundStatus),
+			RefundedAmountMinor: order.RefundedAmountMinor,
+			PromoCode:           order.PromoCode,
+			DiscountAmountMinor: order.DiscountAmountMinor,
+			DonationAmountMinor: order.DonationAmountMinor,
+			InvoiceId:           order.InvoiceID,
 		})
 	}
 
@@ -0,0 +1,149 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+// GetCalendarFeedToken implements zeni.DB.
+func (g *gormZenaoDB) GetCalendarFeedToken(userID string) (string, error) {
+	g, span := g.trace("gzdb.GetCalendarFeedToken")
+	defer span.End()
+
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse user id: %w", err)
+	}
+
+	var user User
+	if err := g.db.Select("id", "calendar_feed_token").First(&user, userIDInt).Error; err != nil {
+		return "", fmt.Errorf("get user by id: %w", err)
+	}
+	if user.CalendarFeedToken == nil {
+		return "", nil
+	}
+
+	return *user.CalendarFeedToken, nil
+}
+
+// SetCalendarFeedToken implements zeni.DB.
+func (g *gormZenaoDB) SetCalendarFeedToken(userID string, token string) error {
+	g, span := g.trace("gzdb.SetCalendarFeedToken")
+	defer span.End()
+
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse user id: %w", err)
+	}
+	if token == "" {
+		return errors.New("calendar feed token is required")
+	}
+
+	res := g.db.Model(&User{}).Where("id = ?", userIDInt).Update("calendar_feed_token", token)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+// GetUserByCalendarFeedToken implements zeni.DB.
+func (g *gormZenaoDB) GetUserByCalendarFeedToken(token string) (*zeni.User, error) {
+	g, span := g.trace("gzdb.GetUserByCalendarFeedToken")
+	defer span.End()
+
+	if token == "" {
+		return nil, nil
+	}
+
+	var user User
+	if err := g.db.Where("calendar_feed_token = ?", token).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbUserToZeniDBUser(&user), nil
+}
+
+// ListUserCalendarEvents implements zeni.DB.
+func (g *gormZenaoDB) ListUserCalendarEvents(userID string, roles []string, since time.Time) ([]*zeni.Event, error) {
+	g, span := g.trace("gzdb.ListUserCalendarEvents")
+	defer span.End()
+
+	if len(roles) == 0 {
+		return []*zeni.Event{}, nil
+	}
+
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	// cancelled events keep the roles of their users, only the event row is soft deleted
+	userEvents := g.db.Model(&EntityRole{}).
+		Select("org_id").
+		Where("entity_type = ? AND entity_id = ? AND org_type = ? AND role IN ?",
+			zeni.EntityTypeUser, userIDInt, zeni.EntityTypeEvent, roles)
+
+	return g.listCalendarEvents(userEvents, since, false)
+}
+
+// ListCommunityCalendarEvents implements zeni.DB.
+func (g *gormZenaoDB) ListCommunityCalendarEvents(communityID string, since time.Time) ([]*zeni.Event, error) {
+	g, span := g.trace("gzdb.ListCommunityCalendarEvents")
+	defer span.End()
+
+	communityIDInt, err := strconv.ParseUint(communityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse community id: %w", err)
+	}
+
+	// CancelEvent removes the event from its community right after deleting it, such links are kept
+	// so subscribers learn about the cancellation, events removed from the community earlier are not
+	communityEvents := g.db.Unscoped().Model(&EntityRole{}).
+		Select("entity_id").
+		Where("entity_type = ? AND org_type = ? AND org_id = ? AND role = ?",
+			zeni.EntityTypeEvent, zeni.EntityTypeCommunity, communityIDInt, zeni.RoleEvent).
+		Where("entity_roles.deleted_at IS NULL OR entity_id IN (?)",
+			g.db.Unscoped().Model(&Event{}).Select("id").Where("events.deleted_at IS NOT NULL AND events.deleted_at <= entity_roles.deleted_at"))
+
+	// the community feed is public, it must not reveal the events reserved to guests
+	return g.listCalendarEvents(communityEvents, since, true)
+}
+
+// listCalendarEvents returns the published events selected by the subquery ending after since, deleted ones included.
+// Public feeds only list the discoverable events that are neither guarded by a password nor invite-only.
+func (g *gormZenaoDB) listCalendarEvents(eventIDs *gorm.DB, since time.Time, public bool) ([]*zeni.Event, error) {
+	query := g.db.Unscoped().
+		Where("id IN (?) AND end_date >= ? AND draft = ?", eventIDs, since, false)
+	if public {
+		query = query.Where("discoverable = ? AND password_hash = ? AND invite_only = ?", true, "", false)
+	}
+
+	var dbEvts []Event
+	if err := query.
+		Order("start_date ASC, id ASC").
+		Find(&dbEvts).Error; err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+
+	result := make([]*zeni.Event, 0, len(dbEvts))
+	for i := range dbEvts {
+		zevt, err := dbEventToZeniEvent(&dbEvts[i])
+		if err != nil {
+			return nil, fmt.Errorf("convert db event to zeni event: %w", err)
+		}
+		result = append(result, zevt)
+	}
+
+	return result, nil
+}
@@ -14,7 +14,14 @@ type Community struct {
 	AvatarURI   string
 	BannerURI   string
 	CreatorID   uint
-	Creator     User `gorm:"foreignKey:CreatorID"`
+	Creator     User   `gorm:"foreignKey:CreatorID"`
+	Category    string `gorm:"index"` // the free-form tags are in EntityTag
+
+	LegalName       string
+	LegalAddress    string
+	TaxID           string
+	TaxRateBps      uint32
+	InvoiceSequence uint64
 }
 
 func dbCommunityToZeniCommunity(dbcmt *Community) (*zeni.Community, error) {
@@ -26,5 +33,11 @@ func dbCommunityToZeniCommunity(dbcmt *Community) (*zeni.Community, error) {
 		AvatarURI:   dbcmt.AvatarURI,
 		BannerURI:   dbcmt.BannerURI,
 		CreatorID:   fmt.Sprintf("%d", dbcmt.CreatorID),
+		Category:    dbcmt.Category,
+
+		LegalName:    dbcmt.LegalName,
+		LegalAddress: dbcmt.LegalAddress,
+		TaxID:        dbcmt.TaxID,
+		TaxRateBps:   dbcmt.TaxRateBps,
 	}, nil
 }
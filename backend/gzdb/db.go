@@ -28,6 +28,8 @@ type User struct {
 	AvatarURI   string
 	Plan        string `gorm:"default:'free'"`
 	IsTeam      bool   `gorm:"default:false"` // true for team accounts (teams are stored as users)
+	// CalendarFeedToken authenticates the calendar feed of the user, nil until the user asks for it
+	CalendarFeedToken *string `gorm:"uniqueIndex"`
 }
 
 type EntityRole struct {
@@ -94,6 +96,10 @@ func SetupDB(dsn string) (zeni.DB, error) {
 		return nil, err
 	}
 
+	if err := checkSearchIndex(db); err != nil {
+		return nil, err
+	}
+
 	return &gormZenaoDB{db: db}, nil
 }
 
@@ -9,7 +9,7 @@ import (
 )
 
 // ListCommunities implements zeni.DB.
-func (g *gormZenaoDB) ListCommunities(entityType string, entityID string, role string, limit int, offset int) ([]*zeni.Community, error) {
+func (g *gormZenaoDB) ListCommunities(entityType string, entityID string, role string, limit int, offset int, tagFilter *zeni.TagFilter) ([]*zeni.Community, error) {
 	g, span := g.trace("gzdb.ListCommunities")
 	defer span.End()
 
@@ -36,6 +36,7 @@ func (g *gormZenaoDB) ListCommunities(entityType string, entityID string, role s
 	if len(orgIDs) > 0 {
 		query = query.Where("id IN ?", orgIDs)
 	}
+	query = whereTagged(query, "communities", zeni.EntityTypeCommunity, tagFilter)
 	if err := query.Find(&dbCmts).Error; err != nil {
 		return nil, fmt.Errorf("query communities: %w", err)
 	}
@@ -145,6 +146,7 @@ func (g *gormZenaoDB) CreateCommunity(creatorID string, administratorsIDs []stri
 		AvatarURI:   req.AvatarUri,
 		BannerURI:   req.BannerUri,
 		CreatorID:   uint(creatorIDInt),
+		Category:    req.Category,
 	}
 
 	if err := g.db.Create(community).Error; err != nil {
@@ -208,6 +210,10 @@ func (g *gormZenaoDB) CreateCommunity(creatorID string, administratorsIDs []stri
 		}
 	}
 
+	if err := g.setEntityTags(zeni.EntityTypeCommunity, community.ID, req.Tags); err != nil {
+		return nil, err
+	}
+
 	zcmt, err := dbCommunityToZeniCommunity(community)
 	if err != nil {
 		return nil, fmt.Errorf("convert db community to zeni community: %w", err)
@@ -262,6 +268,15 @@ func (g *gormZenaoDB) EditCommunity(communityID string, administratorsIDs []stri
 		return nil, fmt.Errorf("update community in db: %w", err)
 	}
 
+	if req.UpdateTags {
+		if err := g.db.Model(&Community{}).Where("id = ?", cmtIDInt).Update("category", req.Category).Error; err != nil {
+			return nil, fmt.Errorf("update community category in db: %w", err)
+		}
+		if err := g.setEntityTags(zeni.EntityTypeCommunity, uint(cmtIDInt), req.Tags); err != nil {
+			return nil, err
+		}
+	}
+
 	dbcmt, err := g.getDBCommunity(communityID)
 	if err != nil {
 		return nil, fmt.Errorf("get community from db: %w", err)
@@ -275,6 +290,33 @@ func (g *gormZenaoDB) EditCommunity(communityID string, administratorsIDs []stri
 	return zcmt, nil
 }
 
+// EditCommunityLegalDetails implements zeni.DB.
+func (g *gormZenaoDB) EditCommunityLegalDetails(communityID string, legalName string, legalAddress string, taxID string, taxRateBps uint32) error {
+	g, span := g.trace("gzdb.EditCommunityLegalDetails")
+	defer span.End()
+
+	cmtIDInt, err := strconv.ParseUint(communityID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse community id: %w", err)
+	}
+
+	// use a map so the details can be cleared
+	updates := map[string]any{
+		"legal_name":    legalName,
+		"legal_address": legalAddress,
+		"tax_id":        taxID,
+		"tax_rate_bps":  taxRateBps,
+	}
+	res := g.db.Model(&Community{}).Where("id = ?", cmtIDInt).Updates(updates)
+	if res.Error != nil {
+		return fmt.Errorf("update community legal details in db: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("community %s not found", communityID)
+	}
+	return nil
+}
+
 // AddMemberToCommunity implements zeni.DB.
 func (g *gormZenaoDB) AddMemberToCommunity(communityID string, userID string) error {
 	communityIDInt, err := strconv.ParseUint(communityID, 10, 64)
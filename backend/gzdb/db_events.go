@@ -39,6 +39,20 @@ func (g *gormZenaoDB) CreateEvent(creatorID string, organizersIDs []string, gate
 		Capacity:     req.Capacity,
 		Discoverable: req.Discoverable,
 		PasswordHash: passwordHash,
+
+		TicketTransfersDisabled: req.TicketTransfersDisabled,
+		ApprovalRequired:        req.ApprovalRequired,
+		InviteOnly:              req.InviteOnly,
+		ReminderOffsets:         joinReminderOffsets(req.ReminderOffsets),
+
+		Draft:          req.Draft || req.PublishAt != 0,
+		CommunityEmail: req.CommunityEmail,
+
+		Category: req.Category,
+	}
+	if req.PublishAt != 0 {
+		publishAt := time.Unix(req.PublishAt, 0)
+		evt.PublishAt = &publishAt
 	}
 	if err := evt.SetLocation(req.Location); err != nil {
 		return nil, fmt.Errorf("convert location: %w", err)
@@ -86,6 +100,10 @@ func (g *gormZenaoDB) CreateEvent(creatorID string, organizersIDs []string, gate
 		}
 	}
 
+	if err := g.setEntityTags(zeni.EntityTypeEvent, evt.ID, req.Tags); err != nil {
+		return nil, err
+	}
+
 	zevt, err := dbEventToZeniEvent(evt)
 	if err != nil {
 		return nil, fmt.Errorf("convert db event to zeni event: %w", err)
@@ -94,6 +112,19 @@ func (g *gormZenaoDB) CreateEvent(creatorID string, organizersIDs []string, gate
 	return zevt, nil
 }
 
+// SetEventPasswordHash implements zeni.DB.
+func (g *gormZenaoDB) SetEventPasswordHash(eventID string, passwordHash string) error {
+	g, span := g.trace("gzdb.SetEventPasswordHash")
+	defer span.End()
+
+	evtIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+
+	return g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("password_hash", passwordHash).Error
+}
+
 // CancelEvent implements zeni.DB.
 func (g *gormZenaoDB) CancelEvent(eventID string) error {
 	g, span := g.trace("gzdb.CancelEvent")
@@ -126,6 +157,11 @@ func (g *gormZenaoDB) CancelEvent(eventID string) error {
 		}
 	}
 
+	// calendars ignore the cancellation in feeds unless the sequence increased
+	if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("ics_sequence_number", gorm.Expr("ics_sequence_number + ?", 1)).Error; err != nil {
+		return err
+	}
+
 	err = g.db.Delete(&Event{}, evtIDInt).Error
 	if err != nil {
 		return err
@@ -191,6 +227,39 @@ func (g *gormZenaoDB) EditEvent(eventID string, organizersIDs []string, gatekeep
 		}
 	}
 
+	if req.UpdateTicketTransfersDisabled {
+		if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("ticket_transfers_disabled", req.TicketTransfersDisabled).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if req.UpdateApprovalRequired {
+		if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("approval_required", req.ApprovalRequired).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if req.UpdateInviteOnly {
+		if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("invite_only", req.InviteOnly).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if req.UpdateReminderOffsets {
+		if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("reminder_offsets", joinReminderOffsets(req.ReminderOffsets)).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if req.UpdateTags {
+		if err := g.db.Model(&Event{}).Where("id = ?", evtIDInt).Update("category", req.Category).Error; err != nil {
+			return nil, err
+		}
+		if err := g.setEntityTags(zeni.EntityTypeEvent, uint(evtIDInt), req.Tags); err != nil {
+			return nil, err
+		}
+	}
+
 	// XXX: this is a hack to allow to disable the guard, since empty values are ignored by db.Updates on structs
 	// we should rewrite this if db become bottleneck
 	if req.UpdatePassword && req.Password == "" {
@@ -217,12 +286,12 @@ func (g *gormZenaoDB) GetEvent(id string) (*zeni.Event, error) {
 }
 
 // ListEvents implements zeni.DB.
-func (g *gormZenaoDB) ListEvents(limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, locationFilter *zeni.LocationFilter) ([]*zeni.Event, error) {
+func (g *gormZenaoDB) ListEvents(limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, locationFilter *zeni.LocationFilter, tagFilter *zeni.TagFilter) ([]*zeni.Event, error) {
 	g, span := g.trace("gzdb.ListEvents")
 	defer span.End()
 
 	var dbEvts []Event
-	query := g.db.Model(&Event{})
+	query := g.db.Model(&Event{}).Where("draft = ?", false)
 
 	// XXX: if both value set we need to know if we want reverse or not (rep. of what we have in eventreg with Iterate/ReverseIterate)
 	if from != 0 || to != 0 {
@@ -246,20 +315,8 @@ func (g *gormZenaoDB) ListEvents(limit int, offset int, from int64, to int64, di
 		query = query.Where("discoverable = ?", d)
 	}
 
-	// Filter by location if provided.
-	// Uses Haversine formula to calculate distance between two points on Earth.
-	// Only applies to events with geo location (loc_kind = 'geo').
-	if locationFilter != nil && locationFilter.RadiusKm > 0 {
-		// Earth's radius in kilometers
-		const earthRadiusKm = 6371.0
-		query = query.Where(`loc_kind = 'geo' AND (
-			? * 2 * ASIN(SQRT(
-				POWER(SIN((RADIANS(loc_lat) - RADIANS(?)) / 2), 2) +
-				COS(RADIANS(?)) * COS(RADIANS(loc_lat)) *
-				POWER(SIN((RADIANS(loc_lng) - RADIANS(?)) / 2), 2)
-			))
-		) <= ?`, earthRadiusKm, locationFilter.Lat, locationFilter.Lat, locationFilter.Lng, locationFilter.RadiusKm)
-	}
+	query = whereWithinRadius(query, "", locationFilter)
+	query = whereTagged(query, "events", zeni.EntityTypeEvent, tagFilter)
 
 	if err := query.Limit(limit).Offset(offset).Find(&dbEvts).Error; err != nil {
 		return nil, fmt.Errorf("query events: %w", err)
@@ -277,8 +334,26 @@ func (g *gormZenaoDB) ListEvents(limit int, offset int, from int64, to int64, di
 	return zenEvts, nil
 }
 
+// whereWithinRadius filters the events by location if a filter is provided, prefix qualifies the events columns.
+// Uses Haversine formula to calculate distance between two points on Earth.
+// Only applies to events with geo location (loc_kind = 'geo').
+func whereWithinRadius(query *gorm.DB, prefix string, locationFilter *zeni.LocationFilter) *gorm.DB {
+	if locationFilter == nil || locationFilter.RadiusKm <= 0 {
+		return query
+	}
+	// Earth's radius in kilometers
+	const earthRadiusKm = 6371.0
+	return query.Where(fmt.Sprintf(`%[1]sloc_kind = 'geo' AND (
+		? * 2 * ASIN(SQRT(
+			POWER(SIN((RADIANS(%[1]sloc_lat) - RADIANS(?)) / 2), 2) +
+			COS(RADIANS(?)) * COS(RADIANS(%[1]sloc_lat)) *
+			POWER(SIN((RADIANS(%[1]sloc_lng) - RADIANS(?)) / 2), 2)
+		))
+	) <= ?`, prefix), earthRadiusKm, locationFilter.Lat, locationFilter.Lat, locationFilter.Lng, locationFilter.RadiusKm)
+}
+
 // ListEventsByUserRoles implements zeni.DB.
-func (g *gormZenaoDB) ListEventsByUserRoles(userID string, roles []string, limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter) ([]*zeni.EventWithRoles, error) {
+func (g *gormZenaoDB) ListEventsByUserRoles(userID string, roles []string, limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, withDrafts bool) ([]*zeni.EventWithRoles, error) {
 	g, span := g.trace("gzdb.ListEventsByUserRoles")
 	defer span.End()
 
@@ -318,6 +393,12 @@ func (g *gormZenaoDB) ListEventsByUserRoles(userID string, roles []string, limit
 		query = query.Where("events.discoverable = ?", d)
 	}
 
+	if withDrafts {
+		query = query.Where("events.draft = ? OR entity_roles.role = ?", false, zeni.RoleOrganizer)
+	} else {
+		query = query.Where("events.draft = ?", false)
+	}
+
 	var dbEvts []Event
 	if err := query.Limit(limit).Offset(offset).Find(&dbEvts).Error; err != nil {
 		return nil, fmt.Errorf("query events: %w", err)
@@ -177,6 +177,11 @@ func (g *gormZenaoDB) CreatePrice(paymentAccount *zeni.PaymentAccount, price *ze
 		AmountMinor:      price.AmountMinor,
 		CurrencyCode:     price.CurrencyCode,
 		PaymentAccountID: paymentAccountID,
+		SaleStartsAt:     price.SaleStartsAt,
+		SaleEndsAt:       price.SaleEndsAt,
+
+		Kind:               string(price.Kind),
+		MinimumAmountMinor: price.MinimumAmountMinor,
 	}
 
 	if err := g.db.Create(dbPrice).Error; err != nil {
@@ -277,10 +282,14 @@ func (g *gormZenaoDB) UpdatePrice(paymentAccount *zeni.PaymentAccount, price *ze
 	}
 
 	res := g.db.Model(&Price{}).Where("id = ?", priceIDInt).Updates(map[string]any{
-		"amount_minor":       price.AmountMinor,
-		"currency_code":      price.CurrencyCode,
-		"payment_account_id": paymentAccountID,
-		"updated_at":         time.Now().UTC(),
+		"amount_minor":         price.AmountMinor,
+		"currency_code":        price.CurrencyCode,
+		"payment_account_id":   paymentAccountID,
+		"sale_starts_at":       price.SaleStartsAt,
+		"sale_ends_at":         price.SaleEndsAt,
+		"kind":                 price.Kind,
+		"minimum_amount_minor": price.MinimumAmountMinor,
+		"updated_at":           time.Now().UTC(),
 	})
 	if res.Error != nil {
 		return res.Error
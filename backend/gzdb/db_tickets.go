@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/samouraiworld/zenao/backend/zeni"
 	"gorm.io/gorm"
@@ -78,6 +79,18 @@ func (g *gormZenaoDB) participate(
 		}
 
 		remaining := int64(evt.Capacity) - participantsCount
+		if orderID == nil {
+			// free registrations can't take the spots reserved for waitlist offers,
+			// paid orders already went through the hold-aware checkout capacity check
+			var reserved int64
+			if err := tx.Model(&TicketHold{}).
+				Select("COALESCE(SUM(quantity), 0)").
+				Where("event_id = ? AND waitlist_entry_id IS NOT NULL AND expires_at > ?", evt.ID, time.Now().Unix()).
+				Row().Scan(&reserved); err != nil {
+				return err
+			}
+			remaining -= reserved
+		}
 		if remaining <= 0 {
 			return errors.New("sold out")
 		}
@@ -176,9 +189,113 @@ func (g *gormZenaoDB) CancelParticipation(eventID string, userID string) error {
 	if err := g.db.Model(&SoldTicket{}).Where("event_id = ? AND user_id = ?", evtIDInt, userIDInt).Delete(&SoldTicket{}).Error; err != nil {
 		return err
 	}
+
+	// the seats taken in the sessions of the event are released with the ticket
+	if err := g.db.Where("user_id = ? AND session_id IN (?)", userIDInt, g.eventSessionIDs(uint(evtIDInt))).Delete(&SessionRegistration{}).Error; err != nil {
+		return err
+	}
 	return nil
 }
 
+// TransferTicket implements zeni.DB.
+func (g *gormZenaoDB) TransferTicket(eventID string, fromUserID string, toUserID string, ticketSecret string) (*zeni.SoldTicket, error) {
+	g, span := g.trace("gzdb.TransferTicket")
+	defer span.End()
+
+	evtIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	fromUserIDInt, err := strconv.ParseUint(fromUserID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+	toUserIDInt, err := strconv.ParseUint(toUserID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	ticket, err := zeni.NewTicketFromSecret(ticketSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	var transferred SoldTicket
+	if err := g.db.Transaction(func(tx *gorm.DB) error {
+		var previous SoldTicket
+		if err := tx.Preload("Checkin").
+			Where("event_id = ? AND user_id = ?", evtIDInt, fromUserIDInt).
+			First(&previous).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("user has no ticket for this event")
+			}
+			return err
+		}
+		if previous.Checkin != nil {
+			return errors.New("ticket already checked-in")
+		}
+
+		var count int64
+		if err := tx.Model(&SoldTicket{}).Where("event_id = ? AND user_id = ?", evtIDInt, toUserIDInt).Count(&count).Error; err != nil {
+			return err
+		}
+		if count != 0 {
+			return errors.New("user is already participant for this event")
+		}
+
+		// the order attendee link is unique, it moves to the new ticket.
+		// The attendee row keeps who the ticket was bought for, the sold ticket tracks who holds it
+		if err := tx.Model(&SoldTicket{}).Where("id = ?", previous.ID).Update("order_attendee_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&SoldTicket{}, previous.ID).Error; err != nil {
+			return err
+		}
+
+		transferred = SoldTicket{
+			EventID:         previous.EventID,
+			BuyerID:         previous.BuyerID,
+			UserID:          uint(toUserIDInt),
+			OrderID:         previous.OrderID,
+			PriceID:         previous.PriceID,
+			PriceGroupID:    previous.PriceGroupID,
+			OrderAttendeeID: previous.OrderAttendeeID,
+			AmountMinor:     previous.AmountMinor,
+			CurrencyCode:    previous.CurrencyCode,
+			Secret:          ticket.Secret(),
+			Pubkey:          ticket.Pubkey(),
+		}
+		if err := tx.Create(&transferred).Error; err != nil {
+			return err
+		}
+
+		// the seats taken in the sessions of the event go with the ticket
+		if err := tx.Model(&SessionRegistration{}).
+			Where("user_id = ? AND session_id IN (?)", fromUserIDInt, g.eventSessionIDs(uint(evtIDInt))).
+			Update("user_id", toUserIDInt).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("org_type = ? AND org_id = ? AND entity_type = ? AND entity_id = ? AND role = ?",
+			zeni.EntityTypeEvent, evtIDInt, zeni.EntityTypeUser, fromUserIDInt, zeni.RoleParticipant).
+			Delete(&EntityRole{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Save(&EntityRole{
+			EntityType: zeni.EntityTypeUser,
+			EntityID:   uint(toUserIDInt),
+			OrgType:    zeni.EntityTypeEvent,
+			OrgID:      uint(evtIDInt),
+			Role:       zeni.RoleParticipant,
+		}).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	return dbSoldTicketToZeniSoldTicket(&transferred)
+}
+
 // GetDeletedTickets implements zeni.DB.
 func (g *gormZenaoDB) GetDeletedTickets(eventID string) ([]*zeni.SoldTicket, error) {
 	evtIDInt, err := strconv.ParseUint(eventID, 10, 64)
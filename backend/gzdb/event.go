@@ -26,6 +26,20 @@ type Event struct {
 
 	PasswordHash string // event is guarded if set
 
+	TicketTransfersDisabled bool
+
+	ApprovalRequired bool // participants apply and are reviewed by organizers
+
+	InviteOnly bool // only the recipients of an EventInvitation can participate
+
+	ReminderOffsets string // seconds before the start date at which participants are reminded, one per line
+
+	Draft          bool       `gorm:"not null;default:false"` // only organizers see the event until it is published
+	PublishAt      *time.Time `gorm:"index"`                  // when a draft is scheduled to be published
+	CommunityEmail bool       // members of the community are mailed when the draft is published
+
+	Category string `gorm:"index"` // the free-form tags are in EntityTag
+
 	LocVenueName    string
 	LocKind         string // one of: geo, virtual or custom
 	LocAddress      string // uri in virtual
@@ -42,6 +56,11 @@ type Event struct {
 
 	// Used to handle updates of ics file
 	ICSSequenceNumber uint32 `gorm:"column:ics_sequence_number;not null;default:0"`
+
+	// Set on occurrences of a recurring series
+
+	SeriesID           *uint `gorm:"index"`
+	SeriesRecurrenceAt *time.Time
 }
 
 func (e *Event) SetLocation(loc *zenaov1.EventLocation) error {
@@ -120,8 +139,30 @@ func dbEventToZeniEvent(dbevt *Event) (*zeni.Event, error) {
 		Location:          loc,
 		PasswordHash:      dbevt.PasswordHash,
 		ICSSequenceNumber: dbevt.ICSSequenceNumber,
+
+		TicketTransfersDisabled: dbevt.TicketTransfersDisabled,
+		ApprovalRequired:        dbevt.ApprovalRequired,
+		InviteOnly:              dbevt.InviteOnly,
+		Draft:                   dbevt.Draft,
+		CommunityEmail:          dbevt.CommunityEmail,
+		Category:                dbevt.Category,
+	}
+
+	reminderOffsets, err := splitReminderOffsets(dbevt.ReminderOffsets)
+	if err != nil {
+		return nil, err
 	}
+	evt.ReminderOffsets = reminderOffsets
 
+	if dbevt.SeriesID != nil {
+		evt.SeriesID = fmt.Sprintf("%d", *dbevt.SeriesID)
+	}
+	if dbevt.PublishAt != nil {
+		evt.PublishAt = *dbevt.PublishAt
+	}
+	if dbevt.SeriesRecurrenceAt != nil {
+		evt.SeriesRecurrenceAt = *dbevt.SeriesRecurrenceAt
+	}
 	if dbevt.DeletedAt.Valid {
 		evt.DeletedAt = dbevt.DeletedAt.Time
 	}
@@ -0,0 +1,193 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type EventApplication struct {
+	gorm.Model
+	EventID    uint   `gorm:"index;not null"`
+	UserID     uint   `gorm:"index;not null"`
+	BuyerID    uint   `gorm:"not null"`
+	Status     string `gorm:"index;not null"`
+	Message    string
+	ReviewedAt *int64
+	Event      *Event `gorm:"foreignKey:EventID"`
+	User       *User  `gorm:"foreignKey:UserID"`
+	Buyer      *User  `gorm:"foreignKey:BuyerID"`
+}
+
+func dbEventApplicationToZeniEventApplication(dbApplication *EventApplication) *zeni.EventApplication {
+	if dbApplication == nil {
+		return nil
+	}
+	return &zeni.EventApplication{
+		CreatedAt:  dbApplication.CreatedAt,
+		ID:         fmt.Sprintf("%d", dbApplication.ID),
+		EventID:    fmt.Sprintf("%d", dbApplication.EventID),
+		UserID:     fmt.Sprintf("%d", dbApplication.UserID),
+		BuyerID:    fmt.Sprintf("%d", dbApplication.BuyerID),
+		Status:     zeni.EventApplicationStatus(dbApplication.Status),
+		Message:    dbApplication.Message,
+		ReviewedAt: dbApplication.ReviewedAt,
+	}
+}
+
+// CreateEventApplication implements zeni.DB.
+func (g *gormZenaoDB) CreateEventApplication(eventID string, buyerID string, userID string) (*zeni.EventApplication, error) {
+	g, span := g.trace("gzdb.CreateEventApplication")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	buyerIDInt, err := strconv.ParseUint(buyerID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse buyer id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var count int64
+	if err := g.db.Model(&EventApplication{}).
+		Where("event_id = ? AND user_id = ?", eventIDInt, userIDInt).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errors.New("user already applied to this event")
+	}
+
+	dbApplication := &EventApplication{
+		EventID: uint(eventIDInt),
+		UserID:  uint(userIDInt),
+		BuyerID: uint(buyerIDInt),
+		Status:  string(zeni.EventApplicationStatusPending),
+	}
+	if err := g.db.Create(dbApplication).Error; err != nil {
+		return nil, err
+	}
+
+	return dbEventApplicationToZeniEventApplication(dbApplication), nil
+}
+
+// GetEventApplication implements zeni.DB.
+func (g *gormZenaoDB) GetEventApplication(applicationID string) (*zeni.EventApplication, error) {
+	g, span := g.trace("gzdb.GetEventApplication")
+	defer span.End()
+
+	applicationIDInt, err := strconv.ParseUint(applicationID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse application id: %w", err)
+	}
+
+	var application EventApplication
+	if err := g.db.First(&application, applicationIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbEventApplicationToZeniEventApplication(&application), nil
+}
+
+// GetUserEventApplication implements zeni.DB.
+func (g *gormZenaoDB) GetUserEventApplication(eventID string, userID string) (*zeni.EventApplication, error) {
+	g, span := g.trace("gzdb.GetUserEventApplication")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var application EventApplication
+	if err := g.db.
+		Where("event_id = ? AND user_id = ?", eventIDInt, userIDInt).
+		Order("id DESC").
+		First(&application).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbEventApplicationToZeniEventApplication(&application), nil
+}
+
+// ListEventApplications implements zeni.DB.
+func (g *gormZenaoDB) ListEventApplications(eventID string, status zeni.EventApplicationStatus) ([]*zeni.EventApplication, error) {
+	g, span := g.trace("gzdb.ListEventApplications")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	query := g.db.Where("event_id = ?", eventIDInt)
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+	var applications []EventApplication
+	if err := query.Order("id ASC").Find(&applications).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.EventApplication, len(applications))
+	for i := range applications {
+		result[i] = dbEventApplicationToZeniEventApplication(&applications[i])
+	}
+
+	return result, nil
+}
+
+// ReviewEventApplication implements zeni.DB.
+func (g *gormZenaoDB) ReviewEventApplication(applicationID string, status zeni.EventApplicationStatus, message string, nowUnix int64) (*zeni.EventApplication, error) {
+	g, span := g.trace("gzdb.ReviewEventApplication")
+	defer span.End()
+
+	if status != zeni.EventApplicationStatusApproved && status != zeni.EventApplicationStatusRejected {
+		return nil, fmt.Errorf("invalid review status %q", status)
+	}
+
+	applicationIDInt, err := strconv.ParseUint(applicationID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse application id: %w", err)
+	}
+
+	// the status condition makes concurrent reviews of the same application fail instead of both applying
+	res := g.db.Model(&EventApplication{}).
+		Where("id = ? AND status = ?", applicationIDInt, string(zeni.EventApplicationStatusPending)).
+		Updates(map[string]any{
+			"status":      string(status),
+			"message":     message,
+			"reviewed_at": nowUnix,
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, errors.New("application not found or already reviewed")
+	}
+
+	var application EventApplication
+	if err := g.db.First(&application, applicationIDInt).Error; err != nil {
+		return nil, err
+	}
+
+	return dbEventApplicationToZeniEventApplication(&application), nil
+}
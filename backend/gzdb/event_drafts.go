@@ -0,0 +1,72 @@
+package gzdb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+// ScheduleEventPublication implements zeni.DB.
+func (g *gormZenaoDB) ScheduleEventPublication(eventID string, publishAt time.Time) error {
+	g, span := g.trace("gzdb.ScheduleEventPublication")
+	defer span.End()
+
+	evtIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+
+	var value *time.Time
+	if !publishAt.IsZero() {
+		value = &publishAt
+	}
+	return g.db.Model(&Event{}).Where("id = ? AND draft = ?", evtIDInt, true).Update("publish_at", value).Error
+}
+
+// PublishEvent implements zeni.DB.
+func (g *gormZenaoDB) PublishEvent(eventID string) (bool, error) {
+	g, span := g.trace("gzdb.PublishEvent")
+	defer span.End()
+
+	evtIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse event id: %w", err)
+	}
+
+	// only one of concurrent publications flips the draft, so the event is announced once
+	res := g.db.Model(&Event{}).Where("id = ? AND draft = ?", evtIDInt, true).Updates(map[string]any{
+		"draft":      false,
+		"publish_at": nil,
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ListEventsToPublish implements zeni.DB.
+func (g *gormZenaoDB) ListEventsToPublish(now time.Time) ([]*zeni.Event, error) {
+	g, span := g.trace("gzdb.ListEventsToPublish")
+	defer span.End()
+
+	var dbEvts []Event
+	if err := g.db.
+		Where("draft = ? AND publish_at IS NOT NULL AND publish_at <= ?", true, now).
+		Order("publish_at ASC, id ASC").
+		Find(&dbEvts).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.Event, 0, len(dbEvts))
+	for i := range dbEvts {
+		zevt, err := dbEventToZeniEvent(&dbEvts[i])
+		if err != nil {
+			return nil, fmt.Errorf("convert db event to zeni event: %w", err)
+		}
+		result = append(result, zevt)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,239 @@
+package gzdb
+
+import (
+	srand "crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+const invitationNonceSize = 16
+
+type EventInvitation struct {
+	gorm.Model
+	EventID     uint   `gorm:"not null;uniqueIndex:idx_event_invitation_email"`
+	Email       string `gorm:"not null;uniqueIndex:idx_event_invitation_email"`
+	Nonce       string `gorm:"not null"` // random part of the token, the token itself is never stored
+	Status      string `gorm:"index;not null"`
+	InviterID   uint   `gorm:"not null"`
+	UserID      *uint  // user who accepted or declined the invitation
+	SentCount   uint32 `gorm:"not null;default:0"`
+	LastSentAt  *int64
+	OpenedAt    *int64
+	RespondedAt *int64
+	Event       *Event `gorm:"foreignKey:EventID"`
+	Inviter     *User  `gorm:"foreignKey:InviterID"`
+	User        *User  `gorm:"foreignKey:UserID"`
+}
+
+func dbEventInvitationToZeniEventInvitation(dbInvitation *EventInvitation) *zeni.EventInvitation {
+	if dbInvitation == nil {
+		return nil
+	}
+	userID := ""
+	if dbInvitation.UserID != nil {
+		userID = fmt.Sprintf("%d", *dbInvitation.UserID)
+	}
+	return &zeni.EventInvitation{
+		CreatedAt:   dbInvitation.CreatedAt,
+		ID:          fmt.Sprintf("%d", dbInvitation.ID),
+		EventID:     fmt.Sprintf("%d", dbInvitation.EventID),
+		Email:       dbInvitation.Email,
+		Nonce:       dbInvitation.Nonce,
+		Status:      zeni.EventInvitationStatus(dbInvitation.Status),
+		InviterID:   fmt.Sprintf("%d", dbInvitation.InviterID),
+		UserID:      userID,
+		SentCount:   dbInvitation.SentCount,
+		LastSentAt:  dbInvitation.LastSentAt,
+		OpenedAt:    dbInvitation.OpenedAt,
+		RespondedAt: dbInvitation.RespondedAt,
+	}
+}
+
+func newInvitationNonce() (string, error) {
+	nonce := make([]byte, invitationNonceSize)
+	if _, err := srand.Read(nonce); err != nil {
+		return "", errors.New("failed to generate invitation nonce")
+	}
+	return base64.RawURLEncoding.EncodeToString(nonce), nil
+}
+
+// CreateEventInvitations implements zeni.DB.
+func (g *gormZenaoDB) CreateEventInvitations(eventID string, inviterID string, emails []string) ([]*zeni.EventInvitation, error) {
+	g, span := g.trace("gzdb.CreateEventInvitations")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	inviterIDInt, err := strconv.ParseUint(inviterID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse inviter id: %w", err)
+	}
+
+	var invited []string
+	if err := g.db.Model(&EventInvitation{}).
+		Where("event_id = ? AND email IN ?", eventIDInt, emails).
+		Pluck("email", &invited).Error; err != nil {
+		return nil, fmt.Errorf("query invited emails: %w", err)
+	}
+	skip := make(map[string]struct{}, len(invited))
+	for _, email := range invited {
+		skip[email] = struct{}{}
+	}
+
+	dbInvitations := make([]*EventInvitation, 0, len(emails))
+	for _, email := range emails {
+		if _, ok := skip[email]; ok {
+			continue
+		}
+		skip[email] = struct{}{}
+		nonce, err := newInvitationNonce()
+		if err != nil {
+			return nil, err
+		}
+		dbInvitations = append(dbInvitations, &EventInvitation{
+			EventID:   uint(eventIDInt),
+			Email:     email,
+			Nonce:     nonce,
+			Status:    string(zeni.EventInvitationStatusInvited),
+			InviterID: uint(inviterIDInt),
+		})
+	}
+	if len(dbInvitations) == 0 {
+		return []*zeni.EventInvitation{}, nil
+	}
+	if err := g.db.Create(dbInvitations).Error; err != nil {
+		return nil, fmt.Errorf("create invitations: %w", err)
+	}
+
+	result := make([]*zeni.EventInvitation, len(dbInvitations))
+	for i, dbInvitation := range dbInvitations {
+		result[i] = dbEventInvitationToZeniEventInvitation(dbInvitation)
+	}
+	return result, nil
+}
+
+// GetEventInvitation implements zeni.DB.
+func (g *gormZenaoDB) GetEventInvitation(invitationID string) (*zeni.EventInvitation, error) {
+	g, span := g.trace("gzdb.GetEventInvitation")
+	defer span.End()
+
+	invitationIDInt, err := strconv.ParseUint(invitationID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse invitation id: %w", err)
+	}
+
+	var invitation EventInvitation
+	if err := g.db.First(&invitation, invitationIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbEventInvitationToZeniEventInvitation(&invitation), nil
+}
+
+// ListEventInvitations implements zeni.DB.
+func (g *gormZenaoDB) ListEventInvitations(eventID string) ([]*zeni.EventInvitation, error) {
+	g, span := g.trace("gzdb.ListEventInvitations")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var invitations []EventInvitation
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("id ASC").Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.EventInvitation, len(invitations))
+	for i := range invitations {
+		result[i] = dbEventInvitationToZeniEventInvitation(&invitations[i])
+	}
+
+	return result, nil
+}
+
+// MarkEventInvitationOpened implements zeni.DB.
+func (g *gormZenaoDB) MarkEventInvitationOpened(invitationID string, nowUnix int64) error {
+	g, span := g.trace("gzdb.MarkEventInvitationOpened")
+	defer span.End()
+
+	invitationIDInt, err := strconv.ParseUint(invitationID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse invitation id: %w", err)
+	}
+
+	return g.db.Model(&EventInvitation{}).
+		Where("id = ? AND status = ?", invitationIDInt, string(zeni.EventInvitationStatusInvited)).
+		Updates(map[string]any{
+			"status":    string(zeni.EventInvitationStatusOpened),
+			"opened_at": nowUnix,
+		}).Error
+}
+
+// RespondEventInvitation implements zeni.DB.
+func (g *gormZenaoDB) RespondEventInvitation(invitationID string, status zeni.EventInvitationStatus, userID string, nowUnix int64) (bool, error) {
+	g, span := g.trace("gzdb.RespondEventInvitation")
+	defer span.End()
+
+	if status != zeni.EventInvitationStatusAccepted && status != zeni.EventInvitationStatusDeclined {
+		return false, fmt.Errorf("invalid response status %q", status)
+	}
+
+	invitationIDInt, err := strconv.ParseUint(invitationID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse invitation id: %w", err)
+	}
+
+	updates := map[string]any{
+		"status":       string(status),
+		"responded_at": nowUnix,
+	}
+	if userID != "" {
+		userIDInt, err := strconv.ParseUint(userID, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("parse user id: %w", err)
+		}
+		updates["user_id"] = uint(userIDInt)
+	}
+
+	// the status condition consumes the invitation, a concurrent response with the same token affects no row
+	res := g.db.Model(&EventInvitation{}).
+		Where("id = ? AND status IN ?", invitationIDInt, []string{
+			string(zeni.EventInvitationStatusInvited),
+			string(zeni.EventInvitationStatusOpened),
+		}).
+		Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// RecordEventInvitationSent implements zeni.DB.
+func (g *gormZenaoDB) RecordEventInvitationSent(invitationID string, nowUnix int64) error {
+	g, span := g.trace("gzdb.RecordEventInvitationSent")
+	defer span.End()
+
+	invitationIDInt, err := strconv.ParseUint(invitationID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse invitation id: %w", err)
+	}
+
+	return g.db.Model(&EventInvitation{}).
+		Where("id = ?", invitationIDInt).
+		Updates(map[string]any{
+			"sent_count":   gorm.Expr("sent_count + 1"),
+			"last_sent_at": nowUnix,
+		}).Error
+}
@@ -0,0 +1,117 @@
+package gzdb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm/clause"
+)
+
+type SentReminder struct {
+	ID            uint   `gorm:"primaryKey"`
+	CreatedAt     int64  `gorm:"not null"`
+	EventID       uint   `gorm:"not null;uniqueIndex:idx_sent_reminders_event_user_offset"`
+	UserID        uint   `gorm:"not null;uniqueIndex:idx_sent_reminders_event_user_offset"`
+	OffsetSeconds uint32 `gorm:"not null;uniqueIndex:idx_sent_reminders_event_user_offset"`
+	Event         *Event `gorm:"foreignKey:EventID"`
+	User          *User  `gorm:"foreignKey:UserID"`
+}
+
+func joinReminderOffsets(offsets []uint32) string {
+	lines := make([]string, len(offsets))
+	for i, offset := range offsets {
+		lines[i] = strconv.FormatUint(uint64(offset), 10)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func splitReminderOffsets(s string) ([]uint32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	lines := strings.Split(s, "\n")
+	offsets := make([]uint32, len(lines))
+	for i, line := range lines {
+		offset, err := strconv.ParseUint(line, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parse reminder offset: %w", err)
+		}
+		offsets[i] = uint32(offset)
+	}
+	return offsets, nil
+}
+
+// ListEventsWithReminders implements zeni.DB.
+func (g *gormZenaoDB) ListEventsWithReminders(from time.Time, to time.Time) ([]*zeni.Event, error) {
+	g, span := g.trace("gzdb.ListEventsWithReminders")
+	defer span.End()
+
+	var dbEvts []Event
+	if err := g.db.
+		Where("reminder_offsets IS NOT NULL AND reminder_offsets != '' AND draft = ?", false).
+		Where("start_date > ? AND start_date <= ?", from, to).
+		Order("start_date ASC, id ASC").
+		Find(&dbEvts).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.Event, 0, len(dbEvts))
+	for i := range dbEvts {
+		zevt, err := dbEventToZeniEvent(&dbEvts[i])
+		if err != nil {
+			return nil, fmt.Errorf("convert db event to zeni event: %w", err)
+		}
+		result = append(result, zevt)
+	}
+
+	return result, nil
+}
+
+// ClaimEventReminder implements zeni.DB.
+func (g *gormZenaoDB) ClaimEventReminder(eventID string, userID string, offset uint32, nowUnix int64) (bool, error) {
+	g, span := g.trace("gzdb.ClaimEventReminder")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse user id: %w", err)
+	}
+
+	// the unique index makes concurrent schedulers claim each reminder once
+	res := g.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&SentReminder{
+		CreatedAt:     nowUnix,
+		EventID:       uint(eventIDInt),
+		UserID:        uint(userIDInt),
+		OffsetSeconds: offset,
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ReleaseEventReminder implements zeni.DB.
+func (g *gormZenaoDB) ReleaseEventReminder(eventID string, userID string, offset uint32) error {
+	g, span := g.trace("gzdb.ReleaseEventReminder")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse user id: %w", err)
+	}
+
+	return g.db.
+		Where("event_id = ? AND user_id = ? AND offset_seconds = ?", eventIDInt, userIDInt, offset).
+		Delete(&SentReminder{}).Error
+}
@@ -0,0 +1,123 @@
+package gzdb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type EventSeries struct {
+	gorm.Model
+	RRule     string `gorm:"column:rrule"`
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+func dbEventSeriesToZeniEventSeries(dbSeries *EventSeries) *zeni.EventSeries {
+	return &zeni.EventSeries{
+		CreatedAt: dbSeries.CreatedAt,
+		ID:        fmt.Sprintf("%d", dbSeries.ID),
+		RRule:     dbSeries.RRule,
+		StartDate: dbSeries.StartDate,
+		EndDate:   dbSeries.EndDate,
+	}
+}
+
+// CreateEventSeries implements zeni.DB.
+func (g *gormZenaoDB) CreateEventSeries(series *zeni.EventSeries) (*zeni.EventSeries, error) {
+	g, span := g.trace("gzdb.CreateEventSeries")
+	defer span.End()
+
+	dbSeries := &EventSeries{
+		RRule:     series.RRule,
+		StartDate: series.StartDate,
+		EndDate:   series.EndDate,
+	}
+	if err := g.db.Create(dbSeries).Error; err != nil {
+		return nil, fmt.Errorf("create event series in db: %w", err)
+	}
+	return dbEventSeriesToZeniEventSeries(dbSeries), nil
+}
+
+// GetEventSeries implements zeni.DB.
+func (g *gormZenaoDB) GetEventSeries(seriesID string) (*zeni.EventSeries, error) {
+	g, span := g.trace("gzdb.GetEventSeries")
+	defer span.End()
+
+	seriesIDInt, err := strconv.ParseUint(seriesID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse series id: %w", err)
+	}
+
+	var dbSeries EventSeries
+	if err := g.db.First(&dbSeries, seriesIDInt).Error; err != nil {
+		return nil, err
+	}
+	return dbEventSeriesToZeniEventSeries(&dbSeries), nil
+}
+
+// UpdateEventSeries implements zeni.DB.
+func (g *gormZenaoDB) UpdateEventSeries(series *zeni.EventSeries) error {
+	g, span := g.trace("gzdb.UpdateEventSeries")
+	defer span.End()
+
+	seriesIDInt, err := strconv.ParseUint(series.ID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse series id: %w", err)
+	}
+
+	return g.db.Model(&EventSeries{}).Where("id = ?", seriesIDInt).Updates(map[string]any{
+		"rrule":      series.RRule,
+		"start_date": series.StartDate,
+		"end_date":   series.EndDate,
+	}).Error
+}
+
+// SetEventSeries implements zeni.DB.
+func (g *gormZenaoDB) SetEventSeries(eventID string, seriesID string, recurrenceAt time.Time) error {
+	g, span := g.trace("gzdb.SetEventSeries")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+	seriesIDInt, err := strconv.ParseUint(seriesID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse series id: %w", err)
+	}
+
+	return g.db.Model(&Event{}).Where("id = ?", eventIDInt).Updates(map[string]any{
+		"series_id":            uint(seriesIDInt),
+		"series_recurrence_at": recurrenceAt,
+	}).Error
+}
+
+// ListSeriesEvents implements zeni.DB.
+func (g *gormZenaoDB) ListSeriesEvents(seriesID string) ([]*zeni.Event, error) {
+	g, span := g.trace("gzdb.ListSeriesEvents")
+	defer span.End()
+
+	seriesIDInt, err := strconv.ParseUint(seriesID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse series id: %w", err)
+	}
+
+	var dbEvts []Event
+	if err := g.db.Where("series_id = ?", seriesIDInt).Order("series_recurrence_at ASC, id ASC").Find(&dbEvts).Error; err != nil {
+		return nil, fmt.Errorf("query series events: %w", err)
+	}
+
+	evts := make([]*zeni.Event, 0, len(dbEvts))
+	for _, dbEvt := range dbEvts {
+		evt, err := dbEventToZeniEvent(&dbEvt)
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, evt)
+	}
+	return evts, nil
+}
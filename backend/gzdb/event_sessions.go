@@ -0,0 +1,347 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type EventSession struct {
+	gorm.Model
+	EventID     uint   `gorm:"index;not null"`
+	Title       string `gorm:"not null"`
+	Description string
+	Speakers    string // one speaker per line
+	Room        string
+	Track       string
+	StartDate   time.Time `gorm:"not null"`
+	EndDate     time.Time `gorm:"not null"`
+	Capacity    uint32    `gorm:"not null;default:0"`
+	Event       *Event    `gorm:"foreignKey:EventID"`
+}
+
+type SessionRegistration struct {
+	ID        uint          `gorm:"primaryKey"`
+	CreatedAt int64         `gorm:"not null"`
+	SessionID uint          `gorm:"not null;uniqueIndex:idx_session_registrations_session_user"`
+	UserID    uint          `gorm:"not null;uniqueIndex:idx_session_registrations_session_user;index"`
+	Session   *EventSession `gorm:"foreignKey:SessionID"`
+	User      *User         `gorm:"foreignKey:UserID"`
+}
+
+func dbEventSessionToZeniEventSession(dbSession *EventSession, registrations uint32) *zeni.EventSession {
+	session := &zeni.EventSession{
+		CreatedAt:     dbSession.CreatedAt,
+		ID:            fmt.Sprintf("%d", dbSession.ID),
+		EventID:       fmt.Sprintf("%d", dbSession.EventID),
+		Title:         dbSession.Title,
+		Description:   dbSession.Description,
+		Room:          dbSession.Room,
+		Track:         dbSession.Track,
+		StartDate:     dbSession.StartDate,
+		EndDate:       dbSession.EndDate,
+		Capacity:      dbSession.Capacity,
+		Registrations: registrations,
+	}
+	if dbSession.Speakers != "" {
+		session.Speakers = strings.Split(dbSession.Speakers, "\n")
+	}
+	return session
+}
+
+// countSessionRegistrations returns the number of registrations of each session.
+func (g *gormZenaoDB) countSessionRegistrations(sessionIDs []uint) (map[uint]uint32, error) {
+	counts := make(map[uint]uint32, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		SessionID uint
+		Count     uint32
+	}
+	if err := g.db.Model(&SessionRegistration{}).
+		Select("session_id, COUNT(*) AS count").
+		Where("session_id IN ?", sessionIDs).
+		Group("session_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.SessionID] = row.Count
+	}
+	return counts, nil
+}
+
+// CreateEventSession implements zeni.DB.
+func (g *gormZenaoDB) CreateEventSession(session *zeni.EventSession) (*zeni.EventSession, error) {
+	g, span := g.trace("gzdb.CreateEventSession")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(session.EventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	dbSession := &EventSession{
+		EventID:     uint(eventIDInt),
+		Title:       session.Title,
+		Description: session.Description,
+		Speakers:    strings.Join(session.Speakers, "\n"),
+		Room:        session.Room,
+		Track:       session.Track,
+		StartDate:   session.StartDate,
+		EndDate:     session.EndDate,
+		Capacity:    session.Capacity,
+	}
+	if err := g.db.Create(dbSession).Error; err != nil {
+		return nil, fmt.Errorf("create event session: %w", err)
+	}
+
+	return dbEventSessionToZeniEventSession(dbSession, 0), nil
+}
+
+// GetEventSession implements zeni.DB.
+func (g *gormZenaoDB) GetEventSession(sessionID string) (*zeni.EventSession, error) {
+	g, span := g.trace("gzdb.GetEventSession")
+	defer span.End()
+
+	sessionIDInt, err := strconv.ParseUint(sessionID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse session id: %w", err)
+	}
+
+	var dbSession EventSession
+	if err := g.db.First(&dbSession, sessionIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	counts, err := g.countSessionRegistrations([]uint{dbSession.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbEventSessionToZeniEventSession(&dbSession, counts[dbSession.ID]), nil
+}
+
+// UpdateEventSession implements zeni.DB.
+func (g *gormZenaoDB) UpdateEventSession(session *zeni.EventSession) (*zeni.EventSession, error) {
+	g, span := g.trace("gzdb.UpdateEventSession")
+	defer span.End()
+
+	sessionIDInt, err := strconv.ParseUint(session.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse session id: %w", err)
+	}
+
+	var updated *zeni.EventSession
+	if err := g.db.Transaction(func(tx *gorm.DB) error {
+		db := &gormZenaoDB{db: tx}
+		counts, err := db.countSessionRegistrations([]uint{uint(sessionIDInt)})
+		if err != nil {
+			return err
+		}
+		if session.Capacity != 0 && session.Capacity < counts[uint(sessionIDInt)] {
+			return fmt.Errorf("capacity cannot be below the %d registrations of the session", counts[uint(sessionIDInt)])
+		}
+
+		// a map is used so empty values are written too
+		if err := tx.Model(&EventSession{}).Where("id = ?", sessionIDInt).Updates(map[string]any{
+			"title":       session.Title,
+			"description": session.Description,
+			"speakers":    strings.Join(session.Speakers, "\n"),
+			"room":        session.Room,
+			"track":       session.Track,
+			"start_date":  session.StartDate,
+			"end_date":    session.EndDate,
+			"capacity":    session.Capacity,
+		}).Error; err != nil {
+			return err
+		}
+
+		var dbSession EventSession
+		if err := tx.First(&dbSession, sessionIDInt).Error; err != nil {
+			return err
+		}
+		updated = dbEventSessionToZeniEventSession(&dbSession, counts[dbSession.ID])
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteEventSession implements zeni.DB.
+func (g *gormZenaoDB) DeleteEventSession(sessionID string) error {
+	g, span := g.trace("gzdb.DeleteEventSession")
+	defer span.End()
+
+	sessionIDInt, err := strconv.ParseUint(sessionID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse session id: %w", err)
+	}
+
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", sessionIDInt).Delete(&SessionRegistration{}).Error; err != nil {
+			return err
+		}
+		res := tx.Delete(&EventSession{}, sessionIDInt)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errors.New("session not found")
+		}
+		return nil
+	})
+}
+
+// ListEventSessions implements zeni.DB.
+func (g *gormZenaoDB) ListEventSessions(eventID string) ([]*zeni.EventSession, error) {
+	g, span := g.trace("gzdb.ListEventSessions")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var dbSessions []EventSession
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("start_date ASC, id ASC").Find(&dbSessions).Error; err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]uint, len(dbSessions))
+	for i, dbSession := range dbSessions {
+		sessionIDs[i] = dbSession.ID
+	}
+	counts, err := g.countSessionRegistrations(sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.EventSession, len(dbSessions))
+	for i := range dbSessions {
+		result[i] = dbEventSessionToZeniEventSession(&dbSessions[i], counts[dbSessions[i].ID])
+	}
+
+	return result, nil
+}
+
+// RegisterForSession implements zeni.DB.
+func (g *gormZenaoDB) RegisterForSession(sessionID string, userID string, nowUnix int64) error {
+	g, span := g.trace("gzdb.RegisterForSession")
+	defer span.End()
+
+	sessionIDInt, err := strconv.ParseUint(sessionID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse session id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse user id: %w", err)
+	}
+
+	// the capacity check and the insert share a transaction so concurrent registrations can't overbook
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		var dbSession EventSession
+		if err := tx.First(&dbSession, sessionIDInt).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("session not found")
+			}
+			return err
+		}
+
+		var registered int64
+		if err := tx.Model(&SessionRegistration{}).
+			Where("session_id = ? AND user_id = ?", sessionIDInt, userIDInt).
+			Count(&registered).Error; err != nil {
+			return err
+		}
+		if registered > 0 {
+			return errors.New("user is already registered to this session")
+		}
+
+		if dbSession.Capacity != 0 {
+			var count int64
+			if err := tx.Model(&SessionRegistration{}).Where("session_id = ?", sessionIDInt).Count(&count).Error; err != nil {
+				return err
+			}
+			if count >= int64(dbSession.Capacity) {
+				return errors.New("session is full")
+			}
+		}
+
+		return tx.Create(&SessionRegistration{
+			CreatedAt: nowUnix,
+			SessionID: uint(sessionIDInt),
+			UserID:    uint(userIDInt),
+		}).Error
+	})
+}
+
+// UnregisterFromSession implements zeni.DB.
+func (g *gormZenaoDB) UnregisterFromSession(sessionID string, userID string) error {
+	g, span := g.trace("gzdb.UnregisterFromSession")
+	defer span.End()
+
+	sessionIDInt, err := strconv.ParseUint(sessionID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse session id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse user id: %w", err)
+	}
+
+	res := g.db.Where("session_id = ? AND user_id = ?", sessionIDInt, userIDInt).Delete(&SessionRegistration{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("user is not registered to this session")
+	}
+	return nil
+}
+
+// ListUserSessionIDs implements zeni.DB.
+func (g *gormZenaoDB) ListUserSessionIDs(eventID string, userID string) ([]string, error) {
+	g, span := g.trace("gzdb.ListUserSessionIDs")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var sessionIDs []uint
+	if err := g.db.Model(&SessionRegistration{}).
+		Where("user_id = ? AND session_id IN (?)", userIDInt, g.eventSessionIDs(uint(eventIDInt))).
+		Order("session_id ASC").
+		Pluck("session_id", &sessionIDs).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		result[i] = fmt.Sprintf("%d", sessionID)
+	}
+	return result, nil
+}
+
+// eventSessionIDs is a subquery selecting the sessions of the event.
+func (g *gormZenaoDB) eventSessionIDs(eventID uint) *gorm.DB {
+	return g.db.Model(&EventSession{}).Select("id").Where("event_id = ?", eventID)
+}
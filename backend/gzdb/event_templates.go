@@ -0,0 +1,136 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+type EventTemplate struct {
+	gorm.Model
+	CommunityID     uint       `gorm:"index;not null"`
+	CreatorID       uint       `gorm:"not null"`
+	Name            string     `gorm:"not null"`
+	Settings        []byte     `gorm:"not null"` // serialized CreateEventRequest
+	DurationSeconds uint32     `gorm:"not null;default:0"`
+	Community       *Community `gorm:"foreignKey:CommunityID"`
+	Creator         *User      `gorm:"foreignKey:CreatorID"`
+}
+
+func dbEventTemplateToZeniEventTemplate(dbTemplate *EventTemplate) (*zeni.EventTemplate, error) {
+	settings := &zenaov1.CreateEventRequest{}
+	if err := proto.Unmarshal(dbTemplate.Settings, settings); err != nil {
+		return nil, fmt.Errorf("unmarshal event template settings: %w", err)
+	}
+	return &zeni.EventTemplate{
+		CreatedAt:   dbTemplate.CreatedAt,
+		ID:          fmt.Sprintf("%d", dbTemplate.ID),
+		CommunityID: fmt.Sprintf("%d", dbTemplate.CommunityID),
+		CreatorID:   fmt.Sprintf("%d", dbTemplate.CreatorID),
+		Name:        dbTemplate.Name,
+		Event:       settings,
+		Duration:    time.Duration(dbTemplate.DurationSeconds) * time.Second,
+	}, nil
+}
+
+// CreateEventTemplate implements zeni.DB.
+func (g *gormZenaoDB) CreateEventTemplate(template *zeni.EventTemplate) (*zeni.EventTemplate, error) {
+	g, span := g.trace("gzdb.CreateEventTemplate")
+	defer span.End()
+
+	communityIDInt, err := strconv.ParseUint(template.CommunityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse community id: %w", err)
+	}
+	creatorIDInt, err := strconv.ParseUint(template.CreatorID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse creator id: %w", err)
+	}
+	settings, err := proto.Marshal(template.Event)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event template settings: %w", err)
+	}
+
+	dbTemplate := &EventTemplate{
+		CommunityID:     uint(communityIDInt),
+		CreatorID:       uint(creatorIDInt),
+		Name:            template.Name,
+		Settings:        settings,
+		DurationSeconds: uint32(template.Duration / time.Second),
+	}
+	if err := g.db.Create(dbTemplate).Error; err != nil {
+		return nil, err
+	}
+
+	return dbEventTemplateToZeniEventTemplate(dbTemplate)
+}
+
+// GetEventTemplate implements zeni.DB.
+func (g *gormZenaoDB) GetEventTemplate(templateID string) (*zeni.EventTemplate, error) {
+	g, span := g.trace("gzdb.GetEventTemplate")
+	defer span.End()
+
+	templateIDInt, err := strconv.ParseUint(templateID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse template id: %w", err)
+	}
+
+	var dbTemplate EventTemplate
+	if err := g.db.First(&dbTemplate, templateIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbEventTemplateToZeniEventTemplate(&dbTemplate)
+}
+
+// ListEventTemplates implements zeni.DB.
+func (g *gormZenaoDB) ListEventTemplates(communityID string) ([]*zeni.EventTemplate, error) {
+	g, span := g.trace("gzdb.ListEventTemplates")
+	defer span.End()
+
+	communityIDInt, err := strconv.ParseUint(communityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse community id: %w", err)
+	}
+
+	var dbTemplates []EventTemplate
+	if err := g.db.
+		Where("community_id = ?", communityIDInt).
+		Order("name ASC, id ASC").
+		Find(&dbTemplates).Error; err != nil {
+		return nil, err
+	}
+
+	templates := make([]*zeni.EventTemplate, 0, len(dbTemplates))
+	for i := range dbTemplates {
+		template, err := dbEventTemplateToZeniEventTemplate(&dbTemplates[i])
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// DeleteEventTemplate implements zeni.DB.
+func (g *gormZenaoDB) DeleteEventTemplate(templateID string) error {
+	g, span := g.trace("gzdb.DeleteEventTemplate")
+	defer span.End()
+
+	templateIDInt, err := strconv.ParseUint(templateID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse template id: %w", err)
+	}
+
+	return g.db.Delete(&EventTemplate{}, templateIDInt).Error
+}
@@ -0,0 +1,127 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyKey struct {
+	ID          uint   `gorm:"primarykey"`
+	CreatedAt   int64  `gorm:"not null;index"`
+	AuthUserID  string `gorm:"not null;uniqueIndex:idx_idempotency_keys_auth_user_key"`
+	Key         string `gorm:"not null;uniqueIndex:idx_idempotency_keys_auth_user_key"`
+	Procedure   string `gorm:"not null"`
+	RequestHash string `gorm:"not null"`
+	Response    []byte
+	CompletedAt *int64
+}
+
+func dbIdempotencyKeyToZeniIdempotencyKey(dbKey *IdempotencyKey) *zeni.IdempotencyKey {
+	return &zeni.IdempotencyKey{
+		CreatedAt:   dbKey.CreatedAt,
+		ID:          fmt.Sprintf("%d", dbKey.ID),
+		AuthUserID:  dbKey.AuthUserID,
+		Key:         dbKey.Key,
+		Procedure:   dbKey.Procedure,
+		RequestHash: dbKey.RequestHash,
+		Response:    dbKey.Response,
+		CompletedAt: dbKey.CompletedAt,
+	}
+}
+
+// ReserveIdempotencyKey implements zeni.DB.
+func (g *gormZenaoDB) ReserveIdempotencyKey(key *zeni.IdempotencyKey, expiredBefore int64, abandonedBefore int64) (*zeni.IdempotencyKey, bool, error) {
+	g, span := g.trace("gzdb.ReserveIdempotencyKey")
+	defer span.End()
+
+	if key == nil {
+		return nil, false, errors.New("idempotency key is nil")
+	}
+
+	var (
+		stored  *zeni.IdempotencyKey
+		created bool
+	)
+	err := g.db.Transaction(func(tx *gorm.DB) error {
+		// a call that never completed its key, e.g. because the server crashed, must not block the retries
+		if err := tx.
+			Where("auth_user_id = ? AND key = ?", key.AuthUserID, key.Key).
+			Where("created_at < ? OR (completed_at IS NULL AND created_at < ?)", expiredBefore, abandonedBefore).
+			Delete(&IdempotencyKey{}).Error; err != nil {
+			return fmt.Errorf("delete expired idempotency key: %w", err)
+		}
+
+		dbKey := &IdempotencyKey{
+			CreatedAt:   key.CreatedAt,
+			AuthUserID:  key.AuthUserID,
+			Key:         key.Key,
+			Procedure:   key.Procedure,
+			RequestHash: key.RequestHash,
+		}
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(dbKey)
+		if res.Error != nil {
+			return fmt.Errorf("create idempotency key: %w", res.Error)
+		}
+		if res.RowsAffected == 1 {
+			stored, created = dbIdempotencyKeyToZeniIdempotencyKey(dbKey), true
+			return nil
+		}
+
+		var existing IdempotencyKey
+		if err := tx.Where("auth_user_id = ? AND key = ?", key.AuthUserID, key.Key).First(&existing).Error; err != nil {
+			return fmt.Errorf("get idempotency key: %w", err)
+		}
+		stored = dbIdempotencyKeyToZeniIdempotencyKey(&existing)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return stored, created, nil
+}
+
+// CompleteIdempotencyKey implements zeni.DB.
+func (g *gormZenaoDB) CompleteIdempotencyKey(keyID string, response []byte, nowUnix int64) error {
+	g, span := g.trace("gzdb.CompleteIdempotencyKey")
+	defer span.End()
+
+	keyIDInt, err := strconv.ParseUint(keyID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse idempotency key id: %w", err)
+	}
+
+	return g.db.Model(&IdempotencyKey{}).Where("id = ?", keyIDInt).Updates(map[string]any{
+		"response":     response,
+		"completed_at": nowUnix,
+	}).Error
+}
+
+// DeleteIdempotencyKey implements zeni.DB.
+func (g *gormZenaoDB) DeleteIdempotencyKey(keyID string) error {
+	g, span := g.trace("gzdb.DeleteIdempotencyKey")
+	defer span.End()
+
+	keyIDInt, err := strconv.ParseUint(keyID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse idempotency key id: %w", err)
+	}
+
+	return g.db.Delete(&IdempotencyKey{}, keyIDInt).Error
+}
+
+// DeleteExpiredIdempotencyKeys implements zeni.DB.
+func (g *gormZenaoDB) DeleteExpiredIdempotencyKeys(createdBefore int64) (int64, error) {
+	g, span := g.trace("gzdb.DeleteExpiredIdempotencyKeys")
+	defer span.End()
+
+	res := g.db.Where("created_at < ?", createdBefore).Delete(&IdempotencyKey{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
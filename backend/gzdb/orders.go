@@ -3,6 +3,7 @@ package gzdb
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -13,51 +14,89 @@ import (
 )
 
 type Order struct {
-	ID                string `gorm:"primaryKey;type:text"`
-	CreatedAt         int64  `gorm:"not null"`
-	EventID           uint   `gorm:"index;not null"`
-	BuyerID           uint   `gorm:"index;not null"`
-	CurrencyCode      string `gorm:"not null"`
-	AmountMinor       int64  `gorm:"not null"`
-	Status            string `gorm:"not null"`
-	PaymentProvider   string
-	PaymentAccountID  uint `gorm:"index;not null"`
-	PaymentSessionID  string
-	PaymentIntentID   string
-	ConfirmedAt       *int64
-	InvoiceID         string
-	InvoiceURL        string
-	TicketIssueStatus string
-	TicketIssueError  string
-	Event             *Event          `gorm:"foreignKey:EventID"`
-	PaymentAccount    *PaymentAccount `gorm:"foreignKey:PaymentAccountID"`
+	ID                  string `gorm:"primaryKey;type:text"`
+	CreatedAt           int64  `gorm:"not null"`
+	EventID             uint   `gorm:"index;not null"`
+	BuyerID             uint   `gorm:"index;not null"`
+	CurrencyCode        string `gorm:"not null"`
+	AmountMinor         int64  `gorm:"not null"`
+	Status              string `gorm:"not null"`
+	PaymentProvider     string
+	PaymentAccountID    uint `gorm:"index;not null"`
+	PaymentSessionID    string
+	PaymentIntentID     string
+	ConfirmedAt         *int64
+	InvoiceID           string
+	InvoiceURL          string
+	InvoiceIssuedAt     *int64
+	InvoiceTaxRateBps   uint32
+	TicketIssueStatus   string
+	TicketIssueError    string
+	TicketIssueAttempts uint32 `gorm:"not null;default:0"`
+	RefundStatus        string
+	RefundedAmountMinor int64
+	RefundedAt          *int64
+	PromoCodeID         *uint `gorm:"index"`
+	PromoCode           string
+	DiscountAmountMinor int64
+	DonationAmountMinor int64
+	// DonationRefundClaimedAt is set once a refund took over the donation, it is never refunded twice
+	DonationRefundClaimedAt *int64
+	Event                   *Event          `gorm:"foreignKey:EventID"`
+	PaymentAccount          *PaymentAccount `gorm:"foreignKey:PaymentAccountID"`
 }
 
 type OrderAttendee struct {
-	ID           string      `gorm:"primaryKey;type:text"`
-	CreatedAt    int64       `gorm:"not null"`
-	OrderID      string      `gorm:"index;not null"`
-	PriceID      uint        `gorm:"index;not null"`
-	PriceGroupID uint        `gorm:"index;not null"`
-	UserID       uint        `gorm:"index;not null"`
-	AmountMinor  int64       `gorm:"not null"`
-	CurrencyCode string      `gorm:"not null"`
-	Order        *Order      `gorm:"foreignKey:OrderID"`
-	Price        *Price      `gorm:"foreignKey:PriceID"`
-	PriceGroup   *PriceGroup `gorm:"foreignKey:PriceGroupID"`
+	ID           string `gorm:"primaryKey;type:text"`
+	CreatedAt    int64  `gorm:"not null"`
+	OrderID      string `gorm:"index;not null"`
+	PriceID      uint   `gorm:"index;not null"`
+	PriceGroupID uint   `gorm:"index;not null"`
+	UserID       uint   `gorm:"index;not null"`
+	AmountMinor  int64  `gorm:"not null"`
+	CurrencyCode string `gorm:"not null"`
+	RefundID     string
+	RefundedAt   *int64
+	// RefundPendingAt is set while a refund of the attendee is in progress with the payment provider
+	RefundPendingAt *int64
+	Order           *Order      `gorm:"foreignKey:OrderID"`
+	Price           *Price      `gorm:"foreignKey:PriceID"`
+	PriceGroup      *PriceGroup `gorm:"foreignKey:PriceGroupID"`
 }
 
 type TicketHold struct {
-	ID           uint        `gorm:"primaryKey"`
-	CreatedAt    int64       `gorm:"not null"`
-	EventID      uint        `gorm:"index;not null"`
-	PriceGroupID uint        `gorm:"index;not null"`
-	OrderID      string      `gorm:"index;not null"`
-	Quantity     uint32      `gorm:"not null"`
-	ExpiresAt    int64       `gorm:"index;not null"`
-	Event        *Event      `gorm:"foreignKey:EventID"`
-	PriceGroup   *PriceGroup `gorm:"foreignKey:PriceGroupID"`
-	Order        *Order      `gorm:"foreignKey:OrderID"`
+	ID              uint           `gorm:"primaryKey"`
+	CreatedAt       int64          `gorm:"not null"`
+	EventID         uint           `gorm:"index;not null"`
+	PriceGroupID    *uint          `gorm:"index"`
+	OrderID         *string        `gorm:"index"`
+	WaitlistEntryID *uint          `gorm:"index"`
+	Quantity        uint32         `gorm:"not null"`
+	ExpiresAt       int64          `gorm:"index;not null"`
+	Event           *Event         `gorm:"foreignKey:EventID"`
+	PriceGroup      *PriceGroup    `gorm:"foreignKey:PriceGroupID"`
+	Order           *Order         `gorm:"foreignKey:OrderID"`
+	WaitlistEntry   *WaitlistEntry `gorm:"foreignKey:WaitlistEntryID"`
+}
+
+func dbTicketHoldToZeniTicketHold(dbHold *TicketHold) *zeni.TicketHold {
+	hold := &zeni.TicketHold{
+		CreatedAt: dbHold.CreatedAt,
+		ID:        fmt.Sprintf("%d", dbHold.ID),
+		EventID:   fmt.Sprintf("%d", dbHold.EventID),
+		Quantity:  dbHold.Quantity,
+		ExpiresAt: dbHold.ExpiresAt,
+	}
+	if dbHold.PriceGroupID != nil {
+		hold.PriceGroupID = fmt.Sprintf("%d", *dbHold.PriceGroupID)
+	}
+	if dbHold.OrderID != nil {
+		hold.OrderID = *dbHold.OrderID
+	}
+	if dbHold.WaitlistEntryID != nil {
+		hold.WaitlistEntryID = fmt.Sprintf("%d", *dbHold.WaitlistEntryID)
+	}
+	return hold
 }
 
 func dbOrderToZeniOrder(dbOrder *Order) *zeni.Order {
@@ -65,24 +104,37 @@ func dbOrderToZeniOrder(dbOrder *Order) *zeni.Order {
 		return nil
 	}
 
-	return &zeni.Order{
-		CreatedAt:         dbOrder.CreatedAt,
-		ID:                dbOrder.ID,
-		EventID:           fmt.Sprintf("%d", dbOrder.EventID),
-		BuyerID:           fmt.Sprintf("%d", dbOrder.BuyerID),
-		CurrencyCode:      dbOrder.CurrencyCode,
-		AmountMinor:       dbOrder.AmountMinor,
-		Status:            zeni.OrderStatus(dbOrder.Status),
-		PaymentProvider:   dbOrder.PaymentProvider,
-		PaymentAccountID:  fmt.Sprintf("%d", dbOrder.PaymentAccountID),
-		PaymentSessionID:  dbOrder.PaymentSessionID,
-		PaymentIntentID:   dbOrder.PaymentIntentID,
-		ConfirmedAt:       dbOrder.ConfirmedAt,
-		InvoiceID:         dbOrder.InvoiceID,
-		InvoiceURL:        dbOrder.InvoiceURL,
-		TicketIssueStatus: zeni.TicketIssueStatus(dbOrder.TicketIssueStatus),
-		TicketIssueError:  dbOrder.TicketIssueError,
-	}
+	order := &zeni.Order{
+		CreatedAt:           dbOrder.CreatedAt,
+		ID:                  dbOrder.ID,
+		EventID:             fmt.Sprintf("%d", dbOrder.EventID),
+		BuyerID:             fmt.Sprintf("%d", dbOrder.BuyerID),
+		CurrencyCode:        dbOrder.CurrencyCode,
+		AmountMinor:         dbOrder.AmountMinor,
+		Status:              zeni.OrderStatus(dbOrder.Status),
+		PaymentProvider:     dbOrder.PaymentProvider,
+		PaymentAccountID:    fmt.Sprintf("%d", dbOrder.PaymentAccountID),
+		PaymentSessionID:    dbOrder.PaymentSessionID,
+		PaymentIntentID:     dbOrder.PaymentIntentID,
+		ConfirmedAt:         dbOrder.ConfirmedAt,
+		InvoiceID:           dbOrder.InvoiceID,
+		InvoiceURL:          dbOrder.InvoiceURL,
+		InvoiceIssuedAt:     dbOrder.InvoiceIssuedAt,
+		InvoiceTaxRateBps:   dbOrder.InvoiceTaxRateBps,
+		TicketIssueStatus:   zeni.TicketIssueStatus(dbOrder.TicketIssueStatus),
+		TicketIssueError:    dbOrder.TicketIssueError,
+		TicketIssueAttempts: dbOrder.TicketIssueAttempts,
+		RefundStatus:        zeni.OrderRefundStatus(dbOrder.RefundStatus),
+		RefundedAmountMinor: dbOrder.RefundedAmountMinor,
+		RefundedAt:          dbOrder.RefundedAt,
+		PromoCode:           dbOrder.PromoCode,
+		DiscountAmountMinor: dbOrder.DiscountAmountMinor,
+		DonationAmountMinor: dbOrder.DonationAmountMinor,
+	}
+	if dbOrder.PromoCodeID != nil {
+		order.PromoCodeID = fmt.Sprintf("%d", *dbOrder.PromoCodeID)
+	}
+	return order
 }
 
 // CreateOrder implements zeni.DB.
@@ -118,22 +170,33 @@ func (g *gormZenaoDB) CreateOrder(order *zeni.Order, attendees []*zeni.OrderAtte
 	}
 
 	dbOrder := &Order{
-		ID:                id,
-		CreatedAt:         createdAt,
-		EventID:           uint(eventIDInt),
-		BuyerID:           uint(buyerIDInt),
-		CurrencyCode:      order.CurrencyCode,
-		AmountMinor:       order.AmountMinor,
-		Status:            string(status),
-		PaymentProvider:   order.PaymentProvider,
-		PaymentAccountID:  uint(paymentAccountIDInt),
-		PaymentSessionID:  order.PaymentSessionID,
-		PaymentIntentID:   order.PaymentIntentID,
-		ConfirmedAt:       order.ConfirmedAt,
-		InvoiceID:         order.InvoiceID,
-		InvoiceURL:        order.InvoiceURL,
-		TicketIssueStatus: string(order.TicketIssueStatus),
-		TicketIssueError:  order.TicketIssueError,
+		ID:                  id,
+		CreatedAt:           createdAt,
+		EventID:             uint(eventIDInt),
+		BuyerID:             uint(buyerIDInt),
+		CurrencyCode:        order.CurrencyCode,
+		AmountMinor:         order.AmountMinor,
+		Status:              string(status),
+		PaymentProvider:     order.PaymentProvider,
+		PaymentAccountID:    uint(paymentAccountIDInt),
+		PaymentSessionID:    order.PaymentSessionID,
+		PaymentIntentID:     order.PaymentIntentID,
+		ConfirmedAt:         order.ConfirmedAt,
+		InvoiceID:           order.InvoiceID,
+		InvoiceURL:          order.InvoiceURL,
+		TicketIssueStatus:   string(order.TicketIssueStatus),
+		TicketIssueError:    order.TicketIssueError,
+		PromoCode:           order.PromoCode,
+		DiscountAmountMinor: order.DiscountAmountMinor,
+		DonationAmountMinor: order.DonationAmountMinor,
+	}
+	if order.PromoCodeID != "" {
+		promoCodeIDInt, err := strconv.ParseUint(order.PromoCodeID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse promo code id: %w", err)
+		}
+		promoCodeID := uint(promoCodeIDInt)
+		dbOrder.PromoCodeID = &promoCodeID
 	}
 	if err := g.db.Create(dbOrder).Error; err != nil {
 		return nil, err
@@ -236,6 +299,76 @@ func (g *gormZenaoDB) ListOrdersByBuyer(buyerID string) ([]*zeni.Order, error) {
 	return result, nil
 }
 
+// ListOrdersByEvent implements zeni.DB.
+func (g *gormZenaoDB) ListOrdersByEvent(eventID string, status zeni.OrderStatus) ([]*zeni.Order, error) {
+	g, span := g.trace("gzdb.ListOrdersByEvent")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	query := g.db.Where("event_id = ?", eventIDInt)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var orders []Order
+	if err := query.Order("created_at ASC, id ASC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.Order, len(orders))
+	for i := range orders {
+		result[i] = dbOrderToZeniOrder(&orders[i])
+	}
+
+	return result, nil
+}
+
+// ListPendingOrders implements zeni.DB.
+func (g *gormZenaoDB) ListPendingOrders(createdBefore int64) ([]*zeni.Order, error) {
+	g, span := g.trace("gzdb.ListPendingOrders")
+	defer span.End()
+
+	var orders []Order
+	if err := g.db.
+		Where("status = ? AND created_at < ?", zeni.OrderStatusPending, createdBefore).
+		Order("created_at ASC, id ASC").
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.Order, len(orders))
+	for i := range orders {
+		result[i] = dbOrderToZeniOrder(&orders[i])
+	}
+
+	return result, nil
+}
+
+// ListOrdersByTicketIssueStatus implements zeni.DB.
+func (g *gormZenaoDB) ListOrdersByTicketIssueStatus(status zeni.TicketIssueStatus) ([]*zeni.Order, error) {
+	g, span := g.trace("gzdb.ListOrdersByTicketIssueStatus")
+	defer span.End()
+
+	var orders []Order
+	if err := g.db.
+		Where("status = ? AND ticket_issue_status = ?", zeni.OrderStatusSuccess, status).
+		Order("created_at ASC, id ASC").
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.Order, len(orders))
+	for i := range orders {
+		result[i] = dbOrderToZeniOrder(&orders[i])
+	}
+
+	return result, nil
+}
+
 // GetOrderAttendees implements zeni.DB.
 func (g *gormZenaoDB) GetOrderAttendees(orderID string) ([]*zeni.OrderAttendee, error) {
 	g, span := g.trace("gzdb.GetOrderAttendees")
@@ -249,14 +382,17 @@ func (g *gormZenaoDB) GetOrderAttendees(orderID string) ([]*zeni.OrderAttendee,
 	result := make([]*zeni.OrderAttendee, 0, len(attendees))
 	for _, attendee := range attendees {
 		result = append(result, &zeni.OrderAttendee{
-			ID:           attendee.ID,
-			CreatedAt:    attendee.CreatedAt,
-			OrderID:      attendee.OrderID,
-			PriceID:      fmt.Sprintf("%d", attendee.PriceID),
-			PriceGroupID: fmt.Sprintf("%d", attendee.PriceGroupID),
-			UserID:       fmt.Sprintf("%d", attendee.UserID),
-			AmountMinor:  attendee.AmountMinor,
-			CurrencyCode: attendee.CurrencyCode,
+			ID:              attendee.ID,
+			CreatedAt:       attendee.CreatedAt,
+			OrderID:         attendee.OrderID,
+			PriceID:         fmt.Sprintf("%d", attendee.PriceID),
+			PriceGroupID:    fmt.Sprintf("%d", attendee.PriceGroupID),
+			UserID:          fmt.Sprintf("%d", attendee.UserID),
+			AmountMinor:     attendee.AmountMinor,
+			CurrencyCode:    attendee.CurrencyCode,
+			RefundID:        attendee.RefundID,
+			RefundedAt:      attendee.RefundedAt,
+			RefundPendingAt: attendee.RefundPendingAt,
 		})
 	}
 
@@ -388,6 +524,9 @@ func (g *gormZenaoDB) UpdateOrderTicketIssue(orderID string, status zeni.TicketI
 		"ticket_issue_status": status,
 		"ticket_issue_error":  errMsg,
 	}
+	if status != zeni.TicketIssueStatusIssued {
+		updates["ticket_issue_attempts"] = gorm.Expr("ticket_issue_attempts + 1")
+	}
 
 	res := g.db.Model(&Order{}).Where("id = ?", orderID).Updates(updates)
 	if res.Error != nil {
@@ -399,6 +538,241 @@ func (g *gormZenaoDB) UpdateOrderTicketIssue(orderID string, status zeni.TicketI
 	return nil
 }
 
+// AssignOrderInvoice implements zeni.DB.
+// The invoice number is taken from the sequence of the community the order was paid to,
+// an order that already has an invoice keeps it.
+func (g *gormZenaoDB) AssignOrderInvoice(orderID string, communityID string, invoiceURL string, issuedAt int64) (*zeni.Order, error) {
+	g, span := g.trace("gzdb.AssignOrderInvoice")
+	defer span.End()
+
+	cmtIDInt, err := strconv.ParseUint(communityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse community id: %w", err)
+	}
+
+	var order Order
+	err = g.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&order, "id = ?", orderID).Error; err != nil {
+			return err
+		}
+		if order.InvoiceID != "" {
+			return nil
+		}
+
+		var cmt Community
+		if err := tx.First(&cmt, cmtIDInt).Error; err != nil {
+			return fmt.Errorf("get community: %w", err)
+		}
+		sequence := cmt.InvoiceSequence + 1
+		if err := tx.Model(&Community{}).Where("id = ?", cmt.ID).Update("invoice_sequence", sequence).Error; err != nil {
+			return fmt.Errorf("update community invoice sequence: %w", err)
+		}
+
+		order.InvoiceID = fmt.Sprintf("INV-%d-%06d", cmt.ID, sequence)
+		order.InvoiceURL = invoiceURL
+		order.InvoiceIssuedAt = &issuedAt
+		order.InvoiceTaxRateBps = cmt.TaxRateBps
+		return tx.Model(&Order{}).Where("id = ?", order.ID).Updates(map[string]any{
+			"invoice_id":           order.InvoiceID,
+			"invoice_url":          order.InvoiceURL,
+			"invoice_issued_at":    issuedAt,
+			"invoice_tax_rate_bps": order.InvoiceTaxRateBps,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbOrderToZeniOrder(&order), nil
+}
+
+// ClaimOrderAttendeesRefund implements zeni.DB.
+func (g *gormZenaoDB) ClaimOrderAttendeesRefund(orderID string, attendeeIDs []string, claimedAt int64) (*zeni.OrderRefundClaim, error) {
+	g, span := g.trace("gzdb.ClaimOrderAttendeesRefund")
+	defer span.End()
+
+	claim := &zeni.OrderRefundClaim{}
+	err := g.db.Transaction(func(tx *gorm.DB) error {
+		var order Order
+		if err := tx.First(&order, "id = ?", orderID).Error; err != nil {
+			return err
+		}
+
+		var attendees []OrderAttendee
+		if err := tx.Where("order_id = ?", orderID).Find(&attendees).Error; err != nil {
+			return err
+		}
+
+		unclaimed := 0
+		for _, attendee := range attendees {
+			requested := slices.Contains(attendeeIDs, attendee.ID)
+			if attendee.RefundedAt != nil {
+				if requested {
+					return errors.New("order attendee is already refunded")
+				}
+				continue
+			}
+			if attendee.RefundPendingAt != nil {
+				if requested {
+					return errors.New("order attendee refund is already in progress")
+				}
+				continue
+			}
+			unclaimed++
+			if len(attendeeIDs) != 0 && !requested {
+				continue
+			}
+			claim.AttendeeIDs = append(claim.AttendeeIDs, attendee.ID)
+			claim.AmountMinor += attendee.AmountMinor
+		}
+		if len(claim.AttendeeIDs) == 0 {
+			return errors.New("no attendee to refund")
+		}
+		if len(attendeeIDs) != 0 && len(claim.AttendeeIDs) != len(attendeeIDs) {
+			return errors.New("order attendee not found")
+		}
+
+		res := tx.Model(&OrderAttendee{}).
+			Where("order_id = ? AND id IN ? AND refunded_at IS NULL AND refund_pending_at IS NULL", orderID, claim.AttendeeIDs).
+			Update("refund_pending_at", claimedAt)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != int64(len(claim.AttendeeIDs)) {
+			return errors.New("order attendee refund is already in progress")
+		}
+
+		// the donation goes back to the buyer with the last tickets of the order
+		if len(claim.AttendeeIDs) == unclaimed && order.DonationAmountMinor > 0 && order.DonationRefundClaimedAt == nil {
+			if err := tx.Model(&Order{}).Where("id = ?", orderID).Update("donation_refund_claimed_at", claimedAt).Error; err != nil {
+				return err
+			}
+			claim.DonationAmountMinor = order.DonationAmountMinor
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// ReleaseOrderAttendeesRefund implements zeni.DB.
+func (g *gormZenaoDB) ReleaseOrderAttendeesRefund(orderID string, claim *zeni.OrderRefundClaim) error {
+	g, span := g.trace("gzdb.ReleaseOrderAttendeesRefund")
+	defer span.End()
+
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&OrderAttendee{}).
+			Where("order_id = ? AND id IN ? AND refunded_at IS NULL", orderID, claim.AttendeeIDs).
+			Update("refund_pending_at", nil).Error; err != nil {
+			return err
+		}
+		if claim.DonationAmountMinor == 0 {
+			return nil
+		}
+		return tx.Model(&Order{}).Where("id = ?", orderID).Update("donation_refund_claimed_at", nil).Error
+	})
+}
+
+// RefundOrderAttendees implements zeni.DB.
+func (g *gormZenaoDB) RefundOrderAttendees(orderID string, attendeeIDs []string, refundID string, refundedAt int64) (*zeni.Order, error) {
+	g, span := g.trace("gzdb.RefundOrderAttendees")
+	defer span.End()
+
+	if len(attendeeIDs) == 0 {
+		return nil, errors.New("attendee ids are required")
+	}
+
+	var order Order
+	err := g.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&order, "id = ?", orderID).Error; err != nil {
+			return err
+		}
+
+		var attendees []OrderAttendee
+		if err := tx.
+			Where("order_id = ? AND id IN ? AND refunded_at IS NULL", orderID, attendeeIDs).
+			Find(&attendees).Error; err != nil {
+			return err
+		}
+		if len(attendees) != len(attendeeIDs) {
+			return errors.New("order attendee not found or already refunded")
+		}
+
+		amount := int64(0)
+		for _, attendee := range attendees {
+			amount += attendee.AmountMinor
+		}
+
+		// transferred tickets are held by another user than the attendee they were bought for
+		var userIDs []uint
+		if err := tx.Model(&SoldTicket{}).
+			Where("order_attendee_id IN ?", attendeeIDs).
+			Pluck("user_id", &userIDs).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&OrderAttendee{}).
+			Where("order_id = ? AND id IN ?", orderID, attendeeIDs).
+			Updates(map[string]any{
+				"refund_id":         refundID,
+				"refunded_at":       refundedAt,
+				"refund_pending_at": nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("order_attendee_id IN ?", attendeeIDs).Delete(&SoldTicket{}).Error; err != nil {
+			return err
+		}
+
+		// users may hold another ticket for the event, e.g. bought in a separate order
+		for _, userID := range userIDs {
+			var remaining int64
+			if err := tx.Model(&SoldTicket{}).
+				Where("event_id = ? AND user_id = ?", order.EventID, userID).
+				Count(&remaining).Error; err != nil {
+				return err
+			}
+			if remaining != 0 {
+				continue
+			}
+			if err := tx.Where("org_type = ? AND org_id = ? AND entity_type = ? AND entity_id = ? AND role = ?",
+				zeni.EntityTypeEvent, order.EventID, zeni.EntityTypeUser, userID, zeni.RoleParticipant).
+				Delete(&EntityRole{}).Error; err != nil {
+				return err
+			}
+		}
+
+		var notRefunded int64
+		if err := tx.Model(&OrderAttendee{}).
+			Where("order_id = ? AND refunded_at IS NULL", orderID).
+			Count(&notRefunded).Error; err != nil {
+			return err
+		}
+		refundStatus := zeni.OrderRefundStatusRefunded
+		if notRefunded > 0 {
+			refundStatus = zeni.OrderRefundStatusPartial
+		} else {
+			amount += order.DonationAmountMinor
+		}
+
+		order.RefundStatus = string(refundStatus)
+		order.RefundedAmountMinor += amount
+		order.RefundedAt = &refundedAt
+		return tx.Model(&Order{}).Where("id = ?", orderID).Updates(map[string]any{
+			"refund_status":         order.RefundStatus,
+			"refunded_amount_minor": order.RefundedAmountMinor,
+			"refunded_at":           refundedAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbOrderToZeniOrder(&order), nil
+}
+
 // UpdateOrderStatus implements zeni.DB.
 func (g *gormZenaoDB) UpdateOrderSetStatus(orderID string, status zeni.OrderStatus) error {
 	res := g.db.Model(&Order{}).Where("id = ?", orderID).Update("status", status)
@@ -420,34 +794,43 @@ func (g *gormZenaoDB) CreateTicketHold(hold *zeni.TicketHold) (*zeni.TicketHold,
 	if err != nil {
 		return nil, fmt.Errorf("parse event id: %w", err)
 	}
-	priceGroupIDInt, err := strconv.ParseUint(hold.PriceGroupID, 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("parse price group id: %w", err)
-	}
 	createdAt := hold.CreatedAt
 	if createdAt == 0 {
 		createdAt = time.Now().Unix()
 	}
 	dbHold := &TicketHold{
-		CreatedAt:    createdAt,
-		EventID:      uint(eventIDInt),
-		PriceGroupID: uint(priceGroupIDInt),
-		OrderID:      hold.OrderID,
-		Quantity:     hold.Quantity,
-		ExpiresAt:    hold.ExpiresAt,
+		CreatedAt: createdAt,
+		EventID:   uint(eventIDInt),
+		Quantity:  hold.Quantity,
+		ExpiresAt: hold.ExpiresAt,
+	}
+	if hold.PriceGroupID != "" {
+		priceGroupIDInt, err := strconv.ParseUint(hold.PriceGroupID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse price group id: %w", err)
+		}
+		priceGroupID := uint(priceGroupIDInt)
+		dbHold.PriceGroupID = &priceGroupID
+	}
+	if hold.OrderID != "" {
+		orderID := hold.OrderID
+		dbHold.OrderID = &orderID
+	}
+	if hold.WaitlistEntryID != "" {
+		entryIDInt, err := strconv.ParseUint(hold.WaitlistEntryID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse waitlist entry id: %w", err)
+		}
+		entryID := uint(entryIDInt)
+		dbHold.WaitlistEntryID = &entryID
+	}
+	if dbHold.OrderID == nil && dbHold.WaitlistEntryID == nil {
+		return nil, errors.New("ticket hold needs an order or a waitlist entry")
 	}
 	if err := g.db.Create(dbHold).Error; err != nil {
 		return nil, err
 	}
-	return &zeni.TicketHold{
-		CreatedAt:    dbHold.CreatedAt,
-		ID:           fmt.Sprintf("%d", dbHold.ID),
-		EventID:      fmt.Sprintf("%d", dbHold.EventID),
-		PriceGroupID: fmt.Sprintf("%d", dbHold.PriceGroupID),
-		OrderID:      dbHold.OrderID,
-		Quantity:     dbHold.Quantity,
-		ExpiresAt:    dbHold.ExpiresAt,
-	}, nil
+	return dbTicketHoldToZeniTicketHold(dbHold), nil
 }
 
 // DeleteTicketHoldsByOrderID implements zeni.DB.
@@ -464,20 +847,31 @@ func (g *gormZenaoDB) DeleteExpiredTicketHolds(eventID string, nowUnix int64) er
 	return g.db.Where("event_id = ? AND expires_at <= ?", eventIDInt, nowUnix).Delete(&TicketHold{}).Error
 }
 
+// DeleteAllExpiredTicketHolds implements zeni.DB.
+func (g *gormZenaoDB) DeleteAllExpiredTicketHolds(nowUnix int64) (int64, error) {
+	g, span := g.trace("gzdb.DeleteAllExpiredTicketHolds")
+	defer span.End()
+
+	res := g.db.Where("expires_at <= ?", nowUnix).Delete(&TicketHold{})
+	return res.RowsAffected, res.Error
+}
+
 // CountEventSoldTickets implements zeni.DB.
 func (g *gormZenaoDB) CountEventSoldTickets(eventID string, priceGroupID string) (uint32, error) {
 	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
 	if err != nil {
 		return 0, fmt.Errorf("parse event id: %w", err)
 	}
-	priceGroupIDInt, err := strconv.ParseUint(priceGroupID, 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("parse price group id: %w", err)
+	query := g.db.Model(&SoldTicket{}).Where("sold_tickets.event_id = ?", eventIDInt)
+	if priceGroupID != "" {
+		priceGroupIDInt, err := strconv.ParseUint(priceGroupID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse price group id: %w", err)
+		}
+		query = query.Where("sold_tickets.price_group_id = ?", priceGroupIDInt)
 	}
 	var count int64
-	if err := g.db.Model(&SoldTicket{}).
-		Where("sold_tickets.event_id = ? AND sold_tickets.price_group_id = ?", eventIDInt, priceGroupIDInt).
-		Count(&count).Error; err != nil {
+	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return uint32(count), nil
@@ -504,6 +898,23 @@ func (g *gormZenaoDB) CountActiveTicketHolds(eventID string, priceGroupID string
 	return uint32(total), nil
 }
 
+// CountActiveWaitlistHolds implements zeni.DB.
+func (g *gormZenaoDB) CountActiveWaitlistHolds(eventID string, nowUnix int64) (uint32, error) {
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse event id: %w", err)
+	}
+	var total int64
+	row := g.db.Model(&TicketHold{}).
+		Select("COALESCE(SUM(quantity), 0)").
+		Where("event_id = ? AND waitlist_entry_id IS NOT NULL AND expires_at > ?", eventIDInt, nowUnix).
+		Row()
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return uint32(total), nil
+}
+
 // ListOrderAttendeeTicketIDs implements zeni.DB.
 func (g *gormZenaoDB) ListOrderAttendeeTicketIDs(orderID string) ([]string, error) {
 	g, span := g.trace("gzdb.ListOrderAttendeeTicketIDs")
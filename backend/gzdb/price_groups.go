@@ -2,6 +2,7 @@ package gzdb
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/samouraiworld/zenao/backend/zeni"
 	"gorm.io/gorm"
@@ -16,11 +17,15 @@ type PriceGroup struct {
 
 type Price struct {
 	gorm.Model
-	PriceGroupID     uint  `gorm:"index"`
-	AmountMinor      int64 `gorm:"not null"`
-	CurrencyCode     string
-	PaymentAccountID *uint           `gorm:"index"`
-	PaymentAccount   *PaymentAccount `gorm:"foreignKey:PaymentAccountID"`
+	PriceGroupID       uint  `gorm:"index"`
+	AmountMinor        int64 `gorm:"not null"`
+	CurrencyCode       string
+	PaymentAccountID   *uint           `gorm:"index"`
+	PaymentAccount     *PaymentAccount `gorm:"foreignKey:PaymentAccountID"`
+	SaleStartsAt       *time.Time
+	SaleEndsAt         *time.Time
+	Kind               string
+	MinimumAmountMinor int64
 }
 
 func dbPriceGroupToZeniPriceGroup(dbGroup *PriceGroup) *zeni.PriceGroup {
@@ -57,6 +62,11 @@ func dbPriceToZeniPrice(dbPrice *Price) *zeni.Price {
 		PriceGroupID: fmt.Sprintf("%d", dbPrice.PriceGroupID),
 		AmountMinor:  dbPrice.AmountMinor,
 		CurrencyCode: dbPrice.CurrencyCode,
+		SaleStartsAt: dbPrice.SaleStartsAt,
+		SaleEndsAt:   dbPrice.SaleEndsAt,
+
+		Kind:               zeni.PriceKind(dbPrice.Kind),
+		MinimumAmountMinor: dbPrice.MinimumAmountMinor,
 	}
 	if dbPrice.PaymentAccountID != nil {
 		price.PaymentAccountID = fmt.Sprintf("%d", *dbPrice.PaymentAccountID)
@@ -0,0 +1,206 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type PromoCode struct {
+	gorm.Model
+	EventID        uint   `gorm:"index;not null"`
+	Code           string `gorm:"not null"`
+	DiscountType   string `gorm:"not null"`
+	PercentOff     uint32
+	AmountOffMinor int64
+	CurrencyCode   string
+	PriceID        *uint
+	MaxRedemptions uint32
+	StartsAt       *int64
+	EndsAt         *int64
+	Event          *Event `gorm:"foreignKey:EventID"`
+}
+
+func dbPromoCodeToZeniPromoCode(dbPromoCode *PromoCode) *zeni.PromoCode {
+	if dbPromoCode == nil {
+		return nil
+	}
+	promoCode := &zeni.PromoCode{
+		CreatedAt:      dbPromoCode.CreatedAt,
+		UpdatedAt:      dbPromoCode.UpdatedAt,
+		ID:             fmt.Sprintf("%d", dbPromoCode.ID),
+		EventID:        fmt.Sprintf("%d", dbPromoCode.EventID),
+		Code:           dbPromoCode.Code,
+		DiscountType:   zeni.PromoCodeDiscountType(dbPromoCode.DiscountType),
+		PercentOff:     dbPromoCode.PercentOff,
+		AmountOffMinor: dbPromoCode.AmountOffMinor,
+		CurrencyCode:   dbPromoCode.CurrencyCode,
+		MaxRedemptions: dbPromoCode.MaxRedemptions,
+		StartsAt:       dbPromoCode.StartsAt,
+		EndsAt:         dbPromoCode.EndsAt,
+	}
+	if dbPromoCode.PriceID != nil {
+		promoCode.PriceID = fmt.Sprintf("%d", *dbPromoCode.PriceID)
+	}
+	return promoCode
+}
+
+// CreatePromoCode implements zeni.DB.
+func (g *gormZenaoDB) CreatePromoCode(promoCode *zeni.PromoCode) (*zeni.PromoCode, error) {
+	g, span := g.trace("gzdb.CreatePromoCode")
+	defer span.End()
+
+	if promoCode == nil {
+		return nil, errors.New("promo code is nil")
+	}
+	eventIDInt, err := strconv.ParseUint(promoCode.EventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	code := strings.ToUpper(strings.TrimSpace(promoCode.Code))
+
+	var count int64
+	if err := g.db.Model(&PromoCode{}).Where("event_id = ? AND code = ?", eventIDInt, code).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("promo code %s already exists for this event", code)
+	}
+
+	dbPromoCode := &PromoCode{
+		EventID:        uint(eventIDInt),
+		Code:           code,
+		DiscountType:   string(promoCode.DiscountType),
+		PercentOff:     promoCode.PercentOff,
+		AmountOffMinor: promoCode.AmountOffMinor,
+		CurrencyCode:   strings.ToUpper(strings.TrimSpace(promoCode.CurrencyCode)),
+		MaxRedemptions: promoCode.MaxRedemptions,
+		StartsAt:       promoCode.StartsAt,
+		EndsAt:         promoCode.EndsAt,
+	}
+	if promoCode.PriceID != "" {
+		priceIDInt, err := strconv.ParseUint(promoCode.PriceID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse price id: %w", err)
+		}
+		priceID := uint(priceIDInt)
+		dbPromoCode.PriceID = &priceID
+	}
+
+	if err := g.db.Create(dbPromoCode).Error; err != nil {
+		return nil, err
+	}
+
+	return dbPromoCodeToZeniPromoCode(dbPromoCode), nil
+}
+
+// GetPromoCode implements zeni.DB.
+func (g *gormZenaoDB) GetPromoCode(promoCodeID string) (*zeni.PromoCode, error) {
+	g, span := g.trace("gzdb.GetPromoCode")
+	defer span.End()
+
+	promoCodeIDInt, err := strconv.ParseUint(promoCodeID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse promo code id: %w", err)
+	}
+
+	var promoCode PromoCode
+	if err := g.db.First(&promoCode, promoCodeIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbPromoCodeToZeniPromoCode(&promoCode), nil
+}
+
+// GetPromoCodeByCode implements zeni.DB.
+func (g *gormZenaoDB) GetPromoCodeByCode(eventID string, code string) (*zeni.PromoCode, error) {
+	g, span := g.trace("gzdb.GetPromoCodeByCode")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var promoCode PromoCode
+	if err := g.db.
+		Where("event_id = ? AND code = ?", eventIDInt, strings.ToUpper(strings.TrimSpace(code))).
+		First(&promoCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbPromoCodeToZeniPromoCode(&promoCode), nil
+}
+
+// ListPromoCodesByEvent implements zeni.DB.
+func (g *gormZenaoDB) ListPromoCodesByEvent(eventID string) ([]*zeni.PromoCode, error) {
+	g, span := g.trace("gzdb.ListPromoCodesByEvent")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var promoCodes []PromoCode
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("id ASC").Find(&promoCodes).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.PromoCode, len(promoCodes))
+	for i := range promoCodes {
+		result[i] = dbPromoCodeToZeniPromoCode(&promoCodes[i])
+	}
+
+	return result, nil
+}
+
+// DeletePromoCode implements zeni.DB.
+func (g *gormZenaoDB) DeletePromoCode(promoCodeID string) error {
+	g, span := g.trace("gzdb.DeletePromoCode")
+	defer span.End()
+
+	promoCodeIDInt, err := strconv.ParseUint(promoCodeID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse promo code id: %w", err)
+	}
+
+	res := g.db.Delete(&PromoCode{}, promoCodeIDInt)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CountPromoCodeRedemptions implements zeni.DB.
+func (g *gormZenaoDB) CountPromoCodeRedemptions(promoCodeID string) (uint32, error) {
+	g, span := g.trace("gzdb.CountPromoCodeRedemptions")
+	defer span.End()
+
+	promoCodeIDInt, err := strconv.ParseUint(promoCodeID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse promo code id: %w", err)
+	}
+
+	var count int64
+	if err := g.db.Model(&Order{}).
+		Where("promo_code_id = ? AND status IN ?", promoCodeIDInt, []string{string(zeni.OrderStatusPending), string(zeni.OrderStatusSuccess)}).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return uint32(count), nil
+}
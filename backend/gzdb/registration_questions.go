@@ -0,0 +1,225 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type RegistrationQuestion struct {
+	gorm.Model
+	EventID  uint `gorm:"index"`
+	Position int
+	Kind     string
+	Label    string
+	Options  string // one option per line
+	Required bool
+}
+
+// RegistrationAnswer stores one value of an answer, multi choice answers have a row per chosen option.
+type RegistrationAnswer struct {
+	ID              uint    `gorm:"primaryKey"`
+	CreatedAt       int64   `gorm:"not null"`
+	EventID         uint    `gorm:"not null;index:idx_registration_answers_event_user"`
+	UserID          uint    `gorm:"not null;index:idx_registration_answers_event_user"`
+	OrderAttendeeID *string `gorm:"index"`
+	QuestionID      uint    `gorm:"not null"`
+	Value           string  `gorm:"not null"`
+}
+
+func dbRegistrationQuestionToZeniRegistrationQuestion(dbQuestion *RegistrationQuestion) *zeni.RegistrationQuestion {
+	question := &zeni.RegistrationQuestion{
+		ID:       fmt.Sprintf("%d", dbQuestion.ID),
+		EventID:  fmt.Sprintf("%d", dbQuestion.EventID),
+		Kind:     zeni.RegistrationQuestionKind(dbQuestion.Kind),
+		Label:    dbQuestion.Label,
+		Required: dbQuestion.Required,
+	}
+	if dbQuestion.Options != "" {
+		question.Options = strings.Split(dbQuestion.Options, "\n")
+	}
+	return question
+}
+
+// SetRegistrationQuestions implements zeni.DB.
+func (g *gormZenaoDB) SetRegistrationQuestions(eventID string, questions []*zeni.RegistrationQuestion) error {
+	g, span := g.trace("gzdb.SetRegistrationQuestions")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+
+	var existing []RegistrationQuestion
+	if err := g.db.Where("event_id = ?", eventIDInt).Find(&existing).Error; err != nil {
+		return fmt.Errorf("query registration questions: %w", err)
+	}
+	existingByID := make(map[uint]bool, len(existing))
+	for _, question := range existing {
+		existingByID[question.ID] = true
+	}
+
+	kept := make(map[uint]bool, len(questions))
+	for position, question := range questions {
+		dbQuestion := RegistrationQuestion{
+			EventID:  uint(eventIDInt),
+			Position: position,
+			Kind:     string(question.Kind),
+			Label:    question.Label,
+			Options:  strings.Join(question.Options, "\n"),
+			Required: question.Required,
+		}
+		if question.ID == "" {
+			if err := g.db.Create(&dbQuestion).Error; err != nil {
+				return fmt.Errorf("create registration question: %w", err)
+			}
+			continue
+		}
+
+		questionIDInt, err := strconv.ParseUint(question.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse question id: %w", err)
+		}
+		if !existingByID[uint(questionIDInt)] {
+			return errors.New("registration question not found")
+		}
+		kept[uint(questionIDInt)] = true
+		if err := g.db.Model(&RegistrationQuestion{}).Where("id = ?", questionIDInt).Updates(map[string]any{
+			"position": dbQuestion.Position,
+			"kind":     dbQuestion.Kind,
+			"label":    dbQuestion.Label,
+			"options":  dbQuestion.Options,
+			"required": dbQuestion.Required,
+		}).Error; err != nil {
+			return fmt.Errorf("update registration question: %w", err)
+		}
+	}
+
+	for _, question := range existing {
+		if kept[question.ID] {
+			continue
+		}
+		// answers are kept, they are not exported anymore
+		if err := g.db.Delete(&RegistrationQuestion{}, question.ID).Error; err != nil {
+			return fmt.Errorf("delete registration question: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetRegistrationQuestions implements zeni.DB.
+func (g *gormZenaoDB) GetRegistrationQuestions(eventID string) ([]*zeni.RegistrationQuestion, error) {
+	g, span := g.trace("gzdb.GetRegistrationQuestions")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var dbQuestions []RegistrationQuestion
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("position ASC, id ASC").Find(&dbQuestions).Error; err != nil {
+		return nil, fmt.Errorf("query registration questions: %w", err)
+	}
+
+	questions := make([]*zeni.RegistrationQuestion, 0, len(dbQuestions))
+	for _, dbQuestion := range dbQuestions {
+		questions = append(questions, dbRegistrationQuestionToZeniRegistrationQuestion(&dbQuestion))
+	}
+	return questions, nil
+}
+
+// SaveRegistrationAnswers implements zeni.DB.
+func (g *gormZenaoDB) SaveRegistrationAnswers(eventID string, userID string, orderAttendeeID string, answers []*zeni.RegistrationAnswer) error {
+	g, span := g.trace("gzdb.SaveRegistrationAnswers")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse user id: %w", err)
+	}
+	var attendeeID *string
+	if orderAttendeeID != "" {
+		attendeeID = &orderAttendeeID
+	}
+
+	var rows []*RegistrationAnswer
+	now := time.Now().Unix()
+	for _, answer := range answers {
+		questionIDInt, err := strconv.ParseUint(answer.QuestionID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse question id: %w", err)
+		}
+		for _, value := range answer.Values {
+			rows = append(rows, &RegistrationAnswer{
+				CreatedAt:       now,
+				EventID:         uint(eventIDInt),
+				UserID:          uint(userIDInt),
+				OrderAttendeeID: attendeeID,
+				QuestionID:      uint(questionIDInt),
+				Value:           value,
+			})
+		}
+	}
+
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ? AND user_id = ?", eventIDInt, userIDInt).Delete(&RegistrationAnswer{}).Error; err != nil {
+			return fmt.Errorf("delete previous registration answers: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := tx.Create(rows).Error; err != nil {
+			return fmt.Errorf("create registration answers: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetEventRegistrationAnswers implements zeni.DB.
+func (g *gormZenaoDB) GetEventRegistrationAnswers(eventID string) ([]*zeni.RegistrationAnswer, error) {
+	g, span := g.trace("gzdb.GetEventRegistrationAnswers")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var rows []RegistrationAnswer
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("user_id ASC, question_id ASC, id ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("query registration answers: %w", err)
+	}
+
+	var answers []*zeni.RegistrationAnswer
+	for _, row := range rows {
+		userID := fmt.Sprintf("%d", row.UserID)
+		questionID := fmt.Sprintf("%d", row.QuestionID)
+		if len(answers) > 0 {
+			last := answers[len(answers)-1]
+			if last.UserID == userID && last.QuestionID == questionID {
+				last.Values = append(last.Values, row.Value)
+				continue
+			}
+		}
+		answers = append(answers, &zeni.RegistrationAnswer{
+			EventID:         eventID,
+			UserID:          userID,
+			OrderAttendeeID: stringPtrToString(row.OrderAttendeeID),
+			QuestionID:      questionID,
+			Values:          []string{row.Value},
+		})
+	}
+	return answers, nil
+}
@@ -0,0 +1,127 @@
+package gzdb
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+type salesReportRow struct {
+	EventID             uint
+	EventTitle          string
+	PriceGroupID        uint
+	CurrencyCode        string
+	TicketsSold         int64
+	TicketsRefunded     int64
+	GrossAmountMinor    int64
+	RefundedAmountMinor int64
+}
+
+type donationsReportRow struct {
+	EventID                     uint
+	EventTitle                  string
+	CurrencyCode                string
+	DonationAmountMinor         int64
+	RefundedDonationAmountMinor int64
+}
+
+// GetCommunitySalesReport implements zeni.DB.
+func (g *gormZenaoDB) GetCommunitySalesReport(communityID string, from int64, to int64) ([]*zeni.SalesReportRow, error) {
+	g, span := g.trace("gzdb.GetCommunitySalesReport")
+	defer span.End()
+
+	cmtIDInt, err := strconv.ParseUint(communityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse community id: %w", err)
+	}
+
+	query := g.db.Table("order_attendees").
+		Select(`orders.event_id AS event_id,
+			events.title AS event_title,
+			order_attendees.price_group_id AS price_group_id,
+			UPPER(order_attendees.currency_code) AS currency_code,
+			COUNT(*) AS tickets_sold,
+			SUM(CASE WHEN order_attendees.refunded_at IS NOT NULL THEN 1 ELSE 0 END) AS tickets_refunded,
+			SUM(order_attendees.amount_minor) AS gross_amount_minor,
+			SUM(CASE WHEN order_attendees.refunded_at IS NOT NULL THEN order_attendees.amount_minor ELSE 0 END) AS refunded_amount_minor`).
+		Joins("JOIN orders ON orders.id = order_attendees.order_id").
+		Joins("JOIN payment_accounts ON payment_accounts.id = orders.payment_account_id").
+		Joins("JOIN events ON events.id = orders.event_id").
+		Where("payment_accounts.community_id = ? AND orders.status = ?", cmtIDInt, zeni.OrderStatusSuccess)
+	if from != 0 {
+		query = query.Where("orders.confirmed_at >= ?", from)
+	}
+	if to != 0 {
+		query = query.Where("orders.confirmed_at < ?", to)
+	}
+
+	var rows []salesReportRow
+	if err := query.
+		Group("orders.event_id, events.title, order_attendees.price_group_id, UPPER(order_attendees.currency_code)").
+		Order("orders.event_id, order_attendees.price_group_id, currency_code").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("aggregate community sales: %w", err)
+	}
+
+	// the donation is refunded with the last ticket of the order
+	donationsQuery := g.db.Table("orders").
+		Select(`orders.event_id AS event_id,
+			events.title AS event_title,
+			UPPER(orders.currency_code) AS currency_code,
+			SUM(orders.donation_amount_minor) AS donation_amount_minor,
+			SUM(CASE WHEN orders.refund_status = ? THEN orders.donation_amount_minor ELSE 0 END) AS refunded_donation_amount_minor`,
+			string(zeni.OrderRefundStatusRefunded)).
+		Joins("JOIN payment_accounts ON payment_accounts.id = orders.payment_account_id").
+		Joins("JOIN events ON events.id = orders.event_id").
+		Where("payment_accounts.community_id = ? AND orders.status = ? AND orders.donation_amount_minor > 0", cmtIDInt, zeni.OrderStatusSuccess)
+	if from != 0 {
+		donationsQuery = donationsQuery.Where("orders.confirmed_at >= ?", from)
+	}
+	if to != 0 {
+		donationsQuery = donationsQuery.Where("orders.confirmed_at < ?", to)
+	}
+
+	var donations []donationsReportRow
+	if err := donationsQuery.
+		Group("orders.event_id, events.title, UPPER(orders.currency_code)").
+		Order("orders.event_id, currency_code").
+		Scan(&donations).Error; err != nil {
+		return nil, fmt.Errorf("aggregate community donations: %w", err)
+	}
+
+	res := make([]*zeni.SalesReportRow, 0, len(rows)+len(donations))
+	for _, row := range rows {
+		res = append(res, &zeni.SalesReportRow{
+			EventID:             fmt.Sprintf("%d", row.EventID),
+			EventTitle:          row.EventTitle,
+			PriceGroupID:        fmt.Sprintf("%d", row.PriceGroupID),
+			CurrencyCode:        strings.ToUpper(row.CurrencyCode),
+			TicketsSold:         row.TicketsSold,
+			TicketsRefunded:     row.TicketsRefunded,
+			GrossAmountMinor:    row.GrossAmountMinor,
+			RefundedAmountMinor: row.RefundedAmountMinor,
+		})
+	}
+
+	// the donations row of an event comes after its price groups
+	for _, donation := range donations {
+		eventID := fmt.Sprintf("%d", donation.EventID)
+		i := len(res)
+		for j, row := range res {
+			if row.EventID == eventID {
+				i = j + 1
+			}
+		}
+		res = slices.Insert(res, i, &zeni.SalesReportRow{
+			EventID:                     eventID,
+			EventTitle:                  donation.EventTitle,
+			CurrencyCode:                strings.ToUpper(donation.CurrencyCode),
+			DonationAmountMinor:         donation.DonationAmountMinor,
+			RefundedDonationAmountMinor: donation.RefundedDonationAmountMinor,
+		})
+	}
+	return res, nil
+}
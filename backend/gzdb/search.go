@@ -0,0 +1,156 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+const maxSearchTerms = 10
+
+// checkSearchIndex makes sure the full-text index created by the migrations can be queried. The index needs sqlite
+// built with FTS5 (the sqlite_fts5 build tag), without it writing to the indexed tables fails too.
+func checkSearchIndex(db *gorm.DB) error {
+	for _, table := range []string{"events_search", "communities_search"} {
+		if err := db.Exec(fmt.Sprintf("SELECT rowid FROM %s WHERE %s MATCH 'zenao' LIMIT 0", table, table)).Error; err != nil {
+			return fmt.Errorf("full-text index %s is unavailable, build with -tags sqlite_fts5 and apply the migrations: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// searchMatchExpression turns the user query into a fts5 expression matching every term as a prefix.
+// Terms are quoted so the fts5 query syntax in the user input is never interpreted.
+func searchMatchExpression(query string) (string, error) {
+	terms := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(terms) == 0 {
+		return "", errors.New("search query has no words")
+	}
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, `"`+term+`"*`)
+	}
+	return strings.Join(quoted, " "), nil
+}
+
+type eventSearchRow struct {
+	Event
+	TitleHighlight     string
+	DescriptionSnippet string
+	VenueHighlight     string
+	Rank               float64
+}
+
+// SearchEvents implements zeni.DB.
+func (g *gormZenaoDB) SearchEvents(query string, limit int, offset int, from int64, to int64, locationFilter *zeni.LocationFilter) ([]*zeni.EventSearchResult, error) {
+	g, span := g.trace("gzdb.SearchEvents")
+	defer span.End()
+
+	match, err := searchMatchExpression(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// the title matters more than the venue, which matters more than the description
+	q := g.db.Table("events_search").
+		Select(`events.*,
+			highlight(events_search, 0, ?, ?) AS title_highlight,
+			snippet(events_search, 1, ?, ?, '…', 24) AS description_snippet,
+			highlight(events_search, 2, ?, ?) AS venue_highlight,
+			bm25(events_search, 10.0, 1.0, 4.0) AS rank`,
+			zeni.SearchMatchStart, zeni.SearchMatchEnd,
+			zeni.SearchMatchStart, zeni.SearchMatchEnd,
+			zeni.SearchMatchStart, zeni.SearchMatchEnd).
+		Joins("INNER JOIN events ON events.id = events_search.rowid").
+		Where("events_search MATCH ?", match).
+		Where("events.deleted_at IS NULL AND events.draft = ? AND events.discoverable = ?", false, true)
+
+	if from != 0 {
+		q = q.Where("events.end_date >= ?", time.Unix(from, 0))
+	}
+	if to != 0 {
+		q = q.Where("events.end_date <= ?", time.Unix(to, 0))
+	}
+	q = whereWithinRadius(q, "events.", locationFilter)
+
+	var rows []eventSearchRow
+	if err := q.Order("rank ASC, events.id DESC").Limit(limit).Offset(offset).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("search events: %w", err)
+	}
+
+	res := make([]*zeni.EventSearchResult, 0, len(rows))
+	for i := range rows {
+		evt, err := dbEventToZeniEvent(&rows[i].Event)
+		if err != nil {
+			return nil, fmt.Errorf("convert db event to zeni event: %w", err)
+		}
+		res = append(res, &zeni.EventSearchResult{
+			Event:              evt,
+			TitleHighlight:     rows[i].TitleHighlight,
+			DescriptionSnippet: rows[i].DescriptionSnippet,
+			VenueHighlight:     rows[i].VenueHighlight,
+			Rank:               rows[i].Rank,
+		})
+	}
+	return res, nil
+}
+
+type communitySearchRow struct {
+	Community
+	NameHighlight      string
+	DescriptionSnippet string
+	Rank               float64
+}
+
+// SearchCommunities implements zeni.DB.
+func (g *gormZenaoDB) SearchCommunities(query string, limit int, offset int) ([]*zeni.CommunitySearchResult, error) {
+	g, span := g.trace("gzdb.SearchCommunities")
+	defer span.End()
+
+	match, err := searchMatchExpression(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []communitySearchRow
+	if err := g.db.Table("communities_search").
+		Select(`communities.*,
+			highlight(communities_search, 0, ?, ?) AS name_highlight,
+			snippet(communities_search, 1, ?, ?, '…', 24) AS description_snippet,
+			bm25(communities_search, 10.0, 1.0) AS rank`,
+			zeni.SearchMatchStart, zeni.SearchMatchEnd,
+			zeni.SearchMatchStart, zeni.SearchMatchEnd).
+		Joins("INNER JOIN communities ON communities.id = communities_search.rowid").
+		Where("communities_search MATCH ?", match).
+		Where("communities.deleted_at IS NULL").
+		Order("rank ASC, communities.id DESC").
+		Limit(limit).Offset(offset).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("search communities: %w", err)
+	}
+
+	res := make([]*zeni.CommunitySearchResult, 0, len(rows))
+	for i := range rows {
+		cmt, err := dbCommunityToZeniCommunity(&rows[i].Community)
+		if err != nil {
+			return nil, fmt.Errorf("convert db community to zeni community: %w", err)
+		}
+		res = append(res, &zeni.CommunitySearchResult{
+			Community:          cmt,
+			NameHighlight:      rows[i].NameHighlight,
+			DescriptionSnippet: rows[i].DescriptionSnippet,
+			Rank:               rows[i].Rank,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,22 @@
+package gzdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupDBRequiresSearchIndex(t *testing.T) {
+	_, err := SetupDB(filepath.Join(t.TempDir(), "unmigrated.db"))
+	require.ErrorContains(t, err, "full-text index events_search is unavailable")
+}
+
+func TestSearchMatchExpression(t *testing.T) {
+	match, err := searchMatchExpression(`Gnolang "smart" OR* NEAR(x)`)
+	require.NoError(t, err)
+	require.Equal(t, `"Gnolang"* "smart"* "OR"* "NEAR"* "x"*`, match)
+
+	_, err = searchMatchExpression(`"*" -`)
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package gzdb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+// EntityTag is a free-form tag of an event or a community.
+type EntityTag struct {
+	CreatedAt time.Time `gorm:"<-:create"`
+
+	EntityType string `gorm:"primaryKey"` // e.g. "event", "community"
+	EntityID   uint   `gorm:"primaryKey;autoIncrement:false"`
+	Tag        string `gorm:"primaryKey;index"`
+}
+
+// setEntityTags replaces the tags of the entity, tags should be normalized by the caller.
+func (g *gormZenaoDB) setEntityTags(entityType string, entityID uint, tags []string) error {
+	if err := g.db.Where("entity_type = ? AND entity_id = ?", entityType, entityID).Delete(&EntityTag{}).Error; err != nil {
+		return fmt.Errorf("delete tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	dbTags := make([]*EntityTag, 0, len(tags))
+	for _, tag := range tags {
+		dbTags = append(dbTags, &EntityTag{EntityType: entityType, EntityID: entityID, Tag: tag})
+	}
+	if err := g.db.Create(dbTags).Error; err != nil {
+		return fmt.Errorf("create tags: %w", err)
+	}
+	return nil
+}
+
+// whereTagged restricts the query on the table of entityType to the entities matching the filter.
+func whereTagged(query *gorm.DB, table string, entityType string, tagFilter *zeni.TagFilter) *gorm.DB {
+	if tagFilter == nil {
+		return query
+	}
+	if tagFilter.Category != "" {
+		query = query.Where(table+".category = ?", tagFilter.Category)
+	}
+	for _, tag := range tagFilter.Tags {
+		query = query.Where("EXISTS (SELECT 1 FROM entity_tags WHERE entity_tags.entity_type = ? AND entity_tags.entity_id = "+table+".id AND entity_tags.tag = ?)", entityType, tag)
+	}
+	return query
+}
+
+// taggedEntities returns the entities of entityType that are listed publicly, as a query on their table.
+func (g *gormZenaoDB) taggedEntities(entityType string) (*gorm.DB, string, error) {
+	switch entityType {
+	case zeni.EntityTypeEvent:
+		return g.db.Model(&Event{}).Where("events.draft = ? AND events.discoverable = ?", false, true), "events", nil
+	case zeni.EntityTypeCommunity:
+		return g.db.Model(&Community{}), "communities", nil
+	default:
+		return nil, "", fmt.Errorf("unsupported tagged entity type %q", entityType)
+	}
+}
+
+// GetEntityTags implements zeni.DB.
+func (g *gormZenaoDB) GetEntityTags(entityType string, entityID string) ([]string, error) {
+	g, span := g.trace("gzdb.GetEntityTags")
+	defer span.End()
+
+	entityIDInt, err := strconv.ParseUint(entityID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse entity id: %w", err)
+	}
+
+	tags := []string{}
+	if err := g.db.Model(&EntityTag{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityIDInt).
+		Order("tag ASC").
+		Pluck("tag", &tags).Error; err != nil {
+		return nil, fmt.Errorf("query tags: %w", err)
+	}
+	return tags, nil
+}
+
+// CountCategories implements zeni.DB.
+func (g *gormZenaoDB) CountCategories(entityType string) ([]*zeni.TagCount, error) {
+	g, span := g.trace("gzdb.CountCategories")
+	defer span.End()
+
+	query, table, err := g.taggedEntities(entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Category string
+		Count    uint32
+	}
+	if err := query.
+		Select(table+".category AS category, COUNT(*) AS count").
+		Where(table+".category IN ?", zeni.Categories).
+		Group(table + ".category").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("count categories: %w", err)
+	}
+
+	counts := make(map[string]uint32, len(rows))
+	for _, row := range rows {
+		counts[row.Category] = row.Count
+	}
+	res := make([]*zeni.TagCount, 0, len(zeni.Categories))
+	for _, category := range zeni.Categories {
+		res = append(res, &zeni.TagCount{Name: category, Count: counts[category]})
+	}
+	return res, nil
+}
+
+// CountTags implements zeni.DB.
+func (g *gormZenaoDB) CountTags(entityType string, limit int) ([]*zeni.TagCount, error) {
+	g, span := g.trace("gzdb.CountTags")
+	defer span.End()
+
+	query, table, err := g.taggedEntities(entityType)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Tag   string
+		Count uint32
+	}
+	if err := query.
+		Select("entity_tags.tag AS tag, COUNT(*) AS count").
+		Joins("INNER JOIN entity_tags ON entity_tags.entity_type = ? AND entity_tags.entity_id = "+table+".id", entityType).
+		Group("entity_tags.tag").
+		Order("count DESC, tag ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("count tags: %w", err)
+	}
+
+	res := make([]*zeni.TagCount, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &zeni.TagCount{Name: row.Tag, Count: row.Count})
+	}
+	return res, nil
+}
@@ -0,0 +1,475 @@
+package gzdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"gorm.io/gorm"
+)
+
+type WaitlistEntry struct {
+	gorm.Model
+	EventID        uint   `gorm:"index;not null"`
+	PriceGroupID   *uint  `gorm:"index"`
+	UserID         uint   `gorm:"index;not null"`
+	Position       uint32 `gorm:"not null"`
+	Status         string `gorm:"index;not null"`
+	OfferedAt      *int64
+	OfferExpiresAt *int64
+	OrderID        *string     `gorm:"index"` // pending order whose holds took over the offered spot
+	Event          *Event      `gorm:"foreignKey:EventID"`
+	PriceGroup     *PriceGroup `gorm:"foreignKey:PriceGroupID"`
+	User           *User       `gorm:"foreignKey:UserID"`
+}
+
+var activeWaitlistStatuses = []string{string(zeni.WaitlistEntryStatusWaiting), string(zeni.WaitlistEntryStatusOffered)}
+
+func dbWaitlistEntryToZeniWaitlistEntry(dbEntry *WaitlistEntry) *zeni.WaitlistEntry {
+	if dbEntry == nil {
+		return nil
+	}
+	entry := &zeni.WaitlistEntry{
+		CreatedAt:      dbEntry.CreatedAt,
+		ID:             fmt.Sprintf("%d", dbEntry.ID),
+		EventID:        fmt.Sprintf("%d", dbEntry.EventID),
+		UserID:         fmt.Sprintf("%d", dbEntry.UserID),
+		Position:       dbEntry.Position,
+		Status:         zeni.WaitlistEntryStatus(dbEntry.Status),
+		OfferedAt:      dbEntry.OfferedAt,
+		OfferExpiresAt: dbEntry.OfferExpiresAt,
+	}
+	if dbEntry.PriceGroupID != nil {
+		entry.PriceGroupID = fmt.Sprintf("%d", *dbEntry.PriceGroupID)
+	}
+	if dbEntry.OrderID != nil {
+		entry.OrderID = *dbEntry.OrderID
+	}
+	return entry
+}
+
+// CreateWaitlistEntry implements zeni.DB.
+func (g *gormZenaoDB) CreateWaitlistEntry(eventID string, priceGroupID string, userID string) (*zeni.WaitlistEntry, error) {
+	g, span := g.trace("gzdb.CreateWaitlistEntry")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var count int64
+	if err := g.db.Model(&WaitlistEntry{}).
+		Where("event_id = ? AND user_id = ? AND status IN ?", eventIDInt, userIDInt, activeWaitlistStatuses).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errors.New("user is already on the waitlist of this event")
+	}
+
+	var lastPosition int64
+	if err := g.db.Model(&WaitlistEntry{}).
+		Select("COALESCE(MAX(position), 0)").
+		Where("event_id = ?", eventIDInt).
+		Row().Scan(&lastPosition); err != nil {
+		return nil, err
+	}
+
+	dbEntry := &WaitlistEntry{
+		EventID:  uint(eventIDInt),
+		UserID:   uint(userIDInt),
+		Position: uint32(lastPosition) + 1,
+		Status:   string(zeni.WaitlistEntryStatusWaiting),
+	}
+	if priceGroupID != "" {
+		priceGroupIDInt, err := strconv.ParseUint(priceGroupID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse price group id: %w", err)
+		}
+		priceGroupID := uint(priceGroupIDInt)
+		dbEntry.PriceGroupID = &priceGroupID
+	}
+
+	if err := g.db.Create(dbEntry).Error; err != nil {
+		return nil, err
+	}
+
+	return dbWaitlistEntryToZeniWaitlistEntry(dbEntry), nil
+}
+
+// GetWaitlistEntry implements zeni.DB.
+func (g *gormZenaoDB) GetWaitlistEntry(entryID string) (*zeni.WaitlistEntry, error) {
+	g, span := g.trace("gzdb.GetWaitlistEntry")
+	defer span.End()
+
+	entryIDInt, err := strconv.ParseUint(entryID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse waitlist entry id: %w", err)
+	}
+
+	var entry WaitlistEntry
+	if err := g.db.First(&entry, entryIDInt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbWaitlistEntryToZeniWaitlistEntry(&entry), nil
+}
+
+// GetUserWaitlistEntry implements zeni.DB.
+func (g *gormZenaoDB) GetUserWaitlistEntry(eventID string, userID string) (*zeni.WaitlistEntry, error) {
+	g, span := g.trace("gzdb.GetUserWaitlistEntry")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var entry WaitlistEntry
+	if err := g.db.
+		Where("event_id = ? AND user_id = ? AND status IN ?", eventIDInt, userIDInt, activeWaitlistStatuses).
+		First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dbWaitlistEntryToZeniWaitlistEntry(&entry), nil
+}
+
+// ListWaitlistEntries implements zeni.DB.
+func (g *gormZenaoDB) ListWaitlistEntries(eventID string) ([]*zeni.WaitlistEntry, error) {
+	g, span := g.trace("gzdb.ListWaitlistEntries")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse event id: %w", err)
+	}
+
+	var entries []WaitlistEntry
+	if err := g.db.Where("event_id = ?", eventIDInt).Order("position ASC, id ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*zeni.WaitlistEntry, len(entries))
+	for i := range entries {
+		result[i] = dbWaitlistEntryToZeniWaitlistEntry(&entries[i])
+	}
+
+	return result, nil
+}
+
+// ListWaitlistEventIDs implements zeni.DB.
+func (g *gormZenaoDB) ListWaitlistEventIDs() ([]string, error) {
+	g, span := g.trace("gzdb.ListWaitlistEventIDs")
+	defer span.End()
+
+	var eventIDs []uint
+	if err := g.db.Model(&WaitlistEntry{}).
+		Distinct("event_id").
+		Where("status = ?", string(zeni.WaitlistEntryStatusWaiting)).
+		Pluck("event_id", &eventIDs).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(eventIDs))
+	for i, eventID := range eventIDs {
+		result[i] = fmt.Sprintf("%d", eventID)
+	}
+
+	return result, nil
+}
+
+// DeleteWaitlistEntry implements zeni.DB.
+func (g *gormZenaoDB) DeleteWaitlistEntry(entryID string) error {
+	g, span := g.trace("gzdb.DeleteWaitlistEntry")
+	defer span.End()
+
+	entryIDInt, err := strconv.ParseUint(entryID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse waitlist entry id: %w", err)
+	}
+
+	if err := g.db.Where("waitlist_entry_id = ?", entryIDInt).Delete(&TicketHold{}).Error; err != nil {
+		return err
+	}
+
+	res := g.db.Delete(&WaitlistEntry{}, entryIDInt)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ReorderWaitlist implements zeni.DB.
+func (g *gormZenaoDB) ReorderWaitlist(eventID string, entryIDs []string) error {
+	g, span := g.trace("gzdb.ReorderWaitlist")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse event id: %w", err)
+	}
+
+	var waiting []WaitlistEntry
+	if err := g.db.
+		Where("event_id = ? AND status = ?", eventIDInt, string(zeni.WaitlistEntryStatusWaiting)).
+		Order("position ASC").
+		Find(&waiting).Error; err != nil {
+		return err
+	}
+	if len(waiting) != len(entryIDs) {
+		return errors.New("new order must list every waiting entry exactly once")
+	}
+
+	// keep the positions already taken by the waiting entries so they stay behind offered ones
+	positions := make([]uint32, len(waiting))
+	waitingIDs := make(map[uint]struct{}, len(waiting))
+	for i, entry := range waiting {
+		positions[i] = entry.Position
+		waitingIDs[entry.ID] = struct{}{}
+	}
+
+	for i, entryID := range entryIDs {
+		entryIDInt, err := strconv.ParseUint(entryID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse waitlist entry id: %w", err)
+		}
+		if _, ok := waitingIDs[uint(entryIDInt)]; !ok {
+			return errors.New("new order must list every waiting entry exactly once")
+		}
+		delete(waitingIDs, uint(entryIDInt))
+
+		if err := g.db.Model(&WaitlistEntry{}).
+			Where("id = ?", entryIDInt).
+			Update("position", positions[i]).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OfferWaitlistEntry implements zeni.DB.
+func (g *gormZenaoDB) OfferWaitlistEntry(entryID string, nowUnix int64, expiresAt int64) (*zeni.WaitlistEntry, error) {
+	g, span := g.trace("gzdb.OfferWaitlistEntry")
+	defer span.End()
+
+	entryIDInt, err := strconv.ParseUint(entryID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse waitlist entry id: %w", err)
+	}
+
+	var entry WaitlistEntry
+	if err := g.db.First(&entry, entryIDInt).Error; err != nil {
+		return nil, err
+	}
+	if entry.Status != string(zeni.WaitlistEntryStatusWaiting) {
+		return nil, fmt.Errorf("waitlist entry is %s", entry.Status)
+	}
+
+	if err := g.db.Create(&TicketHold{
+		CreatedAt:       nowUnix,
+		EventID:         entry.EventID,
+		PriceGroupID:    entry.PriceGroupID,
+		WaitlistEntryID: &entry.ID,
+		Quantity:        1,
+		ExpiresAt:       expiresAt,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	entry.Status = string(zeni.WaitlistEntryStatusOffered)
+	entry.OfferedAt = &nowUnix
+	entry.OfferExpiresAt = &expiresAt
+	if err := g.db.Model(&WaitlistEntry{}).Where("id = ?", entry.ID).Updates(map[string]any{
+		"status":           entry.Status,
+		"offered_at":       nowUnix,
+		"offer_expires_at": expiresAt,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return dbWaitlistEntryToZeniWaitlistEntry(&entry), nil
+}
+
+// ClaimWaitlistOffer implements zeni.DB.
+func (g *gormZenaoDB) ClaimWaitlistOffer(eventID string, userID string, nowUnix int64) (bool, error) {
+	g, span := g.trace("gzdb.ClaimWaitlistOffer")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse event id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse user id: %w", err)
+	}
+
+	var entry WaitlistEntry
+	if err := g.db.
+		Where("event_id = ? AND user_id = ? AND status = ? AND offer_expires_at > ? AND order_id IS NULL", eventIDInt, userIDInt, string(zeni.WaitlistEntryStatusOffered), nowUnix).
+		First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := g.db.Where("waitlist_entry_id = ?", entry.ID).Delete(&TicketHold{}).Error; err != nil {
+		return false, err
+	}
+	if err := g.db.Model(&WaitlistEntry{}).
+		Where("id = ?", entry.ID).
+		Update("status", string(zeni.WaitlistEntryStatusClaimed)).Error; err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// TakeWaitlistOfferHold implements zeni.DB.
+func (g *gormZenaoDB) TakeWaitlistOfferHold(eventID string, priceGroupID string, userID string, nowUnix int64) (string, error) {
+	g, span := g.trace("gzdb.TakeWaitlistOfferHold")
+	defer span.End()
+
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse event id: %w", err)
+	}
+	priceGroupIDInt, err := strconv.ParseUint(priceGroupID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse price group id: %w", err)
+	}
+	userIDInt, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse user id: %w", err)
+	}
+
+	var entry WaitlistEntry
+	if err := g.db.
+		Where("event_id = ? AND user_id = ? AND status = ? AND offer_expires_at > ? AND order_id IS NULL", eventIDInt, userIDInt, string(zeni.WaitlistEntryStatusOffered), nowUnix).
+		Where("price_group_id IS NULL OR price_group_id = ?", priceGroupIDInt).
+		First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if err := g.db.Where("waitlist_entry_id = ?", entry.ID).Delete(&TicketHold{}).Error; err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d", entry.ID), nil
+}
+
+// LinkWaitlistOffersToOrder implements zeni.DB.
+func (g *gormZenaoDB) LinkWaitlistOffersToOrder(entryIDs []string, orderID string) error {
+	g, span := g.trace("gzdb.LinkWaitlistOffersToOrder")
+	defer span.End()
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+	entryIDsInt := make([]uint64, len(entryIDs))
+	for i, entryID := range entryIDs {
+		entryIDInt, err := strconv.ParseUint(entryID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse waitlist entry id: %w", err)
+		}
+		entryIDsInt[i] = entryIDInt
+	}
+
+	return g.db.Model(&WaitlistEntry{}).
+		Where("id IN ? AND status = ?", entryIDsInt, string(zeni.WaitlistEntryStatusOffered)).
+		Update("order_id", orderID).Error
+}
+
+// ClaimOrderWaitlistOffers implements zeni.DB.
+func (g *gormZenaoDB) ClaimOrderWaitlistOffers(orderID string) error {
+	g, span := g.trace("gzdb.ClaimOrderWaitlistOffers")
+	defer span.End()
+
+	return g.db.Model(&WaitlistEntry{}).
+		Where("order_id = ? AND status = ?", orderID, string(zeni.WaitlistEntryStatusOffered)).
+		Update("status", string(zeni.WaitlistEntryStatusClaimed)).Error
+}
+
+// RestoreOrderWaitlistOffers implements zeni.DB.
+func (g *gormZenaoDB) RestoreOrderWaitlistOffers(orderID string, nowUnix int64) error {
+	g, span := g.trace("gzdb.RestoreOrderWaitlistOffers")
+	defer span.End()
+
+	return g.db.Transaction(func(tx *gorm.DB) error {
+		var entries []WaitlistEntry
+		if err := tx.Where("order_id = ? AND status = ?", orderID, string(zeni.WaitlistEntryStatusOffered)).Find(&entries).Error; err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			updates := map[string]any{"order_id": nil}
+			if entry.OfferExpiresAt != nil && *entry.OfferExpiresAt > nowUnix {
+				if err := tx.Create(&TicketHold{
+					CreatedAt:       nowUnix,
+					EventID:         entry.EventID,
+					PriceGroupID:    entry.PriceGroupID,
+					WaitlistEntryID: &entry.ID,
+					Quantity:        1,
+					ExpiresAt:       *entry.OfferExpiresAt,
+				}).Error; err != nil {
+					return err
+				}
+			} else {
+				updates["status"] = string(zeni.WaitlistEntryStatusExpired)
+			}
+			if err := tx.Model(&WaitlistEntry{}).Where("id = ?", entry.ID).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ExpireWaitlistOffers implements zeni.DB.
+func (g *gormZenaoDB) ExpireWaitlistOffers(nowUnix int64) (int64, error) {
+	g, span := g.trace("gzdb.ExpireWaitlistOffers")
+	defer span.End()
+
+	var entryIDs []uint
+	if err := g.db.Model(&WaitlistEntry{}).
+		// offers taken over by a pending order are settled with the order
+		Where("status = ? AND offer_expires_at <= ? AND order_id IS NULL", string(zeni.WaitlistEntryStatusOffered), nowUnix).
+		Pluck("id", &entryIDs).Error; err != nil {
+		return 0, err
+	}
+	if len(entryIDs) == 0 {
+		return 0, nil
+	}
+
+	if err := g.db.Where("waitlist_entry_id IN ?", entryIDs).Delete(&TicketHold{}).Error; err != nil {
+		return 0, err
+	}
+	res := g.db.Model(&WaitlistEntry{}).
+		Where("id IN ?", entryIDs).
+		Update("status", string(zeni.WaitlistEntryStatusExpired))
+	return res.RowsAffected, res.Error
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	ics "github.com/arran4/golang-ical"
@@ -9,36 +10,196 @@ import (
 	"go.uber.org/zap"
 )
 
+// GenerateICS generates the calendar of the event, each session of its agenda is a VEVENT related to the event one.
 // see: https://datatracker.ietf.org/doc/html/rfc5545
-func GenerateICS(zEvent *zeni.Event, zenaoEmail string, logger *zap.Logger) []byte {
+func GenerateICS(zEvent *zeni.Event, sessions []*zeni.EventSession, zenaoEmail string, logger *zap.Logger) []byte {
+	cal := newICSCalendar()
 	uid := fmt.Sprintf("evt_%s@zenao.io", zEvent.ID)
-	eventURL := fmt.Sprintf("https://zenao.io/event/%s", zEvent.ID)
-	description := fmt.Sprintf("You are invited to %s!", zEvent.Title)
-	location, err := zeni.LocationToString(zEvent.Location)
+	event := addICSEvent(cal, uid, zEvent, zenaoEmail, logger)
+	event.SetStartAt(zEvent.StartDate)
+	event.SetEndAt(zEvent.EndDate)
+
+	for _, session := range sessions {
+		addICSSession(cal, uid, zEvent, session, zenaoEmail)
+	}
+
+	// see:https://github.com/arran4/golang-ical/issues/116
+	serialized := cal.Serialize(ics.WithNewLineWindows)
+	return []byte(serialized)
+}
+
+// GenerateCancelICS generates the cancellation of the event and its agenda, calendars remove the events
+// previously added from GenerateICS invites. The sequence must be greater than the one of the last invite.
+func GenerateCancelICS(zEvent *zeni.Event, sessions []*zeni.EventSession, zenaoEmail string, logger *zap.Logger) []byte {
+	cal := newICSCalendar()
+	cal.SetMethod(ics.MethodCancel)
+	uid := fmt.Sprintf("evt_%s@zenao.io", zEvent.ID)
+	event := addICSEvent(cal, uid, zEvent, zenaoEmail, logger)
+	event.SetStartAt(zEvent.StartDate)
+	event.SetEndAt(zEvent.EndDate)
+	event.SetStatus(ics.ObjectStatusCancelled)
+
+	for _, session := range sessions {
+		addICSSession(cal, uid, zEvent, session, zenaoEmail).SetStatus(ics.ObjectStatusCancelled)
+	}
+
+	// see:https://github.com/arran4/golang-ical/issues/116
+	serialized := cal.Serialize(ics.WithNewLineWindows)
+	return []byte(serialized)
+}
+
+// GenerateSeriesICS generates a single recurring event for the whole series.
+// Occurrences of the rule that were removed are excluded and edited occurrences override the rule.
+// occurrences must be the live events of the series ordered by recurrence date.
+func GenerateSeriesICS(series *zeni.EventSeries, occurrences []*zeni.Event, zenaoEmail string, logger *zap.Logger) ([]byte, error) {
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("series %s has no occurrences", series.ID)
+	}
+	first := occurrences[0]
+	loc, err := first.Timezone()
 	if err != nil {
-		logger.Error("failed to convert location to string", zap.Error(err), zap.String("event-id", zEvent.ID))
-		location = ""
+		return nil, fmt.Errorf("event timezone: %w", err)
+	}
+	rule, err := zeni.ParseRRule(series.RRule, loc)
+	if err != nil {
+		return nil, err
+	}
+	recurrences, err := rule.Occurrences(series.StartDate.In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	uid := fmt.Sprintf("series_%s@zenao.io", series.ID)
+	cal := newICSCalendar()
+	master := addICSEvent(cal, uid, first, zenaoEmail, logger)
+	master.SetProperty(ics.ComponentPropertyDtStart, icsLocalTime(series.StartDate, loc), icsTimezone(loc)...)
+	master.SetProperty(ics.ComponentPropertyDtEnd, icsLocalTime(series.EndDate, loc), icsTimezone(loc)...)
+	master.AddRrule(rule.String())
+
+	byRecurrence := make(map[int64]*zeni.Event, len(occurrences))
+	for _, occurrence := range occurrences {
+		byRecurrence[occurrence.SeriesRecurrenceAt.Unix()] = occurrence
+		if occurrence.ICSSequenceNumber > first.ICSSequenceNumber {
+			master.SetSequence(int(occurrence.ICSSequenceNumber))
+		}
+	}
+	duration := series.EndDate.Sub(series.StartDate)
+	for _, recurrence := range recurrences {
+		occurrence, ok := byRecurrence[recurrence.Unix()]
+		if !ok {
+			master.AddExdate(icsLocalTime(recurrence, loc), icsTimezone(loc)...)
+			continue
+		}
+		if occurrence.StartDate.Equal(recurrence) && occurrence.EndDate.Equal(recurrence.Add(duration)) &&
+			occurrence.Title == first.Title && occurrence.Description == first.Description {
+			continue
+		}
+		override := addICSEvent(cal, uid, occurrence, zenaoEmail, logger)
+		override.SetProperty(ics.ComponentPropertyRecurrenceId, icsLocalTime(recurrence, loc), icsTimezone(loc)...)
+		override.SetProperty(ics.ComponentPropertyDtStart, icsLocalTime(occurrence.StartDate, loc), icsTimezone(loc)...)
+		override.SetProperty(ics.ComponentPropertyDtEnd, icsLocalTime(occurrence.EndDate, loc), icsTimezone(loc)...)
+	}
+
+	// see:https://github.com/arran4/golang-ical/issues/116
+	serialized := cal.Serialize(ics.WithNewLineWindows)
+	return []byte(serialized), nil
+}
+
+// GenerateCalendarFeed generates a subscribable calendar of the events, they keep the UID of their invites
+// so subscribers don't get duplicates. Cancelled events are published with STATUS:CANCELLED.
+func GenerateCalendarFeed(name string, zEvents []*zeni.Event, zenaoEmail string, logger *zap.Logger) []byte {
+	cal := newICSCalendar()
+	cal.SetMethod(ics.MethodPublish)
+	cal.SetName(name)
+	cal.SetXWRCalName(name)
+	cal.SetRefreshInterval("PT1H")
+	cal.SetXPublishedTTL("PT1H")
+
+	for _, zEvent := range zEvents {
+		event := addICSEvent(cal, fmt.Sprintf("evt_%s@zenao.io", zEvent.ID), zEvent, zenaoEmail, logger)
+		event.SetStartAt(zEvent.StartDate)
+		event.SetEndAt(zEvent.EndDate)
+		if !zEvent.DeletedAt.IsZero() {
+			event.SetStatus(ics.ObjectStatusCancelled)
+		}
 	}
+
+	// see:https://github.com/arran4/golang-ical/issues/116
+	serialized := cal.Serialize(ics.WithNewLineWindows)
+	return []byte(serialized)
+}
+
+func newICSCalendar() *ics.Calendar {
 	cal := ics.NewCalendar()
 	cal.SetCalscale("GREGORIAN")
 	cal.SetProductId("-//Zenao//EN")
 	cal.SetVersion("2.0")
 	cal.SetMethod(ics.MethodRequest)
+	return cal
+}
+
+// addICSEvent adds the event details to the calendar, dates are left to the caller.
+func addICSEvent(cal *ics.Calendar, uid string, zEvent *zeni.Event, zenaoEmail string, logger *zap.Logger) *ics.VEvent {
+	eventURL := fmt.Sprintf("https://zenao.io/event/%s", zEvent.ID)
+	description := fmt.Sprintf("You are invited to %s!", zEvent.Title)
+	location, err := zeni.LocationToString(zEvent.Location)
+	if err != nil {
+		logger.Error("failed to convert location to string", zap.Error(err), zap.String("event-id", zEvent.ID))
+		location = ""
+	}
 	event := cal.AddEvent(uid)
 	event.SetCreatedTime(time.Now().UTC())
 	event.SetDtStampTime(time.Now().UTC())
 	event.SetSummary(zEvent.Title)
 	event.SetDescription(description)
-	event.SetStartAt(zEvent.StartDate)
-	event.SetEndAt(zEvent.EndDate)
 	event.SetURL(eventURL)
 	event.SetLocation(location)
 	event.SetStatus(ics.ObjectStatusConfirmed)
 	event.SetSequence(int(zEvent.ICSSequenceNumber))
 	// XXX: We could set the display name of the organizer if not empty
 	event.SetOrganizer(zenaoEmail, ics.WithCN("Zenao"))
+	return event
+}
 
-	// see:https://github.com/arran4/golang-ical/issues/116
-	serialized := cal.Serialize(ics.WithNewLineWindows)
-	return []byte(serialized)
+// addICSSession adds a session of the event agenda, calendars group it with the event through RELATED-TO.
+func addICSSession(cal *ics.Calendar, eventUID string, zEvent *zeni.Event, session *zeni.EventSession, zenaoEmail string) *ics.VEvent {
+	description := session.Description
+	if len(session.Speakers) > 0 {
+		description = strings.TrimSpace(fmt.Sprintf("Speakers: %s\n\n%s", strings.Join(session.Speakers, ", "), description))
+	}
+	event := cal.AddEvent(fmt.Sprintf("session_%s@zenao.io", session.ID))
+	event.SetCreatedTime(time.Now().UTC())
+	event.SetDtStampTime(time.Now().UTC())
+	event.SetSummary(session.Title)
+	event.SetDescription(description)
+	event.SetURL(fmt.Sprintf("https://zenao.io/event/%s", zEvent.ID))
+	if session.Room != "" {
+		event.SetLocation(session.Room)
+	}
+	event.SetStatus(ics.ObjectStatusConfirmed)
+	event.SetSequence(int(zEvent.ICSSequenceNumber))
+	event.SetStartAt(session.StartDate)
+	event.SetEndAt(session.EndDate)
+	event.AddProperty(ics.ComponentPropertyRelatedTo, eventUID)
+	if session.Track != "" {
+		event.AddProperty(ics.ComponentPropertyCategories, session.Track)
+	}
+	event.SetOrganizer(zenaoEmail, ics.WithCN("Zenao"))
+	return event
+}
+
+// icsLocalTime formats t as a local time of loc, recurrences must be expanded in the event timezone
+// to follow daylight saving changes.
+func icsLocalTime(t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return t.UTC().Format("20060102T150405Z")
+	}
+	return t.In(loc).Format("20060102T150405")
+}
+
+func icsTimezone(loc *time.Location) []ics.PropertyParameter {
+	if loc == time.UTC {
+		return nil
+	}
+	return []ics.PropertyParameter{ics.WithTZID(loc.String())}
 }
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zenao/v1/zenaov1connect"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// idempotencyKeyTTL is how long a key is remembered, a key reused after it can run the call again
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyKeyLease is how long a call holds its key before a retry can take it over,
+	// the call is then assumed to have died without releasing it
+	idempotencyKeyLease     = time.Minute
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentProcedures lists the mutating procedures honouring the Idempotency-Key header,
+// with the function decoding their stored response.
+var idempotentProcedures = map[string]func(data []byte) (connect.AnyResponse, error){
+	zenaov1connect.ZenaoServiceEditUserProcedure:                       replayResponse[zenaov1.EditUserResponse],
+	zenaov1connect.ZenaoServiceCreateEventProcedure:                    replayResponse[zenaov1.CreateEventResponse],
+	zenaov1connect.ZenaoServiceCancelEventProcedure:                    replayResponse[zenaov1.CancelEventResponse],
+	zenaov1connect.ZenaoServiceEditEventProcedure:                      replayResponse[zenaov1.EditEventResponse],
+	zenaov1connect.ZenaoServiceDuplicateEventProcedure:                 replayResponse[zenaov1.DuplicateEventResponse],
+	zenaov1connect.ZenaoServicePublishEventProcedure:                   replayResponse[zenaov1.PublishEventResponse],
+	zenaov1connect.ZenaoServiceBroadcastEventProcedure:                 replayResponse[zenaov1.BroadcastEventResponse],
+	zenaov1connect.ZenaoServiceParticipateProcedure:                    replayResponse[zenaov1.ParticipateResponse],
+	zenaov1connect.ZenaoServiceStartTicketPaymentProcedure:             replayResponse[zenaov1.StartTicketPaymentResponse],
+	zenaov1connect.ZenaoServiceConfirmTicketPaymentProcedure:           replayResponse[zenaov1.ConfirmTicketPaymentResponse],
+	zenaov1connect.ZenaoServiceCancelParticipationProcedure:            replayResponse[zenaov1.CancelParticipationResponse],
+	zenaov1connect.ZenaoServiceTransferTicketProcedure:                 replayResponse[zenaov1.TransferTicketResponse],
+	zenaov1connect.ZenaoServiceRefundOrderProcedure:                    replayResponse[zenaov1.RefundOrderResponse],
+	zenaov1connect.ZenaoServiceCreatePromoCodeProcedure:                replayResponse[zenaov1.CreatePromoCodeResponse],
+	zenaov1connect.ZenaoServiceDeletePromoCodeProcedure:                replayResponse[zenaov1.DeletePromoCodeResponse],
+	zenaov1connect.ZenaoServiceJoinWaitlistProcedure:                   replayResponse[zenaov1.JoinWaitlistResponse],
+	zenaov1connect.ZenaoServiceLeaveWaitlistProcedure:                  replayResponse[zenaov1.LeaveWaitlistResponse],
+	zenaov1connect.ZenaoServiceReorderWaitlistProcedure:                replayResponse[zenaov1.ReorderWaitlistResponse],
+	zenaov1connect.ZenaoServiceApproveEventApplicationProcedure:        replayResponse[zenaov1.ApproveEventApplicationResponse],
+	zenaov1connect.ZenaoServiceRejectEventApplicationProcedure:         replayResponse[zenaov1.RejectEventApplicationResponse],
+	zenaov1connect.ZenaoServiceInviteToEventProcedure:                  replayResponse[zenaov1.InviteToEventResponse],
+	zenaov1connect.ZenaoServiceResendEventInvitationsProcedure:         replayResponse[zenaov1.ResendEventInvitationsResponse],
+	zenaov1connect.ZenaoServiceDeclineInvitationProcedure:              replayResponse[zenaov1.DeclineInvitationResponse],
+	zenaov1connect.ZenaoServiceCreateEventSessionProcedure:             replayResponse[zenaov1.CreateEventSessionResponse],
+	zenaov1connect.ZenaoServiceEditEventSessionProcedure:               replayResponse[zenaov1.EditEventSessionResponse],
+	zenaov1connect.ZenaoServiceDeleteEventSessionProcedure:             replayResponse[zenaov1.DeleteEventSessionResponse],
+	zenaov1connect.ZenaoServiceRegisterForSessionProcedure:             replayResponse[zenaov1.RegisterForSessionResponse],
+	zenaov1connect.ZenaoServiceUnregisterFromSessionProcedure:          replayResponse[zenaov1.UnregisterFromSessionResponse],
+	zenaov1connect.ZenaoServiceCheckinProcedure:                        replayResponse[zenaov1.CheckinResponse],
+	zenaov1connect.ZenaoServiceRemoveParticipantProcedure:              replayResponse[zenaov1.RemoveParticipantResponse],
+	zenaov1connect.ZenaoServiceCreateCommunityProcedure:                replayResponse[zenaov1.CreateCommunityResponse],
+	zenaov1connect.ZenaoServiceEditCommunityProcedure:                  replayResponse[zenaov1.EditCommunityResponse],
+	zenaov1connect.ZenaoServiceStartCommunityStripeOnboardingProcedure: replayResponse[zenaov1.StartCommunityStripeOnboardingResponse],
+	zenaov1connect.ZenaoServiceEditCommunityLegalDetailsProcedure:      replayResponse[zenaov1.EditCommunityLegalDetailsResponse],
+	zenaov1connect.ZenaoServiceJoinCommunityProcedure:                  replayResponse[zenaov1.JoinCommunityResponse],
+	zenaov1connect.ZenaoServiceLeaveCommunityProcedure:                 replayResponse[zenaov1.LeaveCommunityResponse],
+	zenaov1connect.ZenaoServiceRemoveCommunityMemberProcedure:          replayResponse[zenaov1.RemoveCommunityMemberResponse],
+	zenaov1connect.ZenaoServiceAddEventToCommunityProcedure:            replayResponse[zenaov1.AddEventToCommunityResponse],
+	zenaov1connect.ZenaoServiceRemoveEventFromCommunityProcedure:       replayResponse[zenaov1.RemoveEventFromCommunityResponse],
+	zenaov1connect.ZenaoServiceCreateEventTemplateProcedure:            replayResponse[zenaov1.CreateEventTemplateResponse],
+	zenaov1connect.ZenaoServiceDeleteEventTemplateProcedure:            replayResponse[zenaov1.DeleteEventTemplateResponse],
+	zenaov1connect.ZenaoServiceCreateTeamProcedure:                     replayResponse[zenaov1.CreateTeamResponse],
+	zenaov1connect.ZenaoServiceEditTeamProcedure:                       replayResponse[zenaov1.EditTeamResponse],
+	zenaov1connect.ZenaoServiceDeleteTeamProcedure:                     replayResponse[zenaov1.DeleteTeamResponse],
+	zenaov1connect.ZenaoServiceCreatePollProcedure:                     replayResponse[zenaov1.CreatePollResponse],
+	zenaov1connect.ZenaoServiceVotePollProcedure:                       replayResponse[zenaov1.VotePollResponse],
+	zenaov1connect.ZenaoServiceCreatePostProcedure:                     replayResponse[zenaov1.CreatePostResponse],
+	zenaov1connect.ZenaoServiceDeletePostProcedure:                     replayResponse[zenaov1.DeletePostResponse],
+	zenaov1connect.ZenaoServiceReactPostProcedure:                      replayResponse[zenaov1.ReactPostResponse],
+	zenaov1connect.ZenaoServicePinPostProcedure:                        replayResponse[zenaov1.PinPostResponse],
+	zenaov1connect.ZenaoServiceEditPostProcedure:                       replayResponse[zenaov1.EditPostResponse],
+}
+
+// replayResponse decodes a stored response, connect handlers need it typed as the procedure response.
+func replayResponse[T any, PT interface {
+	*T
+	proto.Message
+}](data []byte) (connect.AnyResponse, error) {
+	msg := PT(new(T))
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("decode stored response: %w", err)
+	}
+	return connect.NewResponse((*T)(msg)), nil
+}
+
+// NewIdempotencyInterceptor creates a ConnectRPC unary interceptor that makes mutating procedures
+// safe to retry with an Idempotency-Key header, for authenticated users only. The first successful response is stored and replayed
+// to retries with the same key and payload, a key reused with another payload is rejected.
+// Failed calls forget the key so they can be retried.
+func NewIdempotencyInterceptor(logger *zap.Logger, db zeni.DB, auth zeni.Auth) connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(
+			ctx context.Context,
+			req connect.AnyRequest,
+		) (connect.AnyResponse, error) {
+			key := strings.TrimSpace(req.Header().Get(IdempotencyKeyHeader))
+			replay, ok := idempotentProcedures[req.Spec().Procedure]
+			if key == "" || !ok {
+				return next(ctx, req)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("idempotency key is too long"))
+			}
+
+			// keys are scoped to their user, anonymous callers would share theirs and get each other's responses
+			authUser := auth.GetUser(ctx)
+			if authUser == nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("idempotency key requires an authenticated user"))
+			}
+
+			requestHash, err := hashIdempotentRequest(req)
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			stored, created, err := db.WithContext(ctx).ReserveIdempotencyKey(&zeni.IdempotencyKey{
+				CreatedAt:   now.Unix(),
+				AuthUserID:  authUser.ID,
+				Key:         key,
+				Procedure:   req.Spec().Procedure,
+				RequestHash: requestHash,
+			}, now.Add(-idempotencyKeyTTL).Unix(), now.Add(-idempotencyKeyLease).Unix())
+			if err != nil {
+				return nil, err
+			}
+			if !created {
+				if stored.RequestHash != requestHash {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("idempotency key was already used with a different request"))
+				}
+				if stored.CompletedAt == nil {
+					return nil, connect.NewError(connect.CodeAborted, errors.New("a request with this idempotency key is still in progress"))
+				}
+				logger.Info("idempotent-replay", zap.String("procedure", req.Spec().Procedure), zap.String("idempotency-key-id", stored.ID))
+				return replay(stored.Response)
+			}
+
+			// the key is settled even if the client went away, a retry must not find it in progress forever
+			dbCtx := context.WithoutCancel(ctx)
+			res, err := next(ctx, req)
+			if err != nil {
+				if delErr := db.WithContext(dbCtx).DeleteIdempotencyKey(stored.ID); delErr != nil {
+					logger.Error("release-idempotency-key", zap.Error(delErr), zap.String("idempotency-key-id", stored.ID))
+				}
+				return nil, err
+			}
+
+			msg, ok := res.Any().(proto.Message)
+			if !ok {
+				return res, nil
+			}
+			data, err := proto.Marshal(msg)
+			if err == nil {
+				err = db.WithContext(dbCtx).CompleteIdempotencyKey(stored.ID, data, time.Now().Unix())
+			}
+			if err != nil {
+				// the call succeeded, failing it now would make the client retry a done operation
+				logger.Error("store-idempotent-response", zap.Error(err), zap.String("idempotency-key-id", stored.ID))
+			}
+			return res, nil
+		})
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// hashIdempotentRequest fingerprints the procedure, the acting team and the request payload.
+func hashIdempotentRequest(req connect.AnyRequest) (string, error) {
+	msg, ok := req.Any().(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("unexpected request type %T", req.Any())
+	}
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("encode request: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.Spec().Procedure))
+	h.Write([]byte{0})
+	h.Write([]byte(req.Header().Get(TeamActorHeader)))
+	h.Write([]byte{0})
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zenao/v1/zenaov1connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyInterceptorReplaysStartTicketPayment(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	f.auth.user = f.auth.ensureAuthUser("buyer@example.com")
+
+	path, handler := zenaov1connect.NewZenaoServiceHandler(f.server,
+		connect.WithInterceptors(NewIdempotencyInterceptor(f.server.Logger, f.db, f.auth)),
+	)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := zenaov1connect.NewZenaoServiceClient(srv.Client(), srv.URL)
+
+	startCheckout := func(key string, email string) (*connect.Response[zenaov1.StartTicketPaymentResponse], error) {
+		req := connect.NewRequest(&zenaov1.StartTicketPaymentRequest{
+			EventId:     f.eventID,
+			LineItems:   []*zenaov1.StartTicketPaymentLineItem{{PriceId: f.priceIDs[0], AttendeeEmail: email}},
+			SuccessPath: "/event/" + f.eventID,
+			CancelPath:  "/event/" + f.eventID,
+		})
+		if key != "" {
+			req.Header().Set(IdempotencyKeyHeader, key)
+		}
+		return client.StartTicketPayment(context.Background(), req)
+	}
+
+	first, err := startCheckout("retry-key", "alice@example.com")
+	require.NoError(t, err)
+	retry, err := startCheckout("retry-key", "alice@example.com")
+	require.NoError(t, err)
+	require.Equal(t, first.Msg.OrderId, retry.Msg.OrderId)
+	require.Equal(t, first.Msg.CheckoutUrl, retry.Msg.CheckoutUrl)
+	require.Len(t, *f.sessions, 1)
+
+	var orderCount int
+	require.NoError(t, f.sqlDB.QueryRow("SELECT COUNT(*) FROM orders").Scan(&orderCount))
+	require.Equal(t, 1, orderCount)
+
+	_, err = startCheckout("retry-key", "bob@example.com")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.ErrorContains(t, err, "different request")
+
+	// failed calls release their key
+	_, err = startCheckout("failing-key", "not-an-email")
+	require.Error(t, err)
+	_, err = startCheckout("failing-key", "bob@example.com")
+	require.NoError(t, err)
+
+	// a call that died before completing its key blocks the retries only for the lease
+	_, err = f.sqlDB.Exec("UPDATE idempotency_keys SET completed_at = NULL, response = NULL WHERE key = ?", "failing-key")
+	require.NoError(t, err)
+	_, err = startCheckout("failing-key", "bob@example.com")
+	require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	_, err = f.sqlDB.Exec("UPDATE idempotency_keys SET created_at = ? WHERE key = ?", time.Now().Add(-idempotencyKeyLease-time.Second).Unix(), "failing-key")
+	require.NoError(t, err)
+	_, err = startCheckout("failing-key", "bob@example.com")
+	require.NoError(t, err)
+
+	_, err = startCheckout("", "carol@example.com")
+	require.NoError(t, err)
+	require.NoError(t, f.sqlDB.QueryRow("SELECT COUNT(*) FROM orders").Scan(&orderCount))
+	require.Equal(t, 4, orderCount)
+
+	// anonymous callers would all share the same keys
+	f.auth.user = nil
+	_, err = startCheckout("anonymous-key", "dave@example.com")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.ErrorContains(t, err, "authenticated user")
+
+	deleted, err := f.db.DeleteExpiredIdempotencyKeys(1 << 62)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+}
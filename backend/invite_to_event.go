@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) InviteToEvent(
+	ctx context.Context,
+	req *connect.Request[zenaov1.InviteToEventRequest],
+) (*connect.Response[zenaov1.InviteToEventResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("invite-to-event", zap.String("event-id", req.Msg.EventId), zap.Int("emails", len(req.Msg.Emails)), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if s.InvitationSecret == "" {
+		return nil, errors.New("invitations are not enabled on this server")
+	}
+	emails, err := normalizeInvitationEmails(req.Msg.Emails)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		evt         *zeni.Event
+		invitations []*zeni.EventInvitation
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.InviteToEvent", func(tx zeni.DB) error {
+		evt, err = invitedEvent(tx, actor.ID(), req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		invitations, err = tx.CreateEventInvitations(evt.ID, actor.ID(), emails)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	s.sendEventInvitations(ctx, evt, invitations, false)
+
+	return connect.NewResponse(&zenaov1.InviteToEventResponse{
+		Invitations: mapsl.Map(invitations, eventInvitationToProto),
+	}), nil
+}
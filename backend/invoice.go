@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+// assignOrderInvoice numbers the invoice of a paid order in the sequence of the community receiving the payment.
+// It is safe to call multiple times for the same order.
+func (s *ZenaoServer) assignOrderInvoice(ctx context.Context, order *zeni.Order) (*zeni.Order, error) {
+	if order.InvoiceID != "" {
+		return order, nil
+	}
+
+	db := s.DB.WithContext(ctx)
+	account, err := db.GetOrderPaymentAccount(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.CommunityID == "" {
+		return nil, errors.New("payment account not found")
+	}
+
+	invoiceURL := ""
+	if s.AppBaseURL != "" {
+		invoiceURL = fmt.Sprintf("%s/order/%s", strings.TrimRight(s.AppBaseURL, "/"), order.ID)
+	}
+	return db.AssignOrderInvoice(order.ID, account.CommunityID, invoiceURL, time.Now().Unix())
+}
+
+// buildOrderInvoice gathers the seller, buyer and line items of an invoiced order.
+// Line items are grouped per price and show the list price when a promo code was used,
+// the discount being printed on its own line.
+func (s *ZenaoServer) buildOrderInvoice(ctx context.Context, order *zeni.Order) (*Invoice, error) {
+	if order.InvoiceID == "" || order.InvoiceIssuedAt == nil {
+		return nil, errors.New("order has no invoice")
+	}
+
+	db := s.DB.WithContext(ctx)
+
+	evt, err := db.GetEvent(order.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt == nil {
+		return nil, errors.New("event not found")
+	}
+	tz, err := evt.Timezone()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timezone: %w", err)
+	}
+
+	account, err := db.GetOrderPaymentAccount(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.CommunityID == "" {
+		return nil, errors.New("payment account not found")
+	}
+	cmt, err := db.GetCommunity(account.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	sellerName := cmt.LegalName
+	if sellerName == "" {
+		sellerName = cmt.DisplayName
+	}
+
+	users, err := db.GetUsersByIDs([]string{order.BuyerID})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 || users[0] == nil || strings.TrimSpace(users[0].AuthID) == "" {
+		return nil, errors.New("buyer auth id not found")
+	}
+	buyerEmail := ""
+	if s.Auth != nil {
+		authUsers, err := s.Auth.GetUsersFromIDs(ctx, []string{users[0].AuthID})
+		if err != nil {
+			return nil, err
+		}
+		if len(authUsers) != 0 && authUsers[0] != nil {
+			buyerEmail = authUsers[0].Email
+		}
+	}
+
+	attendees, err := db.GetOrderAttendees(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	priceGroups, err := db.GetPriceGroupsByEvent(order.EventID)
+	if err != nil {
+		return nil, err
+	}
+	listPrices := map[string]int64{}
+	for _, group := range priceGroups {
+		for _, price := range group.Prices {
+			// pay-what-you-want tickets are never discounted, the paid amount is the list price
+			if price.Kind == zeni.PriceKindFixed {
+				listPrices[price.ID] = price.AmountMinor
+			}
+		}
+	}
+
+	lines := []InvoiceLine{}
+	lineIndexes := map[string]int{}
+	for _, attendee := range attendees {
+		unitAmount := attendee.AmountMinor
+		if listPrice, ok := listPrices[attendee.PriceID]; ok && order.DiscountAmountMinor > 0 {
+			unitAmount = listPrice
+		}
+		key := fmt.Sprintf("%s/%d", attendee.PriceID, unitAmount)
+		idx, ok := lineIndexes[key]
+		if !ok {
+			idx = len(lines)
+			lineIndexes[key] = idx
+			lines = append(lines, InvoiceLine{
+				Description:     fmt.Sprintf("Ticket - %s", evt.Title),
+				UnitAmountMinor: unitAmount,
+			})
+		}
+		lines[idx].Quantity++
+		lines[idx].AmountMinor += unitAmount
+	}
+	if order.DonationAmountMinor > 0 {
+		lines = append(lines, InvoiceLine{
+			Description:     fmt.Sprintf("Donation - %s", evt.Title),
+			Quantity:        1,
+			UnitAmountMinor: order.DonationAmountMinor,
+			AmountMinor:     order.DonationAmountMinor,
+		})
+	}
+
+	return &Invoice{
+		Number:              order.InvoiceID,
+		IssuedAt:            time.Unix(*order.InvoiceIssuedAt, 0),
+		OrderID:             order.ID,
+		PaymentReference:    order.PaymentIntentID,
+		SellerName:          sellerName,
+		SellerAddress:       cmt.LegalAddress,
+		SellerTaxID:         cmt.TaxID,
+		BuyerName:           users[0].DisplayName,
+		BuyerEmail:          buyerEmail,
+		EventTitle:          evt.Title,
+		EventDate:           evt.StartDate.In(tz),
+		CurrencyCode:        order.CurrencyCode,
+		Lines:               lines,
+		PromoCode:           order.PromoCode,
+		DiscountAmountMinor: order.DiscountAmountMinor,
+		TotalAmountMinor:    order.AmountMinor,
+		TaxRateBps:          order.InvoiceTaxRateBps,
+	}, nil
+}
+
+func (s *ZenaoServer) generateOrderInvoicePDF(ctx context.Context, order *zeni.Order) ([]byte, error) {
+	inv, err := s.buildOrderInvoice(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateInvoicePDF(inv, s.Logger)
+}
+
+func invoiceFilename(order *zeni.Order) string {
+	return fmt.Sprintf("invoice_%s.pdf", order.InvoiceID)
+}
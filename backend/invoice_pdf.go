@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/go-pdf/fpdf"
+	"go.uber.org/zap"
+)
+
+// Invoice holds everything printed on the invoice of a paid order.
+// Amounts are in minor units of CurrencyCode and prices are tax inclusive.
+type Invoice struct {
+	Number           string
+	IssuedAt         time.Time
+	OrderID          string
+	PaymentReference string
+
+	SellerName    string
+	SellerAddress string
+	SellerTaxID   string
+
+	BuyerName  string
+	BuyerEmail string
+
+	EventTitle string
+	EventDate  time.Time
+
+	CurrencyCode        string
+	Lines               []InvoiceLine
+	PromoCode           string
+	DiscountAmountMinor int64
+	TotalAmountMinor    int64
+	TaxRateBps          uint32
+}
+
+type InvoiceLine struct {
+	Description     string
+	Quantity        int
+	UnitAmountMinor int64
+	AmountMinor     int64
+}
+
+// TaxAmountMinor returns the tax included in the total, rounded half up.
+func (inv *Invoice) TaxAmountMinor() int64 {
+	if inv.TaxRateBps == 0 || inv.TotalAmountMinor <= 0 {
+		return 0
+	}
+	divisor := int64(10000 + inv.TaxRateBps)
+	return (inv.TotalAmountMinor*int64(inv.TaxRateBps)*2 + divisor) / (divisor * 2)
+}
+
+func GenerateInvoicePDF(inv *Invoice, logger *zap.Logger) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("cp1252")
+
+	pdf.SetTitle(fmt.Sprintf("Invoice - %s", inv.Number), true)
+	pdf.SetAuthor("Zenao", true)
+	pdf.SetCreationDate(inv.IssuedAt)
+	pdf.AddPage()
+
+	// A4 size (210x297mm)
+	pageWidth := 210.0
+	pageHeight := 297.0
+	widthMargin := 10.0
+	contentWidth := pageWidth - widthMargin*2
+	halfWidth := contentWidth / 2
+
+	pdf.SetFont("Helvetica", "B", 24)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetXY(widthMargin, 10.0)
+	pdf.Cell(halfWidth, 12, "INVOICE")
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(51, 51, 51)
+	infoY := 12.0
+	for _, line := range []string{
+		fmt.Sprintf("Invoice number: %s", inv.Number),
+		fmt.Sprintf("Issue date: %s", inv.IssuedAt.UTC().Format("January 2, 2006")),
+		fmt.Sprintf("Order: %s", inv.OrderID),
+		fmt.Sprintf("Payment reference: %s", inv.PaymentReference),
+	} {
+		pdf.SetXY(widthMargin+halfWidth, infoY)
+		pdf.CellFormat(halfWidth, 5, tr(line), "", 0, "R", false, 0, "")
+		infoY += 6
+	}
+
+	partiesY := 45.0
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetXY(widthMargin, partiesY)
+	pdf.Cell(halfWidth, 6, "From")
+	pdf.SetXY(widthMargin+halfWidth, partiesY)
+	pdf.Cell(halfWidth, 6, "Billed to")
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(51, 51, 51)
+	seller := []string{inv.SellerName}
+	if inv.SellerAddress != "" {
+		seller = append(seller, inv.SellerAddress)
+	}
+	if inv.SellerTaxID != "" {
+		seller = append(seller, fmt.Sprintf("Tax ID: %s", inv.SellerTaxID))
+	}
+	pdf.SetXY(widthMargin, partiesY+8)
+	pdf.MultiCell(halfWidth-5, 5, tr(strings.Join(seller, "\n")), "", "", false)
+	sellerBottom := pdf.GetY()
+
+	pdf.SetXY(widthMargin+halfWidth, partiesY+8)
+	pdf.MultiCell(halfWidth, 5, tr(strings.Join([]string{inv.BuyerName, inv.BuyerEmail}, "\n")), "", "", false)
+
+	eventY := max(sellerBottom, pdf.GetY()) + 8
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetXY(widthMargin, eventY)
+	pdf.MultiCell(contentWidth, 5, tr(fmt.Sprintf("Event: %s - %s", inv.EventTitle, inv.EventDate.Format("January 2, 2006 15:04"))), "", "", false)
+
+	colWidths := []float64{contentWidth - 90, 20, 35, 35}
+	tableY := pdf.GetY() + 6
+	pdf.SetXY(widthMargin, tableY)
+	pdf.SetFillColor(240, 240, 240)
+	pdf.SetDrawColor(180, 180, 180)
+	for i, header := range []string{"Description", "Qty", "Unit price", "Amount"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(colWidths[i], 8, header, "1", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(51, 51, 51)
+	subtotal := int64(0)
+	for _, line := range inv.Lines {
+		pdf.SetX(widthMargin)
+		pdf.CellFormat(colWidths[0], 8, tr(line.Description), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(colWidths[1], 8, fmt.Sprintf("%d", line.Quantity), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(colWidths[2], 8, formatInvoiceAmount(line.UnitAmountMinor, inv.CurrencyCode), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(colWidths[3], 8, formatInvoiceAmount(line.AmountMinor, inv.CurrencyCode), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+		subtotal += line.AmountMinor
+	}
+
+	totals := [][2]string{{"Subtotal", formatInvoiceAmount(subtotal, inv.CurrencyCode)}}
+	if inv.DiscountAmountMinor > 0 {
+		label := "Discount"
+		if inv.PromoCode != "" {
+			label = fmt.Sprintf("Discount (%s)", inv.PromoCode)
+		}
+		totals = append(totals, [2]string{label, formatInvoiceAmount(-inv.DiscountAmountMinor, inv.CurrencyCode)})
+	}
+	taxAmount := inv.TaxAmountMinor()
+	if inv.TaxRateBps > 0 {
+		totals = append(totals,
+			[2]string{"Net amount", formatInvoiceAmount(inv.TotalAmountMinor-taxAmount, inv.CurrencyCode)},
+			[2]string{fmt.Sprintf("Tax (%s%%)", formatTaxRate(inv.TaxRateBps)), formatInvoiceAmount(taxAmount, inv.CurrencyCode)},
+		)
+	}
+
+	pdf.Ln(4)
+	labelWidth := colWidths[1] + colWidths[2]
+	totalsX := widthMargin + colWidths[0]
+	for _, row := range totals {
+		pdf.SetX(totalsX)
+		pdf.CellFormat(labelWidth, 6, tr(row[0]), "", 0, "L", false, 0, "")
+		pdf.CellFormat(colWidths[3], 6, row[1], "", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+	}
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetX(totalsX)
+	pdf.CellFormat(labelWidth, 8, fmt.Sprintf("Total (%s)", strings.ToUpper(inv.CurrencyCode)), "T", 0, "L", false, 0, "")
+	pdf.CellFormat(colWidths[3], 8, formatInvoiceAmount(inv.TotalAmountMinor, inv.CurrencyCode), "T", 0, "R", false, 0, "")
+	pdf.Ln(-1)
+
+	pdf.Ln(6)
+	pdf.SetFont("Helvetica", "I", 9)
+	pdf.SetTextColor(120, 120, 120)
+	pdf.SetX(widthMargin)
+	note := "Paid in full. Prices include all applicable taxes."
+	if inv.TaxRateBps == 0 {
+		note = "Paid in full. No tax was charged on this order."
+	}
+	pdf.MultiCell(contentWidth, 5, note, "", "", false)
+
+	drawPDFFooter(pdf, pageWidth, pageHeight, logger)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func formatInvoiceAmount(amountMinor int64, currency string) string {
+	return fmt.Sprintf("%s %s", formatMinorAmount(amountMinor, currency), strings.ToUpper(currency))
+}
+
+// formatMinorAmount formats an amount in minor units as a decimal, e.g. 1250 EUR is "12.50".
+func formatMinorAmount(amountMinor int64, currency string) string {
+	sign := ""
+	if amountMinor < 0 {
+		sign = "-"
+		amountMinor = -amountMinor
+	}
+	if strings.EqualFold(currency, "JPY") {
+		return fmt.Sprintf("%s%d", sign, amountMinor)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amountMinor/100, amountMinor%100)
+}
+
+func formatTaxRate(bps uint32) string {
+	if bps%100 == 0 {
+		return fmt.Sprintf("%d", bps/100)
+	}
+	return strings.TrimRight(fmt.Sprintf("%d.%02d", bps/100, bps%100), "0")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) JoinWaitlist(
+	ctx context.Context,
+	req *connect.Request[zenaov1.JoinWaitlistRequest],
+) (*connect.Response[zenaov1.JoinWaitlistResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("join-waitlist", zap.String("event-id", req.Msg.EventId), zap.String("price-id", req.Msg.PriceId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	nowUnix := time.Now().Unix()
+	var entry *zeni.WaitlistEntry
+	if err := s.DB.TxWithSpan(ctx, "db.JoinWaitlist", func(db zeni.DB) error {
+		evt, err := db.GetEvent(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if evt == nil {
+			return errors.New("event not found")
+		}
+		if nowUnix >= evt.StartDate.Unix() {
+			return errors.New("event already started")
+		}
+
+		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, evt.ID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(roles, zeni.RoleParticipant) {
+			return errors.New("user is already participant for this event")
+		}
+
+		priceGroups, err := db.GetPriceGroupsByEvent(evt.ID)
+		if err != nil {
+			return err
+		}
+
+		group := (*zeni.PriceGroup)(nil)
+		priceID := strings.TrimSpace(req.Msg.PriceId)
+		if priceID != "" {
+			price, ok := mapPricesFromGroups(priceGroups)[priceID]
+			if !ok {
+				return fmt.Errorf("price %s not found", priceID)
+			}
+			group = mapPriceGroups(priceGroups)[price.PriceGroupID]
+		} else {
+			for _, price := range mapPricesFromGroups(priceGroups) {
+				if price.AmountMinor > 0 {
+					return errors.New("price id is required to join the waitlist of a paid event")
+				}
+			}
+		}
+
+		available, err := waitlistAvailableSpots(db, evt, group, nowUnix)
+		if err != nil {
+			return err
+		}
+		if available > 0 {
+			return errors.New("spots are still available, register directly")
+		}
+
+		priceGroupID := ""
+		if group != nil {
+			priceGroupID = group.ID
+		}
+		entry, err = db.CreateWaitlistEntry(evt.ID, priceGroupID, actor.ID())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.JoinWaitlistResponse{
+		Entry: waitlistEntryToProto(entry),
+	}), nil
+}
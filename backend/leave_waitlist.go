@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) LeaveWaitlist(
+	ctx context.Context,
+	req *connect.Request[zenaov1.LeaveWaitlistRequest],
+) (*connect.Response[zenaov1.LeaveWaitlistResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("leave-waitlist", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	wasOffered := false
+	if err := s.DB.TxWithSpan(ctx, "db.LeaveWaitlist", func(db zeni.DB) error {
+		entry, err := db.GetUserWaitlistEntry(req.Msg.EventId, actor.ID())
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return errors.New("user is not on the waitlist of this event")
+		}
+		wasOffered = entry.Status == zeni.WaitlistEntryStatusOffered
+		return db.DeleteWaitlistEntry(entry.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	if wasOffered {
+		// the reserved spot goes to the next person in line
+		if err := s.offerWaitlistSpots(ctx, req.Msg.EventId, time.Now()); err != nil {
+			s.Logger.Error("offer-waitlist-spots", zap.Error(err), zap.String("event-id", req.Msg.EventId))
+		}
+	}
+
+	return connect.NewResponse(&zenaov1.LeaveWaitlistResponse{}), nil
+}
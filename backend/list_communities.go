@@ -12,9 +12,14 @@ import (
 func (s *ZenaoServer) ListCommunities(ctx context.Context, req *connect.Request[zenaov1.ListCommunitiesRequest]) (*connect.Response[zenaov1.ListCommunitiesResponse], error) {
 	var cmts []*zeni.Community
 	var infos []*zenaov1.CommunityInfo
+	tagFilter, err := listTagFilter(req.Msg.Category, req.Msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.DB.TxWithSpan(ctx, "ListCommunities", func(tx zeni.DB) error {
 		var err error
-		cmts, err = tx.ListCommunities("", "", "", int(req.Msg.Limit), int(req.Msg.Offset))
+		cmts, err = tx.ListCommunities("", "", "", int(req.Msg.Limit), int(req.Msg.Offset), tagFilter)
 		if err != nil {
 			return err
 		}
@@ -28,6 +33,10 @@ func (s *ZenaoServer) ListCommunities(ctx context.Context, req *connect.Request[
 			if err != nil {
 				return err
 			}
+			tags, err := tx.GetEntityTags(zeni.EntityTypeCommunity, cmt.ID)
+			if err != nil {
+				return err
+			}
 
 			admIDs := mapsl.Map(adm, func(u *zeni.User) string {
 				return u.ID
@@ -41,6 +50,8 @@ func (s *ZenaoServer) ListCommunities(ctx context.Context, req *connect.Request[
 				BannerUri:      cmt.BannerURI,
 				Administrators: admIDs,
 				CountMembers:   count,
+				Category:       cmt.Category,
+				Tags:           tags,
 			}
 			infos = append(infos, &info)
 		}
@@ -19,7 +19,7 @@ func (s *ZenaoServer) ListCommunitiesByEvent(ctx context.Context, req *connect.R
 	var infos []*zenaov1.CommunityInfo
 	if err := s.DB.TxWithSpan(ctx, "ListCommunitiesByEvent", func(tx zeni.DB) error {
 		var err error
-		cmts, err = tx.ListCommunities(zeni.EntityTypeEvent, req.Msg.EventId, zeni.RoleEvent, int(req.Msg.Limit), int(req.Msg.Offset))
+		cmts, err = tx.ListCommunities(zeni.EntityTypeEvent, req.Msg.EventId, zeni.RoleEvent, int(req.Msg.Limit), int(req.Msg.Offset), nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ListEventApplications(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ListEventApplicationsRequest],
+) (*connect.Response[zenaov1.ListEventApplicationsResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("list-event-applications", zap.String("event-id", req.Msg.EventId), zap.String("status", req.Msg.Status), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	status := zeni.EventApplicationStatus(req.Msg.Status)
+	switch status {
+	case "", zeni.EventApplicationStatusPending, zeni.EventApplicationStatusApproved, zeni.EventApplicationStatusRejected:
+	default:
+		return nil, fmt.Errorf("invalid application status %q", req.Msg.Status)
+	}
+
+	db := s.DB.WithContext(ctx)
+	roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("only organizers can view the applications")
+	}
+
+	applications, err := db.ListEventApplications(req.Msg.EventId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*zenaov1.EventApplication, len(applications))
+	for i, application := range applications {
+		result[i] = eventApplicationToProto(application)
+	}
+
+	return connect.NewResponse(&zenaov1.ListEventApplicationsResponse{
+		Applications: result,
+	}), nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ListEventInvitations(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ListEventInvitationsRequest],
+) (*connect.Response[zenaov1.ListEventInvitationsResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("list-event-invitations", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	var invitations []*zeni.EventInvitation
+	if err := s.DB.TxWithSpan(ctx, "db.ListEventInvitations", func(tx zeni.DB) error {
+		if _, err := organizedEvent(tx, actor.ID(), req.Msg.EventId); err != nil {
+			return err
+		}
+		invitations, err = tx.ListEventInvitations(req.Msg.EventId)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.ListEventInvitationsResponse{
+		Invitations: mapsl.Map(invitations, eventInvitationToProto),
+	}), nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ListEventSessions(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ListEventSessionsRequest],
+) (*connect.Response[zenaov1.ListEventSessionsResponse], error) {
+	actor, err := s.GetOptionalActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("list-event-sessions", zap.String("event-id", req.Msg.EventId), zap.String("track", req.Msg.Track))
+
+	db := s.DB.WithContext(ctx)
+	evt, err := db.GetEvent(req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if evt == nil {
+		return nil, errors.New("event not found")
+	}
+
+	sessions, err := db.ListEventSessions(evt.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredIDs := []string(nil)
+	if actor != nil {
+		registeredIDs, err = db.ListUserSessionIDs(evt.ID, actor.ID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	track := strings.TrimSpace(req.Msg.Track)
+	res := &zenaov1.ListEventSessionsResponse{
+		Sessions: make([]*zenaov1.EventSession, 0, len(sessions)),
+		Tracks:   sessionTracks(sessions),
+	}
+	for _, session := range sessions {
+		if track != "" && session.Track != track {
+			continue
+		}
+		res.Sessions = append(res.Sessions, eventSessionToProto(session, slices.Contains(registeredIDs, session.ID)))
+	}
+
+	return connect.NewResponse(res), nil
+}
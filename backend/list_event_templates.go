@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ListEventTemplates(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ListEventTemplatesRequest],
+) (*connect.Response[zenaov1.ListEventTemplatesResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("list-event-templates", zap.String("community-id", req.Msg.CommunityId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if req.Msg.CommunityId == "" {
+		return nil, errors.New("community id is required")
+	}
+
+	var templates []*zeni.EventTemplate
+	if err := s.DB.TxWithSpan(ctx, "db.ListEventTemplates", func(tx zeni.DB) error {
+		if err := administeredCommunity(tx, actor.ID(), req.Msg.CommunityId); err != nil {
+			return err
+		}
+		templates, err = tx.ListEventTemplates(req.Msg.CommunityId)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.ListEventTemplatesResponse{
+		Templates: mapsl.Map(templates, eventTemplateToProto),
+	}), nil
+}
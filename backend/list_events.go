@@ -23,9 +23,14 @@ func (s *ZenaoServer) ListEvents(ctx context.Context, req *connect.Request[zenao
 		}
 	}
 
+	tagFilter, err := listTagFilter(req.Msg.Category, req.Msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.DB.TxWithSpan(ctx, "ListEvents", func(tx zeni.DB) error {
 		var err error
-		evts, err = tx.ListEvents(int(req.Msg.Limit), int(req.Msg.Offset), req.Msg.From, req.Msg.To, req.Msg.DiscoverableFilter, locFilter)
+		evts, err = tx.ListEvents(int(req.Msg.Limit), int(req.Msg.Offset), req.Msg.From, req.Msg.To, req.Msg.DiscoverableFilter, locFilter, tagFilter)
 		if err != nil {
 			return err
 		}
@@ -47,6 +52,10 @@ func (s *ZenaoServer) ListEvents(ctx context.Context, req *connect.Request[zenao
 			if err != nil {
 				return err
 			}
+			tags, err := tx.GetEntityTags(zeni.EntityTypeEvent, evt.ID)
+			if err != nil {
+				return err
+			}
 
 			orgIDs := mapsl.Map(organizers, func(u *zeni.User) string {
 				return u.ID
@@ -70,6 +79,8 @@ func (s *ZenaoServer) ListEvents(ctx context.Context, req *connect.Request[zenao
 				Participants: participants,
 				CheckedIn:    checkedIn,
 				Discoverable: evt.Discoverable,
+				Category:     evt.Category,
+				Tags:         tags,
 			}
 			infos = append(infos, &info)
 		}
@@ -33,6 +33,12 @@ func (s *ZenaoServer) ListEventsByUserRoles(ctx context.Context, req *connect.Re
 		}
 	}
 
+	// Drafts are only listed to the organizers themselves
+	withDrafts := false
+	if actor, err := s.GetOptionalActor(ctx, req.Header()); err == nil && actor != nil {
+		withDrafts = actor.ID() == req.Msg.UserId
+	}
+
 	var eventUsers []*zenaov1.EventUser
 	if err := s.DB.TxWithSpan(ctx, "ListEventsByUserRoles", func(tx zeni.DB) error {
 		eventsWithRoles, err := tx.ListEventsByUserRoles(
@@ -43,6 +49,7 @@ func (s *ZenaoServer) ListEventsByUserRoles(ctx context.Context, req *connect.Re
 			req.Msg.From,
 			req.Msg.To,
 			req.Msg.DiscoverableFilter,
+			withDrafts,
 		)
 		if err != nil {
 			return err
@@ -90,6 +97,7 @@ func (s *ZenaoServer) ListEventsByUserRoles(ctx context.Context, req *connect.Re
 					Participants: participants,
 					CheckedIn:    checkedIn,
 					Discoverable: ewr.Event.Discoverable,
+					Draft:        ewr.Event.Draft,
 				},
 				Roles: ewr.Roles,
 			})
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ListPromoCodes(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ListPromoCodesRequest],
+) (*connect.Response[zenaov1.ListPromoCodesResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("list-promo-codes", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	roles, err := s.DB.WithContext(ctx).EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("only organizers can list promo codes")
+	}
+
+	promoCodes, err := s.DB.WithContext(ctx).ListPromoCodesByEvent(req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*zenaov1.PromoCode, 0, len(promoCodes))
+	for _, promoCode := range promoCodes {
+		redemptions, err := s.DB.WithContext(ctx).CountPromoCodeRedemptions(promoCode.ID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, promoCodeToProto(promoCode, redemptions))
+	}
+
+	return connect.NewResponse(&zenaov1.ListPromoCodesResponse{
+		PromoCodes: result,
+	}), nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+func (s *ZenaoServer) ListTags(ctx context.Context, req *connect.Request[zenaov1.ListTagsRequest]) (*connect.Response[zenaov1.ListTagsResponse], error) {
+	if req.Msg.EntityType != zeni.EntityTypeEvent && req.Msg.EntityType != zeni.EntityTypeCommunity {
+		return nil, errors.New("entity type should be one of: event, community (invalid: " + req.Msg.EntityType + ")")
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultTagLimit
+	}
+	limit = min(limit, maxTagLimit)
+
+	var categories, tags []*zeni.TagCount
+	if err := s.DB.TxWithSpan(ctx, "ListTags", func(tx zeni.DB) error {
+		var err error
+		if categories, err = tx.CountCategories(req.Msg.EntityType); err != nil {
+			return err
+		}
+		tags, err = tx.CountTags(req.Msg.EntityType, limit)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	tagCountToProto := func(count *zeni.TagCount) *zenaov1.TagCount {
+		return &zenaov1.TagCount{Name: count.Name, Count: count.Count}
+	}
+	return connect.NewResponse(&zenaov1.ListTagsResponse{
+		Categories: mapsl.Map(categories, tagCountToProto),
+		Tags:       mapsl.Map(tags, tagCountToProto),
+	}), nil
+}
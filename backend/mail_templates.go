@@ -49,6 +49,46 @@ var eventCancelledTmplHTML *template.Template
 var eventCancelledTmplTextSrc string
 var eventCancelledTmplText *template.Template
 
+//go:embed mails/html/event-updated.tmpl.html
+var eventUpdatedTmplHTMLSrc string
+var eventUpdatedTmplHTML *template.Template
+
+//go:embed mails/text/event-updated.tmpl.txt
+var eventUpdatedTmplTextSrc string
+var eventUpdatedTmplText *template.Template
+
+//go:embed mails/html/event-reminder.tmpl.html
+var eventReminderTmplHTMLSrc string
+var eventReminderTmplHTML *template.Template
+
+//go:embed mails/text/event-reminder.tmpl.txt
+var eventReminderTmplTextSrc string
+var eventReminderTmplText *template.Template
+
+//go:embed mails/html/waitlist-offer.tmpl.html
+var waitlistOfferTmplHTMLSrc string
+var waitlistOfferTmplHTML *template.Template
+
+//go:embed mails/text/waitlist-offer.tmpl.txt
+var waitlistOfferTmplTextSrc string
+var waitlistOfferTmplText *template.Template
+
+//go:embed mails/html/event-application-reviewed.tmpl.html
+var eventApplicationReviewedTmplHTMLSrc string
+var eventApplicationReviewedTmplHTML *template.Template
+
+//go:embed mails/text/event-application-reviewed.tmpl.txt
+var eventApplicationReviewedTmplTextSrc string
+var eventApplicationReviewedTmplText *template.Template
+
+//go:embed mails/html/event-invitation.tmpl.html
+var eventInvitationTmplHTMLSrc string
+var eventInvitationTmplHTML *template.Template
+
+//go:embed mails/text/event-invitation.tmpl.txt
+var eventInvitationTmplTextSrc string
+var eventInvitationTmplText *template.Template
+
 func init() {
 	tmpl, err := template.New("ticketsConfirmationHTML").Parse(ticketsConfirmationTmplHTMLSrc)
 	if err != nil {
@@ -108,6 +148,66 @@ func init() {
 		panic(err)
 	}
 	eventCancelledTmplText = tmpl
+
+	tmpl, err = template.New("eventUpdatedHTML").Parse(eventUpdatedTmplHTMLSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventUpdatedTmplHTML = tmpl
+
+	tmpl, err = template.New("eventUpdatedText").Parse(eventUpdatedTmplTextSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventUpdatedTmplText = tmpl
+
+	tmpl, err = template.New("eventReminderHTML").Parse(eventReminderTmplHTMLSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventReminderTmplHTML = tmpl
+
+	tmpl, err = template.New("eventReminderText").Parse(eventReminderTmplTextSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventReminderTmplText = tmpl
+
+	tmpl, err = template.New("waitlistOfferHTML").Parse(waitlistOfferTmplHTMLSrc)
+	if err != nil {
+		panic(err)
+	}
+	waitlistOfferTmplHTML = tmpl
+
+	tmpl, err = template.New("waitlistOfferText").Parse(waitlistOfferTmplTextSrc)
+	if err != nil {
+		panic(err)
+	}
+	waitlistOfferTmplText = tmpl
+
+	tmpl, err = template.New("eventApplicationReviewedHTML").Parse(eventApplicationReviewedTmplHTMLSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventApplicationReviewedTmplHTML = tmpl
+
+	tmpl, err = template.New("eventApplicationReviewedText").Parse(eventApplicationReviewedTmplTextSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventApplicationReviewedTmplText = tmpl
+
+	tmpl, err = template.New("eventInvitationHTML").Parse(eventInvitationTmplHTMLSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventInvitationTmplHTML = tmpl
+
+	tmpl, err = template.New("eventInvitationText").Parse(eventInvitationTmplTextSrc)
+	if err != nil {
+		panic(err)
+	}
+	eventInvitationTmplText = tmpl
 }
 
 type ticketsConfirmation struct {
@@ -304,3 +404,204 @@ func eventCancelledMailContent(event *zeni.Event) (string, string, error) {
 
 	return htmlContent, textContent, nil
 }
+
+type eventUpdated struct {
+	ImageURL        string
+	EventName       string
+	Changes         []string
+	EventStartDate  string
+	EventEndDate    string
+	LocationText    string
+	CalendarIconURL string
+	PinIconURL      string
+}
+
+// eventUpdatedMailContent renders the notice of an event edit, changes summarize what attendees must know.
+func eventUpdatedMailContent(event *zeni.Event, changes []string) (string, string, error) {
+	locStr, err := zeni.LocationToString(event.Location)
+	if err != nil {
+		return "", "", err
+	}
+	tz, err := event.Timezone()
+	if err != nil {
+		return "", "", err
+	}
+
+	data := eventUpdated{
+		ImageURL:        web2URL(event.ImageURI) + "?img-width=960&img-height=540&img-fit=cover&dpr=2",
+		EventName:       event.Title,
+		Changes:         changes,
+		EventStartDate:  event.StartDate.In(tz).Format(time.ANSIC),
+		EventEndDate:    event.EndDate.In(tz).Format(time.ANSIC),
+		LocationText:    locStr,
+		CalendarIconURL: web2URL("ipfs://bafkreiaknq3mxzx5ulryv5tnikjkntmckvz3h4mhjyjle4zbtqkwhyb5xa"),
+		PinIconURL:      web2URL("ipfs://bafkreidfskfo2ld3i75s3d2uf6asiena3jletbz5cy7ostihwoyjclceqa"),
+	}
+
+	buf := &strings.Builder{}
+	if err := eventUpdatedTmplHTML.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	htmlContent := buf.String()
+
+	buf = &strings.Builder{}
+	if err := eventUpdatedTmplText.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	textContent := buf.String()
+
+	return htmlContent, textContent, nil
+}
+
+type eventReminder struct {
+	ImageURL        string
+	EventName       string
+	StartsIn        string
+	EventStartDate  string
+	EventEndDate    string
+	LocationText    string
+	CalendarIconURL string
+	PinIconURL      string
+}
+
+// eventReminderMailContent renders the reminder sent to participants startsIn before the event.
+func eventReminderMailContent(event *zeni.Event, startsIn string) (string, string, error) {
+	locStr, err := zeni.LocationToString(event.Location)
+	if err != nil {
+		return "", "", err
+	}
+	tz, err := event.Timezone()
+	if err != nil {
+		return "", "", err
+	}
+
+	data := eventReminder{
+		ImageURL:        web2URL(event.ImageURI) + "?img-width=960&img-height=540&img-fit=cover&dpr=2",
+		EventName:       event.Title,
+		StartsIn:        startsIn,
+		EventStartDate:  event.StartDate.In(tz).Format(time.ANSIC),
+		EventEndDate:    event.EndDate.In(tz).Format(time.ANSIC),
+		LocationText:    locStr,
+		CalendarIconURL: web2URL("ipfs://bafkreiaknq3mxzx5ulryv5tnikjkntmckvz3h4mhjyjle4zbtqkwhyb5xa"),
+		PinIconURL:      web2URL("ipfs://bafkreidfskfo2ld3i75s3d2uf6asiena3jletbz5cy7ostihwoyjclceqa"),
+	}
+
+	buf := &strings.Builder{}
+	if err := eventReminderTmplHTML.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	htmlContent := buf.String()
+
+	buf = &strings.Builder{}
+	if err := eventReminderTmplText.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	textContent := buf.String()
+
+	return htmlContent, textContent, nil
+}
+
+type waitlistOffer struct {
+	ImageURL  string
+	EventName string
+	ExpiresAt string
+	EventURL  string
+}
+
+func waitlistOfferMailContent(event *zeni.Event, expiresAt time.Time) (string, string, error) {
+	tz, err := event.Timezone()
+	if err != nil {
+		return "", "", err
+	}
+
+	data := waitlistOffer{
+		ImageURL:  web2URL(event.ImageURI) + "?img-width=960&img-height=540&img-fit=cover&dpr=2",
+		EventName: event.Title,
+		ExpiresAt: expiresAt.In(tz).Format(time.ANSIC),
+		EventURL:  eventPublicURL(event.ID),
+	}
+
+	buf := &strings.Builder{}
+	if err := waitlistOfferTmplHTML.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	htmlContent := buf.String()
+
+	buf = &strings.Builder{}
+	if err := waitlistOfferTmplText.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	textContent := buf.String()
+
+	return htmlContent, textContent, nil
+}
+
+type eventApplicationReviewed struct {
+	ImageURL   string
+	EventName  string
+	Title      string
+	Message    string
+	ButtonText string
+	EventURL   string
+}
+
+// eventApplicationReviewedMailContent tells an applicant the decision of the organizers,
+// the button to the event page is only shown when buttonText is set.
+func eventApplicationReviewedMailContent(event *zeni.Event, title string, message string, buttonText string) (string, string, error) {
+	data := eventApplicationReviewed{
+		ImageURL:   web2URL(event.ImageURI) + "?img-width=960&img-height=540&img-fit=cover&dpr=2",
+		EventName:  event.Title,
+		Title:      title,
+		Message:    message,
+		ButtonText: buttonText,
+		EventURL:   eventPublicURL(event.ID),
+	}
+
+	buf := &strings.Builder{}
+	if err := eventApplicationReviewedTmplHTML.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	htmlContent := buf.String()
+
+	buf = &strings.Builder{}
+	if err := eventApplicationReviewedTmplText.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	textContent := buf.String()
+
+	return htmlContent, textContent, nil
+}
+
+type eventInvitation struct {
+	ImageURL      string
+	EventName     string
+	Title         string
+	Message       string
+	InvitationURL string
+}
+
+// eventInvitationMailContent invites the recipient to an invite-only event,
+// invitationURL carries the personal token of the recipient.
+func eventInvitationMailContent(event *zeni.Event, title string, message string, invitationURL string) (string, string, error) {
+	data := eventInvitation{
+		ImageURL:      web2URL(event.ImageURI) + "?img-width=960&img-height=540&img-fit=cover&dpr=2",
+		EventName:     event.Title,
+		Title:         title,
+		Message:       message,
+		InvitationURL: invitationURL,
+	}
+
+	buf := &strings.Builder{}
+	if err := eventInvitationTmplHTML.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	htmlContent := buf.String()
+
+	buf = &strings.Builder{}
+	if err := eventInvitationTmplText.Execute(buf, data); err != nil {
+		return "", "", err
+	}
+	textContent := buf.String()
+
+	return htmlContent, textContent, nil
+}
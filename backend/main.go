@@ -17,6 +17,7 @@ import (
 	"github.com/resend/resend-go/v2"
 	"github.com/rs/cors"
 	"github.com/samouraiworld/zenao/backend/payment"
+	"github.com/samouraiworld/zenao/backend/payment/fakepay"
 	"github.com/samouraiworld/zenao/backend/payment/zpstripe"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
@@ -48,27 +49,36 @@ func main() {
 		newGenPdfTicketCmd(),
 		newConvertEvtToComCmd(),
 		newPinIPFSCIDsCmd(),
+		newReconcileCmd(),
 	)
 
 	cmd.Execute(context.Background(), os.Args[1:])
 }
 
 type config struct {
-	appBaseURL        string
-	allowedOrigins    string
-	clerkSecretKey    string
-	bindAddr          string
-	dbPath            string
-	mailSender        string
-	resendSecretKey   string
-	discordtoken      string
-	maintenance       bool
-	stripeSecretKey   string
-	paidEventsEnabled bool
+	appBaseURL          string
+	publicURL           string
+	allowedOrigins      string
+	clerkSecretKey      string
+	bindAddr            string
+	dbPath              string
+	mailSender          string
+	resendSecretKey     string
+	discordtoken        string
+	maintenance         bool
+	stripeSecretKey     string
+	stripeWebhookSecret string
+	paymentProvider     string
+	invitationSecret    string
+	paidEventsEnabled   bool
+	reconcileInterval   time.Duration
+	reminderInterval    time.Duration
+	publishInterval     time.Duration
 }
 
 func (conf *config) RegisterFlags(flset *flag.FlagSet) {
 	flset.StringVar(&conf.appBaseURL, "app-base-url", "https://zenao.io/", "App base URL")
+	flset.StringVar(&conf.publicURL, "public-url", "http://localhost:4242", "Public URL of this server, used in the links it serves itself like the fake checkout page")
 	flset.StringVar(&conf.allowedOrigins, "allowed-origins", "*", "CORS allowed origin")
 	flset.StringVar(&conf.clerkSecretKey, "clerk-secret-key", "", "Clerk secret key")
 	flset.StringVar(&conf.bindAddr, "bind-addr", "localhost:4242", "Address to bind to")
@@ -78,7 +88,13 @@ func (conf *config) RegisterFlags(flset *flag.FlagSet) {
 	flset.StringVar(&conf.discordtoken, "discord-token", "", "Discord Token")
 	flset.BoolVar(&conf.maintenance, "maintenance", false, "Maintenance mode, disable all API calls except healthcheck")
 	flset.StringVar(&conf.stripeSecretKey, "stripe-secret-key", "", "Stripe secret key")
+	flset.StringVar(&conf.stripeWebhookSecret, "stripe-webhook-secret", "", "Stripe webhook signing secret, enables the Stripe webhook endpoint")
+	flset.StringVar(&conf.paymentProvider, "payment-provider", "stripe", "Payment provider for paid tickets, one of: stripe, fake (development only)")
+	flset.StringVar(&conf.invitationSecret, "invitation-secret", "", "Secret signing the invitation tokens of invite-only events, required to invite")
 	flset.BoolVar(&conf.paidEventsEnabled, "paid-events", false, "Enable paid events feature")
+	flset.DurationVar(&conf.reconcileInterval, "reconcile-interval", 5*time.Minute, "Interval between background reconciliations of holds and orders, 0 to disable")
+	flset.DurationVar(&conf.reminderInterval, "reminder-interval", time.Minute, "Interval between checks for due event reminders, 0 to disable")
+	flset.DurationVar(&conf.publishInterval, "publish-interval", time.Minute, "Interval between checks for drafts whose scheduled publication is due, 0 to disable")
 }
 
 var conf config
@@ -99,14 +115,18 @@ func newStartCmd() *commands.Command {
 
 func injectStartEnv() {
 	mappings := map[string]*string{
-		"ZENAO_APP_BASE_URL":      &conf.appBaseURL,
-		"ZENAO_RESEND_SECRET_KEY": &conf.resendSecretKey,
-		"ZENAO_CLERK_SECRET_KEY":  &conf.clerkSecretKey,
-		"ZENAO_DB":                &conf.dbPath,
-		"ZENAO_ALLOWED_ORIGINS":   &conf.allowedOrigins,
-		"ZENAO_MAIL_SENDER":       &conf.mailSender,
-		"DISCORD_TOKEN":           &conf.discordtoken,
-		"ZENAO_STRIPE_SECRET_KEY": &conf.stripeSecretKey,
+		"ZENAO_APP_BASE_URL":          &conf.appBaseURL,
+		"ZENAO_PUBLIC_URL":            &conf.publicURL,
+		"ZENAO_RESEND_SECRET_KEY":     &conf.resendSecretKey,
+		"ZENAO_CLERK_SECRET_KEY":      &conf.clerkSecretKey,
+		"ZENAO_DB":                    &conf.dbPath,
+		"ZENAO_ALLOWED_ORIGINS":       &conf.allowedOrigins,
+		"ZENAO_MAIL_SENDER":           &conf.mailSender,
+		"DISCORD_TOKEN":               &conf.discordtoken,
+		"ZENAO_STRIPE_SECRET_KEY":     &conf.stripeSecretKey,
+		"ZENAO_STRIPE_WEBHOOK_SECRET": &conf.stripeWebhookSecret,
+		"ZENAO_PAYMENT_PROVIDER":      &conf.paymentProvider,
+		"ZENAO_INVITATION_SECRET":     &conf.invitationSecret,
 	}
 
 	for key, ps := range mappings {
@@ -120,6 +140,23 @@ func injectStartEnv() {
 	if val := os.Getenv("ZENAO_PAID_EVENTS_ENABLED"); val == "true" || val == "1" {
 		conf.paidEventsEnabled = true
 	}
+
+	// Duration env vars
+	if val := os.Getenv("ZENAO_RECONCILE_INTERVAL"); val != "" {
+		if interval, err := time.ParseDuration(val); err == nil {
+			conf.reconcileInterval = interval
+		}
+	}
+	if val := os.Getenv("ZENAO_REMINDER_INTERVAL"); val != "" {
+		if interval, err := time.ParseDuration(val); err == nil {
+			conf.reminderInterval = interval
+		}
+	}
+	if val := os.Getenv("ZENAO_PUBLISH_INTERVAL"); val != "" {
+		if interval, err := time.ParseDuration(val); err == nil {
+			conf.publishInterval = interval
+		}
+	}
 }
 
 func execStart(ctx context.Context) (retErr error) {
@@ -175,13 +212,14 @@ func execStart(ctx context.Context) (retErr error) {
 		DiscordToken:      conf.discordtoken,
 		Maintenance:       conf.maintenance,
 		StripeSecretKey:   conf.stripeSecretKey,
+		InvitationSecret:  conf.invitationSecret,
 		PaidEventsEnabled: conf.paidEventsEnabled,
 		PaymentProviders:  map[string]payment.Payment{},
 	}
 
-	if conf.stripeSecretKey != "" {
-		stripePaymentProvider := zpstripe.NewStripe(conf.stripeSecretKey)
-		zenao.PaymentProviders[stripePaymentProvider.PlatformType()] = stripePaymentProvider
+	fakePaymentProvider, err := zenao.setupPaymentProvider(conf.paymentProvider, conf.stripeSecretKey, conf.publicURL)
+	if err != nil {
+		return err
 	}
 
 	allowedOrigins := strings.Split(conf.allowedOrigins, ",")
@@ -194,6 +232,7 @@ func execStart(ctx context.Context) (retErr error) {
 			NewRateLimitInterceptor(rateLimiter),
 			NewLoggingInterceptor(logger),
 			NewMaintenanceInterceptor(conf.maintenance),
+			NewIdempotencyInterceptor(logger, zenao.DB, zenao.Auth),
 		),
 	)
 	mux.Handle(path, middlewares(handler,
@@ -202,6 +241,45 @@ func execStart(ctx context.Context) (retErr error) {
 		auth.WithAuth(),
 	))
 
+	if fakePaymentProvider != nil {
+		mux.Handle(fakepay.PathPrefix, fakePaymentProvider.Handler())
+	}
+
+	if conf.paymentProvider == "stripe" && conf.stripeSecretKey != "" && conf.stripeWebhookSecret != "" {
+		logger.Info("stripe webhook endpoint enabled", zap.String("path", stripeWebhookPath))
+		mux.Handle(stripeWebhookPath, middlewares(zenao.StripeWebhookHandler(conf.stripeWebhookSecret),
+			withTracing(),
+		))
+	}
+
+	mux.Handle(calendarFeedPathPrefix, middlewares(zenao.CalendarFeedHandler(),
+		withTracing(),
+	))
+
+	if conf.reconcileInterval > 0 {
+		go func() {
+			if err := zenao.RunReconciler(ctx, conf.reconcileInterval, defaultReconcileStaleAfter); err != nil {
+				logger.Error("reconciler", zap.Error(err))
+			}
+		}()
+	}
+
+	if conf.reminderInterval > 0 {
+		go func() {
+			if err := zenao.RunReminderScheduler(ctx, conf.reminderInterval); err != nil {
+				logger.Error("reminder-scheduler", zap.Error(err))
+			}
+		}()
+	}
+
+	if conf.publishInterval > 0 {
+		go func() {
+			if err := zenao.RunPublicationScheduler(ctx, conf.publishInterval); err != nil {
+				logger.Error("publication-scheduler", zap.Error(err))
+			}
+		}()
+	}
+
 	logger.Info("Starting server", zap.String("addr", conf.bindAddr))
 
 	return http.ListenAndServe(
@@ -226,7 +304,7 @@ func withConnectCORS(allowedOrigins ...string) func(http.Handler) http.Handler {
 		middleware := cors.New(cors.Options{
 			AllowedOrigins: allowedOrigins,
 			AllowedMethods: connectcors.AllowedMethods(),
-			AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization", "X-Team-Id"),
+			AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization", "X-Team-Id", IdempotencyKeyHeader),
 			ExposedHeaders: connectcors.ExposedHeaders(),
 		})
 		return middleware.Handler(next)
@@ -266,3 +344,23 @@ func NewLoggingInterceptor(logger *zap.Logger) connect.UnaryInterceptorFunc {
 	}
 	return connect.UnaryInterceptorFunc(interceptor)
 }
+
+// setupPaymentProvider registers the payment provider named by the payment-provider flag,
+// the fake provider is returned so its checkout pages can be served.
+func (s *ZenaoServer) setupPaymentProvider(name string, stripeSecretKey string, publicURL string) (*fakepay.FakePay, error) {
+	switch name {
+	case "stripe":
+		if stripeSecretKey != "" {
+			stripePaymentProvider := zpstripe.NewStripe(stripeSecretKey)
+			s.PaymentProviders[stripePaymentProvider.PlatformType()] = stripePaymentProvider
+		}
+		return nil, nil
+	case "fake":
+		s.Logger.Warn("using fake payment provider, no real payment will be processed")
+		fakePaymentProvider := fakepay.New(publicURL)
+		s.PaymentProviders[fakePaymentProvider.PlatformType()] = fakePaymentProvider
+		return fakePaymentProvider, nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -100,6 +101,12 @@ func (s *ZenaoServer) Participate(ctx context.Context, req *connect.Request[zena
 		participants = append(participants, guest)
 	}
 
+	guestEmails := mapsl.Map(authGuests, func(authGuest *zeni.AuthUser) string { return strings.ToLower(authGuest.Email) })
+	guestsAnswers := make(map[string][]*zenaov1.RegistrationAnswer, len(req.Msg.GuestsAnswers))
+	for _, guestAnswers := range req.Msg.GuestsAnswers {
+		guestsAnswers[strings.ToLower(strings.TrimSpace(guestAnswers.Email))] = guestAnswers.Answers
+	}
+
 	tickets, err := mapsl.MapRangeErr(len(participants), zeni.NewTicket)
 	if err != nil {
 		return nil, err
@@ -108,6 +115,7 @@ func (s *ZenaoServer) Participate(ctx context.Context, req *connect.Request[zena
 	evt := (*zeni.Event)(nil)
 	communities := ([]*zeni.Community)(nil)
 	needPasswordIfGuarded := true
+	applications := []*zeni.EventApplication(nil)
 	rolesByParticipant := make([][]string, len(participants))
 
 	if err := s.DB.TxWithSpan(ctx, "db.Participate", func(tx zeni.DB) error {
@@ -120,12 +128,95 @@ func (s *ZenaoServer) Participate(ctx context.Context, req *connect.Request[zena
 			needPasswordIfGuarded = false
 		}
 
+		evt, err = tx.GetEvent(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if evt == nil {
+			return errors.New("event not found")
+		}
+		if evt.Draft && !slices.Contains(buyerRoles, zeni.RoleOrganizer) {
+			return errors.New("event is not published")
+		}
+
+		// the invitation of the buyer is consumed, guests need their own invitation
+		invited := false
+		if evt.InviteOnly && needPasswordIfGuarded {
+			if len(participants) > 1 {
+				return errors.New("guests cannot be added to an invite-only event")
+			}
+			if err := s.acceptEventInvitation(tx, evt, req.Msg.InvitationToken, authUser.Email, buyer.ID); err != nil {
+				return err
+			}
+			invited = true
+		}
+
+		// organizers and invited users register directly, the others apply and get their ticket once approved
+		applying := evt.ApprovalRequired && needPasswordIfGuarded && !invited
+		if applying && evt.PasswordHash != "" {
+			validPass, err := tx.ValidatePassword(&zenaov1.ValidatePasswordRequest{EventId: evt.ID, Password: req.Msg.Password})
+			if err != nil {
+				return err
+			}
+			if !validPass {
+				return errors.New("invalid password")
+			}
+		}
+
 		communities, err = tx.CommunitiesByEvent(req.Msg.EventId)
 		if err != nil {
 			return err
 		}
 
+		questions, err := tx.GetRegistrationQuestions(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		buyerAnswers, err := validateRegistrationAnswers(questions, req.Msg.Answers)
+		if err != nil {
+			return err
+		}
+		guestAnswers, err := registrationAnswersByEmail(questions, guestEmails, guestsAnswers)
+		if err != nil {
+			return err
+		}
+
+		nowUnix := time.Now().Unix()
 		for i, ticket := range tickets {
+			if applying {
+				roles, err := tx.EntityRoles(zeni.EntityTypeUser, participants[i].ID, zeni.EntityTypeEvent, evt.ID)
+				if err != nil {
+					return err
+				}
+				if slices.Contains(roles, zeni.RoleParticipant) {
+					return errors.New("user is already participant for this event")
+				}
+				application, err := tx.CreateEventApplication(evt.ID, buyer.ID, participants[i].ID)
+				if err != nil {
+					return err
+				}
+				applications = append(applications, application)
+			}
+
+			if len(questions) > 0 {
+				answers := buyerAnswers
+				if i > 0 {
+					answers = guestAnswers[guestEmails[i-1]]
+				}
+				if err := tx.SaveRegistrationAnswers(req.Msg.EventId, participants[i].ID, "", answers); err != nil {
+					return err
+				}
+			}
+
+			if applying {
+				continue
+			}
+
+			// a spot offered from the waitlist is released for the participant who claims it
+			if _, err := tx.ClaimWaitlistOffer(req.Msg.EventId, participants[i].ID, nowUnix); err != nil {
+				return err
+			}
+
 			// XXX: support batch
 			if err := tx.Participate(req.Msg.EventId, buyer.ID, participants[i].ID, ticket.Secret(), req.Msg.Password, needPasswordIfGuarded); err != nil {
 				return err
@@ -146,20 +237,21 @@ func (s *ZenaoServer) Participate(ctx context.Context, req *connect.Request[zena
 			}
 		}
 
-		evt, err = tx.GetEvent(req.Msg.EventId)
-		if err != nil {
-			return err
-		}
-
-		if evt == nil {
-			return errors.New("nil event after participate")
-		}
-
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
+	if len(applications) > 0 {
+		res := &zenaov1.ParticipateResponse{
+			Applications: make([]*zenaov1.EventApplication, len(applications)),
+		}
+		for i, application := range applications {
+			res.Applications[i] = eventApplicationToProto(application)
+		}
+		return connect.NewResponse(res), nil
+	}
+
 	wg := sync.WaitGroup{}
 	defer wg.Wait()
 
@@ -201,7 +293,7 @@ func (s *ZenaoServer) Participate(ctx context.Context, req *connect.Request[zena
 						Filename:    fmt.Sprintf("ticket_%s_%s_%d.pdf", buyer.ID, evt.ID, i),
 						ContentType: "application/pdf",
 					})
-					icsData := GenerateICS(evt, s.MailSender, s.Logger)
+					icsData := s.eventICS(ctx, evt)
 					attachments = append(attachments, &resend.Attachment{
 						Content:     icsData,
 						Filename:    fmt.Sprintf("zenao_events_%s.ics", evt.ID),
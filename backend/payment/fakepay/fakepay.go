@@ -0,0 +1,283 @@
+// Package fakepay provides an in-memory payment provider with a minimal hosted checkout page.
+// It is meant for local development and e2e runs only, payments never leave the process.
+package fakepay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samouraiworld/zenao/backend/payment"
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+const (
+	// PathPrefix is where Handler must be mounted.
+	PathPrefix = "/fakepay/"
+
+	sessionIDPlaceholder = "{CHECKOUT_SESSION_ID}"
+)
+
+type sessionState string
+
+const (
+	sessionStateOpen      sessionState = "open"
+	sessionStatePaid      sessionState = "paid"
+	sessionStateFailed    sessionState = "failed"
+	sessionStateAbandoned sessionState = "abandoned"
+)
+
+type session struct {
+	id              string
+	input           payment.CheckoutSessionInput
+	amountMinor     int64
+	state           sessionState
+	paymentIntentID string
+	refundedMinor   int64
+}
+
+// FakePay stands in for the Stripe Connect platform so existing stripe_connect
+// payment accounts and prices can be used without any Stripe key.
+type FakePay struct {
+	baseURL  string
+	mu       sync.Mutex
+	sessions map[string]*session
+	intents  map[string]*session
+}
+
+// New returns a provider whose checkout pages are served under baseURL + PathPrefix.
+func New(baseURL string) *FakePay {
+	return &FakePay{
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		sessions: map[string]*session{},
+		intents:  map[string]*session{},
+	}
+}
+
+func (f *FakePay) DefaultHoldTTL() time.Duration {
+	return 30 * time.Minute
+}
+
+func (f *FakePay) PlatformType() string {
+	return zeni.PaymentPlatformStripeConnect
+}
+
+func (f *FakePay) CreateCheckoutSession(ctx context.Context, input payment.CheckoutSessionInput) (*payment.CheckoutSession, error) {
+	_ = ctx
+	if strings.TrimSpace(input.OrderID) == "" {
+		return nil, errors.New("order id is required")
+	}
+	if len(input.LineItems) == 0 {
+		return nil, errors.New("line items are required")
+	}
+
+	amount := int64(0)
+	for _, item := range input.LineItems {
+		amount += int64(item.Quantity) * item.AmountMinor
+	}
+
+	s := &session{
+		id:          "fakecs_" + uuid.NewString(),
+		input:       input,
+		amountMinor: amount,
+		state:       sessionStateOpen,
+	}
+
+	f.mu.Lock()
+	f.sessions[s.id] = s
+	f.mu.Unlock()
+
+	return &payment.CheckoutSession{
+		ID:  s.id,
+		URL: fmt.Sprintf("%s%scheckout/%s", f.baseURL, PathPrefix, s.id),
+	}, nil
+}
+
+func (f *FakePay) GetCheckoutSession(ctx context.Context, sessionID string, accountID string) (*payment.CheckoutSessionStatus, error) {
+	_ = ctx
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, errors.New("checkout session not found")
+	}
+	if accountID != "" && s.input.ProviderAccountID != accountID {
+		return nil, errors.New("checkout session not found")
+	}
+
+	status := payment.PaymentStatusUnpaid
+	switch s.state {
+	case sessionStatePaid:
+		status = payment.PaymentStatusPaid
+	case sessionStateFailed:
+		status = payment.PaymentStatusFailed
+	}
+
+	return &payment.CheckoutSessionStatus{
+		PaymentStatus:   status,
+		PaymentIntentID: s.paymentIntentID,
+	}, nil
+}
+
+func (f *FakePay) CheckPaymentStatus(orderID string) (zeni.OrderStatus, error) {
+	if strings.TrimSpace(orderID) == "" {
+		return "", errors.New("order id is required")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range f.sessions {
+		if s.input.OrderID != orderID {
+			continue
+		}
+		switch s.state {
+		case sessionStatePaid:
+			return zeni.OrderStatusSuccess, nil
+		case sessionStateFailed, sessionStateAbandoned:
+			return zeni.OrderStatusFailed, nil
+		}
+		return zeni.OrderStatusPending, nil
+	}
+	return "", errors.New("order not found")
+}
+
+func (f *FakePay) RefundPayment(ctx context.Context, input payment.RefundInput) (*payment.Refund, error) {
+	_ = ctx
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, ok := f.intents[input.PaymentIntentID]
+	if !ok {
+		return nil, errors.New("payment intent not found")
+	}
+	if input.AmountMinor <= 0 || s.refundedMinor+input.AmountMinor > s.amountMinor {
+		return nil, errors.New("invalid refund amount")
+	}
+	s.refundedMinor += input.AmountMinor
+
+	return &payment.Refund{
+		ID:          "fakere_" + uuid.NewString(),
+		AmountMinor: input.AmountMinor,
+	}, nil
+}
+
+// Handler serves the hosted checkout pages, it must be mounted on PathPrefix.
+func (f *FakePay) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PathPrefix+"checkout/{id}", f.serveCheckout)
+	mux.HandleFunc("POST "+PathPrefix+"checkout/{id}/{action}", f.serveCheckoutAction)
+	return mux
+}
+
+func (f *FakePay) serveCheckout(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	s, ok := f.sessions[r.PathValue("id")]
+	var data map[string]any
+	if ok {
+		data = map[string]any{
+			"ID":         s.id,
+			"EventTitle": s.input.EventTitle,
+			"Email":      s.input.CustomerEmail,
+			"Amount":     formatAmount(s.amountMinor, s.input.Currency),
+			"Open":       s.state == sessionStateOpen,
+			"State":      string(s.state),
+			"Prefix":     PathPrefix,
+		}
+	}
+	f.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := checkoutTemplate.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (f *FakePay) serveCheckoutAction(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	s, ok := f.sessions[r.PathValue("id")]
+	if !ok {
+		f.mu.Unlock()
+		http.NotFound(w, r)
+		return
+	}
+	if s.state != sessionStateOpen {
+		f.mu.Unlock()
+		http.Error(w, "checkout session is already "+string(s.state), http.StatusConflict)
+		return
+	}
+
+	redirect := s.input.CancelURL
+	switch r.PathValue("action") {
+	case "pay":
+		s.state = sessionStatePaid
+		s.paymentIntentID = "fakepi_" + uuid.NewString()
+		f.intents[s.paymentIntentID] = s
+		redirect = s.input.SuccessURL
+	case "fail":
+		s.state = sessionStateFailed
+	case "abandon":
+		s.state = sessionStateAbandoned
+	default:
+		f.mu.Unlock()
+		http.Error(w, "unknown action", http.StatusBadRequest)
+		return
+	}
+	id := s.id
+	f.mu.Unlock()
+
+	http.Redirect(w, r, strings.ReplaceAll(redirect, sessionIDPlaceholder, url.QueryEscape(id)), http.StatusSeeOther)
+}
+
+func formatAmount(amountMinor int64, currency string) string {
+	currency = strings.ToUpper(currency)
+	if currency == "JPY" {
+		return fmt.Sprintf("%d %s", amountMinor, currency)
+	}
+	return fmt.Sprintf("%d.%02d %s", amountMinor/100, amountMinor%100, currency)
+}
+
+var checkoutTemplate = template.Must(template.New("checkout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Fake checkout</title>
+<style>
+body { font-family: sans-serif; max-width: 480px; margin: 48px auto; }
+form { display: inline-block; margin-right: 8px; }
+button { padding: 8px 16px; cursor: pointer; }
+</style>
+</head>
+<body>
+<h1>Fake checkout</h1>
+<p>This payment provider is for development only, no money is involved.</p>
+<p><strong>{{.EventTitle}}</strong></p>
+<p>Customer: {{.Email}}</p>
+<p>Total: <span data-testid="fakepay-amount">{{.Amount}}</span></p>
+{{if .Open}}
+<form method="post" action="{{.Prefix}}checkout/{{.ID}}/pay"><button type="submit" data-testid="fakepay-pay">Pay</button></form>
+<form method="post" action="{{.Prefix}}checkout/{{.ID}}/fail"><button type="submit" data-testid="fakepay-fail">Fail</button></form>
+<form method="post" action="{{.Prefix}}checkout/{{.ID}}/abandon"><button type="submit" data-testid="fakepay-abandon">Abandon</button></form>
+{{else}}
+<p>Session is {{.State}}.</p>
+{{end}}
+</body>
+</html>
+`))
+
+var _ payment.Payment = (*FakePay)(nil)
+var _ payment.CheckoutSessionFetcher = (*FakePay)(nil)
+var _ payment.Refunder = (*FakePay)(nil)
@@ -0,0 +1,79 @@
+package fakepay
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/samouraiworld/zenao/backend/payment"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakePayCheckoutFlow(t *testing.T) {
+	provider := New("http://localhost:4242")
+
+	session, err := provider.CreateCheckoutSession(context.Background(), payment.CheckoutSessionInput{
+		EventTitle:        "Paid event",
+		OrderID:           "order-1",
+		CustomerEmail:     "buyer@example.com",
+		Currency:          "eur",
+		LineItems:         []payment.LineItem{{Quantity: 2, AmountMinor: 1250}},
+		SuccessURL:        "https://zenao.test/events/1?checkout=success&session_id={CHECKOUT_SESSION_ID}",
+		CancelURL:         "https://zenao.test/events/1?checkout=cancel",
+		ProviderAccountID: "acct_123",
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(session.URL, "http://localhost:4242"+PathPrefix+"checkout/"))
+
+	status, err := provider.GetCheckoutSession(context.Background(), session.ID, "acct_123")
+	require.NoError(t, err)
+	require.Equal(t, payment.PaymentStatusUnpaid, status.PaymentStatus)
+
+	handler := provider.Handler()
+	page := httptest.NewRecorder()
+	handler.ServeHTTP(page, httptest.NewRequest(http.MethodGet, PathPrefix+"checkout/"+session.ID, nil))
+	require.Equal(t, http.StatusOK, page.Code)
+	require.Contains(t, page.Body.String(), "25.00 EUR")
+
+	pay := httptest.NewRecorder()
+	handler.ServeHTTP(pay, httptest.NewRequest(http.MethodPost, PathPrefix+"checkout/"+session.ID+"/pay", nil))
+	require.Equal(t, http.StatusSeeOther, pay.Code)
+	require.Equal(t, "https://zenao.test/events/1?checkout=success&session_id="+session.ID, pay.Header().Get("Location"))
+
+	status, err = provider.GetCheckoutSession(context.Background(), session.ID, "acct_123")
+	require.NoError(t, err)
+	require.Equal(t, payment.PaymentStatusPaid, status.PaymentStatus)
+	require.NotEmpty(t, status.PaymentIntentID)
+
+	again := httptest.NewRecorder()
+	handler.ServeHTTP(again, httptest.NewRequest(http.MethodPost, PathPrefix+"checkout/"+session.ID+"/fail", nil))
+	require.Equal(t, http.StatusConflict, again.Code)
+
+	_, err = provider.RefundPayment(context.Background(), payment.RefundInput{PaymentIntentID: status.PaymentIntentID, AmountMinor: 1250})
+	require.NoError(t, err)
+	_, err = provider.RefundPayment(context.Background(), payment.RefundInput{PaymentIntentID: status.PaymentIntentID, AmountMinor: 1251})
+	require.Error(t, err)
+}
+
+func TestFakePayFailedCheckout(t *testing.T) {
+	provider := New("http://localhost:4242")
+
+	session, err := provider.CreateCheckoutSession(context.Background(), payment.CheckoutSessionInput{
+		OrderID:   "order-1",
+		Currency:  "jpy",
+		LineItems: []payment.LineItem{{Quantity: 1, AmountMinor: 500}},
+		CancelURL: "https://zenao.test/events/1?checkout=cancel",
+	})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	provider.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, PathPrefix+"checkout/"+session.ID+"/fail", nil))
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	require.Equal(t, "https://zenao.test/events/1?checkout=cancel", rec.Header().Get("Location"))
+
+	status, err := provider.GetCheckoutSession(context.Background(), session.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, payment.PaymentStatusFailed, status.PaymentStatus)
+}
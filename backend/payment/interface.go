@@ -18,6 +18,8 @@ const (
 )
 
 type LineItem struct {
+	// Name is shown to the buyer, providers fall back to a ticket name when empty
+	Name        string
 	Quantity    uint32
 	AmountMinor int64
 }
@@ -48,6 +50,25 @@ type CheckoutSessionFetcher interface {
 	GetCheckoutSession(ctx context.Context, sessionID string, accountID string) (*CheckoutSessionStatus, error)
 }
 
+type RefundInput struct {
+	OrderID           string
+	PaymentIntentID   string
+	AmountMinor       int64
+	Currency          string
+	ProviderAccountID string
+	IdempotencyKey    string
+}
+
+type Refund struct {
+	ID          string
+	AmountMinor int64
+}
+
+// Refunder is implemented by payment providers able to refund a confirmed payment.
+type Refunder interface {
+	RefundPayment(ctx context.Context, input RefundInput) (*Refund, error)
+}
+
 type Payment interface {
 	PlatformType() string
 	CreateCheckoutSession(ctx context.Context, input CheckoutSessionInput) (*CheckoutSession, error)
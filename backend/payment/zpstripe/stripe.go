@@ -11,6 +11,7 @@ import (
 	"github.com/samouraiworld/zenao/backend/zeni"
 	"github.com/stripe/stripe-go/v84"
 	checkoutsession "github.com/stripe/stripe-go/v84/checkout/session"
+	"github.com/stripe/stripe-go/v84/refund"
 )
 
 type Stripe struct{}
@@ -50,7 +51,7 @@ func (s *Stripe) CreateCheckoutSession(ctx context.Context, input payment.Checko
 	}, nil
 }
 
-func mapStripePaymentStatus(status stripe.CheckoutSessionPaymentStatus) (payment.PaymentStatus, error) {
+func MapPaymentStatus(status stripe.CheckoutSessionPaymentStatus) (payment.PaymentStatus, error) {
 	switch status {
 	case stripe.CheckoutSessionPaymentStatusPaid:
 		return payment.PaymentStatusPaid, nil
@@ -87,7 +88,7 @@ func (s *Stripe) GetCheckoutSession(ctx context.Context, sessionID string, accou
 		intentID = session.PaymentIntent.ID
 	}
 
-	paymentStatus, err := mapStripePaymentStatus(session.PaymentStatus)
+	paymentStatus, err := MapPaymentStatus(session.PaymentStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -105,17 +106,60 @@ func (s *Stripe) CheckPaymentStatus(orderID string) (zeni.OrderStatus, error) {
 	return zeni.OrderStatusPending, errors.New("check payment status not implemented")
 }
 
+func (s *Stripe) RefundPayment(ctx context.Context, input payment.RefundInput) (*payment.Refund, error) {
+	_ = ctx
+	if strings.TrimSpace(input.PaymentIntentID) == "" {
+		return nil, errors.New("payment intent id is required")
+	}
+	if input.AmountMinor <= 0 {
+		return nil, errors.New("refund amount must be positive")
+	}
+
+	params := &stripe.RefundParams{
+		PaymentIntent: stripe.String(input.PaymentIntentID),
+		Amount:        stripe.Int64(input.AmountMinor),
+		Reason:        stripe.String(string(stripe.RefundReasonRequestedByCustomer)),
+	}
+	params.AddMetadata("order_id", input.OrderID)
+	if strings.TrimSpace(input.IdempotencyKey) != "" {
+		params.SetIdempotencyKey(input.IdempotencyKey)
+	}
+	if strings.TrimSpace(input.ProviderAccountID) != "" {
+		params.SetStripeAccount(input.ProviderAccountID)
+	}
+
+	r, err := RefundNew(params)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, errors.New("stripe refund is nil")
+	}
+	if r.Status == stripe.RefundStatusFailed || r.Status == stripe.RefundStatusCanceled {
+		return nil, fmt.Errorf("stripe refund %s: %s", r.ID, r.Status)
+	}
+
+	return &payment.Refund{
+		ID:          r.ID,
+		AmountMinor: r.Amount,
+	}, nil
+}
+
 func (s *Stripe) buildCheckoutSessionParams(input payment.CheckoutSessionInput) *stripe.CheckoutSessionParams {
 	currency := strings.ToLower(strings.TrimSpace(input.Currency))
 	checkoutLineItems := make([]*stripe.CheckoutSessionLineItemParams, 0, len(input.LineItems))
 	for _, item := range input.LineItems {
+		name := item.Name
+		if name == "" {
+			name = fmt.Sprintf("Ticket: %s", input.EventTitle)
+		}
 		checkoutLineItems = append(checkoutLineItems, &stripe.CheckoutSessionLineItemParams{
 			Quantity: stripe.Int64(int64(item.Quantity)),
 			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
 				Currency:   stripe.String(currency),
 				UnitAmount: stripe.Int64(item.AmountMinor),
 				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
-					Name: stripe.String(fmt.Sprintf("Ticket: %s", input.EventTitle)),
+					Name: stripe.String(name),
 				},
 			},
 		})
@@ -134,4 +178,6 @@ func (s *Stripe) buildCheckoutSessionParams(input payment.CheckoutSessionInput)
 
 var CheckoutSessionNew = checkoutsession.New
 var CheckoutSessionGet = checkoutsession.Get
+var RefundNew = refund.New
 var _ payment.Payment = (*Stripe)(nil)
+var _ payment.Refunder = (*Stripe)(nil)
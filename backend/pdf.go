@@ -186,6 +186,19 @@ func GeneratePDFTicket(event *zeni.Event, ticketSecret string, DisplayName strin
 	pdf.SetXY(widthMargin, ticketInfoY+15)
 	pdf.Cell(pageWidth-20, 5, tr(fmt.Sprintf("Purchase date: %s", purchaseDate.Format("January 2, 2006 15:04"))))
 
+	drawPDFFooter(pdf, pageWidth, pageHeight, logger)
+
+	var buf bytes.Buffer
+	err = pdf.Output(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// drawPDFFooter draws the Zenao footer with the logo at the bottom of the current page.
+func drawPDFFooter(pdf *fpdf.Fpdf, pageWidth, pageHeight float64, logger *zap.Logger) {
 	pdf.SetAutoPageBreak(false, 0)
 
 	bottomY := pageHeight - 15
@@ -231,14 +244,6 @@ func GeneratePDFTicket(event *zeni.Event, ticketSecret string, DisplayName strin
 	}
 
 	pdf.SetAutoPageBreak(true, 0)
-
-	var buf bytes.Buffer
-	err = pdf.Output(&buf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate PDF: %w", err)
-	}
-
-	return buf.Bytes(), nil
 }
 
 func drawImagePlaceholder(pdf *fpdf.Fpdf, x, y, width, height float64) {
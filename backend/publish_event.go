@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/resend/resend-go/v2"
+	"github.com/samouraiworld/zenao/backend/webhook"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) PublishEvent(
+	ctx context.Context,
+	req *connect.Request[zenaov1.PublishEventRequest],
+) (*connect.Response[zenaov1.PublishEventResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("publish-event", zap.String("event-id", req.Msg.EventId), zap.Int64("publish-at", req.Msg.PublishAt), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if err := s.DB.TxWithSpan(ctx, "db.PublishEvent", func(tx zeni.DB) error {
+		evt, err := organizedEvent(tx, actor.ID(), req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if !evt.Draft {
+			return errors.New("event is already published")
+		}
+		if req.Msg.PublishAt == 0 {
+			return nil
+		}
+		if err := validatePublishAt(req.Msg.PublishAt, uint64(evt.EndDate.Unix()), time.Now()); err != nil {
+			return err
+		}
+		return tx.ScheduleEventPublication(evt.ID, time.Unix(req.Msg.PublishAt, 0))
+	}); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.PublishAt != 0 {
+		return connect.NewResponse(&zenaov1.PublishEventResponse{Published: false}), nil
+	}
+
+	published, err := s.publishEvent(ctx, req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.PublishEventResponse{Published: published}), nil
+}
+
+// validatePublishAt checks that the publication of a draft is scheduled in the future, before the event ends.
+func validatePublishAt(publishAt int64, endDate uint64, now time.Time) error {
+	if publishAt <= now.Unix() {
+		return errors.New("publication must be scheduled in the future")
+	}
+	if publishAt >= int64(endDate) {
+		return errors.New("publication must be scheduled before the end of the event")
+	}
+	return nil
+}
+
+// publishEvent makes the draft visible and announces it, it returns false if the event was already published.
+func (s *ZenaoServer) publishEvent(ctx context.Context, eventID string) (bool, error) {
+	var (
+		evt      *zeni.Event
+		cmt      *zeni.Community
+		announce bool
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.PublishEventDraft", func(tx zeni.DB) error {
+		published, err := tx.PublishEvent(eventID)
+		if err != nil || !published {
+			return err
+		}
+		if evt, err = tx.GetEvent(eventID); err != nil {
+			return err
+		}
+		if cmt, err = tx.GetEventCommunity(eventID); err != nil {
+			return err
+		}
+
+		// like at creation, only the first occurrence of a series is announced
+		announce = true
+		if evt.SeriesID != "" {
+			occurrences, err := tx.ListSeriesEvents(evt.SeriesID)
+			if err != nil {
+				return err
+			}
+			announce = len(occurrences) == 0 || occurrences[0].ID == evt.ID
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	if evt == nil {
+		return false, nil
+	}
+
+	if announce {
+		if err := s.announceEvent(ctx, evt, cmt, evt.CommunityEmail); err != nil {
+			s.Logger.Error("announce-event", zap.Error(err), zap.String("event-id", evt.ID))
+		}
+	}
+
+	return true, nil
+}
+
+// RunPublicationScheduler publishes the scheduled drafts every interval until ctx is done.
+func (s *ZenaoServer) RunPublicationScheduler(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("publish interval must be positive")
+	}
+
+	publishedCounter, err := otel.Meter("publications").Int64Counter("publications.events.published",
+		metric.WithDescription("Drafts published at their scheduled time"),
+	)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := s.publishScheduledEvents(ctx, time.Now())
+		if err != nil {
+			s.Logger.Error("publish-scheduled-events", zap.Error(err))
+		}
+		publishedCounter.Add(ctx, int64(published))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishScheduledEvents publishes the drafts whose publication is due at now, it returns how many were published.
+func (s *ZenaoServer) publishScheduledEvents(ctx context.Context, now time.Time) (int, error) {
+	drafts, err := s.DB.WithContext(ctx).ListEventsToPublish(now)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	var errs []error
+	for _, draft := range drafts {
+		published, err := s.publishEvent(ctx, draft.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("publish event %s: %w", draft.ID, err))
+			continue
+		}
+		if published {
+			count++
+		}
+	}
+
+	return count, errors.Join(errs...)
+}
+
+// announceEvent tells Discord about a published event and, if communityEmail is set, mails the members of its community.
+func (s *ZenaoServer) announceEvent(ctx context.Context, evt *zeni.Event, cmt *zeni.Community, communityEmail bool) error {
+	webhook.TrySendDiscordMessage(s.Logger, s.DiscordToken, evt)
+
+	if cmt == nil || !communityEmail || s.MailClient == nil {
+		return nil
+	}
+
+	targets, err := s.DB.WithContext(ctx).GetOrgUsersWithRoles(zeni.EntityTypeCommunity, cmt.ID, []string{zeni.RoleMember})
+	if err != nil {
+		return err
+	}
+
+	var authIDs []string
+	for _, target := range targets {
+		// Skip teams which don't have AuthID
+		if target.AuthID == "" {
+			continue
+		}
+		authIDs = append(authIDs, target.AuthID)
+	}
+	if len(authIDs) == 0 {
+		return nil
+	}
+	authTargets, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
+	if err != nil {
+		return err
+	}
+
+	htmlStr, text, err := communityNewEventMailContent(evt, cmt)
+	if err != nil {
+		return err
+	}
+
+	var requests []*resend.SendEmailRequest
+	for _, authTarget := range authTargets {
+		if authTarget.Email == "" {
+			s.Logger.Error("announce-event", zap.String("target-id", authTarget.ID), zap.String("target-email", authTarget.Email), zap.Error(errors.New("target has no email")))
+			continue
+		}
+		requests = append(requests, &resend.SendEmailRequest{
+			From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
+			To:      []string{authTarget.Email},
+			Subject: "New event by " + cmt.DisplayName + "!",
+			Html:    htmlStr,
+			Text:    text,
+		})
+	}
+	count := 0
+	s.Logger.Info("send-community-new-event-emails", zap.Int("count", len(requests)))
+	for i := 0; i < len(requests); i += 100 {
+		batch := requests[i:min(i+100, len(requests))]
+		if _, err := s.MailClient.Batch.SendWithContext(context.Background(), batch); err != nil {
+			s.Logger.Error("send-community-new-event-emails", zap.Error(err), zap.Int("batch-size", len(batch)))
+			continue
+		}
+		count += len(batch)
+		s.Logger.Info("send-community-new-event-emails", zap.Int("already-sent-count", count), zap.Int("total", len(requests)))
+	}
+
+	return nil
+}
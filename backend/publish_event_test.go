@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftEventScheduledPublication(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+	organizer, err := f.db.GetUser(f.auth.user.ID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Draft event",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		CommunityId: community.ID,
+		Draft:       true,
+		PublishAt:   now.Add(-time.Hour).Unix(),
+	}))
+	require.ErrorContains(t, err, "in the future")
+
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Draft event",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+		},
+		CommunityId:  community.ID,
+		Discoverable: true,
+		Draft:        true,
+	}))
+	require.NoError(t, err)
+	draftID := created.Msg.Id
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: draftID}))
+	require.NoError(t, err)
+	require.True(t, got.Msg.Event.Draft)
+
+	listed, err := f.server.ListEventsByUserRoles(context.Background(), connect.NewRequest(&zenaov1.ListEventsByUserRolesRequest{
+		UserId: organizer.ID,
+		Roles:  []string{"organizer"},
+		Limit:  10,
+	}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Events, 2)
+
+	publishAt := now.Add(24 * time.Hour)
+	resp, err := f.server.PublishEvent(context.Background(), connect.NewRequest(&zenaov1.PublishEventRequest{
+		EventId:   draftID,
+		PublishAt: publishAt.Unix(),
+	}))
+	require.NoError(t, err)
+	require.False(t, resp.Msg.Published)
+
+	f.auth.user = f.auth.ensureAuthUser("alice@example.com")
+	_, err = f.db.CreateUser(f.auth.user.ID)
+	require.NoError(t, err)
+
+	_, err = f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: draftID}))
+	require.ErrorContains(t, err, "event not found")
+	listed, err = f.server.ListEventsByUserRoles(context.Background(), connect.NewRequest(&zenaov1.ListEventsByUserRolesRequest{
+		UserId:             organizer.ID,
+		Roles:              []string{"organizer"},
+		Limit:              10,
+		DiscoverableFilter: zenaov1.DiscoverableFilter_DISCOVERABLE_FILTER_DISCOVERABLE,
+	}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Events, 1)
+	require.Equal(t, f.eventID, listed.Msg.Events[0].Event.Id)
+	_, err = f.server.PublishEvent(context.Background(), connect.NewRequest(&zenaov1.PublishEventRequest{EventId: draftID}))
+	require.Error(t, err)
+
+	count, err := f.server.publishScheduledEvents(context.Background(), publishAt.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Zero(t, count)
+	count, err = f.server.publishScheduledEvents(context.Background(), publishAt)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	got, err = f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: draftID}))
+	require.NoError(t, err)
+	require.False(t, got.Msg.Event.Draft)
+	require.Zero(t, got.Msg.Event.PublishAt)
+
+	count, err = f.server.publishScheduledEvents(context.Background(), publishAt)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gnolang/gno/tm2/pkg/commands"
+	"github.com/resend/resend-go/v2"
+	"github.com/samouraiworld/zenao/backend/czauth"
+	"github.com/samouraiworld/zenao/backend/gzdb"
+	"github.com/samouraiworld/zenao/backend/payment"
+	"go.uber.org/zap"
+)
+
+func newReconcileCmd() *commands.Command {
+	return commands.NewCommand(
+		commands.Metadata{
+			Name:       "reconcile",
+			ShortUsage: "reconcile [flags]",
+			ShortHelp:  "run a single reconciliation pass on holds, pending orders and ticket issuances",
+		},
+		&reconcileConf,
+		func(ctx context.Context, args []string) error {
+			return execReconcile(ctx)
+		},
+	)
+}
+
+var reconcileConf = reconcileConfig{}
+
+type reconcileConfig struct {
+	dbPath          string
+	clerkSecretKey  string
+	resendSecretKey string
+	mailSender      string
+	stripeSecretKey string
+	paymentProvider string
+	publicURL       string
+	appBaseURL      string
+	staleAfter      time.Duration
+}
+
+func (conf *reconcileConfig) RegisterFlags(flset *flag.FlagSet) {
+	flset.StringVar(&conf.dbPath, "db", "dev.db", "DB, can be a file or a libsql dsn")
+	flset.StringVar(&conf.clerkSecretKey, "clerk-secret-key", "", "Clerk secret key")
+	flset.StringVar(&conf.resendSecretKey, "resend-secret-key", "", "Resend secret key, purchase confirmation mails are skipped when empty")
+	flset.StringVar(&conf.mailSender, "mail-sender", "contact@mail.zenao.io", "Mail sender address")
+	flset.StringVar(&conf.stripeSecretKey, "stripe-secret-key", "", "Stripe secret key")
+	flset.StringVar(&conf.paymentProvider, "payment-provider", "stripe", "Payment provider of the orders, one of: stripe, fake (development only)")
+	flset.StringVar(&conf.publicURL, "public-url", "http://localhost:4242", "Public URL of the server")
+	flset.StringVar(&conf.appBaseURL, "app-base-url", "https://zenao.io/", "App base URL, used in the links of the waitlist offer mails")
+	flset.DurationVar(&conf.staleAfter, "stale-after", defaultReconcileStaleAfter, "Age after which a pending order is checked against its payment provider")
+}
+
+func execReconcile(ctx context.Context) error {
+	mappings := map[string]*string{
+		"ZENAO_DB":                &reconcileConf.dbPath,
+		"ZENAO_CLERK_SECRET_KEY":  &reconcileConf.clerkSecretKey,
+		"ZENAO_RESEND_SECRET_KEY": &reconcileConf.resendSecretKey,
+		"ZENAO_MAIL_SENDER":       &reconcileConf.mailSender,
+		"ZENAO_STRIPE_SECRET_KEY": &reconcileConf.stripeSecretKey,
+		"ZENAO_PAYMENT_PROVIDER":  &reconcileConf.paymentProvider,
+		"ZENAO_PUBLIC_URL":        &reconcileConf.publicURL,
+		"ZENAO_APP_BASE_URL":      &reconcileConf.appBaseURL,
+	}
+
+	for key, ps := range mappings {
+		val := os.Getenv(key)
+		if val != "" {
+			*ps = val
+		}
+	}
+
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		return err
+	}
+
+	auth, err := czauth.SetupAuth(reconcileConf.clerkSecretKey, logger)
+	if err != nil {
+		return err
+	}
+
+	db, err := gzdb.SetupDB(reconcileConf.dbPath)
+	if err != nil {
+		return err
+	}
+
+	mailClient := (*resend.Client)(nil)
+	if reconcileConf.resendSecretKey != "" {
+		mailClient = resend.NewCustomClient(&http.Client{Timeout: time.Minute}, reconcileConf.resendSecretKey)
+	}
+
+	zenao := &ZenaoServer{
+		Logger:           logger,
+		Auth:             auth,
+		DB:               db,
+		MailClient:       mailClient,
+		MailSender:       reconcileConf.mailSender,
+		AppBaseURL:       reconcileConf.appBaseURL,
+		PaymentProviders: map[string]payment.Payment{},
+	}
+	if _, err := zenao.setupPaymentProvider(reconcileConf.paymentProvider, reconcileConf.stripeSecretKey, reconcileConf.publicURL); err != nil {
+		return err
+	}
+
+	stats := zenao.ReconcileOnce(ctx, time.Now(), reconcileConf.staleAfter)
+	if stats.Errors > 0 {
+		return fmt.Errorf("reconciliation finished with %d errors", stats.Errors)
+	}
+	return nil
+}
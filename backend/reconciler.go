@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/payment"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// defaultReconcileStaleAfter is how old a pending order must be before the reconciler polls its checkout session.
+// It leaves time to the success redirect and the webhook to confirm the order first.
+const defaultReconcileStaleAfter = 10 * time.Minute
+
+type reconcileStats struct {
+	ExpiredHolds    int64
+	ExpiredOffers   int64
+	ExpiredKeys     int64
+	CheckedOrders   int
+	ConfirmedOrders int
+	FailedOrders    int
+	RetriedIssues   int
+	WaitlistEvents  int
+	Errors          int
+}
+
+type reconcilerMetrics struct {
+	expiredHolds    metric.Int64Counter
+	expiredOffers   metric.Int64Counter
+	expiredKeys     metric.Int64Counter
+	checkedOrders   metric.Int64Counter
+	confirmedOrders metric.Int64Counter
+	failedOrders    metric.Int64Counter
+	retriedIssues   metric.Int64Counter
+	waitlistEvents  metric.Int64Counter
+	errors          metric.Int64Counter
+	passDuration    metric.Float64Histogram
+}
+
+func newReconcilerMetrics() (*reconcilerMetrics, error) {
+	meter := otel.Meter("reconciler")
+
+	var err error
+	m := &reconcilerMetrics{}
+	counters := []struct {
+		dst         *metric.Int64Counter
+		name        string
+		description string
+	}{
+		{&m.expiredHolds, "reconciler.ticket_holds.expired", "Expired ticket holds deleted"},
+		{&m.expiredOffers, "reconciler.waitlist.offers_expired", "Waitlist offers expired without being claimed"},
+		{&m.expiredKeys, "reconciler.idempotency_keys.expired", "Expired idempotency keys deleted"},
+		{&m.checkedOrders, "reconciler.orders.checked", "Stale pending orders checked against their payment provider"},
+		{&m.confirmedOrders, "reconciler.orders.confirmed", "Pending orders confirmed as paid"},
+		{&m.failedOrders, "reconciler.orders.failed", "Pending orders marked as failed or abandoned"},
+		{&m.retriedIssues, "reconciler.ticket_issues.retried", "Ticket issuances retried"},
+		{&m.waitlistEvents, "reconciler.waitlist.events", "Events whose waitlist was offered the available spots"},
+		{&m.errors, "reconciler.errors", "Errors encountered while reconciling"},
+	}
+	for _, c := range counters {
+		if *c.dst, err = meter.Int64Counter(c.name, metric.WithDescription(c.description)); err != nil {
+			return nil, err
+		}
+	}
+	if m.passDuration, err = meter.Float64Histogram("reconciler.pass.duration",
+		metric.WithDescription("Duration of a reconciliation pass"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *reconcilerMetrics) record(ctx context.Context, stats *reconcileStats, duration time.Duration) {
+	m.expiredHolds.Add(ctx, stats.ExpiredHolds)
+	m.expiredOffers.Add(ctx, stats.ExpiredOffers)
+	m.expiredKeys.Add(ctx, stats.ExpiredKeys)
+	m.checkedOrders.Add(ctx, int64(stats.CheckedOrders))
+	m.confirmedOrders.Add(ctx, int64(stats.ConfirmedOrders))
+	m.failedOrders.Add(ctx, int64(stats.FailedOrders))
+	m.retriedIssues.Add(ctx, int64(stats.RetriedIssues))
+	m.waitlistEvents.Add(ctx, int64(stats.WaitlistEvents))
+	m.errors.Add(ctx, int64(stats.Errors))
+	m.passDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attribute.Bool("success", stats.Errors == 0)))
+}
+
+// RunReconciler runs a reconciliation pass every interval until ctx is done.
+func (s *ZenaoServer) RunReconciler(ctx context.Context, interval time.Duration, staleAfter time.Duration) error {
+	if interval <= 0 {
+		return errors.New("reconcile interval must be positive")
+	}
+
+	metrics, err := newReconcilerMetrics()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		stats := s.ReconcileOnce(ctx, start, staleAfter)
+		metrics.record(ctx, stats, time.Since(start))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReconcileOnce sweeps expired ticket holds, resolves stale pending orders with their payment provider,
+// retries failed ticket issuances, hands the freed spots to waitlists and forgets expired idempotency keys.
+// Errors are logged and counted so one bad order does not block the others.
+func (s *ZenaoServer) ReconcileOnce(ctx context.Context, now time.Time, staleAfter time.Duration) *reconcileStats {
+	stats := &reconcileStats{}
+
+	ctx, span := otel.Tracer("reconciler").Start(ctx, "reconciler.Pass", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	deleted, err := s.DB.WithContext(ctx).DeleteAllExpiredTicketHolds(now.Unix())
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-holds", zap.Error(err))
+	}
+	stats.ExpiredHolds = deleted
+
+	pending, err := s.DB.WithContext(ctx).ListPendingOrders(now.Add(-staleAfter).Unix())
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-pending-orders", zap.Error(err))
+	}
+	for _, order := range pending {
+		stats.CheckedOrders++
+		status, err := s.reconcilePendingOrder(ctx, order, now)
+		if err != nil {
+			stats.Errors++
+			s.Logger.Error("reconcile-pending-order", zap.Error(err), zap.String("order-id", order.ID))
+			continue
+		}
+		switch status {
+		case zeni.OrderStatusSuccess:
+			stats.ConfirmedOrders++
+		case zeni.OrderStatusFailed:
+			stats.FailedOrders++
+		}
+	}
+
+	failedIssues, err := s.DB.WithContext(ctx).ListOrdersByTicketIssueStatus(zeni.TicketIssueStatusFailed)
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-ticket-issues", zap.Error(err))
+	}
+	for _, order := range failedIssues {
+		stats.RetriedIssues++
+		s.issueTicketsAfterConfirmation(ctx, order)
+	}
+
+	expiredOffers, err := s.DB.WithContext(ctx).ExpireWaitlistOffers(now.Unix())
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-waitlist-offers", zap.Error(err))
+	}
+	stats.ExpiredOffers = expiredOffers
+	waitlistEventIDs, err := s.DB.WithContext(ctx).ListWaitlistEventIDs()
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-waitlists", zap.Error(err))
+	}
+	for _, eventID := range waitlistEventIDs {
+		stats.WaitlistEvents++
+		if err := s.offerWaitlistSpots(ctx, eventID, now); err != nil {
+			stats.Errors++
+			s.Logger.Error("reconcile-waitlist", zap.Error(err), zap.String("event-id", eventID))
+		}
+	}
+
+	expiredKeys, err := s.DB.WithContext(ctx).DeleteExpiredIdempotencyKeys(now.Add(-idempotencyKeyTTL).Unix())
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("reconcile-idempotency-keys", zap.Error(err))
+	}
+	stats.ExpiredKeys = expiredKeys
+
+	s.Logger.Info("reconcile-pass",
+		zap.Int64("expired-holds", stats.ExpiredHolds),
+		zap.Int("checked-orders", stats.CheckedOrders),
+		zap.Int("confirmed-orders", stats.ConfirmedOrders),
+		zap.Int("failed-orders", stats.FailedOrders),
+		zap.Int("retried-issues", stats.RetriedIssues),
+		zap.Int64("expired-offers", stats.ExpiredOffers),
+		zap.Int("waitlist-events", stats.WaitlistEvents),
+		zap.Int64("expired-idempotency-keys", stats.ExpiredKeys),
+		zap.Int("errors", stats.Errors),
+	)
+
+	return stats
+}
+
+// reconcilePendingOrder looks up the checkout session of a pending order and applies its outcome.
+// Orders still unpaid after the provider hold TTL are considered abandoned.
+func (s *ZenaoServer) reconcilePendingOrder(ctx context.Context, order *zeni.Order, now time.Time) (zeni.OrderStatus, error) {
+	account, err := s.DB.WithContext(ctx).GetOrderPaymentAccount(order.ID)
+	if err != nil {
+		return "", err
+	}
+	if account == nil || strings.TrimSpace(account.PlatformType) == "" {
+		return "", errors.New("payment account not found")
+	}
+
+	provider, ok := s.PaymentProviders[account.PlatformType]
+	if !ok {
+		return "", errors.New("payment provider not found")
+	}
+	abandoned := now.Unix() >= order.CreatedAt+int64(provider.DefaultHoldTTL().Seconds())
+
+	sessionID := strings.TrimSpace(order.PaymentSessionID)
+	if sessionID == "" {
+		// the checkout session was never created, nothing can be paid
+		if !abandoned {
+			return zeni.OrderStatusPending, nil
+		}
+		failOrderAndReleaseHolds(ctx, s.DB, order.ID)
+		return zeni.OrderStatusFailed, nil
+	}
+
+	sessionFetcher, ok := provider.(payment.CheckoutSessionFetcher)
+	if !ok {
+		return "", errors.New("payment provider does not support checkout session lookup")
+	}
+
+	session, err := sessionFetcher.GetCheckoutSession(ctx, sessionID, account.PlatformAccountID)
+	if err != nil {
+		return "", err
+	}
+
+	switch mapCheckoutPaymentStatus(session.PaymentStatus) {
+	case zeni.OrderStatusSuccess:
+		if err := s.confirmOrderPayment(ctx, order, session.PaymentIntentID); err != nil {
+			return "", err
+		}
+		return zeni.OrderStatusSuccess, nil
+	case zeni.OrderStatusFailed:
+		failOrderAndReleaseHolds(ctx, s.DB, order.ID)
+		return zeni.OrderStatusFailed, nil
+	}
+
+	if abandoned {
+		failOrderAndReleaseHolds(ctx, s.DB, order.ID)
+		return zeni.OrderStatusFailed, nil
+	}
+	return zeni.OrderStatusPending, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/payment/zpstripe"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v84"
+	"go.uber.org/zap"
+)
+
+func TestReconcileConfirmsPaidPendingOrder(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	originalStripeGet := zpstripe.CheckoutSessionGet
+	zpstripe.CheckoutSessionGet = func(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+		require.Equal(t, sessionID, id)
+		return &stripe.CheckoutSession{
+			PaymentStatus: stripe.CheckoutSessionPaymentStatusPaid,
+			PaymentIntent: &stripe.PaymentIntent{ID: "pi_test_123"},
+		}, nil
+	}
+	t.Cleanup(func() { zpstripe.CheckoutSessionGet = originalStripeGet })
+
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             checkoutAuth,
+		DB:               db,
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	// recent pending orders are left to the checkout redirect and the webhook
+	stats := server.ReconcileOnce(context.Background(), time.Now(), defaultReconcileStaleAfter)
+	require.Zero(t, stats.CheckedOrders)
+
+	stats = server.ReconcileOnce(context.Background(), time.Now().Add(15*time.Minute), defaultReconcileStaleAfter)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, 1, stats.CheckedOrders)
+	require.Equal(t, 1, stats.ConfirmedOrders)
+
+	var status, issueStatus string
+	require.NoError(t, sqlDB.QueryRow("SELECT status, ticket_issue_status FROM orders WHERE id = ?", orderID).Scan(&status, &issueStatus))
+	require.Equal(t, string(zeni.OrderStatusSuccess), status)
+	require.Equal(t, string(zeni.TicketIssueStatusIssued), issueStatus)
+}
+
+func TestReconcileFailsAbandonedOrder(t *testing.T) {
+	db, sqlDB, orderID, _, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	originalStripeGet := zpstripe.CheckoutSessionGet
+	zpstripe.CheckoutSessionGet = func(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+		return &stripe.CheckoutSession{PaymentStatus: stripe.CheckoutSessionPaymentStatusUnpaid}, nil
+	}
+	t.Cleanup(func() { zpstripe.CheckoutSessionGet = originalStripeGet })
+
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             checkoutAuth,
+		DB:               db,
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	// still within the checkout session lifetime
+	stats := server.ReconcileOnce(context.Background(), time.Now().Add(15*time.Minute), defaultReconcileStaleAfter)
+	require.Equal(t, 1, stats.CheckedOrders)
+	require.Zero(t, stats.FailedOrders)
+
+	stats = server.ReconcileOnce(context.Background(), time.Now().Add(time.Hour), defaultReconcileStaleAfter)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, 1, stats.FailedOrders)
+	require.NotZero(t, stats.ExpiredHolds)
+
+	var status string
+	require.NoError(t, sqlDB.QueryRow("SELECT status FROM orders WHERE id = ?", orderID).Scan(&status))
+	require.Equal(t, string(zeni.OrderStatusFailed), status)
+
+	var holdCount int64
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM ticket_holds").Scan(&holdCount))
+	require.Zero(t, holdCount)
+}
+
+func TestReconcileRetriesFailedTicketIssuance(t *testing.T) {
+	db, sqlDB, orderID, _, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	require.NoError(t, db.UpdateOrderConfirmation(orderID, zeni.OrderStatusSuccess, "pi_test_123", time.Now().Unix()))
+	require.NoError(t, db.UpdateOrderTicketIssue(orderID, zeni.TicketIssueStatusFailed, "boom"))
+
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             checkoutAuth,
+		DB:               db,
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	stats := server.ReconcileOnce(context.Background(), time.Now(), defaultReconcileStaleAfter)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, 1, stats.RetriedIssues)
+
+	var issueStatus string
+	var ticketCount int64
+	require.NoError(t, sqlDB.QueryRow("SELECT ticket_issue_status FROM orders WHERE id = ?", orderID).Scan(&issueStatus))
+	require.Equal(t, string(zeni.TicketIssueStatusIssued), issueStatus)
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE order_id = ?", orderID).Scan(&ticketCount))
+	require.Equal(t, int64(1), ticketCount)
+
+	stats = server.ReconcileOnce(context.Background(), time.Now(), defaultReconcileStaleAfter)
+	require.Zero(t, stats.RetriedIssues)
+}
+
+func TestReconcileGivesUpFailingTicketIssuance(t *testing.T) {
+	db, sqlDB, orderID, _, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	require.NoError(t, db.UpdateOrderConfirmation(orderID, zeni.OrderStatusSuccess, "pi_test_123", time.Now().Unix()))
+	require.NoError(t, db.UpdateOrderTicketIssue(orderID, zeni.TicketIssueStatusFailed, "boom"))
+
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             checkoutAuth,
+		DB:               &ticketIssueFailingDB{DB: db},
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	for i := 1; i < maxTicketIssueAttempts; i++ {
+		stats := server.ReconcileOnce(context.Background(), time.Now(), defaultReconcileStaleAfter)
+		require.Equal(t, 1, stats.RetriedIssues)
+	}
+
+	var issueStatus string
+	var attempts int
+	require.NoError(t, sqlDB.QueryRow("SELECT ticket_issue_status, ticket_issue_attempts FROM orders WHERE id = ?", orderID).Scan(&issueStatus, &attempts))
+	require.Equal(t, string(zeni.TicketIssueStatusNeedsAttention), issueStatus)
+	require.Equal(t, maxTicketIssueAttempts, attempts)
+
+	stats := server.ReconcileOnce(context.Background(), time.Now(), defaultReconcileStaleAfter)
+	require.Zero(t, stats.RetriedIssues)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/payment"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) RefundOrder(
+	ctx context.Context,
+	req *connect.Request[zenaov1.RefundOrderRequest],
+) (*connect.Response[zenaov1.RefundOrderResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	orderID := strings.TrimSpace(req.Msg.OrderId)
+	if orderID == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	s.Logger.Info("refund-order", zap.String("order-id", orderID), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	order, err := s.DB.WithContext(ctx).GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, errors.New("order not found")
+	}
+
+	roles, err := s.DB.WithContext(ctx).EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, order.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, zeni.RoleOrganizer) {
+		return nil, errors.New("only organizers can refund an order")
+	}
+
+	attendeeIDs, err := s.resolveOrderAttendeeIDsByEmails(ctx, order.ID, req.Msg.AttendeeEmails)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.refundOrder(ctx, order, attendeeIDs)
+	if err != nil {
+		s.Logger.Error("refund-order", zap.Error(err), zap.String("order-id", orderID))
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.RefundOrderResponse{
+		OrderId:             updated.ID,
+		RefundStatus:        string(updated.RefundStatus),
+		RefundedAmountMinor: updated.RefundedAmountMinor,
+	}), nil
+}
+
+// refundOrder refunds the given attendees of a successful order through its payment provider
+// and invalidates their tickets. An empty attendeeIDs refunds every attendee not refunded yet.
+// The donation of the order is refunded along with its last attendees.
+func (s *ZenaoServer) refundOrder(ctx context.Context, order *zeni.Order, attendeeIDs []string) (*zeni.Order, error) {
+	if order.Status != zeni.OrderStatusSuccess {
+		return nil, errors.New("only successful orders can be refunded")
+	}
+	if order.RefundStatus == zeni.OrderRefundStatusRefunded {
+		return nil, errors.New("order is already refunded")
+	}
+
+	// the attendees are taken over before calling the provider so that concurrent refunds cannot refund them twice
+	claim, err := s.DB.WithContext(ctx).ClaimOrderAttendeesRefund(order.ID, attendeeIDs, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	amount := claim.AmountMinor + claim.DonationAmountMinor
+
+	refundID, err := s.refundOrderPayment(ctx, order, claim, amount)
+	if err != nil {
+		if releaseErr := s.DB.WithContext(ctx).ReleaseOrderAttendeesRefund(order.ID, claim); releaseErr != nil {
+			s.Logger.Error("release-order-refund", zap.Error(releaseErr), zap.String("order-id", order.ID))
+		}
+		return nil, err
+	}
+
+	updated, err := s.DB.WithContext(ctx).RefundOrderAttendees(order.ID, claim.AttendeeIDs, refundID, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("order-refunded",
+		zap.String("order-id", order.ID),
+		zap.String("refund-id", refundID),
+		zap.Int64("amount-minor", amount),
+		zap.Int("attendees-count", len(claim.AttendeeIDs)),
+	)
+
+	return updated, nil
+}
+
+// refundOrderPayment refunds amount through the payment provider of the order, it returns the provider refund id
+// or an empty one if there is nothing to refund.
+func (s *ZenaoServer) refundOrderPayment(ctx context.Context, order *zeni.Order, claim *zeni.OrderRefundClaim, amount int64) (string, error) {
+	if amount <= 0 {
+		return "", nil
+	}
+
+	provider, ok := s.PaymentProviders[order.PaymentProvider]
+	if !ok {
+		return "", errors.New("payment provider not found")
+	}
+	refunder, ok := provider.(payment.Refunder)
+	if !ok {
+		return "", errors.New("payment provider does not support refunds")
+	}
+
+	account, err := s.DB.WithContext(ctx).GetOrderPaymentAccount(order.ID)
+	if err != nil {
+		return "", err
+	}
+	if account == nil {
+		return "", errors.New("payment account not found")
+	}
+
+	refund, err := refunder.RefundPayment(ctx, payment.RefundInput{
+		OrderID:           order.ID,
+		PaymentIntentID:   order.PaymentIntentID,
+		AmountMinor:       amount,
+		Currency:          order.CurrencyCode,
+		ProviderAccountID: account.PlatformAccountID,
+		IdempotencyKey:    refundIdempotencyKey(order.ID, claim.AttendeeIDs),
+	})
+	if err != nil {
+		return "", fmt.Errorf("refund payment %s: %w", order.PaymentProvider, err)
+	}
+	return refund.ID, nil
+}
+
+func (s *ZenaoServer) resolveOrderAttendeeIDsByEmails(ctx context.Context, orderID string, emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	attendees, err := s.DB.WithContext(ctx).GetOrderAttendees(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(attendees))
+	for _, attendee := range attendees {
+		userIDs = append(userIDs, attendee.UserID)
+	}
+	users, err := s.DB.WithContext(ctx).GetUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	authIDs := make([]string, 0, len(users))
+	authIDByUserID := map[string]string{}
+	for _, user := range users {
+		authIDs = append(authIDs, user.AuthID)
+		authIDByUserID[user.ID] = user.AuthID
+	}
+	authUsers, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
+	if err != nil {
+		return nil, err
+	}
+	emailByAuthID := map[string]string{}
+	for _, authUser := range authUsers {
+		emailByAuthID[authUser.ID] = strings.ToLower(strings.TrimSpace(authUser.Email))
+	}
+
+	result := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		found := false
+		for _, attendee := range attendees {
+			if emailByAuthID[authIDByUserID[attendee.UserID]] == email && !slices.Contains(result, attendee.ID) {
+				result = append(result, attendee.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no attendee with email %q in order", email)
+		}
+	}
+	return result, nil
+}
+
+func refundIdempotencyKey(orderID string, attendeeIDs []string) string {
+	ids := slices.Clone(attendeeIDs)
+	slices.Sort(ids)
+	sum := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	return fmt.Sprintf("refund.order.%s.%s", orderID, hex.EncodeToString(sum[:8]))
+}
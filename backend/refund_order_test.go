@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/payment/zpstripe"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v84"
+	"go.uber.org/zap"
+)
+
+func setupRefundFixture(t *testing.T) (*ZenaoServer, *sql.DB, string, *[]*stripe.RefundParams) {
+	db, sqlDB, orderID, _, checkoutAuth := setupPaymentConfirmationFixtureWithAttendees(
+		t,
+		[]string{"buyer@example.com", "guest@example.com"},
+	)
+
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             checkoutAuth,
+		DB:               db,
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	order, err := db.GetOrder(orderID)
+	require.NoError(t, err)
+	require.NoError(t, server.confirmOrderPayment(context.Background(), order, "pi_test_123"))
+
+	refunds := []*stripe.RefundParams{}
+	originalRefundNew := zpstripe.RefundNew
+	zpstripe.RefundNew = func(params *stripe.RefundParams) (*stripe.Refund, error) {
+		refunds = append(refunds, params)
+		return &stripe.Refund{ID: "re_test_123", Amount: *params.Amount, Status: stripe.RefundStatusSucceeded}, nil
+	}
+	t.Cleanup(func() { zpstripe.RefundNew = originalRefundNew })
+
+	// act as the event organizer
+	checkoutAuth.user = checkoutAuth.ensureAuthUser("org@example.com")
+
+	return server, sqlDB, orderID, &refunds
+}
+
+func TestRefundOrderPerAttendee(t *testing.T) {
+	server, sqlDB, orderID, refunds := setupRefundFixture(t)
+
+	resp, err := server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId:        orderID,
+		AttendeeEmails: []string{"guest@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, string(zeni.OrderRefundStatusPartial), resp.Msg.RefundStatus)
+	require.Equal(t, int64(2500), resp.Msg.RefundedAmountMinor)
+
+	require.Len(t, *refunds, 1)
+	params := (*refunds)[0]
+	require.Equal(t, "pi_test_123", *params.PaymentIntent)
+	require.Equal(t, int64(2500), *params.Amount)
+	require.NotNil(t, params.StripeAccount)
+	require.Equal(t, "acct_123", *params.StripeAccount)
+
+	var activeTickets int64
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE order_id = ? AND deleted_at IS NULL", orderID).Scan(&activeTickets))
+	require.Equal(t, int64(1), activeTickets)
+
+	_, err = server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId:        orderID,
+		AttendeeEmails: []string{"guest@example.com"},
+	}))
+	require.Error(t, err)
+
+	resp, err = server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId: orderID,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, string(zeni.OrderRefundStatusRefunded), resp.Msg.RefundStatus)
+	require.Equal(t, int64(5000), resp.Msg.RefundedAmountMinor)
+	require.Len(t, *refunds, 2)
+
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE order_id = ? AND deleted_at IS NULL", orderID).Scan(&activeTickets))
+	require.Zero(t, activeTickets)
+}
+
+func TestRefundOrderRequiresOrganizer(t *testing.T) {
+	server, _, orderID, refunds := setupRefundFixture(t)
+
+	server.Auth.(*ticketPaymentStubAuth).user = server.Auth.(*ticketPaymentStubAuth).ensureAuthUser("buyer@example.com")
+
+	_, err := server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId: orderID,
+	}))
+	require.Error(t, err)
+	require.Empty(t, *refunds)
+}
+
+func TestCancelEventRefundsOrders(t *testing.T) {
+	server, sqlDB, orderID, refunds := setupRefundFixture(t)
+
+	var eventID string
+	require.NoError(t, sqlDB.QueryRow("SELECT event_id FROM orders WHERE id = ?", orderID).Scan(&eventID))
+	_, err := sqlDB.Exec("UPDATE events SET start_date = ?, end_date = ? WHERE id = ?",
+		time.Now().Add(72*time.Hour), time.Now().Add(75*time.Hour), eventID)
+	require.NoError(t, err)
+	_, err = sqlDB.Exec("UPDATE orders SET donation_amount_minor = 1000, amount_minor = amount_minor + 1000 WHERE id = ?", orderID)
+	require.NoError(t, err)
+
+	resp, err := server.CancelEvent(context.Background(), connect.NewRequest(&zenaov1.CancelEventRequest{
+		EventId:      eventID,
+		RefundOrders: true,
+	}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.RefundFailedOrderIds)
+	require.Len(t, *refunds, 1)
+	require.Equal(t, int64(6000), *(*refunds)[0].Amount)
+
+	var refundStatus string
+	require.NoError(t, sqlDB.QueryRow("SELECT refund_status FROM orders WHERE id = ?", orderID).Scan(&refundStatus))
+	require.Equal(t, string(zeni.OrderRefundStatusRefunded), refundStatus)
+}
+
+func TestRefundOrderReturnsDonationWithLastAttendee(t *testing.T) {
+	server, sqlDB, orderID, refunds := setupRefundFixture(t)
+	_, err := sqlDB.Exec("UPDATE orders SET donation_amount_minor = 1000, amount_minor = amount_minor + 1000 WHERE id = ?", orderID)
+	require.NoError(t, err)
+
+	resp, err := server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId:        orderID,
+		AttendeeEmails: []string{"guest@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, int64(2500), resp.Msg.RefundedAmountMinor)
+	require.Equal(t, int64(2500), *(*refunds)[0].Amount)
+
+	resp, err = server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+		OrderId:        orderID,
+		AttendeeEmails: []string{"buyer@example.com"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, string(zeni.OrderRefundStatusRefunded), resp.Msg.RefundStatus)
+	require.Equal(t, int64(6000), resp.Msg.RefundedAmountMinor)
+	require.Equal(t, int64(3500), *(*refunds)[1].Amount)
+}
+
+func TestRefundOrderConcurrentPartialRefunds(t *testing.T) {
+	server, sqlDB, orderID, _ := setupRefundFixture(t)
+	_, err := sqlDB.Exec("UPDATE orders SET donation_amount_minor = 1000, amount_minor = amount_minor + 1000 WHERE id = ?", orderID)
+	require.NoError(t, err)
+
+	// both refunds reach the provider before any of them is recorded, the steps run one at a time
+	// so the sqlite writes of the two requests don't collide
+	var mu sync.Mutex
+	amounts := []int64{}
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	zpstripe.RefundNew = func(params *stripe.RefundParams) (*stripe.Refund, error) {
+		mu.Lock()
+		amounts = append(amounts, *params.Amount)
+		mu.Unlock()
+		entered <- struct{}{}
+		<-release
+		return &stripe.Refund{ID: "re_test_123", Amount: *params.Amount, Status: stripe.RefundStatusSucceeded}, nil
+	}
+
+	errs := make(chan error, 2)
+	for _, email := range []string{"buyer@example.com", "guest@example.com"} {
+		go func() {
+			_, err := server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{
+				OrderId:        orderID,
+				AttendeeEmails: []string{email},
+			}))
+			errs <- err
+		}()
+		select {
+		case <-entered:
+		case err := <-errs:
+			t.Fatalf("refund returned before reaching the provider: %v", err)
+		}
+	}
+	for range 2 {
+		release <- struct{}{}
+		require.NoError(t, <-errs)
+	}
+
+	// the donation is refunded exactly once
+	require.ElementsMatch(t, []int64{2500, 3500}, amounts)
+
+	var refundStatus string
+	var refundedAmount int64
+	require.NoError(t, sqlDB.QueryRow("SELECT refund_status, refunded_amount_minor FROM orders WHERE id = ?", orderID).Scan(&refundStatus, &refundedAmount))
+	require.Equal(t, string(zeni.OrderRefundStatusRefunded), refundStatus)
+	require.Equal(t, int64(6000), refundedAmount)
+}
+
+func TestRefundOrderReleasesAttendeesOnProviderFailure(t *testing.T) {
+	server, sqlDB, orderID, refunds := setupRefundFixture(t)
+	_, err := sqlDB.Exec("UPDATE orders SET donation_amount_minor = 1000, amount_minor = amount_minor + 1000 WHERE id = ?", orderID)
+	require.NoError(t, err)
+
+	succeeding := zpstripe.RefundNew
+	zpstripe.RefundNew = func(params *stripe.RefundParams) (*stripe.Refund, error) {
+		return nil, errors.New("provider is down")
+	}
+	_, err = server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{OrderId: orderID}))
+	require.ErrorContains(t, err, "provider is down")
+
+	zpstripe.RefundNew = succeeding
+	resp, err := server.RefundOrder(context.Background(), connect.NewRequest(&zenaov1.RefundOrderRequest{OrderId: orderID}))
+	require.NoError(t, err)
+	require.Equal(t, int64(6000), resp.Msg.RefundedAmountMinor)
+	require.Len(t, *refunds, 1)
+	require.Equal(t, int64(6000), *(*refunds)[0].Amount)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) RegisterForSession(
+	ctx context.Context,
+	req *connect.Request[zenaov1.RegisterForSessionRequest],
+) (*connect.Response[zenaov1.RegisterForSessionResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("register-for-session", zap.String("session-id", req.Msg.SessionId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	now := time.Now()
+	var session *zeni.EventSession
+	if err := s.DB.TxWithSpan(ctx, "db.RegisterForSession", func(tx zeni.DB) error {
+		session, err = tx.GetEventSession(req.Msg.SessionId)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return errors.New("session not found")
+		}
+		if !now.Before(session.StartDate) {
+			return errors.New("session already started")
+		}
+
+		// sessions are part of the event, only its ticket holders can take a seat
+		roles, err := tx.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, session.EventID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleParticipant) {
+			return errors.New("user is not participant of the event")
+		}
+
+		if err := tx.RegisterForSession(session.ID, actor.ID(), now.Unix()); err != nil {
+			return err
+		}
+
+		session, err = tx.GetEventSession(session.ID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.RegisterForSessionResponse{
+		Session: eventSessionToProto(session, true),
+	}), nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+const (
+	maxRegistrationQuestions         = 50
+	maxRegistrationOptions           = 50
+	maxRegistrationLabelLength       = 500
+	maxRegistrationOptionLength      = 200
+	maxRegistrationAnswerLength      = 2000
+	registrationCheckboxCheckedValue = "true"
+)
+
+func validateRegistrationQuestions(questions []*zenaov1.RegistrationQuestion) error {
+	if len(questions) > maxRegistrationQuestions {
+		return fmt.Errorf("registration form can't have more than %d questions", maxRegistrationQuestions)
+	}
+	for _, question := range questions {
+		label := strings.TrimSpace(question.Label)
+		if len(label) == 0 || len(label) > maxRegistrationLabelLength {
+			return fmt.Errorf("question label must be of length 1 to %d", maxRegistrationLabelLength)
+		}
+		switch zeni.RegistrationQuestionKind(question.Kind) {
+		case zeni.RegistrationQuestionKindText, zeni.RegistrationQuestionKindCheckbox:
+			if len(question.Options) != 0 {
+				return fmt.Errorf("question %q can't have options", label)
+			}
+		case zeni.RegistrationQuestionKindSingleChoice, zeni.RegistrationQuestionKindMultiChoice:
+			if len(question.Options) < 2 || len(question.Options) > maxRegistrationOptions {
+				return fmt.Errorf("question %q must have 2 to %d options", label, maxRegistrationOptions)
+			}
+			seen := make(map[string]bool, len(question.Options))
+			for _, option := range question.Options {
+				option = strings.TrimSpace(option)
+				if len(option) == 0 || len(option) > maxRegistrationOptionLength || strings.Contains(option, "\n") {
+					return fmt.Errorf("options of question %q must be single lines of length 1 to %d", label, maxRegistrationOptionLength)
+				}
+				if seen[option] {
+					return fmt.Errorf("duplicate option %q in question %q", option, label)
+				}
+				seen[option] = true
+			}
+		default:
+			return fmt.Errorf("unknown question kind %q", question.Kind)
+		}
+	}
+	return nil
+}
+
+func registrationQuestionsFromProto(questions []*zenaov1.RegistrationQuestion) []*zeni.RegistrationQuestion {
+	return mapsl.Map(questions, func(question *zenaov1.RegistrationQuestion) *zeni.RegistrationQuestion {
+		return &zeni.RegistrationQuestion{
+			ID:       strings.TrimSpace(question.Id),
+			Kind:     zeni.RegistrationQuestionKind(question.Kind),
+			Label:    strings.TrimSpace(question.Label),
+			Options:  mapsl.Map(question.Options, strings.TrimSpace),
+			Required: question.Required,
+		}
+	})
+}
+
+func registrationQuestionsToProto(questions []*zeni.RegistrationQuestion) []*zenaov1.RegistrationQuestion {
+	return mapsl.Map(questions, func(question *zeni.RegistrationQuestion) *zenaov1.RegistrationQuestion {
+		return &zenaov1.RegistrationQuestion{
+			Id:       question.ID,
+			Kind:     string(question.Kind),
+			Label:    question.Label,
+			Options:  question.Options,
+			Required: question.Required,
+		}
+	})
+}
+
+// validateRegistrationAnswers checks the answers of an attendee against the registration form of the event.
+// Empty answers are dropped, an unchecked checkbox is not answered.
+func validateRegistrationAnswers(questions []*zeni.RegistrationQuestion, answers []*zenaov1.RegistrationAnswer) ([]*zeni.RegistrationAnswer, error) {
+	answersByQuestion := make(map[string]*zenaov1.RegistrationAnswer, len(answers))
+	for _, answer := range answers {
+		if !slices.ContainsFunc(questions, func(question *zeni.RegistrationQuestion) bool { return question.ID == answer.QuestionId }) {
+			return nil, fmt.Errorf("unknown registration question %q", answer.QuestionId)
+		}
+		if _, ok := answersByQuestion[answer.QuestionId]; ok {
+			return nil, fmt.Errorf("duplicate answer to registration question %q", answer.QuestionId)
+		}
+		answersByQuestion[answer.QuestionId] = answer
+	}
+
+	var res []*zeni.RegistrationAnswer
+	for _, question := range questions {
+		var values []string
+		if answer, ok := answersByQuestion[question.ID]; ok {
+			for _, value := range answer.Values {
+				if value = strings.TrimSpace(value); value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+		if len(values) == 0 {
+			if question.Required {
+				return nil, fmt.Errorf("answer to %q is required", question.Label)
+			}
+			continue
+		}
+
+		switch question.Kind {
+		case zeni.RegistrationQuestionKindText:
+			if len(values) != 1 || len(values[0]) > maxRegistrationAnswerLength {
+				return nil, fmt.Errorf("answer to %q must be a single text of at most %d characters", question.Label, maxRegistrationAnswerLength)
+			}
+		case zeni.RegistrationQuestionKindCheckbox:
+			if len(values) != 1 || values[0] != registrationCheckboxCheckedValue {
+				return nil, fmt.Errorf("answer to %q must be %q when checked", question.Label, registrationCheckboxCheckedValue)
+			}
+		case zeni.RegistrationQuestionKindSingleChoice, zeni.RegistrationQuestionKindMultiChoice:
+			if question.Kind == zeni.RegistrationQuestionKindSingleChoice && len(values) != 1 {
+				return nil, fmt.Errorf("answer to %q must be a single option", question.Label)
+			}
+			for i, value := range values {
+				if !slices.Contains(question.Options, value) {
+					return nil, fmt.Errorf("%q is not an option of %q", value, question.Label)
+				}
+				if slices.Contains(values[:i], value) {
+					return nil, fmt.Errorf("duplicate option %q in answer to %q", value, question.Label)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unknown question kind %q", question.Kind)
+		}
+
+		res = append(res, &zeni.RegistrationAnswer{
+			QuestionID: question.ID,
+			Values:     values,
+		})
+	}
+	return res, nil
+}
+
+// registrationAnswersByEmail validates the answers of each attendee, keyed by lowercased email.
+func registrationAnswersByEmail(questions []*zeni.RegistrationQuestion, emails []string, answers map[string][]*zenaov1.RegistrationAnswer) (map[string][]*zeni.RegistrationAnswer, error) {
+	for email := range answers {
+		if !slices.Contains(emails, email) {
+			return nil, errors.New("registration answers given for an unknown attendee")
+		}
+	}
+	res := make(map[string][]*zeni.RegistrationAnswer, len(emails))
+	for _, email := range emails {
+		validated, err := validateRegistrationAnswers(questions, answers[email])
+		if err != nil {
+			return nil, fmt.Errorf("attendee %s: %w", email, err)
+		}
+		res[email] = validated
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) RejectEventApplication(
+	ctx context.Context,
+	req *connect.Request[zenaov1.RejectEventApplicationRequest],
+) (*connect.Response[zenaov1.RejectEventApplicationResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("reject-event-application", zap.String("application-id", req.Msg.ApplicationId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	message := strings.TrimSpace(req.Msg.Message)
+	if len(message) > maxApplicationMessageLength {
+		return nil, fmt.Errorf("message cannot be longer than %d characters", maxApplicationMessageLength)
+	}
+
+	var (
+		evt         *zeni.Event
+		application *zeni.EventApplication
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.RejectEventApplication", func(tx zeni.DB) error {
+		application, evt, err = reviewEventApplication(tx, actor.ID(), req.Msg.ApplicationId)
+		if err != nil {
+			return err
+		}
+
+		application, err = tx.ReviewEventApplication(application.ID, zeni.EventApplicationStatusRejected, message, time.Now().Unix())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.sendEventApplicationEmail(ctx, evt, application, nil); err != nil {
+		s.Logger.Error("send-event-application-email", zap.Error(err), zap.String("application-id", application.ID))
+	}
+
+	return connect.NewResponse(&zenaov1.RejectEventApplicationResponse{
+		Application: eventApplicationToProto(application),
+	}), nil
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	maxEventReminders = 5
+	minReminderOffset = 5 * time.Minute
+	maxReminderOffset = 30 * 24 * time.Hour
+)
+
+// validateReminderOffsets checks the reminders configured by an organizer, offsets are in seconds before the start date.
+func validateReminderOffsets(offsets []uint32) error {
+	if len(offsets) > maxEventReminders {
+		return fmt.Errorf("an event can have at most %d reminders", maxEventReminders)
+	}
+	seen := make(map[uint32]struct{}, len(offsets))
+	for _, offset := range offsets {
+		d := time.Duration(offset) * time.Second
+		if d < minReminderOffset || d > maxReminderOffset {
+			return fmt.Errorf("reminder offset must be between %s and %s, got %s", minReminderOffset, maxReminderOffset, d)
+		}
+		if _, ok := seen[offset]; ok {
+			return fmt.Errorf("duplicate reminder offset %s", d)
+		}
+		seen[offset] = struct{}{}
+	}
+	return nil
+}
+
+// reminderDueAt returns when the reminder at offset is due. Whole days are counted in the event timezone
+// so a reminder 7 days before an event at 18:00 is sent at 18:00 across daylight saving changes.
+func reminderDueAt(start time.Time, loc *time.Location, offset uint32) time.Time {
+	d := time.Duration(offset) * time.Second
+	days := int(d / (24 * time.Hour))
+	rest := d % (24 * time.Hour)
+	return start.In(loc).AddDate(0, 0, -days).Add(-rest)
+}
+
+// dueReminderOffset returns the smallest offset of the event that is due at now, the larger ones are outdated by it.
+func dueReminderOffset(evt *zeni.Event, loc *time.Location, now time.Time) (uint32, time.Time, bool) {
+	offsets := slices.Clone(evt.ReminderOffsets)
+	slices.Sort(offsets)
+	for _, offset := range offsets {
+		dueAt := reminderDueAt(evt.StartDate, loc, offset)
+		if !dueAt.After(now) {
+			return offset, dueAt, true
+		}
+	}
+	return 0, time.Time{}, false
+}
+
+// reminderStartsIn formats the offset for the reminder mail, e.g. "7 days", "1 hour" or "30 minutes".
+func reminderStartsIn(offset uint32) string {
+	d := time.Duration(offset) * time.Second
+	unit := func(n int64, name string) string {
+		if n == 1 {
+			return "1 " + name
+		}
+		return fmt.Sprintf("%d %ss", n, name)
+	}
+	switch {
+	case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+		return unit(int64(d/(24*time.Hour)), "day")
+	case d >= time.Hour && d%time.Hour == 0:
+		return unit(int64(d/time.Hour), "hour")
+	default:
+		return unit(int64(d/time.Minute), "minute")
+	}
+}
+
+type reminderStats struct {
+	Events  int
+	Sent    int
+	Skipped int
+	Errors  int
+}
+
+type reminderMetrics struct {
+	sent         metric.Int64Counter
+	skipped      metric.Int64Counter
+	errors       metric.Int64Counter
+	passDuration metric.Float64Histogram
+}
+
+func newReminderMetrics() (*reminderMetrics, error) {
+	meter := otel.Meter("reminders")
+
+	var err error
+	m := &reminderMetrics{}
+	counters := []struct {
+		dst         *metric.Int64Counter
+		name        string
+		description string
+	}{
+		{&m.sent, "reminders.sent", "Event reminders sent to participants"},
+		{&m.skipped, "reminders.skipped", "Event reminders skipped because the participant registered after they were due"},
+		{&m.errors, "reminders.errors", "Errors encountered while sending reminders"},
+	}
+	for _, c := range counters {
+		if *c.dst, err = meter.Int64Counter(c.name, metric.WithDescription(c.description)); err != nil {
+			return nil, err
+		}
+	}
+	if m.passDuration, err = meter.Float64Histogram("reminders.pass.duration",
+		metric.WithDescription("Duration of a reminders pass"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *reminderMetrics) record(ctx context.Context, stats *reminderStats, duration time.Duration) {
+	m.sent.Add(ctx, int64(stats.Sent))
+	m.skipped.Add(ctx, int64(stats.Skipped))
+	m.errors.Add(ctx, int64(stats.Errors))
+	m.passDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attribute.Bool("success", stats.Errors == 0)))
+}
+
+// RunReminderScheduler sends the due event reminders every interval until ctx is done.
+func (s *ZenaoServer) RunReminderScheduler(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("reminder interval must be positive")
+	}
+
+	metrics, err := newReminderMetrics()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		stats := s.SendDueReminders(ctx, start)
+		metrics.record(ctx, stats, time.Since(start))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders sends the participants of upcoming events the reminders that are due at now.
+// Sent reminders are recorded before sending so they are not sent twice across restarts or schedulers,
+// the record is released if the mail fails so the next pass retries it.
+// Participants who registered after a reminder was due already got their ticket and are skipped.
+func (s *ZenaoServer) SendDueReminders(ctx context.Context, now time.Time) *reminderStats {
+	stats := &reminderStats{}
+	if s.MailClient == nil || s.Auth == nil {
+		return stats
+	}
+
+	ctx, span := otel.Tracer("reminders").Start(ctx, "reminders.Pass", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	// a day of margin for the offsets counted in days across daylight saving changes
+	events, err := s.DB.WithContext(ctx).ListEventsWithReminders(now, now.Add(maxReminderOffset+24*time.Hour))
+	if err != nil {
+		stats.Errors++
+		s.Logger.Error("list-events-with-reminders", zap.Error(err))
+	}
+	for _, evt := range events {
+		if !evt.DeletedAt.IsZero() {
+			continue
+		}
+		if err := s.sendEventReminders(ctx, evt, now, stats); err != nil {
+			stats.Errors++
+			s.Logger.Error("send-event-reminders", zap.Error(err), zap.String("event-id", evt.ID))
+		}
+	}
+
+	if stats.Sent > 0 || stats.Errors > 0 {
+		s.Logger.Info("reminders-pass",
+			zap.Int("events", stats.Events),
+			zap.Int("sent", stats.Sent),
+			zap.Int("skipped", stats.Skipped),
+			zap.Int("errors", stats.Errors),
+		)
+	}
+
+	return stats
+}
+
+func (s *ZenaoServer) sendEventReminders(ctx context.Context, evt *zeni.Event, now time.Time, stats *reminderStats) error {
+	loc, err := evt.Timezone()
+	if err != nil {
+		return fmt.Errorf("event timezone: %w", err)
+	}
+	offset, dueAt, ok := dueReminderOffset(evt, loc, now)
+	if !ok {
+		return nil
+	}
+	stats.Events++
+
+	tickets, err := s.DB.WithContext(ctx).GetEventTickets(evt.ID)
+	if err != nil {
+		return err
+	}
+	var pending []*zeni.SoldTicket
+	authIDs := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		// Skip teams which don't have AuthID
+		if ticket.User == nil || ticket.User.AuthID == "" {
+			continue
+		}
+		if ticket.CreatedAt.After(dueAt) {
+			stats.Skipped++
+			continue
+		}
+		pending = append(pending, ticket)
+		authIDs = append(authIDs, ticket.User.AuthID)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	authUsers, err := s.Auth.GetUsersFromIDs(ctx, authIDs)
+	if err != nil {
+		return err
+	}
+	emails := make(map[string]string, len(authUsers))
+	for _, authUser := range authUsers {
+		emails[authUser.ID] = authUser.Email
+	}
+
+	htmlStr, text, err := eventReminderMailContent(evt, reminderStartsIn(offset))
+	if err != nil {
+		return err
+	}
+
+	for _, ticket := range pending {
+		email := emails[ticket.User.AuthID]
+		if email == "" {
+			s.Logger.Error("user-email", zap.String("auth-id", ticket.User.AuthID), zap.String("email", email))
+			continue
+		}
+
+		claimed, err := s.DB.WithContext(ctx).ClaimEventReminder(evt.ID, ticket.UserID, offset, now.Unix())
+		if err != nil {
+			stats.Errors++
+			s.Logger.Error("claim-event-reminder", zap.Error(err), zap.String("event-id", evt.ID), zap.String("user-id", ticket.UserID))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.sendEventReminder(ctx, evt, ticket, email, htmlStr, text); err != nil {
+			stats.Errors++
+			s.Logger.Error("send-event-reminder", zap.Error(err), zap.String("event-id", evt.ID), zap.String("user-id", ticket.UserID))
+			if err := s.DB.WithContext(ctx).ReleaseEventReminder(evt.ID, ticket.UserID, offset); err != nil {
+				s.Logger.Error("release-event-reminder", zap.Error(err), zap.String("event-id", evt.ID), zap.String("user-id", ticket.UserID))
+			}
+			continue
+		}
+		stats.Sent++
+	}
+
+	return nil
+}
+
+func (s *ZenaoServer) sendEventReminder(ctx context.Context, evt *zeni.Event, ticket *zeni.SoldTicket, email string, htmlStr string, text string) error {
+	pdfData, err := GeneratePDFTicket(evt, ticket.Ticket.Secret(), ticket.User.DisplayName, email, ticket.CreatedAt, s.Logger)
+	if err != nil {
+		return fmt.Errorf("generate ticket pdf: %w", err)
+	}
+
+	// cannot batch send w/ attachments: https://resend.com/docs/api-reference/emails/send-batch-emails
+	_, err = s.MailClient.Emails.SendWithContext(ctx, &resend.SendEmailRequest{
+		From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
+		To:      []string{email},
+		Subject: "Reminder: " + evt.Title,
+		Html:    htmlStr,
+		Text:    text,
+		Attachments: []*resend.Attachment{{
+			Content:     pdfData,
+			Filename:    fmt.Sprintf("ticket_%s_%s.pdf", ticket.UserID, evt.ID),
+			ContentType: "application/pdf",
+		}},
+	})
+	return err
+}
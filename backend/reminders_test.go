@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderOffsets(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	// the clocks go back on Oct 25 2026 in Paris, the reminder keeps the local start time
+	start := time.Date(2026, 10, 28, 18, 0, 0, 0, paris)
+	require.Equal(t, time.Date(2026, 10, 21, 18, 0, 0, 0, paris), reminderDueAt(start, paris, 7*24*3600))
+	require.Equal(t, start.Add(-time.Hour), reminderDueAt(start, paris, 3600))
+
+	require.Equal(t, "7 days", reminderStartsIn(7*24*3600))
+	require.Equal(t, "1 hour", reminderStartsIn(3600))
+	require.Equal(t, "36 hours", reminderStartsIn(36*3600))
+	require.Equal(t, "30 minutes", reminderStartsIn(1800))
+
+	require.NoError(t, validateReminderOffsets([]uint32{7 * 24 * 3600, 24 * 3600, 3600}))
+	require.Error(t, validateReminderOffsets([]uint32{60}))
+	require.Error(t, validateReminderOffsets([]uint32{31 * 24 * 3600}))
+	require.Error(t, validateReminderOffsets([]uint32{3600, 3600}))
+	require.Error(t, validateReminderOffsets([]uint32{3600, 7200, 10800, 14400, 18000, 21600}))
+}
+
+func TestEventRemindersAreClaimedOnce(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	start := now.Add(48 * time.Hour).Truncate(time.Hour)
+	createEvent := func(offsets []uint32) string {
+		created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+			Title:       "Reminded meetup",
+			Description: "test description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(start.Unix()),
+			EndDate:     uint64(start.Add(2 * time.Hour).Unix()),
+			Capacity:    100,
+			Location: &zenaov1.EventLocation{
+				Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+			},
+			CommunityId:     community.ID,
+			ReminderOffsets: offsets,
+		}))
+		require.NoError(t, err)
+		return created.Msg.Id
+	}
+
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:           "Invalid reminders",
+		ReminderOffsets: []uint32{60},
+	}))
+	require.Error(t, err)
+
+	eventID := createEvent([]uint32{7 * 24 * 3600, 3600})
+	cancelledID := createEvent([]uint32{3600})
+	_, err = f.server.CancelEvent(context.Background(), connect.NewRequest(&zenaov1.CancelEventRequest{EventId: cancelledID}))
+	require.NoError(t, err)
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: eventID}))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{7 * 24 * 3600, 3600}, got.Msg.Event.ReminderOffsets)
+
+	events, err := f.db.ListEventsWithReminders(now, now.Add(maxReminderOffset))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, eventID, events[0].ID)
+
+	loc, err := events[0].Timezone()
+	require.NoError(t, err)
+	offset, _, ok := dueReminderOffset(events[0], loc, now)
+	require.True(t, ok)
+	require.Equal(t, uint32(7*24*3600), offset)
+	_, _, ok = dueReminderOffset(events[0], loc, start.Add(-2*time.Hour))
+	require.True(t, ok)
+	offset, _, _ = dueReminderOffset(events[0], loc, start.Add(-30*time.Minute))
+	require.Equal(t, uint32(3600), offset)
+
+	organizer, err := f.db.GetUser(f.auth.user.ID)
+	require.NoError(t, err)
+	claimed, err := f.db.ClaimEventReminder(eventID, organizer.ID, offset, now.Unix())
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = f.db.ClaimEventReminder(eventID, organizer.ID, offset, now.Unix())
+	require.NoError(t, err)
+	require.False(t, claimed)
+	require.NoError(t, f.db.ReleaseEventReminder(eventID, organizer.ID, offset))
+	claimed, err = f.db.ClaimEventReminder(eventID, organizer.ID, offset, now.Unix())
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+		EventId:               eventID,
+		Title:                 "Reminded meetup",
+		Description:           "test description",
+		ImageUri:              "ipfs://image",
+		StartDate:             uint64(start.Unix()),
+		EndDate:               uint64(start.Add(2 * time.Hour).Unix()),
+		Capacity:              100,
+		Location:              &zenaov1.EventLocation{Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}}},
+		CommunityId:           community.ID,
+		UpdateReminderOffsets: true,
+	}))
+	require.NoError(t, err)
+	events, err = f.db.ListEventsWithReminders(now, now.Add(maxReminderOffset))
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
@@ -54,5 +54,9 @@ func (s *ZenaoServer) RemoveParticipant(ctx context.Context, req *connect.Reques
 		return nil, err
 	}
 
+	if err := s.offerWaitlistSpots(ctx, req.Msg.EventId, time.Now()); err != nil {
+		s.Logger.Error("offer-waitlist-spots", zap.Error(err), zap.String("event-id", req.Msg.EventId))
+	}
+
 	return connect.NewResponse(&zenaov1.RemoveParticipantResponse{}), nil
 }
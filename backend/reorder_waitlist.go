@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ReorderWaitlist(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ReorderWaitlistRequest],
+) (*connect.Response[zenaov1.ReorderWaitlistResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("reorder-waitlist", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	var entries []*zeni.WaitlistEntry
+	if err := s.DB.TxWithSpan(ctx, "db.ReorderWaitlist", func(db zeni.DB) error {
+		roles, err := db.EntityRoles(zeni.EntityTypeUser, actor.ID(), zeni.EntityTypeEvent, req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(roles, zeni.RoleOrganizer) {
+			return errors.New("only organizers can reorder the waitlist")
+		}
+
+		if err := db.ReorderWaitlist(req.Msg.EventId, req.Msg.EntryIds); err != nil {
+			return err
+		}
+
+		entries, err = db.ListWaitlistEntries(req.Msg.EventId)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	result := make([]*zenaov1.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = waitlistEntryToProto(entry)
+	}
+
+	return connect.NewResponse(&zenaov1.ReorderWaitlistResponse{
+		Entries: result,
+	}), nil
+}
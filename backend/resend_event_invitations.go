@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) ResendEventInvitations(
+	ctx context.Context,
+	req *connect.Request[zenaov1.ResendEventInvitationsRequest],
+) (*connect.Response[zenaov1.ResendEventInvitationsResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("resend-event-invitations", zap.String("event-id", req.Msg.EventId), zap.Strings("invitation-ids", req.Msg.InvitationIds), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if s.InvitationSecret == "" {
+		return nil, errors.New("invitations are not enabled on this server")
+	}
+	if len(req.Msg.InvitationIds) > maxInvitationsPerRequest {
+		return nil, fmt.Errorf("cannot resend more than %d invitations at once", maxInvitationsPerRequest)
+	}
+
+	var (
+		evt     *zeni.Event
+		pending []*zeni.EventInvitation
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.ResendEventInvitations", func(tx zeni.DB) error {
+		evt, err = invitedEvent(tx, actor.ID(), req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		invitations, err := tx.ListEventInvitations(evt.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		// explicitly selected invitations must all be resendable, the others are resent when possible
+		if len(req.Msg.InvitationIds) == 0 {
+			for _, invitation := range invitations {
+				if checkInvitationResendable(invitation, now) == nil {
+					pending = append(pending, invitation)
+				}
+			}
+			return nil
+		}
+		for _, invitationID := range req.Msg.InvitationIds {
+			idx := slices.IndexFunc(invitations, func(invitation *zeni.EventInvitation) bool { return invitation.ID == invitationID })
+			if idx == -1 {
+				return fmt.Errorf("invitation %s not found in event", invitationID)
+			}
+			if slices.Contains(pending, invitations[idx]) {
+				continue
+			}
+			if err := checkInvitationResendable(invitations[idx], now); err != nil {
+				return err
+			}
+			pending = append(pending, invitations[idx])
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.sendEventInvitations(ctx, evt, pending, true)
+
+	return connect.NewResponse(&zenaov1.ResendEventInvitationsResponse{
+		Invitations: mapsl.Map(pending, eventInvitationToProto),
+	}), nil
+}
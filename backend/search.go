@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/samouraiworld/zenao/backend/mapsl"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+const (
+	maxSearchQueryLength = 200
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 100
+)
+
+func (s *ZenaoServer) Search(ctx context.Context, req *connect.Request[zenaov1.SearchRequest]) (*connect.Response[zenaov1.SearchResponse], error) {
+	query := strings.TrimSpace(req.Msg.Query)
+	if query == "" {
+		return nil, errors.New("search query is required")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, fmt.Errorf("search query cannot be longer than %d characters", maxSearchQueryLength)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	var locFilter *zeni.LocationFilter
+	if req.Msg.LocationFilter != nil {
+		locFilter = &zeni.LocationFilter{
+			Lat:      req.Msg.LocationFilter.Lat,
+			Lng:      req.Msg.LocationFilter.Lng,
+			RadiusKm: req.Msg.LocationFilter.RadiusKm,
+		}
+	}
+	// communities have neither dates nor location, they would not fit a filtered search
+	withCommunities := req.Msg.From == 0 && req.Msg.To == 0 && (locFilter == nil || locFilter.RadiusKm <= 0)
+
+	res := &zenaov1.SearchResponse{}
+	if err := s.DB.TxWithSpan(ctx, "Search", func(tx zeni.DB) error {
+		evts, err := tx.SearchEvents(query, limit, int(req.Msg.Offset), req.Msg.From, req.Msg.To, locFilter)
+		if err != nil {
+			return err
+		}
+		for _, found := range evts {
+			evt := found.Event
+			organizers, err := tx.GetOrgUsersWithRoles(zeni.EntityTypeEvent, evt.ID, []string{zeni.RoleOrganizer})
+			if err != nil {
+				return err
+			}
+			participants, err := tx.CountEntities(zeni.EntityTypeEvent, evt.ID, zeni.EntityTypeUser, zeni.RoleParticipant)
+			if err != nil {
+				return err
+			}
+
+			res.Events = append(res.Events, &zenaov1.EventSearchResult{
+				Event: &zenaov1.EventInfo{
+					Id:          evt.ID,
+					Title:       evt.Title,
+					Description: evt.Description,
+					ImageUri:    evt.ImageURI,
+					Organizers: mapsl.Map(organizers, func(u *zeni.User) string {
+						return u.ID
+					}),
+					StartDate:    evt.StartDate.Unix(),
+					EndDate:      evt.EndDate.Unix(),
+					Capacity:     evt.Capacity,
+					Location:     evt.Location,
+					Participants: participants,
+					Discoverable: evt.Discoverable,
+				},
+				TitleHighlight:     searchHighlightToHTML(found.TitleHighlight),
+				DescriptionSnippet: searchHighlightToHTML(found.DescriptionSnippet),
+				VenueHighlight:     searchHighlightToHTML(found.VenueHighlight),
+			})
+		}
+
+		if !withCommunities {
+			return nil
+		}
+		cmts, err := tx.SearchCommunities(query, limit, int(req.Msg.Offset))
+		if err != nil {
+			return err
+		}
+		for _, found := range cmts {
+			cmt := found.Community
+			adm, err := tx.GetOrgUsersWithRoles(zeni.EntityTypeCommunity, cmt.ID, []string{zeni.RoleAdministrator})
+			if err != nil {
+				return err
+			}
+			count, err := tx.CountEntities(zeni.EntityTypeCommunity, cmt.ID, zeni.EntityTypeUser, zeni.RoleMember)
+			if err != nil {
+				return err
+			}
+
+			res.Communities = append(res.Communities, &zenaov1.CommunitySearchResult{
+				Community: &zenaov1.CommunityInfo{
+					Id:          cmt.ID,
+					DisplayName: cmt.DisplayName,
+					Description: cmt.Description,
+					AvatarUri:   cmt.AvatarURI,
+					BannerUri:   cmt.BannerURI,
+					Administrators: mapsl.Map(adm, func(u *zeni.User) string {
+						return u.ID
+					}),
+					CountMembers: count,
+				},
+				NameHighlight:      searchHighlightToHTML(found.NameHighlight),
+				DescriptionSnippet: searchHighlightToHTML(found.DescriptionSnippet),
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// searchHighlightToHTML escapes the user text and turns the match markers into <mark> tags.
+func searchHighlightToHTML(highlight string) string {
+	escaped := html.EscapeString(highlight)
+	escaped = strings.ReplaceAll(escaped, zeni.SearchMatchStart, "<mark>")
+	return strings.ReplaceAll(escaped, zeni.SearchMatchEnd, "</mark>")
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchHighlightToHTML(t *testing.T) {
+	require.Equal(t,
+		"<mark>Go</mark> &amp; <mark>Gno</mark> &lt;meetup&gt;",
+		searchHighlightToHTML(zeni.SearchMatchStart+"Go"+zeni.SearchMatchEnd+" & "+zeni.SearchMatchStart+"Gno"+zeni.SearchMatchEnd+" <meetup>"),
+	)
+}
+
+func TestSearch(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	search := func(req *zenaov1.SearchRequest) *zenaov1.SearchResponse {
+		t.Helper()
+		res, err := f.server.Search(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+		return res.Msg
+	}
+
+	now := time.Now()
+	createEvent := func(title string, description string, venue string, discoverable bool) string {
+		t.Helper()
+		created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+			Title:       title,
+			Description: description,
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+			EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+			Capacity:    100,
+			Location: &zenaov1.EventLocation{
+				VenueName: venue,
+				Address:   &zenaov1.EventLocation_Custom{Custom: &zenaov1.AddressCustom{Address: "Paris", Timezone: "Europe/Paris"}},
+			},
+			Discoverable: discoverable,
+		}))
+		require.NoError(t, err)
+		return created.Msg.Id
+	}
+	inTitle := createEvent("Gnolang workshop", "Hands-on session", "Le Loft", true)
+	inDescription := createEvent("Monthly meetup", "We talk about gnolang and smart contracts", "Station F", true)
+	createEvent("Gnolang private", "Hidden from the search", "Le Loft", false)
+	createEvent("Cooking class", "Nothing to see", "Kitchen", true)
+
+	_, err = f.server.Search(context.Background(), connect.NewRequest(&zenaov1.SearchRequest{Query: "  "}))
+	require.Error(t, err)
+
+	res := search(&zenaov1.SearchRequest{Query: "gnol"})
+	require.Len(t, res.Events, 2)
+	require.Equal(t, inTitle, res.Events[0].Event.Id)
+	require.Equal(t, "<mark>Gnolang</mark> workshop", res.Events[0].TitleHighlight)
+	require.Equal(t, inDescription, res.Events[1].Event.Id)
+	require.Contains(t, res.Events[1].DescriptionSnippet, "<mark>gnolang</mark>")
+	require.Empty(t, res.Communities)
+
+	res = search(&zenaov1.SearchRequest{Query: "loft"})
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "Le <mark>Loft</mark>", res.Events[0].VenueHighlight)
+
+	res = search(&zenaov1.SearchRequest{Query: `gnolang "smart" OR*`})
+	require.Empty(t, res.Events)
+
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+		EventId:     inTitle,
+		Title:       "Rust workshop",
+		Description: "Hands-on session",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    100,
+		Location: &zenaov1.EventLocation{
+			VenueName: "Le Loft",
+			Address:   &zenaov1.EventLocation_Custom{Custom: &zenaov1.AddressCustom{Address: "Paris", Timezone: "Europe/Paris"}},
+		},
+		Discoverable: true,
+	}))
+	require.NoError(t, err)
+	res = search(&zenaov1.SearchRequest{Query: "gnolang"})
+	require.Len(t, res.Events, 1)
+	require.Equal(t, inDescription, res.Events[0].Event.Id)
+
+	res = search(&zenaov1.SearchRequest{Query: "gnolang", To: now.Add(24 * time.Hour).Unix()})
+	require.Empty(t, res.Events)
+
+	res = search(&zenaov1.SearchRequest{Query: "test commu"})
+	require.Len(t, res.Communities, 1)
+	require.Equal(t, community.ID, res.Communities[0].Community.Id)
+	require.Equal(t, "<mark>Test</mark> <mark>community</mark>", res.Communities[0].NameHighlight)
+	res = search(&zenaov1.SearchRequest{Query: "test commu", From: now.Unix()})
+	require.Empty(t, res.Communities)
+}
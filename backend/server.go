@@ -17,6 +17,7 @@ type ZenaoServer struct {
 	DiscordToken      string
 	Maintenance       bool
 	StripeSecretKey   string
+	InvitationSecret  string // signs the invitation tokens, inviting is disabled when empty
 	PaidEventsEnabled bool
 	PaymentProviders  map[string]payment.Payment
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -26,6 +27,8 @@ type checkoutCartRow struct {
 	priceGroup *zeni.PriceGroup
 	quantity   uint32
 	emails     []string
+	// unitAmount is the price amount once the promo code is applied
+	unitAmount int64
 }
 
 type checkoutCart struct {
@@ -34,6 +37,9 @@ type checkoutCart struct {
 	paymentAccount *zeni.PaymentAccount
 	allEmails      []string
 	totalAmount    int64
+	promoCode      *zeni.PromoCode
+	discountAmount int64
+	donationAmount int64
 }
 
 func (s *ZenaoServer) StartTicketPayment(
@@ -70,7 +76,7 @@ func (s *ZenaoServer) StartTicketPayment(
 		priceMap := mapPricesFromGroups(priceGroups)
 		priceGroupsMap := mapPriceGroups(priceGroups)
 
-		cart, err = buildCheckoutCart(req.Msg.LineItems, priceMap, priceGroupsMap)
+		cart, err = buildCheckoutCart(req.Msg.LineItems, priceMap, priceGroupsMap, time.Unix(nowUnix, 0))
 		if err != nil {
 			return err
 		}
@@ -90,38 +96,122 @@ func (s *ZenaoServer) StartTicketPayment(
 		if err != nil {
 			return err
 		}
+		// like Participate, the invitation of the attendee is consumed and guests need their own invitation
+		invited := false
+		if evt.InviteOnly {
+			if len(cart.allEmails) > 1 {
+				return errors.New("guests cannot be added to an invite-only event")
+			}
+			if err := s.acceptEventInvitation(tx, evt, req.Msg.InvitationToken, buyerEmail, attendeesUsers[buyerEmail].ID); err != nil {
+				return err
+			}
+			invited = true
+		}
+		if evt.ApprovalRequired && !invited {
+			if err := ensureApprovedApplicants(tx, evt.ID, cart.allEmails, attendeesUsers); err != nil {
+				return err
+			}
+		}
 
-		paymentProvider, ok = s.PaymentProviders[cart.paymentAccount.PlatformType]
-		if !ok {
-			return errors.New("payment provider not found")
+		questions, err := tx.GetRegistrationQuestions(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		lineItemsAnswers := make(map[string][]*zenaov1.RegistrationAnswer, len(req.Msg.LineItems))
+		for _, item := range req.Msg.LineItems {
+			if len(item.Answers) > 0 {
+				lineItemsAnswers[item.AttendeeEmail] = item.Answers
+			}
+		}
+		answersByEmail, err := registrationAnswersByEmail(questions, cart.allEmails, lineItemsAnswers)
+		if err != nil {
+			return err
 		}
 
 		if err := tx.DeleteExpiredTicketHolds(req.Msg.EventId, nowUnix); err != nil {
 			return err
 		}
 
+		waitlistEntryIDs, err := takeCartWaitlistOfferHolds(tx, req.Msg.EventId, cart, attendeesUsers, nowUnix)
+		if err != nil {
+			return err
+		}
+
 		if err := ensureCheckoutCapacity(tx, req.Msg.EventId, cart, priceGroupsMap, nowUnix); err != nil {
 			return err
 		}
 
+		if err := applyPromoCodeToCart(tx, req.Msg.EventId, req.Msg.PromoCode, cart, nowUnix); err != nil {
+			return err
+		}
+
+		if err := addDonationToCart(req.Msg.DonationAmountMinor, cart); err != nil {
+			return err
+		}
+
+		if cart.totalAmount > 0 {
+			paymentProvider, ok = s.PaymentProviders[cart.paymentAccount.PlatformType]
+			if !ok {
+				return errors.New("payment provider not found")
+			}
+		}
+
 		orderAttendees, err := createOrderAttendeesFromCart(cart, attendeesUsers, nowUnix)
 		if err != nil {
 			return err
 		}
 
-		createdOrder, err = tx.CreateOrder(&zeni.Order{
-			CreatedAt:        nowUnix,
-			EventID:          evt.ID,
-			BuyerID:          buyerID,
-			CurrencyCode:     strings.ToUpper(strings.TrimSpace(cart.currencyCode)),
-			AmountMinor:      cart.totalAmount,
-			Status:           zeni.OrderStatusPending,
-			PaymentProvider:  paymentProvider.PlatformType(),
-			PaymentAccountID: cart.paymentAccount.ID,
-		}, orderAttendees)
+		order := &zeni.Order{
+			CreatedAt:           nowUnix,
+			EventID:             evt.ID,
+			BuyerID:             buyerID,
+			CurrencyCode:        strings.ToUpper(strings.TrimSpace(cart.currencyCode)),
+			AmountMinor:         cart.totalAmount,
+			Status:              zeni.OrderStatusPending,
+			PaymentAccountID:    cart.paymentAccount.ID,
+			DiscountAmountMinor: cart.discountAmount,
+			DonationAmountMinor: cart.donationAmount,
+		}
+		if cart.promoCode != nil {
+			order.PromoCodeID = cart.promoCode.ID
+			order.PromoCode = cart.promoCode.Code
+		}
+		if cart.totalAmount == 0 {
+			// nothing to pay, the tickets are issued in this transaction so they take their seats right away
+			order.Status = zeni.OrderStatusSuccess
+			order.ConfirmedAt = &nowUnix
+			order.TicketIssueStatus = zeni.TicketIssueStatusIssued
+			createdOrder, err = tx.CreateOrder(order, orderAttendees)
+			if err != nil {
+				return err
+			}
+			if err := tx.LinkWaitlistOffersToOrder(waitlistEntryIDs, createdOrder.ID); err != nil {
+				return err
+			}
+			if err := tx.ClaimOrderWaitlistOffers(createdOrder.ID); err != nil {
+				return err
+			}
+			if len(questions) > 0 {
+				if err := saveOrderRegistrationAnswers(tx, createdOrder, attendeesUsers, answersByEmail); err != nil {
+					return err
+				}
+			}
+			_, err = issueOrderTicketsTx(tx, createdOrder)
+			return err
+		}
+		order.PaymentProvider = paymentProvider.PlatformType()
+		createdOrder, err = tx.CreateOrder(order, orderAttendees)
 		if err != nil {
 			return err
 		}
+		if err := tx.LinkWaitlistOffersToOrder(waitlistEntryIDs, createdOrder.ID); err != nil {
+			return err
+		}
+		if len(questions) > 0 {
+			if err := saveOrderRegistrationAnswers(tx, createdOrder, attendeesUsers, answersByEmail); err != nil {
+				return err
+			}
+		}
 
 		if err := createTicketHoldsFromCart(tx, createdOrder.ID, cart, nowUnix, paymentProvider.DefaultHoldTTL()); err != nil {
 			return err
@@ -132,6 +222,10 @@ func (s *ZenaoServer) StartTicketPayment(
 		return nil, err
 	}
 
+	if createdOrder.Status == zeni.OrderStatusSuccess {
+		return s.completeFreeOrder(ctx, req, createdOrder)
+	}
+
 	successURL, err := buildCheckoutRedirectURL(s.AppBaseURL, req.Msg.SuccessPath, map[string]string{
 		"checkout":   "success",
 		"order_id":   createdOrder.ID,
@@ -152,9 +246,19 @@ func (s *ZenaoServer) StartTicketPayment(
 
 	lineItems := []payment.LineItem{}
 	for _, item := range cart.rows {
+		if item.unitAmount == 0 {
+			continue
+		}
 		lineItems = append(lineItems, payment.LineItem{
 			Quantity:    item.quantity,
-			AmountMinor: item.price.AmountMinor,
+			AmountMinor: item.unitAmount,
+		})
+	}
+	if cart.donationAmount > 0 {
+		lineItems = append(lineItems, payment.LineItem{
+			Name:        fmt.Sprintf("Donation: %s", evt.Title),
+			Quantity:    1,
+			AmountMinor: cart.donationAmount,
 		})
 	}
 
@@ -196,6 +300,36 @@ func (s *ZenaoServer) StartTicketPayment(
 	}), nil
 }
 
+// completeFreeOrder confirms an order that had nothing to pay and sends the buyer straight to the success page.
+func (s *ZenaoServer) completeFreeOrder(
+	ctx context.Context,
+	req *connect.Request[zenaov1.StartTicketPaymentRequest],
+	order *zeni.Order,
+) (*connect.Response[zenaov1.StartTicketPaymentResponse], error) {
+	if err := s.sendPurchaseConfirmationEmail(ctx, order); err != nil {
+		s.Logger.Error("purchase-confirmation-mail", zap.Error(err), zap.String("order-id", order.ID))
+	}
+
+	successURL, err := buildCheckoutRedirectURL(s.AppBaseURL, req.Msg.SuccessPath, map[string]string{
+		"checkout": "success",
+		"order_id": order.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("start-ticket-payment",
+		zap.String("event-id", req.Msg.EventId),
+		zap.String("order-id", order.ID),
+		zap.Bool("free", true),
+	)
+
+	return connect.NewResponse(&zenaov1.StartTicketPaymentResponse{
+		CheckoutUrl: successURL,
+		OrderId:     order.ID,
+	}), nil
+}
+
 func resolveBuyerID(
 	actor *Actor,
 	attendeeUsers map[string]*zeni.User,
@@ -215,6 +349,7 @@ func resolveBuyerID(
 func failOrderAndReleaseHolds(ctx context.Context, db zeni.DB, orderID string) {
 	_ = db.WithContext(ctx).UpdateOrderSetStatus(orderID, zeni.OrderStatusFailed)
 	_ = db.WithContext(ctx).DeleteTicketHoldsByOrderID(orderID)
+	_ = db.WithContext(ctx).RestoreOrderWaitlistOffers(orderID, time.Now().Unix())
 }
 
 func validateStartTicketPaymentRequest(
@@ -250,6 +385,9 @@ func loadEventForCheckout(tx zeni.DB, eventID string, password string) (*zeni.Ev
 	if evt == nil {
 		return nil, errors.New("event not found")
 	}
+	if evt.Draft {
+		return nil, errors.New("event is not published")
+	}
 	if evt.PasswordHash == "" {
 		return evt, nil
 	}
@@ -266,7 +404,7 @@ func loadEventForCheckout(tx zeni.DB, eventID string, password string) (*zeni.Ev
 	return evt, nil
 }
 
-func buildCheckoutCart(items []*zenaov1.StartTicketPaymentLineItem, prices map[string]*zeni.Price, priceGroups map[string]*zeni.PriceGroup) (*checkoutCart, error) {
+func buildCheckoutCart(items []*zenaov1.StartTicketPaymentLineItem, prices map[string]*zeni.Price, priceGroups map[string]*zeni.PriceGroup, now time.Time) (*checkoutCart, error) {
 	result := &checkoutCart{
 		rows:           make(map[string]*checkoutCartRow, len(items)),
 		currencyCode:   "",
@@ -282,58 +420,77 @@ func buildCheckoutCart(items []*zenaov1.StartTicketPaymentLineItem, prices map[s
 		if _, ok := prices[item.PriceId]; !ok {
 			return nil, fmt.Errorf("price %s not found", item.PriceId)
 		}
+		if !prices[item.PriceId].OnSaleAt(now) {
+			return nil, fmt.Errorf("price %s is not on sale", item.PriceId)
+		}
 
 		item.AttendeeEmail = strings.TrimSpace(strings.ToLower(item.AttendeeEmail))
 		if err := validateEmailAddress(item.AttendeeEmail); err != nil {
 			return nil, fmt.Errorf("invalid attendee email: %w", err)
 		}
 
-		if result.currencyCode == "" {
-			result.currencyCode = strings.ToUpper(strings.TrimSpace(prices[item.PriceId].CurrencyCode))
-		} else if result.currencyCode != strings.ToUpper(strings.TrimSpace(prices[item.PriceId].CurrencyCode)) {
-			return nil, errors.New("multiple currencies are not supported")
-		}
+		// free tiers have no currency nor payment account, the order uses the ones of the paid tiers
+		if !isFreePrice(prices[item.PriceId]) {
+			if result.currencyCode == "" {
+				result.currencyCode = strings.ToUpper(strings.TrimSpace(prices[item.PriceId].CurrencyCode))
+			} else if result.currencyCode != strings.ToUpper(strings.TrimSpace(prices[item.PriceId].CurrencyCode)) {
+				return nil, errors.New("multiple currencies are not supported")
+			}
 
-		if result.paymentAccount == nil {
-			result.paymentAccount = prices[item.PriceId].PaymentAccount
-		} else if prices[item.PriceId].PaymentAccount == nil {
-			return nil, errors.New("no payment account found")
-		} else if result.paymentAccount.ID != prices[item.PriceId].PaymentAccount.ID {
-			return nil, errors.New("multiple payment accounts are not supported")
+			if prices[item.PriceId].PaymentAccount == nil {
+				return nil, errors.New("no payment account found")
+			} else if result.paymentAccount == nil {
+				result.paymentAccount = prices[item.PriceId].PaymentAccount
+			} else if result.paymentAccount.ID != prices[item.PriceId].PaymentAccount.ID {
+				return nil, errors.New("multiple payment accounts are not supported")
+			}
 		}
 
 		if _, ok := priceGroups[prices[item.PriceId].PriceGroupID]; !ok {
 			return nil, fmt.Errorf("price group %s not found", prices[item.PriceId].PriceGroupID)
 		}
 
-		if _, ok := result.rows[item.PriceId]; !ok {
-			result.rows[item.PriceId] = &checkoutCartRow{
+		unitAmount, err := checkoutUnitAmount(prices[item.PriceId], item.AmountMinor)
+		if err != nil {
+			return nil, err
+		}
+
+		// pay-what-you-want tickets bought at different amounts get their own rows
+		rowKey := item.PriceId
+		if prices[item.PriceId].Kind == zeni.PriceKindPayWhatYouWant {
+			rowKey = fmt.Sprintf("%s/%d", item.PriceId, unitAmount)
+		}
+		if _, ok := result.rows[rowKey]; !ok {
+			result.rows[rowKey] = &checkoutCartRow{
 				price:      prices[item.PriceId],
 				priceGroup: priceGroups[prices[item.PriceId].PriceGroupID],
 				quantity:   0,
 				emails:     nil,
+				unitAmount: unitAmount,
 			}
 		}
 
-		if prices[item.PriceId].AmountMinor <= 0 {
-			return nil, errors.New("event is free")
-		} else if prices[item.PriceId].CurrencyCode == "" {
+		if unitAmount > 0 && prices[item.PriceId].CurrencyCode == "" {
 			return nil, errors.New("missing currency code for price")
 		}
 
-		result.rows[item.PriceId].quantity++
-		result.rows[item.PriceId].emails = append(result.rows[item.PriceId].emails, item.AttendeeEmail)
+		result.rows[rowKey].quantity++
+		result.rows[rowKey].emails = append(result.rows[rowKey].emails, item.AttendeeEmail)
 		result.allEmails = append(result.allEmails, item.AttendeeEmail)
-		result.totalAmount += prices[item.PriceId].AmountMinor
-		result.paymentAccount = prices[item.PriceId].PaymentAccount
+		result.totalAmount += unitAmount
 	}
 
-	if result.paymentAccount == nil {
-		return nil, errors.New("no payment account found")
+	if len(result.allEmails) == 0 {
+		return nil, errors.New("no tickets selected")
 	}
 
-	if result.totalAmount == 0 {
-		return nil, errors.New("no tickets selected")
+	if result.paymentAccount == nil {
+		paidPrice := firstPaidPrice(prices)
+		if paidPrice == nil {
+			return nil, errors.New("event is free")
+		}
+		result.currencyCode = strings.ToUpper(strings.TrimSpace(paidPrice.CurrencyCode))
+		result.paymentAccount = paidPrice.PaymentAccount
 	}
 
 	dedupEmails := append(result.allEmails[:0:0], result.allEmails...)
@@ -347,6 +504,167 @@ func buildCheckoutCart(items []*zenaov1.StartTicketPaymentLineItem, prices map[s
 	return result, nil
 }
 
+// isFreePrice tells whether the price is a zero-amount tier, its tickets are given away without payment.
+func isFreePrice(price *zeni.Price) bool {
+	return price.Kind == zeni.PriceKindFixed && price.AmountMinor == 0
+}
+
+// firstPaidPrice returns the paid price with the lowest numeric id, nil when every price is free.
+func firstPaidPrice(prices map[string]*zeni.Price) *zeni.Price {
+	var first *zeni.Price
+	for _, price := range prices {
+		if isFreePrice(price) || price.PaymentAccount == nil {
+			continue
+		}
+		if first == nil || len(price.ID) < len(first.ID) || (len(price.ID) == len(first.ID) && price.ID < first.ID) {
+			first = price
+		}
+	}
+	return first
+}
+
+// checkoutUnitAmount returns the amount charged for one ticket of the price.
+// Fixed prices ignore the requested amount, pay-what-you-want prices charge it once checked against the price
+// minimum and the lowest amount the payment provider accepts.
+func checkoutUnitAmount(price *zeni.Price, requested int64) (int64, error) {
+	if price.Kind != zeni.PriceKindPayWhatYouWant {
+		if requested != 0 && requested != price.AmountMinor {
+			return 0, fmt.Errorf("price %s has a fixed amount", price.ID)
+		}
+		return price.AmountMinor, nil
+	}
+
+	if requested == 0 {
+		requested = price.AmountMinor
+	}
+	minimum := max(price.MinimumAmountMinor, zeni.StripeMinimumChargeAmount(price.CurrencyCode))
+	if requested < minimum {
+		return 0, fmt.Errorf("amount for price %s must be at least %s", price.ID, formatInvoiceAmount(minimum, price.CurrencyCode))
+	}
+	return requested, nil
+}
+
+// applyPromoCodeToCart validates the promo code against the cart and discounts every matching ticket.
+// An empty code leaves the cart untouched.
+func applyPromoCodeToCart(tx zeni.DB, eventID string, code string, cart *checkoutCart, nowUnix int64) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil
+	}
+
+	promoCode, err := tx.GetPromoCodeByCode(eventID, code)
+	if err != nil {
+		return err
+	}
+	if promoCode == nil {
+		return errors.New("invalid promo code")
+	}
+	if promoCode.StartsAt != nil && nowUnix < *promoCode.StartsAt {
+		return errors.New("promo code is not active yet")
+	}
+	if promoCode.EndsAt != nil && nowUnix >= *promoCode.EndsAt {
+		return errors.New("promo code has expired")
+	}
+	if promoCode.DiscountType == zeni.PromoCodeDiscountFixed && !strings.EqualFold(promoCode.CurrencyCode, cart.currencyCode) {
+		return errors.New("promo code does not apply to this currency")
+	}
+	if promoCode.MaxRedemptions != 0 {
+		redemptions, err := tx.CountPromoCodeRedemptions(promoCode.ID)
+		if err != nil {
+			return err
+		}
+		if redemptions >= promoCode.MaxRedemptions {
+			return errors.New("promo code usage limit reached")
+		}
+	}
+
+	discount := int64(0)
+	for _, row := range cart.rows {
+		if promoCode.PriceID != "" && promoCode.PriceID != row.price.ID {
+			continue
+		}
+		// buyers already choose what they pay for pay-what-you-want tickets
+		if row.price.Kind == zeni.PriceKindPayWhatYouWant {
+			continue
+		}
+
+		unitDiscount := int64(0)
+		switch promoCode.DiscountType {
+		case zeni.PromoCodeDiscountPercent:
+			unitDiscount = row.price.AmountMinor * int64(promoCode.PercentOff) / 100
+		case zeni.PromoCodeDiscountFixed:
+			unitDiscount = min(promoCode.AmountOffMinor, row.price.AmountMinor)
+		default:
+			return fmt.Errorf("unknown promo code discount type %q", promoCode.DiscountType)
+		}
+
+		// a discounted ticket below what the payment provider can charge would fail once the order exists
+		unitAmount := row.price.AmountMinor - unitDiscount
+		if minimum := zeni.StripeMinimumChargeAmount(cart.currencyCode); unitDiscount > 0 && unitAmount < minimum {
+			return fmt.Errorf("promo code would bring the price %s below the minimum of %s", row.price.ID, formatInvoiceAmount(minimum, cart.currencyCode))
+		}
+		row.unitAmount = unitAmount
+		discount += unitDiscount * int64(row.quantity)
+	}
+	if discount == 0 {
+		return errors.New("promo code does not apply to the selected tickets")
+	}
+	if discount >= cart.totalAmount {
+		return errors.New("promo code cannot cover the whole order")
+	}
+
+	cart.promoCode = promoCode
+	cart.discountAmount = discount
+	cart.totalAmount -= discount
+	return nil
+}
+
+// addDonationToCart adds an optional donation on top of the tickets, it does not take any seat.
+// The donation, like the whole order, must reach the minimum the payment provider can charge.
+func addDonationToCart(amountMinor int64, cart *checkoutCart) error {
+	if amountMinor < 0 {
+		return errors.New("donation amount cannot be negative")
+	}
+	minimum := zeni.StripeMinimumChargeAmount(cart.currencyCode)
+	if amountMinor > 0 && amountMinor < minimum {
+		return fmt.Errorf("donation amount must be at least %s", formatInvoiceAmount(minimum, cart.currencyCode))
+	}
+	cart.donationAmount = amountMinor
+	cart.totalAmount += amountMinor
+	if cart.totalAmount > 0 && cart.totalAmount < minimum {
+		return fmt.Errorf("order total must be at least %s", formatInvoiceAmount(minimum, cart.currencyCode))
+	}
+	return nil
+}
+
+// takeCartWaitlistOfferHolds releases the spots offered from the waitlist to the attendees for the price group
+// they are buying, so the order holds take them over. It returns the offered entries.
+func takeCartWaitlistOfferHolds(
+	tx zeni.DB,
+	eventID string,
+	cart *checkoutCart,
+	attendeeUsers map[string]*zeni.User,
+	nowUnix int64,
+) ([]string, error) {
+	entryIDs := []string{}
+	for _, row := range cart.rows {
+		for _, email := range row.emails {
+			user, ok := attendeeUsers[email]
+			if !ok {
+				continue
+			}
+			entryID, err := tx.TakeWaitlistOfferHold(eventID, row.priceGroup.ID, user.ID, nowUnix)
+			if err != nil {
+				return nil, err
+			}
+			if entryID != "" {
+				entryIDs = append(entryIDs, entryID)
+			}
+		}
+	}
+	return entryIDs, nil
+}
+
 func ensureCheckoutCapacity(
 	tx zeni.DB,
 	eventID string,
@@ -401,8 +719,8 @@ func createOrderAttendeesFromCart(
 				PriceID:      row.price.ID,
 				PriceGroupID: row.priceGroup.ID,
 				UserID:       user.ID,
-				AmountMinor:  row.price.AmountMinor,
-				CurrencyCode: row.price.CurrencyCode,
+				AmountMinor:  row.unitAmount,
+				CurrencyCode: cmp.Or(row.price.CurrencyCode, cart.currencyCode),
 			})
 		}
 	}
@@ -414,6 +732,24 @@ func createOrderAttendeesFromCart(
 	return attendees, nil
 }
 
+// saveOrderRegistrationAnswers stores the registration answers of each attendee alongside its order attendee.
+func saveOrderRegistrationAnswers(tx zeni.DB, order *zeni.Order, attendeeUsers map[string]*zeni.User, answersByEmail map[string][]*zeni.RegistrationAnswer) error {
+	attendees, err := tx.GetOrderAttendees(order.ID)
+	if err != nil {
+		return err
+	}
+	attendeeIDs := make(map[string]string, len(attendees))
+	for _, attendee := range attendees {
+		attendeeIDs[attendee.UserID] = attendee.ID
+	}
+	for email, user := range attendeeUsers {
+		if err := tx.SaveRegistrationAnswers(order.EventID, user.ID, attendeeIDs[user.ID], answersByEmail[email]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createTicketHoldsFromCart(tx zeni.DB, orderID string, cart *checkoutCart, nowUnix int64, ttl time.Duration) error {
 	holdExpiresAt := nowUnix + int64((ttl + ticketHoldExpiryBuffer).Seconds())
 	for _, row := range cart.rows {
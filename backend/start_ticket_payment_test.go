@@ -99,6 +99,137 @@ func (a *ticketPaymentStubAuth) ensureAuthUser(email string) *zeni.AuthUser {
 	return auth
 }
 
+type paidEventFixture struct {
+	db       zeni.DB
+	sqlDB    *sql.DB
+	auth     *ticketPaymentStubAuth
+	server   *ZenaoServer
+	eventID  string
+	priceIDs []string
+	sessions *[]*stripe.CheckoutSessionParams
+}
+
+// setupPaidEventFixture creates an event organized by org@example.com with one price per given price
+// in a single price group, the stripe checkout session creation is stubbed and recorded.
+func setupPaidEventFixture(t *testing.T, prices ...*zenaov1.EventPrice) *paidEventFixture {
+	db, sqlDB := ztesting.SetupTestDB(t)
+	auth := &ticketPaymentStubAuth{}
+	auth.user = auth.ensureAuthUser("org@example.com")
+	server := &ZenaoServer{
+		Logger:           zap.NewNop(),
+		Auth:             auth,
+		DB:               db,
+		AppBaseURL:       "https://zenao.test",
+		StripeSecretKey:  "sk_test_123",
+		PaymentProviders: testPaymentProviders("sk_test_123"),
+	}
+
+	organizer, err := db.CreateUser(auth.user.ID)
+	require.NoError(t, err)
+
+	community, err := db.CreateCommunity(
+		organizer.ID,
+		[]string{organizer.ID},
+		[]string{},
+		[]string{},
+		&zenaov1.CreateCommunityRequest{DisplayName: "Test community"},
+	)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	_, err = db.UpsertPaymentAccount(&zeni.PaymentAccount{
+		CommunityID:       community.ID,
+		PlatformType:      zeni.PaymentPlatformStripeConnect,
+		PlatformAccountID: "acct_123",
+		OnboardingState:   zeni.PaymentOnboardingStateCompleted,
+		StartedAt:         now,
+		VerificationState: zeni.PaymentVerificationStateVerified,
+		LastVerifiedAt:    &now,
+	})
+	require.NoError(t, err)
+
+	if len(prices) == 0 {
+		prices = []*zenaov1.EventPrice{{AmountMinor: 2500, CurrencyCode: "EUR"}}
+	}
+
+	createResp, err := server.CreateEvent(
+		context.Background(),
+		connect.NewRequest(&zenaov1.CreateEventRequest{
+			Title:       "Paid event",
+			Description: "test description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+			EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+			Capacity:    100,
+			Location: &zenaov1.EventLocation{
+				Address: &zenaov1.EventLocation_Virtual{
+					Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"},
+				},
+			},
+			CommunityId:  community.ID,
+			Discoverable: true,
+			PricesGroups: []*zenaov1.EventPriceGroup{{Prices: prices}},
+		}),
+	)
+	require.NoError(t, err)
+
+	priceGroups, err := db.GetPriceGroupsByEvent(createResp.Msg.Id)
+	require.NoError(t, err)
+	require.Len(t, priceGroups, 1)
+	require.Len(t, priceGroups[0].Prices, len(prices))
+	priceIDs := make([]string, 0, len(prices))
+	for _, price := range priceGroups[0].Prices {
+		priceIDs = append(priceIDs, price.ID)
+	}
+
+	sessions := []*stripe.CheckoutSessionParams{}
+	originalStripeNew := zpstripe.CheckoutSessionNew
+	zpstripe.CheckoutSessionNew = func(params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+		sessions = append(sessions, params)
+		return &stripe.CheckoutSession{ID: fmt.Sprintf("cs_test_%d", len(sessions)), URL: "https://checkout.test"}, nil
+	}
+	t.Cleanup(func() { zpstripe.CheckoutSessionNew = originalStripeNew })
+
+	return &paidEventFixture{
+		db:       db,
+		sqlDB:    sqlDB,
+		auth:     auth,
+		server:   server,
+		eventID:  createResp.Msg.Id,
+		priceIDs: priceIDs,
+		sessions: &sessions,
+	}
+}
+
+// startCheckout starts a logged out checkout of one ticket of the given price per attendee.
+func (f *paidEventFixture) startCheckout(priceID string, promoCode string, attendeeEmails ...string) (*zenaov1.StartTicketPaymentResponse, error) {
+	lineItems := make([]*zenaov1.StartTicketPaymentLineItem, 0, len(attendeeEmails))
+	for _, email := range attendeeEmails {
+		lineItems = append(lineItems, &zenaov1.StartTicketPaymentLineItem{PriceId: priceID, AttendeeEmail: email})
+	}
+
+	return f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: lineItems,
+		PromoCode: promoCode,
+	})
+}
+
+// startCheckoutRequest starts a logged out checkout of the fixture event with the given request.
+func (f *paidEventFixture) startCheckoutRequest(req *zenaov1.StartTicketPaymentRequest) (*zenaov1.StartTicketPaymentResponse, error) {
+	user := f.auth.user
+	f.auth.user = nil
+	defer func() { f.auth.user = user }()
+
+	req.EventId = f.eventID
+	req.SuccessPath = "/event/" + f.eventID
+	req.CancelPath = "/event/" + f.eventID
+	resp, err := f.server.StartTicketPayment(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 func TestStartTicketPaymentCreatesOrderAndHold(t *testing.T) {
 	db, sqlDB := ztesting.SetupTestDB(t)
 	organizerAuth := &ticketPaymentStubAuth{}
@@ -960,3 +1091,147 @@ func TestStartTicketPaymentUsesFirstEmailForBuyerWhenLoggedOut(t *testing.T) {
 
 	require.Equal(t, expectedBuyerID, buyerID)
 }
+
+func TestStartTicketPaymentRejectsPriceOutsideSaleWindow(t *testing.T) {
+	switchAt := time.Now().Add(time.Hour).Unix()
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2000, CurrencyCode: "EUR", SaleEndsAt: switchAt},
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR", SaleStartsAt: switchAt},
+	)
+	earlyBirdID, lateID := f.priceIDs[0], f.priceIDs[1]
+
+	_, err := f.startCheckout(lateID, "", "buyer@example.com")
+	require.ErrorContains(t, err, "not on sale")
+	_, err = f.startCheckout(earlyBirdID, "", "buyer@example.com")
+	require.NoError(t, err)
+
+	resp, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Event.PricesGroups, 1)
+	group := resp.Msg.Event.PricesGroups[0]
+	require.Equal(t, earlyBirdID, group.ActivePriceId)
+	require.Equal(t, switchAt, group.NextPriceChangeAt)
+	require.Equal(t, switchAt, group.Prices[1].SaleStartsAt)
+
+	require.ErrorContains(t, validatePriceGroups([]*zenaov1.EventPriceGroup{{
+		Prices: []*zenaov1.EventPrice{{AmountMinor: 2000, CurrencyCode: "EUR", SaleStartsAt: switchAt, SaleEndsAt: switchAt}},
+	}}), "must end after it starts")
+}
+
+func TestStartTicketPaymentPayWhatYouWantAndDonation(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 1500, CurrencyCode: "EUR", Kind: string(zeni.PriceKindPayWhatYouWant), MinimumAmountMinor: 500},
+	)
+	priceID := f.priceIDs[0]
+
+	_, err := f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{{PriceId: priceID, AttendeeEmail: "low@example.com", AmountMinor: 400}},
+	})
+	require.ErrorContains(t, err, "must be at least 5.00 EUR")
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems:           []*zenaov1.StartTicketPaymentLineItem{{PriceId: priceID, AttendeeEmail: "low@example.com"}},
+		DonationAmountMinor: -1,
+	})
+	require.ErrorContains(t, err, "donation amount cannot be negative")
+
+	_, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems:           []*zenaov1.StartTicketPaymentLineItem{{PriceId: priceID, AttendeeEmail: "low@example.com"}},
+		DonationAmountMinor: 10,
+	})
+	require.ErrorContains(t, err, "donation amount must be at least 0.50 EUR")
+	require.Empty(t, *f.sessions)
+
+	resp, err := f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{
+			{PriceId: priceID, AttendeeEmail: "alice@example.com", AmountMinor: 3000},
+			{PriceId: priceID, AttendeeEmail: "bob@example.com"},
+		},
+		DonationAmountMinor: 1000,
+	})
+	require.NoError(t, err)
+
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, int64(5500), order.AmountMinor)
+	require.Equal(t, int64(1000), order.DonationAmountMinor)
+
+	attendees, err := f.db.GetOrderAttendees(resp.OrderId)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{3000, 1500}, []int64{attendees[0].AmountMinor, attendees[1].AmountMinor})
+
+	var heldCount int64
+	require.NoError(t, f.sqlDB.QueryRow("SELECT COALESCE(SUM(quantity), 0) FROM ticket_holds WHERE order_id = ?", resp.OrderId).Scan(&heldCount))
+	require.Equal(t, int64(2), heldCount)
+
+	require.ErrorContains(t, validatePriceGroups([]*zenaov1.EventPriceGroup{{
+		Prices: []*zenaov1.EventPrice{{AmountMinor: 1000, CurrencyCode: "EUR", Kind: string(zeni.PriceKindPayWhatYouWant), MinimumAmountMinor: 2000}},
+	}}), "minimum amount must be between 0 and the suggested amount")
+}
+
+func TestStartTicketPaymentIssuesFreeTierWithoutPayment(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 0},
+	)
+	paidID, freeID := f.priceIDs[0], f.priceIDs[1]
+
+	resp, err := f.startCheckout(freeID, "", "speaker@example.com")
+	require.NoError(t, err)
+	require.Contains(t, resp.CheckoutUrl, "https://zenao.test/event/"+f.eventID)
+	require.Contains(t, resp.CheckoutUrl, "order_id="+resp.OrderId)
+	require.Empty(t, *f.sessions)
+
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, zeni.OrderStatusSuccess, order.Status)
+	require.Equal(t, int64(0), order.AmountMinor)
+	require.Equal(t, "EUR", order.CurrencyCode)
+	require.Equal(t, zeni.TicketIssueStatusIssued, order.TicketIssueStatus)
+
+	var ticketCount int
+	require.NoError(t, f.sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE order_id = ?", resp.OrderId).Scan(&ticketCount))
+	require.Equal(t, 1, ticketCount)
+
+	confirmResp, err := f.server.ConfirmTicketPayment(context.Background(), connect.NewRequest(&zenaov1.ConfirmTicketPaymentRequest{OrderId: resp.OrderId}))
+	require.NoError(t, err)
+	require.Equal(t, string(zeni.OrderStatusSuccess), confirmResp.Msg.Status)
+
+	resp, err = f.startCheckoutRequest(&zenaov1.StartTicketPaymentRequest{
+		LineItems: []*zenaov1.StartTicketPaymentLineItem{
+			{PriceId: paidID, AttendeeEmail: "buyer@example.com"},
+			{PriceId: freeID, AttendeeEmail: "student@example.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://checkout.test", resp.CheckoutUrl)
+	require.Len(t, *f.sessions, 1)
+	require.Len(t, (*f.sessions)[0].LineItems, 1)
+	require.Equal(t, int64(2500), *(*f.sessions)[0].LineItems[0].PriceData.UnitAmount)
+
+	order, err = f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.Equal(t, zeni.OrderStatusPending, order.Status)
+	require.Equal(t, int64(2500), order.AmountMinor)
+	attendees, err := f.db.GetOrderAttendees(resp.OrderId)
+	require.NoError(t, err)
+	require.Len(t, attendees, 2)
+}
+
+func TestStartTicketPaymentFreeTierRespectsCapacity(t *testing.T) {
+	f := setupPaidEventFixture(t,
+		&zenaov1.EventPrice{AmountMinor: 2500, CurrencyCode: "EUR"},
+		&zenaov1.EventPrice{AmountMinor: 0},
+	)
+	priceGroups, err := f.db.GetPriceGroupsByEvent(f.eventID)
+	require.NoError(t, err)
+	_, err = f.sqlDB.Exec("UPDATE price_groups SET capacity = 1 WHERE id = ?", priceGroups[0].ID)
+	require.NoError(t, err)
+
+	_, err = f.startCheckout(f.priceIDs[1], "", "speaker@example.com")
+	require.NoError(t, err)
+	_, err = f.startCheckout(f.priceIDs[1], "", "student@example.com")
+	require.ErrorContains(t, err, "sold out")
+	_, err = f.startCheckout(f.priceIDs[0], "", "buyer@example.com")
+	require.ErrorContains(t, err, "sold out")
+}
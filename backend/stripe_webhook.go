@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/payment/zpstripe"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/webhook"
+	"go.uber.org/zap"
+)
+
+const (
+	stripeWebhookPath         = "/webhooks/stripe"
+	stripeWebhookMaxBodyBytes = 65536
+)
+
+// StripeWebhookHandler returns an http handler receiving Stripe Connect events.
+// Checkout session events drive the same confirmation path as ConfirmTicketPayment,
+// so buyers get their tickets even if they never come back to the app.
+func (s *ZenaoServer) StripeWebhookHandler(webhookSecret string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, stripeWebhookMaxBodyBytes))
+		if err != nil {
+			s.Logger.Error("stripe-webhook", zap.Error(err))
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		event, err := webhook.ConstructEventWithOptions(payload, r.Header.Get("Stripe-Signature"), webhookSecret, webhook.ConstructEventOptions{
+			// connected accounts may be pinned to another api version, we only read stable checkout session fields
+			IgnoreAPIVersionMismatch: true,
+		})
+		if err != nil {
+			s.Logger.Error("stripe-webhook", zap.Error(err))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := s.handleStripeEvent(r, &event); err != nil {
+			s.Logger.Error("stripe-webhook", zap.Error(err), zap.String("event-id", event.ID), zap.String("event-type", string(event.Type)))
+			// non-2xx makes stripe retry the delivery later
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *ZenaoServer) handleStripeEvent(r *http.Request, event *stripe.Event) error {
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted,
+		stripe.EventTypeCheckoutSessionAsyncPaymentSucceeded,
+		stripe.EventTypeCheckoutSessionExpired,
+		stripe.EventTypeCheckoutSessionAsyncPaymentFailed:
+	default:
+		s.Logger.Info("stripe-webhook-ignored", zap.String("event-id", event.ID), zap.String("event-type", string(event.Type)))
+		return nil
+	}
+
+	if event.Data == nil {
+		return errors.New("event data is required")
+	}
+	var session stripe.CheckoutSession
+	if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
+		return err
+	}
+
+	ctx := r.Context()
+	order, err := s.getStripeWebhookOrder(r, event, &session)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return nil
+	}
+
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted, stripe.EventTypeCheckoutSessionAsyncPaymentSucceeded:
+		status, err := zpstripe.MapPaymentStatus(session.PaymentStatus)
+		if err != nil {
+			return err
+		}
+		if mapCheckoutPaymentStatus(status) != zeni.OrderStatusSuccess {
+			// async payment methods complete the session before the funds arrive,
+			// a later async_payment_succeeded or async_payment_failed event settles the order
+			return nil
+		}
+		intentID := ""
+		if session.PaymentIntent != nil {
+			intentID = session.PaymentIntent.ID
+		}
+		if err := s.confirmOrderPayment(ctx, order, intentID); err != nil {
+			return err
+		}
+	case stripe.EventTypeCheckoutSessionExpired, stripe.EventTypeCheckoutSessionAsyncPaymentFailed:
+		if order.Status != zeni.OrderStatusPending {
+			return nil
+		}
+		if err := s.DB.WithContext(ctx).UpdateOrderSetStatus(order.ID, zeni.OrderStatusFailed); err != nil {
+			return err
+		}
+		if err := s.DB.WithContext(ctx).DeleteTicketHoldsByOrderID(order.ID); err != nil {
+			return err
+		}
+		if err := s.DB.WithContext(ctx).RestoreOrderWaitlistOffers(order.ID, time.Now().Unix()); err != nil {
+			return err
+		}
+	}
+
+	s.Logger.Info("stripe-webhook",
+		zap.String("event-id", event.ID),
+		zap.String("event-type", string(event.Type)),
+		zap.String("order-id", order.ID),
+	)
+	return nil
+}
+
+// getStripeWebhookOrder returns the order targeted by the checkout session or nil if
+// the session does not belong to a known order.
+func (s *ZenaoServer) getStripeWebhookOrder(r *http.Request, event *stripe.Event, session *stripe.CheckoutSession) (*zeni.Order, error) {
+	orderID := strings.TrimSpace(session.Metadata["order_id"])
+	if orderID == "" {
+		orderID = strings.TrimSpace(session.ClientReferenceID)
+	}
+	if orderID == "" {
+		s.Logger.Info("stripe-webhook-ignored", zap.String("event-id", event.ID), zap.String("session-id", session.ID))
+		return nil, nil
+	}
+
+	order, err := s.DB.WithContext(r.Context()).GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		s.Logger.Info("stripe-webhook-ignored", zap.String("event-id", event.ID), zap.String("order-id", orderID))
+		return nil, nil
+	}
+
+	if order.PaymentProvider != zeni.PaymentPlatformStripeConnect ||
+		(strings.TrimSpace(order.PaymentSessionID) != "" && order.PaymentSessionID != session.ID) {
+		s.Logger.Warn("stripe-webhook-session-mismatch", zap.String("event-id", event.ID), zap.String("order-id", orderID), zap.String("session-id", session.ID))
+		return nil, nil
+	}
+
+	if event.Account != "" {
+		account, err := s.DB.WithContext(r.Context()).GetOrderPaymentAccount(orderID)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil || account.PlatformAccountID != event.Account {
+			s.Logger.Warn("stripe-webhook-account-mismatch", zap.String("event-id", event.ID), zap.String("order-id", orderID), zap.String("account-id", event.Account))
+			return nil, nil
+		}
+	}
+
+	return order, nil
+}
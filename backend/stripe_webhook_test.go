@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/webhook"
+	"go.uber.org/zap"
+)
+
+const testStripeWebhookSecret = "whsec_test_123"
+
+func newStripeWebhookEventPayload(t *testing.T, eventType stripe.EventType, account string, session map[string]any) []byte {
+	payload, err := json.Marshal(map[string]any{
+		"id":          "evt_test_123",
+		"object":      "event",
+		"type":        eventType,
+		"account":     account,
+		"api_version": stripe.APIVersion,
+		"data":        map[string]any{"object": session},
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func postStripeWebhook(t *testing.T, server *ZenaoServer, payload []byte, secret string) int {
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload:   payload,
+		Secret:    secret,
+		Timestamp: time.Now(),
+	})
+
+	req := httptest.NewRequest(http.MethodPost, stripeWebhookPath, bytes.NewReader(signed.Payload))
+	req.Header.Set("Stripe-Signature", signed.Header)
+	rec := httptest.NewRecorder()
+	server.StripeWebhookHandler(testStripeWebhookSecret).ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestStripeWebhookCompletedConfirmsOrderOnce(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixtureWithAttendees(
+		t,
+		[]string{"buyer@example.com", "guest@example.com"},
+	)
+	mailClient, sendCount := newTestResendClient(t)
+
+	server := &ZenaoServer{
+		Logger:     zap.NewNop(),
+		Auth:       checkoutAuth,
+		DB:         db,
+		MailClient: mailClient,
+		MailSender: "contact@mail.zenao.io",
+	}
+
+	payload := newStripeWebhookEventPayload(t, stripe.EventTypeCheckoutSessionCompleted, "acct_123", map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"payment_status": "paid",
+		"payment_intent": "pi_test_123",
+		"metadata":       map[string]string{"order_id": orderID},
+	})
+
+	require.Equal(t, http.StatusOK, postStripeWebhook(t, server, payload, testStripeWebhookSecret))
+	// stripe delivers events at least once
+	require.Equal(t, http.StatusOK, postStripeWebhook(t, server, payload, testStripeWebhookSecret))
+
+	row := sqlDB.QueryRow("SELECT status, payment_intent_id, confirmed_at, ticket_issue_status FROM orders WHERE id = ?", orderID)
+	var status string
+	var intent, issueStatus string
+	var confirmed *int64
+	require.NoError(t, row.Scan(&status, &intent, &confirmed, &issueStatus))
+	require.Equal(t, string(zeni.OrderStatusSuccess), status)
+	require.Equal(t, "pi_test_123", intent)
+	require.NotNil(t, confirmed)
+	require.Equal(t, string(zeni.TicketIssueStatusIssued), issueStatus)
+	require.Equal(t, 1, *sendCount)
+
+	var ticketCount int64
+	row = sqlDB.QueryRow("SELECT COUNT(*) FROM sold_tickets WHERE order_id = ?", orderID)
+	require.NoError(t, row.Scan(&ticketCount))
+	require.Equal(t, int64(2), ticketCount)
+}
+
+func TestStripeWebhookCompletedUnpaidKeepsOrderPending(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	server := &ZenaoServer{Logger: zap.NewNop(), Auth: checkoutAuth, DB: db}
+
+	payload := newStripeWebhookEventPayload(t, stripe.EventTypeCheckoutSessionCompleted, "acct_123", map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"payment_status": "unpaid",
+		"metadata":       map[string]string{"order_id": orderID},
+	})
+	require.Equal(t, http.StatusOK, postStripeWebhook(t, server, payload, testStripeWebhookSecret))
+
+	var status string
+	require.NoError(t, sqlDB.QueryRow("SELECT status FROM orders WHERE id = ?", orderID).Scan(&status))
+	require.Equal(t, string(zeni.OrderStatusPending), status)
+}
+
+func TestStripeWebhookExpiredFailsOrderAndReleasesHolds(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	server := &ZenaoServer{Logger: zap.NewNop(), Auth: checkoutAuth, DB: db}
+
+	var holdCount int64
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM ticket_holds WHERE order_id = ?", orderID).Scan(&holdCount))
+	require.NotZero(t, holdCount)
+
+	payload := newStripeWebhookEventPayload(t, stripe.EventTypeCheckoutSessionExpired, "acct_123", map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"payment_status": "unpaid",
+		"metadata":       map[string]string{"order_id": orderID},
+	})
+	require.Equal(t, http.StatusOK, postStripeWebhook(t, server, payload, testStripeWebhookSecret))
+
+	var status string
+	require.NoError(t, sqlDB.QueryRow("SELECT status FROM orders WHERE id = ?", orderID).Scan(&status))
+	require.Equal(t, string(zeni.OrderStatusFailed), status)
+	require.NoError(t, sqlDB.QueryRow("SELECT COUNT(*) FROM ticket_holds WHERE order_id = ?", orderID).Scan(&holdCount))
+	require.Zero(t, holdCount)
+}
+
+func TestStripeWebhookRejectsInvalidSignature(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	server := &ZenaoServer{Logger: zap.NewNop(), Auth: checkoutAuth, DB: db}
+
+	payload := newStripeWebhookEventPayload(t, stripe.EventTypeCheckoutSessionCompleted, "acct_123", map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"payment_status": "paid",
+		"metadata":       map[string]string{"order_id": orderID},
+	})
+	require.Equal(t, http.StatusBadRequest, postStripeWebhook(t, server, payload, "whsec_wrong"))
+
+	var status string
+	require.NoError(t, sqlDB.QueryRow("SELECT status FROM orders WHERE id = ?", orderID).Scan(&status))
+	require.Equal(t, string(zeni.OrderStatusPending), status)
+}
+
+func TestStripeWebhookIgnoresOtherAccount(t *testing.T) {
+	db, sqlDB, orderID, sessionID, checkoutAuth := setupPaymentConfirmationFixture(t)
+
+	server := &ZenaoServer{Logger: zap.NewNop(), Auth: checkoutAuth, DB: db}
+
+	payload := newStripeWebhookEventPayload(t, stripe.EventTypeCheckoutSessionCompleted, "acct_other", map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"payment_status": "paid",
+		"metadata":       map[string]string{"order_id": orderID},
+	})
+	require.Equal(t, http.StatusOK, postStripeWebhook(t, server, payload, testStripeWebhookSecret))
+
+	var status string
+	require.NoError(t, sqlDB.QueryRow("SELECT status FROM orders WHERE id = ?", orderID).Scan(&status))
+	require.Equal(t, string(zeni.OrderStatusPending), status)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+)
+
+const (
+	maxTags         = 10
+	maxFilterTags   = 5
+	minTagLength    = 2
+	maxTagLength    = 32
+	defaultTagLimit = 30
+	maxTagLimit     = 100
+)
+
+func validateCategory(category string) error {
+	if category != "" && !slices.Contains(zeni.Categories, category) {
+		return fmt.Errorf("unknown category %q, should be one of: %s", category, strings.Join(zeni.Categories, ", "))
+	}
+	return nil
+}
+
+// normalizeTags lowercases the tags, drops a leading # and joins the words with dashes.
+// The result is sorted and without duplicates.
+func normalizeTags(tags []string, limit int) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		tag = strings.Join(strings.Fields(tag), "-")
+		if len([]rune(tag)) < minTagLength || len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("tag %q should be %d to %d characters long", tag, minTagLength, maxTagLength)
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-' {
+				return nil, fmt.Errorf("tag %q can only contain letters, numbers and dashes", tag)
+			}
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+	if len(normalized) > limit {
+		return nil, fmt.Errorf("cannot have more than %d tags", limit)
+	}
+	return normalized, nil
+}
+
+// validateTaxonomy checks the category and returns the normalized tags.
+func validateTaxonomy(category string, tags []string) ([]string, error) {
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+	return normalizeTags(tags, maxTags)
+}
+
+// listTagFilter returns the filter of a listing, nil if it is not filtered.
+func listTagFilter(category string, tags []string) (*zeni.TagFilter, error) {
+	if category == "" && len(tags) == 0 {
+		return nil, nil
+	}
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeTags(tags, maxFilterTags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tags filter: %w", err)
+	}
+	return &zeni.TagFilter{Category: category, Tags: normalized}, nil
+}
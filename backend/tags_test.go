@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags([]string{"#Go", " web 3 ", "go", "Café"}, maxTags)
+	require.NoError(t, err)
+	require.Equal(t, []string{"café", "go", "web-3"}, tags)
+
+	_, err = normalizeTags([]string{"c"}, maxTags)
+	require.Error(t, err)
+	_, err = normalizeTags([]string{"c++"}, maxTags)
+	require.Error(t, err)
+	_, err = normalizeTags([]string{"aa", "bb", "cc"}, 2)
+	require.Error(t, err)
+
+	require.NoError(t, validateCategory(""))
+	require.NoError(t, validateCategory("music"))
+	require.Error(t, validateCategory("Music"))
+}
+
+func TestEventTags(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	community, err := f.db.GetEventCommunity(f.eventID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	createReq := func(title string, category string, tags ...string) *zenaov1.CreateEventRequest {
+		return &zenaov1.CreateEventRequest{
+			Title:       title,
+			Description: "test description",
+			ImageUri:    "ipfs://image",
+			StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+			EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+			Capacity:    100,
+			Location: &zenaov1.EventLocation{
+				Address: &zenaov1.EventLocation_Virtual{Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"}},
+			},
+			Discoverable: true,
+			Category:     category,
+			Tags:         tags,
+		}
+	}
+
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(createReq("Bad", "techno")))
+	require.ErrorContains(t, err, "unknown category")
+
+	created, err := f.server.CreateEvent(context.Background(), connect.NewRequest(createReq("Go workshop", "tech", "Golang", "#web3")))
+	require.NoError(t, err)
+	goID := created.Msg.Id
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(createReq("Rust workshop", "tech", "rust", "web3")))
+	require.NoError(t, err)
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(createReq("Jazz night", "music", "jazz")))
+	require.NoError(t, err)
+	hidden := createReq("Private jam", "music", "jazz")
+	hidden.Discoverable = false
+	_, err = f.server.CreateEvent(context.Background(), connect.NewRequest(hidden))
+	require.NoError(t, err)
+
+	got, err := f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: goID}))
+	require.NoError(t, err)
+	require.Equal(t, "tech", got.Msg.Event.Category)
+	require.Equal(t, []string{"golang", "web3"}, got.Msg.Event.Tags)
+
+	listed, err := f.server.ListEvents(context.Background(), connect.NewRequest(&zenaov1.ListEventsRequest{Limit: 10, Category: "tech"}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Events, 2)
+	listed, err = f.server.ListEvents(context.Background(), connect.NewRequest(&zenaov1.ListEventsRequest{Limit: 10, Tags: []string{"web3", "Golang"}}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Events, 1)
+	require.Equal(t, goID, listed.Msg.Events[0].Id)
+	require.Equal(t, []string{"golang", "web3"}, listed.Msg.Events[0].Tags)
+
+	tags, err := f.server.ListTags(context.Background(), connect.NewRequest(&zenaov1.ListTagsRequest{EntityType: zeni.EntityTypeEvent}))
+	require.NoError(t, err)
+	require.Len(t, tags.Msg.Categories, len(zeni.Categories))
+	require.Equal(t, &zenaov1.TagCount{Name: "tech", Count: 2}, tags.Msg.Categories[0])
+	require.Equal(t, []*zenaov1.TagCount{
+		{Name: "web3", Count: 2},
+		{Name: "golang", Count: 1},
+		{Name: "jazz", Count: 1},
+		{Name: "rust", Count: 1},
+	}, tags.Msg.Tags)
+
+	src, err := f.db.GetEvent(goID)
+	require.NoError(t, err)
+	_, err = f.server.EditEvent(context.Background(), connect.NewRequest(&zenaov1.EditEventRequest{
+		EventId:      goID,
+		Title:        src.Title,
+		Description:  src.Description,
+		ImageUri:     src.ImageURI,
+		StartDate:    uint64(src.StartDate.Unix()),
+		EndDate:      uint64(src.EndDate.Unix()),
+		Capacity:     src.Capacity,
+		Location:     src.Location,
+		Discoverable: true,
+		UpdateTags:   true,
+		Category:     "workshop",
+		Tags:         []string{"golang"},
+	}))
+	require.NoError(t, err)
+	got, err = f.server.GetEvent(context.Background(), connect.NewRequest(&zenaov1.GetEventRequest{EventId: goID}))
+	require.NoError(t, err)
+	require.Equal(t, "workshop", got.Msg.Event.Category)
+	require.Equal(t, []string{"golang"}, got.Msg.Event.Tags)
+
+	_, err = f.server.CreateCommunity(context.Background(), connect.NewRequest(&zenaov1.CreateCommunityRequest{
+		DisplayName: "Jazz lovers",
+		Description: "A community about jazz",
+		AvatarUri:   "ipfs://avatar",
+		Category:    "music",
+		Tags:        []string{"Jazz"},
+	}))
+	require.NoError(t, err)
+	cmts, err := f.server.ListCommunities(context.Background(), connect.NewRequest(&zenaov1.ListCommunitiesRequest{Limit: 10, Tags: []string{"jazz"}}))
+	require.NoError(t, err)
+	require.Len(t, cmts.Msg.Communities, 1)
+	require.Equal(t, "music", cmts.Msg.Communities[0].Category)
+	require.Equal(t, []string{"jazz"}, cmts.Msg.Communities[0].Tags)
+	cmts, err = f.server.ListCommunities(context.Background(), connect.NewRequest(&zenaov1.ListCommunitiesRequest{Limit: 10}))
+	require.NoError(t, err)
+	require.Len(t, cmts.Msg.Communities, 2)
+	require.NotEqual(t, community.ID, cmts.Msg.Communities[0].Id)
+
+	_, err = f.server.ListTags(context.Background(), connect.NewRequest(&zenaov1.ListTagsRequest{EntityType: "user"}))
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/resend/resend-go/v2"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) TransferTicket(
+	ctx context.Context,
+	req *connect.Request[zenaov1.TransferTicketRequest],
+) (*connect.Response[zenaov1.TransferTicketResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("transfer-ticket", zap.String("event-id", req.Msg.EventId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	email := strings.TrimSpace(req.Msg.Email)
+	if err := validateEmailAddress(email); err != nil {
+		return nil, err
+	}
+
+	authRecipient, err := s.Auth.EnsureUserExists(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if authRecipient.Banned {
+		return nil, fmt.Errorf("user %s is banned", email)
+	}
+	recipient, err := s.EnsureUserExists(ctx, authRecipient)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.ID == actor.ID() {
+		return nil, errors.New("cannot transfer a ticket to yourself")
+	}
+
+	ticket, err := zeni.NewTicket()
+	if err != nil {
+		return nil, err
+	}
+
+	evt := (*zeni.Event)(nil)
+	if err := s.DB.TxWithSpan(ctx, "db.TransferTicket", func(db zeni.DB) error {
+		evt, err = db.GetEvent(req.Msg.EventId)
+		if err != nil {
+			return err
+		}
+		if evt == nil {
+			return errors.New("event not found")
+		}
+		if time.Now().After(evt.StartDate) {
+			return errors.New("event already started")
+		}
+		if evt.TicketTransfersDisabled {
+			return errors.New("ticket transfers are disabled for this event")
+		}
+
+		if _, err := db.TransferTicket(evt.ID, actor.ID(), recipient.ID, ticket.Secret()); err != nil {
+			return err
+		}
+
+		communities, err := db.CommunitiesByEvent(evt.ID)
+		if err != nil {
+			return err
+		}
+		for _, cmt := range communities {
+			roles, err := db.EntityRoles(zeni.EntityTypeUser, recipient.ID, zeni.EntityTypeCommunity, cmt.ID)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(roles, zeni.RoleMember) {
+				continue
+			}
+			if err := db.AddMemberToCommunity(cmt.ID, recipient.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if s.MailClient != nil {
+		if err := s.sendTransferredTicketEmail(ctx, evt, ticket, recipient, authRecipient.Email); err != nil {
+			s.Logger.Error("send-transferred-ticket-email", zap.Error(err), zap.String("event-id", evt.ID), zap.String("user-id", recipient.ID))
+		}
+	}
+
+	return connect.NewResponse(&zenaov1.TransferTicketResponse{}), nil
+}
+
+func (s *ZenaoServer) sendTransferredTicketEmail(ctx context.Context, evt *zeni.Event, ticket *zeni.Ticket, holder *zeni.User, email string) error {
+	tracer := otel.Tracer("mail")
+	ctx, span := tracer.Start(ctx, "mail.TransferTicket", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	htmlStr, text, err := ticketsConfirmationMailContent(evt, "A ticket was transferred to you! It is attached to this email.")
+	if err != nil {
+		return err
+	}
+
+	pdfData, err := GeneratePDFTicket(evt, ticket.Secret(), holder.DisplayName, email, time.Now(), s.Logger)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.MailClient.Emails.SendWithContext(ctx, &resend.SendEmailRequest{
+		From:    fmt.Sprintf("Zenao <%s>", s.MailSender),
+		To:      []string{email},
+		Subject: fmt.Sprintf("%s - Ticket transferred", evt.Title),
+		Html:    htmlStr,
+		Text:    text,
+		Attachments: []*resend.Attachment{
+			{
+				Content:     pdfData,
+				Filename:    fmt.Sprintf("ticket_%s_%s.pdf", holder.ID, evt.ID),
+				ContentType: "application/pdf",
+			},
+			{
+				Content:     s.eventICS(ctx, evt),
+				Filename:    fmt.Sprintf("zenao_events_%s.ics", evt.ID),
+				ContentType: "text/calendar",
+			},
+		},
+	})
+	return err
+}
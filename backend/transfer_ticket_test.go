@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+// setupIssuedTicketFixture buys and issues a ticket for buyer@example.com and logs them in.
+func setupIssuedTicketFixture(t *testing.T) (*paidEventFixture, string) {
+	f := setupPaidEventFixture(t)
+
+	resp, err := f.startCheckout(f.priceIDs[0], "", "buyer@example.com")
+	require.NoError(t, err)
+	require.NoError(t, f.db.UpdateOrderConfirmation(resp.OrderId, zeni.OrderStatusSuccess, "pi_test_123", time.Now().Unix()))
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	f.server.issueTicketsAfterConfirmation(context.Background(), order)
+
+	f.auth.user = f.auth.ensureAuthUser("buyer@example.com")
+	return f, resp.OrderId
+}
+
+func TestTransferTicketReissuesTicket(t *testing.T) {
+	f, orderID := setupIssuedTicketFixture(t)
+	mailClient, sendCount := newTestResendClient(t)
+	f.server.MailClient = mailClient
+
+	buyer, err := f.db.GetUser(f.auth.user.ID)
+	require.NoError(t, err)
+	previous, err := f.db.GetEventUserTicket(f.eventID, buyer.ID)
+	require.NoError(t, err)
+
+	_, err = f.server.TransferTicket(context.Background(), connect.NewRequest(&zenaov1.TransferTicketRequest{
+		EventId: f.eventID,
+		Email:   "buyer@example.com",
+	}))
+	require.ErrorContains(t, err, "yourself")
+
+	_, err = f.server.TransferTicket(context.Background(), connect.NewRequest(&zenaov1.TransferTicketRequest{
+		EventId: f.eventID,
+		Email:   "colleague@example.com",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 1, *sendCount)
+
+	colleague, err := f.db.GetUser(f.auth.ensureAuthUser("colleague@example.com").ID)
+	require.NoError(t, err)
+	ticket, err := f.db.GetEventUserTicket(f.eventID, colleague.ID)
+	require.NoError(t, err)
+	require.NotEqual(t, previous.Ticket.Pubkey(), ticket.Ticket.Pubkey())
+	require.Equal(t, orderID, ticket.OrderID)
+	require.Equal(t, buyer.ID, ticket.BuyerID)
+	require.Equal(t, previous.OrderAttendeeID, ticket.OrderAttendeeID)
+
+	attendees, err := f.db.GetOrderAttendees(orderID)
+	require.NoError(t, err)
+	require.Len(t, attendees, 1)
+	require.Equal(t, buyer.ID, attendees[0].UserID)
+
+	buyerRoles, err := f.db.EntityRoles(zeni.EntityTypeUser, buyer.ID, zeni.EntityTypeEvent, f.eventID)
+	require.NoError(t, err)
+	require.NotContains(t, buyerRoles, zeni.RoleParticipant)
+	colleagueRoles, err := f.db.EntityRoles(zeni.EntityTypeUser, colleague.ID, zeni.EntityTypeEvent, f.eventID)
+	require.NoError(t, err)
+	require.Contains(t, colleagueRoles, zeni.RoleParticipant)
+
+	organizer, err := f.db.GetUser("auth-org@example.com")
+	require.NoError(t, err)
+	_, err = f.db.Checkin(previous.Ticket.Pubkey(), organizer.ID, "sig")
+	require.ErrorContains(t, err, "not found")
+	_, err = f.db.Checkin(ticket.Ticket.Pubkey(), organizer.ID, "sig")
+	require.NoError(t, err)
+
+	// the buyer has no ticket left to transfer
+	_, err = f.server.TransferTicket(context.Background(), connect.NewRequest(&zenaov1.TransferTicketRequest{
+		EventId: f.eventID,
+		Email:   "other@example.com",
+	}))
+	require.Error(t, err)
+}
+
+func TestTransferTicketDisabledByOrganizer(t *testing.T) {
+	f, _ := setupIssuedTicketFixture(t)
+
+	_, err := f.sqlDB.Exec("UPDATE events SET ticket_transfers_disabled = true WHERE id = ?", f.eventID)
+	require.NoError(t, err)
+
+	_, err = f.server.TransferTicket(context.Background(), connect.NewRequest(&zenaov1.TransferTicketRequest{
+		EventId: f.eventID,
+		Email:   "colleague@example.com",
+	}))
+	require.ErrorContains(t, err, "disabled")
+}
+
+func TestRefundTransferredTicket(t *testing.T) {
+	f, orderID := setupIssuedTicketFixture(t)
+
+	_, err := f.server.TransferTicket(context.Background(), connect.NewRequest(&zenaov1.TransferTicketRequest{
+		EventId: f.eventID,
+		Email:   "colleague@example.com",
+	}))
+	require.NoError(t, err)
+	colleague, err := f.db.GetUser(f.auth.ensureAuthUser("colleague@example.com").ID)
+	require.NoError(t, err)
+
+	attendees, err := f.db.GetOrderAttendees(orderID)
+	require.NoError(t, err)
+	_, err = f.db.RefundOrderAttendees(orderID, []string{attendees[0].ID}, "re_test", time.Now().Unix())
+	require.NoError(t, err)
+
+	// the refund revokes the ticket from whoever holds it
+	_, err = f.db.GetEventUserTicket(f.eventID, colleague.ID)
+	require.Error(t, err)
+	roles, err := f.db.EntityRoles(zeni.EntityTypeUser, colleague.ID, zeni.EntityTypeEvent, f.eventID)
+	require.NoError(t, err)
+	require.NotContains(t, roles, zeni.RoleParticipant)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"go.uber.org/zap"
+)
+
+func (s *ZenaoServer) UnregisterFromSession(
+	ctx context.Context,
+	req *connect.Request[zenaov1.UnregisterFromSessionRequest],
+) (*connect.Response[zenaov1.UnregisterFromSessionResponse], error) {
+	actor, err := s.GetActor(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("unregister-from-session", zap.String("session-id", req.Msg.SessionId), zap.String("actor-id", actor.ID()), zap.Bool("acting-as-team", actor.IsTeam()))
+
+	if err := s.DB.WithContext(ctx).UnregisterFromSession(req.Msg.SessionId, actor.ID()); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&zenaov1.UnregisterFromSessionResponse{}), nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v2"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// waitlistOfferTTL is how long a spot offered to a waitlisted user stays reserved for them.
+// Offers never outlive the start of the event.
+const waitlistOfferTTL = 24 * time.Hour
+
+// waitlistAvailableSpots returns the number of spots that can be offered to the waitlist of a price group,
+// or of the whole event when group is nil.
+func waitlistAvailableSpots(tx zeni.DB, evt *zeni.Event, group *zeni.PriceGroup, nowUnix int64) (int64, error) {
+	if group != nil {
+		soldCount, err := tx.CountEventSoldTickets(evt.ID, group.ID)
+		if err != nil {
+			return 0, err
+		}
+		heldCount, err := tx.CountActiveTicketHolds(evt.ID, group.ID, nowUnix)
+		if err != nil {
+			return 0, err
+		}
+		return int64(group.Capacity) - int64(soldCount) - int64(heldCount), nil
+	}
+
+	soldCount, err := tx.CountEventSoldTickets(evt.ID, "")
+	if err != nil {
+		return 0, err
+	}
+	heldCount, err := tx.CountActiveWaitlistHolds(evt.ID, nowUnix)
+	if err != nil {
+		return 0, err
+	}
+	return int64(evt.Capacity) - int64(soldCount) - int64(heldCount), nil
+}
+
+// offerWaitlistSpots offers the spots available in the event to the next waiting users and notifies them by email.
+// It runs after the change that freed the spots is committed, so callers only log its error.
+func (s *ZenaoServer) offerWaitlistSpots(ctx context.Context, eventID string, now time.Time) error {
+	var (
+		evt     *zeni.Event
+		offered []*zeni.WaitlistEntry
+	)
+	if err := s.DB.TxWithSpan(ctx, "db.OfferWaitlistSpots", func(tx zeni.DB) error {
+		var err error
+		evt, err = tx.GetEvent(eventID)
+		if err != nil {
+			return err
+		}
+		if evt == nil || !now.Before(evt.StartDate) {
+			return nil
+		}
+
+		entries, err := tx.ListWaitlistEntries(eventID)
+		if err != nil {
+			return err
+		}
+
+		priceGroups, err := tx.GetPriceGroupsByEvent(eventID)
+		if err != nil {
+			return err
+		}
+		priceGroupsMap := mapPriceGroups(priceGroups)
+
+		expiresAt := now.Add(waitlistOfferTTL)
+		if evt.StartDate.Before(expiresAt) {
+			expiresAt = evt.StartDate
+		}
+
+		available := map[string]int64{}
+		for _, entry := range entries {
+			if entry.Status != zeni.WaitlistEntryStatusWaiting {
+				continue
+			}
+
+			spots, ok := available[entry.PriceGroupID]
+			if !ok {
+				group := (*zeni.PriceGroup)(nil)
+				if entry.PriceGroupID != "" {
+					if group, ok = priceGroupsMap[entry.PriceGroupID]; !ok {
+						continue
+					}
+				}
+				if spots, err = waitlistAvailableSpots(tx, evt, group, now.Unix()); err != nil {
+					return err
+				}
+			}
+			if spots <= 0 {
+				available[entry.PriceGroupID] = spots
+				continue
+			}
+
+			offeredEntry, err := tx.OfferWaitlistEntry(entry.ID, now.Unix(), expiresAt.Unix())
+			if err != nil {
+				return err
+			}
+			offered = append(offered, offeredEntry)
+			available[entry.PriceGroupID] = spots - 1
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range offered {
+		s.Logger.Info("waitlist-offer", zap.String("event-id", eventID), zap.String("entry-id", entry.ID), zap.String("user-id", entry.UserID))
+		if err := s.sendWaitlistOfferEmail(ctx, evt, entry); err != nil {
+			s.Logger.Error("send-waitlist-offer-email", zap.Error(err), zap.String("entry-id", entry.ID))
+		}
+	}
+	return nil
+}
+
+func (s *ZenaoServer) sendWaitlistOfferEmail(ctx context.Context, evt *zeni.Event, entry *zeni.WaitlistEntry) error {
+	if s.MailClient == nil || s.Auth == nil || entry.OfferExpiresAt == nil {
+		return nil
+	}
+
+	users, err := s.DB.WithContext(ctx).GetUsersByIDs([]string{entry.UserID})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 || users[0] == nil || strings.TrimSpace(users[0].AuthID) == "" {
+		return errors.New("waitlisted user auth id not found")
+	}
+
+	authUsers, err := s.Auth.GetUsersFromIDs(ctx, []string{users[0].AuthID})
+	if err != nil {
+		return err
+	}
+	if len(authUsers) == 0 || authUsers[0] == nil || strings.TrimSpace(authUsers[0].Email) == "" {
+		return errors.New("waitlisted user email not found")
+	}
+
+	htmlStr, text, err := waitlistOfferMailContent(evt, time.Unix(*entry.OfferExpiresAt, 0))
+	if err != nil {
+		return err
+	}
+
+	tracer := otel.Tracer("mail")
+	mailCtx, span := tracer.Start(ctx, "mail.WaitlistOffer", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	_, err = s.MailClient.Emails.SendWithContext(mailCtx, &resend.SendEmailRequest{
+		From:    "Zenao <" + s.MailSender + ">",
+		To:      []string{authUsers[0].Email},
+		Subject: evt.Title + " - A spot opened up for you",
+		Html:    htmlStr,
+		Text:    text,
+	})
+	return err
+}
+
+func waitlistEntryToProto(entry *zeni.WaitlistEntry) *zenaov1.WaitlistEntry {
+	result := &zenaov1.WaitlistEntry{
+		Id:           entry.ID,
+		EventId:      entry.EventID,
+		PriceGroupId: entry.PriceGroupID,
+		UserId:       entry.UserID,
+		Position:     entry.Position,
+		Status:       string(entry.Status),
+		CreatedAt:    entry.CreatedAt.Unix(),
+	}
+	if entry.OfferedAt != nil {
+		result.OfferedAt = *entry.OfferedAt
+	}
+	if entry.OfferExpiresAt != nil {
+		result.OfferExpiresAt = *entry.OfferExpiresAt
+	}
+	return result
+}
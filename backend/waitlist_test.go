@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	zenaov1 "github.com/samouraiworld/zenao/backend/zenao/v1"
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/samouraiworld/zenao/backend/ztesting"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type waitlistFixture struct {
+	db      zeni.DB
+	auth    *ticketPaymentStubAuth
+	server  *ZenaoServer
+	eventID string
+}
+
+// setupWaitlistFixture creates a free event with a single spot organized by org@example.com.
+func setupWaitlistFixture(t *testing.T) *waitlistFixture {
+	db, _ := ztesting.SetupTestDB(t)
+	auth := &ticketPaymentStubAuth{}
+	auth.user = auth.ensureAuthUser("org@example.com")
+	server := &ZenaoServer{
+		Logger: zap.NewNop(),
+		Auth:   auth,
+		DB:     db,
+	}
+
+	_, err := db.CreateUser(auth.user.ID)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	createResp, err := server.CreateEvent(context.Background(), connect.NewRequest(&zenaov1.CreateEventRequest{
+		Title:       "Small event",
+		Description: "test description",
+		ImageUri:    "ipfs://image",
+		StartDate:   uint64(now.Add(72 * time.Hour).Unix()),
+		EndDate:     uint64(now.Add(75 * time.Hour).Unix()),
+		Capacity:    1,
+		Location: &zenaov1.EventLocation{
+			Address: &zenaov1.EventLocation_Virtual{
+				Virtual: &zenaov1.AddressVirtual{Uri: "https://example.com"},
+			},
+		},
+		Discoverable: true,
+	}))
+	require.NoError(t, err)
+
+	return &waitlistFixture{db: db, auth: auth, server: server, eventID: createResp.Msg.Id}
+}
+
+func (f *waitlistFixture) as(email string) {
+	f.auth.user = f.auth.ensureAuthUser(email)
+}
+
+func (f *waitlistFixture) participate() error {
+	_, err := f.server.Participate(context.Background(), connect.NewRequest(&zenaov1.ParticipateRequest{EventId: f.eventID}))
+	return err
+}
+
+func (f *waitlistFixture) joinWaitlist() (*zenaov1.WaitlistEntry, error) {
+	resp, err := f.server.JoinWaitlist(context.Background(), connect.NewRequest(&zenaov1.JoinWaitlistRequest{EventId: f.eventID}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Entry, nil
+}
+
+func (f *waitlistFixture) waitlist(t *testing.T) []*zenaov1.WaitlistEntry {
+	f.as("org@example.com")
+	resp, err := f.server.GetEventWaitlist(context.Background(), connect.NewRequest(&zenaov1.GetEventWaitlistRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+	return resp.Msg.Entries
+}
+
+func TestWaitlistOffersFreedSpot(t *testing.T) {
+	f := setupWaitlistFixture(t)
+	mailClient, sendCount := newTestResendClient(t)
+	f.server.MailClient = mailClient
+
+	f.as("alice@example.com")
+	require.NoError(t, f.participate())
+
+	f.as("bob@example.com")
+	_, err := f.joinWaitlist()
+	require.NoError(t, err)
+	require.ErrorContains(t, f.participate(), "sold out")
+	_, err = f.joinWaitlist()
+	require.Error(t, err)
+
+	f.as("carol@example.com")
+	carolEntry, err := f.joinWaitlist()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), carolEntry.Position)
+
+	sentBefore := *sendCount
+	f.as("alice@example.com")
+	_, err = f.server.CancelParticipation(context.Background(), connect.NewRequest(&zenaov1.CancelParticipationRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+	require.Equal(t, sentBefore+1, *sendCount)
+
+	entries := f.waitlist(t)
+	require.Len(t, entries, 2)
+	require.Equal(t, string(zeni.WaitlistEntryStatusOffered), entries[0].Status)
+	require.NotZero(t, entries[0].OfferExpiresAt)
+	require.Equal(t, string(zeni.WaitlistEntryStatusWaiting), entries[1].Status)
+
+	// the freed spot is reserved for bob
+	f.as("carol@example.com")
+	require.ErrorContains(t, f.participate(), "sold out")
+
+	f.as("bob@example.com")
+	require.NoError(t, f.participate())
+
+	entries = f.waitlist(t)
+	require.Equal(t, string(zeni.WaitlistEntryStatusClaimed), entries[0].Status)
+}
+
+func TestWaitlistReorderAndExpiredOffer(t *testing.T) {
+	f := setupWaitlistFixture(t)
+
+	f.as("alice@example.com")
+	_, err := f.joinWaitlist()
+	require.ErrorContains(t, err, "spots are still available")
+	require.NoError(t, f.participate())
+
+	f.as("bob@example.com")
+	bobEntry, err := f.joinWaitlist()
+	require.NoError(t, err)
+	f.as("carol@example.com")
+	carolEntry, err := f.joinWaitlist()
+	require.NoError(t, err)
+
+	_, err = f.server.ReorderWaitlist(context.Background(), connect.NewRequest(&zenaov1.ReorderWaitlistRequest{
+		EventId:  f.eventID,
+		EntryIds: []string{carolEntry.Id, bobEntry.Id},
+	}))
+	require.ErrorContains(t, err, "only organizers")
+	_, err = f.server.GetEventWaitlist(context.Background(), connect.NewRequest(&zenaov1.GetEventWaitlistRequest{EventId: f.eventID}))
+	require.ErrorContains(t, err, "only organizers")
+
+	f.as("org@example.com")
+	_, err = f.server.ReorderWaitlist(context.Background(), connect.NewRequest(&zenaov1.ReorderWaitlistRequest{
+		EventId:  f.eventID,
+		EntryIds: []string{carolEntry.Id},
+	}))
+	require.Error(t, err)
+	resp, err := f.server.ReorderWaitlist(context.Background(), connect.NewRequest(&zenaov1.ReorderWaitlistRequest{
+		EventId:  f.eventID,
+		EntryIds: []string{carolEntry.Id, bobEntry.Id},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, carolEntry.Id, resp.Msg.Entries[0].Id)
+
+	f.as("alice@example.com")
+	_, err = f.server.CancelParticipation(context.Background(), connect.NewRequest(&zenaov1.CancelParticipationRequest{EventId: f.eventID}))
+	require.NoError(t, err)
+
+	entries := f.waitlist(t)
+	require.Equal(t, carolEntry.Id, entries[0].Id)
+	require.Equal(t, string(zeni.WaitlistEntryStatusOffered), entries[0].Status)
+
+	// carol lets the offer expire, the spot moves on to bob
+	stats := f.server.ReconcileOnce(context.Background(), time.Now().Add(waitlistOfferTTL+time.Minute), defaultReconcileStaleAfter)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, int64(1), stats.ExpiredOffers)
+
+	entries = f.waitlist(t)
+	require.Equal(t, string(zeni.WaitlistEntryStatusExpired), entries[0].Status)
+	require.Equal(t, bobEntry.Id, entries[1].Id)
+	require.Equal(t, string(zeni.WaitlistEntryStatusOffered), entries[1].Status)
+}
+
+func TestWaitlistOfferKeptUntilOrderSettles(t *testing.T) {
+	f := setupPaidEventFixture(t)
+	ctx := context.Background()
+
+	buyer, err := f.db.CreateUser(f.auth.ensureAuthUser("buyer@example.com").ID)
+	require.NoError(t, err)
+	priceGroups, err := f.db.GetPriceGroupsByEvent(f.eventID)
+	require.NoError(t, err)
+	entry, err := f.db.CreateWaitlistEntry(f.eventID, priceGroups[0].ID, buyer.ID)
+	require.NoError(t, err)
+	nowUnix := time.Now().Unix()
+	_, err = f.db.OfferWaitlistEntry(entry.ID, nowUnix, nowUnix+int64(waitlistOfferTTL.Seconds()))
+	require.NoError(t, err)
+
+	// an offer for another price group is left alone
+	entryID, err := f.db.TakeWaitlistOfferHold(f.eventID, "999999", buyer.ID, nowUnix)
+	require.NoError(t, err)
+	require.Empty(t, entryID)
+
+	resp, err := f.startCheckout(f.priceIDs[0], "", "buyer@example.com")
+	require.NoError(t, err)
+	entry, err = f.db.GetWaitlistEntry(entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, zeni.WaitlistEntryStatusOffered, entry.Status)
+	require.Equal(t, resp.OrderId, entry.OrderID)
+	held, err := f.db.CountActiveWaitlistHolds(f.eventID, nowUnix)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	// the abandoned checkout gives the offer its spot back
+	failOrderAndReleaseHolds(ctx, f.db, resp.OrderId)
+	entry, err = f.db.GetWaitlistEntry(entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, zeni.WaitlistEntryStatusOffered, entry.Status)
+	require.Empty(t, entry.OrderID)
+	held, err = f.db.CountActiveWaitlistHolds(f.eventID, nowUnix)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), held)
+
+	resp, err = f.startCheckout(f.priceIDs[0], "", "buyer@example.com")
+	require.NoError(t, err)
+	order, err := f.db.GetOrder(resp.OrderId)
+	require.NoError(t, err)
+	require.NoError(t, f.server.confirmOrderPayment(ctx, order, "pi_test"))
+	entry, err = f.db.GetWaitlistEntry(entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, zeni.WaitlistEntryStatusClaimed, entry.Status)
+}
@@ -24,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventSeriesEditScope int32
+
+const (
+	EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS               EventSeriesEditScope = 0
+	EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING EventSeriesEditScope = 1
+	EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_ALL                EventSeriesEditScope = 2
+)
+
+// Enum value maps for EventSeriesEditScope.
+var (
+	EventSeriesEditScope_name = map[int32]string{
+		0: "EVENT_SERIES_EDIT_SCOPE_THIS",
+		1: "EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING",
+		2: "EVENT_SERIES_EDIT_SCOPE_ALL",
+	}
+	EventSeriesEditScope_value = map[string]int32{
+		"EVENT_SERIES_EDIT_SCOPE_THIS":               0,
+		"EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING": 1,
+		"EVENT_SERIES_EDIT_SCOPE_ALL":                2,
+	}
+)
+
+func (x EventSeriesEditScope) Enum() *EventSeriesEditScope {
+	p := new(EventSeriesEditScope)
+	*p = x
+	return p
+}
+
+func (x EventSeriesEditScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSeriesEditScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_zenao_v1_zenao_proto_enumTypes[0].Descriptor()
+}
+
+func (EventSeriesEditScope) Type() protoreflect.EnumType {
+	return &file_zenao_v1_zenao_proto_enumTypes[0]
+}
+
+func (x EventSeriesEditScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSeriesEditScope.Descriptor instead.
+func (EventSeriesEditScope) EnumDescriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{0}
+}
+
 type DiscoverableFilter int32
 
 const (
@@ -57,11 +106,11 @@ func (x DiscoverableFilter) String() string {
 }
 
 func (DiscoverableFilter) Descriptor() protoreflect.EnumDescriptor {
-	return file_zenao_v1_zenao_proto_enumTypes[0].Descriptor()
+	return file_zenao_v1_zenao_proto_enumTypes[1].Descriptor()
 }
 
 func (DiscoverableFilter) Type() protoreflect.EnumType {
-	return &file_zenao_v1_zenao_proto_enumTypes[0]
+	return &file_zenao_v1_zenao_proto_enumTypes[1]
 }
 
 func (x DiscoverableFilter) Number() protoreflect.EnumNumber {
@@ -70,7 +119,7 @@ func (x DiscoverableFilter) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiscoverableFilter.Descriptor instead.
 func (DiscoverableFilter) EnumDescriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{0}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{1}
 }
 
 type HealthRequest struct {
@@ -362,6 +411,106 @@ func (x *GetUserInfoResponse) GetActorPlan() string {
 	return ""
 }
 
+type GetUserCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rotate replaces the feed token, calendars subscribed to the previous url
+	// stop receiving updates
+	Rotate        bool `protobuf:"varint,1,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCalendarFeedRequest) Reset() {
+	*x = GetUserCalendarFeedRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetUserCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserCalendarFeedRequest) GetRotate() bool {
+	if x != nil {
+		return x.Rotate
+	}
+	return false
+}
+
+type GetUserCalendarFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// path of the feed on the backend, community feeds are served under
+	// /calendar/communities/{community_id}.ics
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCalendarFeedResponse) Reset() {
+	*x = GetUserCalendarFeedResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetUserCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetUserCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserCalendarFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUserCalendarFeedResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type Profile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -375,7 +524,7 @@ type Profile struct {
 
 func (x *Profile) Reset() {
 	*x = Profile{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[6]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +536,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[6]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +549,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{6}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Profile) GetUserId() string {
@@ -447,7 +596,7 @@ type GetUsersProfileRequest struct {
 
 func (x *GetUsersProfileRequest) Reset() {
 	*x = GetUsersProfileRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[7]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +608,7 @@ func (x *GetUsersProfileRequest) String() string {
 func (*GetUsersProfileRequest) ProtoMessage() {}
 
 func (x *GetUsersProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[7]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +621,7 @@ func (x *GetUsersProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetUsersProfileRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{7}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUsersProfileRequest) GetIds() []string {
@@ -491,7 +640,7 @@ type GetUsersProfileResponse struct {
 
 func (x *GetUsersProfileResponse) Reset() {
 	*x = GetUsersProfileResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[8]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +652,7 @@ func (x *GetUsersProfileResponse) String() string {
 func (*GetUsersProfileResponse) ProtoMessage() {}
 
 func (x *GetUsersProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[8]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +665,7 @@ func (x *GetUsersProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetUsersProfileResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{8}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUsersProfileResponse) GetProfiles() []*Profile {
@@ -535,7 +684,7 @@ type GetEventRequest struct {
 
 func (x *GetEventRequest) Reset() {
 	*x = GetEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[9]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +696,7 @@ func (x *GetEventRequest) String() string {
 func (*GetEventRequest) ProtoMessage() {}
 
 func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[9]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +709,7 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
 func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{9}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetEventRequest) GetEventId() string {
@@ -579,7 +728,7 @@ type GetEventResponse struct {
 
 func (x *GetEventResponse) Reset() {
 	*x = GetEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[10]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +740,7 @@ func (x *GetEventResponse) String() string {
 func (*GetEventResponse) ProtoMessage() {}
 
 func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[10]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +753,7 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
 func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{10}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetEventResponse) GetEvent() *EventInfo {
@@ -622,13 +771,15 @@ type ListEventsRequest struct {
 	To                 int64                  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`                                                                                            // unix seconds
 	DiscoverableFilter DiscoverableFilter     `protobuf:"varint,5,opt,name=discoverable_filter,json=discoverableFilter,proto3,enum=zenao.v1.DiscoverableFilter" json:"discoverable_filter,omitempty"` // XXX: should we ensure events non discoverable are listed only if the user is an organizer?
 	LocationFilter     *LocationFilter        `protobuf:"bytes,6,opt,name=location_filter,json=locationFilter,proto3" json:"location_filter,omitempty"`                                               // optional location filter
+	Category           string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`                                                                                 // optional category filter
+	Tags               []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                         // only events having all these tags
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[11]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +791,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[11]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +804,7 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{11}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListEventsRequest) GetLimit() uint32 {
@@ -698,6 +849,20 @@ func (x *ListEventsRequest) GetLocationFilter() *LocationFilter {
 	return nil
 }
 
+func (x *ListEventsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // Filter events by geographical location
 type LocationFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -710,7 +875,7 @@ type LocationFilter struct {
 
 func (x *LocationFilter) Reset() {
 	*x = LocationFilter{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[12]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +887,7 @@ func (x *LocationFilter) String() string {
 func (*LocationFilter) ProtoMessage() {}
 
 func (x *LocationFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[12]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +900,7 @@ func (x *LocationFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocationFilter.ProtoReflect.Descriptor instead.
 func (*LocationFilter) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{12}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LocationFilter) GetLat() float64 {
@@ -768,7 +933,7 @@ type ListEventsResponse struct {
 
 func (x *ListEventsResponse) Reset() {
 	*x = ListEventsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[13]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -780,7 +945,7 @@ func (x *ListEventsResponse) String() string {
 func (*ListEventsResponse) ProtoMessage() {}
 
 func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[13]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +958,7 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{13}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListEventsResponse) GetEvents() []*EventInfo {
@@ -803,29 +968,34 @@ func (x *ListEventsResponse) GetEvents() []*EventInfo {
 	return nil
 }
 
-type EventUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *EventInfo             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: organizer, gatekeeper, participant
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Full-text search over the discoverable events and the communities, every word of the query matches as a prefix
+type SearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit          uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	From           int64                  `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`                                          // unix seconds, only events ending after
+	To             int64                  `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`                                              // unix seconds, only events ending before
+	LocationFilter *LocationFilter        `protobuf:"bytes,6,opt,name=location_filter,json=locationFilter,proto3" json:"location_filter,omitempty"` // optional location filter
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *EventUser) Reset() {
-	*x = EventUser{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[14]
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventUser) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUser) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *EventUser) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[14]
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,53 +1006,77 @@ func (x *EventUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventUser.ProtoReflect.Descriptor instead.
-func (*EventUser) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventUser) GetEvent() *EventInfo {
+func (x *SearchRequest) GetQuery() string {
 	if x != nil {
-		return x.Event
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-func (x *EventUser) GetRoles() []string {
+func (x *SearchRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.Roles
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLocationFilter() *LocationFilter {
+	if x != nil {
+		return x.LocationFilter
 	}
 	return nil
 }
 
-type ListEventsByUserRolesRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Roles              []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: organizer, gatekeeper, participant
-	Limit              uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset             uint32                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	From               int64                  `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`                                                                                        // unix seconds
-	To                 int64                  `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`                                                                                            // unix seconds
-	DiscoverableFilter DiscoverableFilter     `protobuf:"varint,7,opt,name=discoverable_filter,json=discoverableFilter,proto3,enum=zenao.v1.DiscoverableFilter" json:"discoverable_filter,omitempty"` // XXX: should we ensure events non discoverable are listed only if the user is an organizer?
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// Highlights and snippets are html-escaped with the matched words wrapped in <mark> tags
+type SearchResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Events        []*EventSearchResult     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Communities   []*CommunitySearchResult `protobuf:"bytes,2,rep,name=communities,proto3" json:"communities,omitempty"` // not searched when the events are filtered by date or location
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsByUserRolesRequest) Reset() {
-	*x = ListEventsByUserRolesRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[15]
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsByUserRolesRequest) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsByUserRolesRequest) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *ListEventsByUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[15]
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,30 +1087,267 @@ func (x *ListEventsByUserRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsByUserRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsByUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListEventsByUserRolesRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListEventsByUserRolesRequest) GetRoles() []string {
+func (x *SearchResponse) GetEvents() []*EventSearchResult {
 	if x != nil {
-		return x.Roles
+		return x.Events
 	}
 	return nil
 }
 
-func (x *ListEventsByUserRolesRequest) GetLimit() uint32 {
+func (x *SearchResponse) GetCommunities() []*CommunitySearchResult {
 	if x != nil {
-		return x.Limit
+		return x.Communities
 	}
-	return 0
+	return nil
+}
+
+type EventSearchResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Event              *EventInfo             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	TitleHighlight     string                 `protobuf:"bytes,2,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionSnippet string                 `protobuf:"bytes,3,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	VenueHighlight     string                 `protobuf:"bytes,4,opt,name=venue_highlight,json=venueHighlight,proto3" json:"venue_highlight,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EventSearchResult) Reset() {
+	*x = EventSearchResult{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSearchResult) ProtoMessage() {}
+
+func (x *EventSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSearchResult.ProtoReflect.Descriptor instead.
+func (*EventSearchResult) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EventSearchResult) GetEvent() *EventInfo {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventSearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *EventSearchResult) GetDescriptionSnippet() string {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return ""
+}
+
+func (x *EventSearchResult) GetVenueHighlight() string {
+	if x != nil {
+		return x.VenueHighlight
+	}
+	return ""
+}
+
+type CommunitySearchResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Community          *CommunityInfo         `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	NameHighlight      string                 `protobuf:"bytes,2,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	DescriptionSnippet string                 `protobuf:"bytes,3,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CommunitySearchResult) Reset() {
+	*x = CommunitySearchResult{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunitySearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunitySearchResult) ProtoMessage() {}
+
+func (x *CommunitySearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunitySearchResult.ProtoReflect.Descriptor instead.
+func (*CommunitySearchResult) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommunitySearchResult) GetCommunity() *CommunityInfo {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+func (x *CommunitySearchResult) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *CommunitySearchResult) GetDescriptionSnippet() string {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return ""
+}
+
+type EventUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventInfo             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: organizer, gatekeeper, participant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventUser) Reset() {
+	*x = EventUser{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUser) ProtoMessage() {}
+
+func (x *EventUser) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUser.ProtoReflect.Descriptor instead.
+func (*EventUser) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EventUser) GetEvent() *EventInfo {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventUser) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type ListEventsByUserRolesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles              []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: organizer, gatekeeper, participant
+	Limit              uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             uint32                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	From               int64                  `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`                                                                                        // unix seconds
+	To                 int64                  `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`                                                                                            // unix seconds
+	DiscoverableFilter DiscoverableFilter     `protobuf:"varint,7,opt,name=discoverable_filter,json=discoverableFilter,proto3,enum=zenao.v1.DiscoverableFilter" json:"discoverable_filter,omitempty"` // XXX: should we ensure events non discoverable are listed only if the user is an organizer?
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListEventsByUserRolesRequest) Reset() {
+	*x = ListEventsByUserRolesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsByUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsByUserRolesRequest) ProtoMessage() {}
+
+func (x *ListEventsByUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsByUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsByUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEventsByUserRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListEventsByUserRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListEventsByUserRolesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
 func (x *ListEventsByUserRolesRequest) GetOffset() uint32 {
@@ -956,7 +1387,7 @@ type ListEventsByUserRolesResponse struct {
 
 func (x *ListEventsByUserRolesResponse) Reset() {
 	*x = ListEventsByUserRolesResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[16]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1399,7 @@ func (x *ListEventsByUserRolesResponse) String() string {
 func (*ListEventsByUserRolesResponse) ProtoMessage() {}
 
 func (x *ListEventsByUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[16]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1412,7 @@ func (x *ListEventsByUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsByUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsByUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{16}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListEventsByUserRolesResponse) GetEvents() []*EventUser {
@@ -992,29 +1423,48 @@ func (x *ListEventsByUserRolesResponse) GetEvents() []*EventUser {
 }
 
 type CreateEventRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // markdown
-	ImageUri       string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	StartDate      uint64                 `protobuf:"varint,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`        // unix seconds
-	EndDate        uint64                 `protobuf:"varint,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`              // unix seconds
-	TicketPrice    float64                `protobuf:"fixed64,6,opt,name=ticket_price,json=ticketPrice,proto3" json:"ticket_price,omitempty"` // XXX: use fixed point?
-	Capacity       uint32                 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Location       *EventLocation         `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
-	Password       string                 `protobuf:"bytes,10,opt,name=password,proto3" json:"password,omitempty"`
-	Organizers     []string               `protobuf:"bytes,11,rep,name=organizers,proto3" json:"organizers,omitempty"`
-	Gatekeepers    []string               `protobuf:"bytes,12,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
-	Discoverable   bool                   `protobuf:"varint,13,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
-	CommunityId    string                 `protobuf:"bytes,14,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // optional
-	CommunityEmail bool                   `protobuf:"varint,15,opt,name=community_email,json=communityEmail,proto3" json:"community_email,omitempty"`
-	PricesGroups   []*EventPriceGroup     `protobuf:"bytes,16,rep,name=prices_groups,json=pricesGroups,proto3" json:"prices_groups,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Title                   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description             string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // markdown
+	ImageUri                string                 `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	StartDate               uint64                 `protobuf:"varint,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`        // unix seconds
+	EndDate                 uint64                 `protobuf:"varint,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`              // unix seconds
+	TicketPrice             float64                `protobuf:"fixed64,6,opt,name=ticket_price,json=ticketPrice,proto3" json:"ticket_price,omitempty"` // XXX: use fixed point?
+	Capacity                uint32                 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Location                *EventLocation         `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Password                string                 `protobuf:"bytes,10,opt,name=password,proto3" json:"password,omitempty"`
+	Organizers              []string               `protobuf:"bytes,11,rep,name=organizers,proto3" json:"organizers,omitempty"`
+	Gatekeepers             []string               `protobuf:"bytes,12,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
+	Discoverable            bool                   `protobuf:"varint,13,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	CommunityId             string                 `protobuf:"bytes,14,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // optional
+	CommunityEmail          bool                   `protobuf:"varint,15,opt,name=community_email,json=communityEmail,proto3" json:"community_email,omitempty"`
+	PricesGroups            []*EventPriceGroup     `protobuf:"bytes,16,rep,name=prices_groups,json=pricesGroups,proto3" json:"prices_groups,omitempty"`
+	TicketTransfersDisabled bool                   `protobuf:"varint,17,opt,name=ticket_transfers_disabled,json=ticketTransfersDisabled,proto3" json:"ticket_transfers_disabled,omitempty"`
+	// optional RFC 5545 recurrence rule (e.g. FREQ=WEEKLY;COUNT=10), one event is created per occurrence
+	Rrule                 string                  `protobuf:"bytes,18,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	RegistrationQuestions []*RegistrationQuestion `protobuf:"bytes,19,rep,name=registration_questions,json=registrationQuestions,proto3" json:"registration_questions,omitempty"`
+	// participants apply and get a ticket, or access to checkout for paid events, once an organizer approves them
+	ApprovalRequired bool `protobuf:"varint,20,opt,name=approval_required,json=approvalRequired,proto3" json:"approval_required,omitempty"`
+	// seconds before the start date at which participants get a reminder email
+	ReminderOffsets []uint32 `protobuf:"varint,21,rep,packed,name=reminder_offsets,json=reminderOffsets,proto3" json:"reminder_offsets,omitempty"`
+	// optional community event template, the fields left empty are taken from it
+	// and the end date defaults to the start date plus the template duration
+	TemplateId string `protobuf:"bytes,22,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// only organizers see a draft until it is published, the community members and
+	// Discord are told about the event when it is published instead of now
+	Draft     bool     `protobuf:"varint,23,opt,name=draft,proto3" json:"draft,omitempty"`
+	PublishAt int64    `protobuf:"varint,24,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // unix seconds, schedules the publication of the draft
+	Category  string   `protobuf:"bytes,25,opt,name=category,proto3" json:"category,omitempty"`                     // optional, one of the curated categories
+	Tags      []string `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
+	// only the recipients of an invitation can participate, it excludes password and paid tickets
+	InviteOnly    bool `protobuf:"varint,27,opt,name=invite_only,json=inviteOnly,proto3" json:"invite_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateEventRequest) Reset() {
 	*x = CreateEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[17]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1026,7 +1476,7 @@ func (x *CreateEventRequest) String() string {
 func (*CreateEventRequest) ProtoMessage() {}
 
 func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[17]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1489,7 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{17}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateEventRequest) GetTitle() string {
@@ -1147,28 +1597,107 @@ func (x *CreateEventRequest) GetPricesGroups() []*EventPriceGroup {
 	return nil
 }
 
-type CreateEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateEventRequest) GetTicketTransfersDisabled() bool {
+	if x != nil {
+		return x.TicketTransfersDisabled
+	}
+	return false
 }
 
-func (x *CreateEventResponse) Reset() {
-	*x = CreateEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateEventRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
 }
 
-func (x *CreateEventResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateEventRequest) GetRegistrationQuestions() []*RegistrationQuestion {
+	if x != nil {
+		return x.RegistrationQuestions
+	}
+	return nil
 }
 
-func (*CreateEventResponse) ProtoMessage() {}
+func (x *CreateEventRequest) GetApprovalRequired() bool {
+	if x != nil {
+		return x.ApprovalRequired
+	}
+	return false
+}
 
-func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[18]
+func (x *CreateEventRequest) GetReminderOffsets() []uint32 {
+	if x != nil {
+		return x.ReminderOffsets
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetInviteOnly() bool {
+	if x != nil {
+		return x.InviteOnly
+	}
+	return false
+}
+
+type CreateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // first occurrence of a series
+	SeriesId      string                 `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	OccurrenceIds []string               `protobuf:"bytes,3,rep,name=occurrence_ids,json=occurrenceIds,proto3" json:"occurrence_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventResponse) ProtoMessage() {}
+
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1710,7 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
 func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{18}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateEventResponse) GetId() string {
@@ -1191,16 +1720,31 @@ func (x *CreateEventResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateEventResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CreateEventResponse) GetOccurrenceIds() []string {
+	if x != nil {
+		return x.OccurrenceIds
+	}
+	return nil
+}
+
 type CancelEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	RefundOrders  bool                   `protobuf:"varint,2,opt,name=refund_orders,json=refundOrders,proto3" json:"refund_orders,omitempty"` // refund every successful order of the event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelEventRequest) Reset() {
 	*x = CancelEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[19]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +1756,7 @@ func (x *CancelEventRequest) String() string {
 func (*CancelEventRequest) ProtoMessage() {}
 
 func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[19]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,7 +1769,7 @@ func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
 func (*CancelEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{19}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CancelEventRequest) GetEventId() string {
@@ -1235,15 +1779,23 @@ func (x *CancelEventRequest) GetEventId() string {
 	return ""
 }
 
+func (x *CancelEventRequest) GetRefundOrders() bool {
+	if x != nil {
+		return x.RefundOrders
+	}
+	return false
+}
+
 type CancelEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RefundFailedOrderIds []string               `protobuf:"bytes,1,rep,name=refund_failed_order_ids,json=refundFailedOrderIds,proto3" json:"refund_failed_order_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *CancelEventResponse) Reset() {
 	*x = CancelEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[20]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1255,7 +1807,7 @@ func (x *CancelEventResponse) String() string {
 func (*CancelEventResponse) ProtoMessage() {}
 
 func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[20]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +1820,14 @@ func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelEventResponse.ProtoReflect.Descriptor instead.
 func (*CancelEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{20}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelEventResponse) GetRefundFailedOrderIds() []string {
+	if x != nil {
+		return x.RefundFailedOrderIds
+	}
+	return nil
 }
 
 type EditEventRequest struct {
@@ -1290,13 +1849,35 @@ type EditEventRequest struct {
 	CommunityId    string                 `protobuf:"bytes,15,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"` // optional
 	CommunityEmail bool                   `protobuf:"varint,16,opt,name=community_email,json=communityEmail,proto3" json:"community_email,omitempty"`
 	PricesGroups   []*EventPriceGroup     `protobuf:"bytes,17,rep,name=prices_groups,json=pricesGroups,proto3" json:"prices_groups,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// changed only when update_ticket_transfers_disabled is set
+	TicketTransfersDisabled bool `protobuf:"varint,18,opt,name=ticket_transfers_disabled,json=ticketTransfersDisabled,proto3" json:"ticket_transfers_disabled,omitempty"`
+	// which occurrences of the event series are edited, ignored for standalone events
+	SeriesScope EventSeriesEditScope `protobuf:"varint,19,opt,name=series_scope,json=seriesScope,proto3,enum=zenao.v1.EventSeriesEditScope" json:"series_scope,omitempty"`
+	// replaces the registration form when update_registration_questions is set,
+	// questions without id are added and missing ones are removed
+	RegistrationQuestions       []*RegistrationQuestion `protobuf:"bytes,20,rep,name=registration_questions,json=registrationQuestions,proto3" json:"registration_questions,omitempty"`
+	UpdateRegistrationQuestions bool                    `protobuf:"varint,21,opt,name=update_registration_questions,json=updateRegistrationQuestions,proto3" json:"update_registration_questions,omitempty"`
+	// changed only when update_approval_required is set
+	ApprovalRequired bool `protobuf:"varint,22,opt,name=approval_required,json=approvalRequired,proto3" json:"approval_required,omitempty"`
+	// replaces the reminders of the event when update_reminder_offsets is set
+	ReminderOffsets       []uint32 `protobuf:"varint,23,rep,packed,name=reminder_offsets,json=reminderOffsets,proto3" json:"reminder_offsets,omitempty"`
+	UpdateReminderOffsets bool     `protobuf:"varint,24,opt,name=update_reminder_offsets,json=updateReminderOffsets,proto3" json:"update_reminder_offsets,omitempty"`
+	// replaces the category and the tags of the event when update_tags is set
+	Category   string   `protobuf:"bytes,25,opt,name=category,proto3" json:"category,omitempty"`
+	Tags       []string `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdateTags bool     `protobuf:"varint,27,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	// changed only when update_invite_only is set
+	InviteOnly                    bool `protobuf:"varint,28,opt,name=invite_only,json=inviteOnly,proto3" json:"invite_only,omitempty"`
+	UpdateTicketTransfersDisabled bool `protobuf:"varint,29,opt,name=update_ticket_transfers_disabled,json=updateTicketTransfersDisabled,proto3" json:"update_ticket_transfers_disabled,omitempty"`
+	UpdateApprovalRequired        bool `protobuf:"varint,30,opt,name=update_approval_required,json=updateApprovalRequired,proto3" json:"update_approval_required,omitempty"`
+	UpdateInviteOnly              bool `protobuf:"varint,31,opt,name=update_invite_only,json=updateInviteOnly,proto3" json:"update_invite_only,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
 func (x *EditEventRequest) Reset() {
 	*x = EditEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[21]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1308,7 +1889,7 @@ func (x *EditEventRequest) String() string {
 func (*EditEventRequest) ProtoMessage() {}
 
 func (x *EditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[21]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1902,7 @@ func (x *EditEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEventRequest.ProtoReflect.Descriptor instead.
 func (*EditEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{21}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *EditEventRequest) GetEventId() string {
@@ -1443,16 +2024,116 @@ func (x *EditEventRequest) GetPricesGroups() []*EventPriceGroup {
 	return nil
 }
 
+func (x *EditEventRequest) GetTicketTransfersDisabled() bool {
+	if x != nil {
+		return x.TicketTransfersDisabled
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetSeriesScope() EventSeriesEditScope {
+	if x != nil {
+		return x.SeriesScope
+	}
+	return EventSeriesEditScope_EVENT_SERIES_EDIT_SCOPE_THIS
+}
+
+func (x *EditEventRequest) GetRegistrationQuestions() []*RegistrationQuestion {
+	if x != nil {
+		return x.RegistrationQuestions
+	}
+	return nil
+}
+
+func (x *EditEventRequest) GetUpdateRegistrationQuestions() bool {
+	if x != nil {
+		return x.UpdateRegistrationQuestions
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetApprovalRequired() bool {
+	if x != nil {
+		return x.ApprovalRequired
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetReminderOffsets() []uint32 {
+	if x != nil {
+		return x.ReminderOffsets
+	}
+	return nil
+}
+
+func (x *EditEventRequest) GetUpdateReminderOffsets() bool {
+	if x != nil {
+		return x.UpdateReminderOffsets
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EditEventRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EditEventRequest) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetInviteOnly() bool {
+	if x != nil {
+		return x.InviteOnly
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetUpdateTicketTransfersDisabled() bool {
+	if x != nil {
+		return x.UpdateTicketTransfersDisabled
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetUpdateApprovalRequired() bool {
+	if x != nil {
+		return x.UpdateApprovalRequired
+	}
+	return false
+}
+
+func (x *EditEventRequest) GetUpdateInviteOnly() bool {
+	if x != nil {
+		return x.UpdateInviteOnly
+	}
+	return false
+}
+
 type EditEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// set when editing this and following occurrences split the event series
+	SeriesId      string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EditEventResponse) Reset() {
 	*x = EditEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[22]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1464,7 +2145,7 @@ func (x *EditEventResponse) String() string {
 func (*EditEventResponse) ProtoMessage() {}
 
 func (x *EditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[22]
+	mi := &file_zenao_v1_zenao_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +2158,7 @@ func (x *EditEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditEventResponse.ProtoReflect.Descriptor instead.
 func (*EditEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{22}
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *EditEventResponse) GetId() string {
@@ -1487,28 +2168,37 @@ func (x *EditEventResponse) GetId() string {
 	return ""
 }
 
-type GetEventGatekeepersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+func (x *EditEventResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type DuplicateEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// unix seconds, the end date and the price sale windows are shifted along
+	StartDate     uint64 `protobuf:"varint,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventGatekeepersRequest) Reset() {
-	*x = GetEventGatekeepersRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[23]
+func (x *DuplicateEventRequest) Reset() {
+	*x = DuplicateEventRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventGatekeepersRequest) String() string {
+func (x *DuplicateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventGatekeepersRequest) ProtoMessage() {}
+func (*DuplicateEventRequest) ProtoMessage() {}
 
-func (x *GetEventGatekeepersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[23]
+func (x *DuplicateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,40 +2209,47 @@ func (x *GetEventGatekeepersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventGatekeepersRequest.ProtoReflect.Descriptor instead.
-func (*GetEventGatekeepersRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DuplicateEventRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateEventRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetEventGatekeepersRequest) GetEventId() string {
+func (x *DuplicateEventRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-type GetEventGatekeepersResponse struct {
+func (x *DuplicateEventRequest) GetStartDate() uint64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+type DuplicateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gatekeepers   []string               `protobuf:"bytes,1,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventGatekeepersResponse) Reset() {
-	*x = GetEventGatekeepersResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[24]
+func (x *DuplicateEventResponse) Reset() {
+	*x = DuplicateEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventGatekeepersResponse) String() string {
+func (x *DuplicateEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventGatekeepersResponse) ProtoMessage() {}
+func (*DuplicateEventResponse) ProtoMessage() {}
 
-func (x *GetEventGatekeepersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[24]
+func (x *DuplicateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,41 +2260,41 @@ func (x *GetEventGatekeepersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventGatekeepersResponse.ProtoReflect.Descriptor instead.
-func (*GetEventGatekeepersResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DuplicateEventResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateEventResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetEventGatekeepersResponse) GetGatekeepers() []string {
+func (x *DuplicateEventResponse) GetId() string {
 	if x != nil {
-		return x.Gatekeepers
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ValidatePasswordRequest struct {
+type PublishEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PublishAt     int64                  `protobuf:"varint,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // unix seconds, 0 publishes the draft now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidatePasswordRequest) Reset() {
-	*x = ValidatePasswordRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[25]
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatePasswordRequest) String() string {
+func (x *PublishEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatePasswordRequest) ProtoMessage() {}
+func (*PublishEventRequest) ProtoMessage() {}
 
-func (x *ValidatePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[25]
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,47 +2305,47 @@ func (x *ValidatePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ValidatePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ValidatePasswordRequest) GetEventId() string {
+func (x *PublishEventRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *ValidatePasswordRequest) GetPassword() string {
+func (x *PublishEventRequest) GetPublishAt() int64 {
 	if x != nil {
-		return x.Password
+		return x.PublishAt
 	}
-	return ""
+	return 0
 }
 
-type ValidatePasswordResponse struct {
+type PublishEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Published     bool                   `protobuf:"varint,1,opt,name=published,proto3" json:"published,omitempty"` // false when the publication was scheduled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidatePasswordResponse) Reset() {
-	*x = ValidatePasswordResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[26]
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatePasswordResponse) String() string {
+func (x *PublishEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatePasswordResponse) ProtoMessage() {}
+func (*PublishEventResponse) ProtoMessage() {}
 
-func (x *ValidatePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[26]
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,43 +2356,40 @@ func (x *ValidatePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ValidatePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ValidatePasswordResponse) GetValid() bool {
+func (x *PublishEventResponse) GetPublished() bool {
 	if x != nil {
-		return x.Valid
+		return x.Published
 	}
 	return false
 }
 
-type ParticipateRequest struct {
+type GetEventSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Guests        []string               `protobuf:"bytes,3,rep,name=guests,proto3" json:"guests,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParticipateRequest) Reset() {
-	*x = ParticipateRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[27]
+func (x *GetEventSeriesRequest) Reset() {
+	*x = GetEventSeriesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParticipateRequest) String() string {
+func (x *GetEventSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParticipateRequest) ProtoMessage() {}
+func (*GetEventSeriesRequest) ProtoMessage() {}
 
-func (x *ParticipateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[27]
+func (x *GetEventSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,61 +2400,43 @@ func (x *ParticipateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParticipateRequest.ProtoReflect.Descriptor instead.
-func (*ParticipateRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *ParticipateRequest) GetEventId() string {
-	if x != nil {
-		return x.EventId
-	}
-	return ""
-}
-
-func (x *ParticipateRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *ParticipateRequest) GetGuests() []string {
-	if x != nil {
-		return x.Guests
-	}
-	return nil
+// Deprecated: Use GetEventSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetEventSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ParticipateRequest) GetPassword() string {
+func (x *GetEventSeriesRequest) GetSeriesId() string {
 	if x != nil {
-		return x.Password
+		return x.SeriesId
 	}
 	return ""
 }
 
-type CancelParticipationRequest struct {
+type GetEventSeriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Rrule         string                 `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	EventIds      []string               `protobuf:"bytes,3,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"` // live occurrences ordered by date
+	Ics           []byte                 `protobuf:"bytes,4,opt,name=ics,proto3" json:"ics,omitempty"`                           // calendar with a single recurring event for the whole series
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelParticipationRequest) Reset() {
-	*x = CancelParticipationRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[28]
+func (x *GetEventSeriesResponse) Reset() {
+	*x = GetEventSeriesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelParticipationRequest) String() string {
+func (x *GetEventSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelParticipationRequest) ProtoMessage() {}
+func (*GetEventSeriesResponse) ProtoMessage() {}
 
-func (x *CancelParticipationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[28]
+func (x *GetEventSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,77 +2447,61 @@ func (x *CancelParticipationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelParticipationRequest.ProtoReflect.Descriptor instead.
-func (*CancelParticipationRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetEventSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetEventSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CancelParticipationRequest) GetEventId() string {
+func (x *GetEventSeriesResponse) GetSeriesId() string {
 	if x != nil {
-		return x.EventId
+		return x.SeriesId
 	}
 	return ""
 }
 
-type CancelParticipationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CancelParticipationResponse) Reset() {
-	*x = CancelParticipationResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CancelParticipationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetEventSeriesResponse) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
 }
 
-func (*CancelParticipationResponse) ProtoMessage() {}
-
-func (x *CancelParticipationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[29]
+func (x *GetEventSeriesResponse) GetEventIds() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EventIds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelParticipationResponse.ProtoReflect.Descriptor instead.
-func (*CancelParticipationResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{29}
+func (x *GetEventSeriesResponse) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
 }
 
-type RemoveParticipantRequest struct {
+type GetEventGatekeepersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveParticipantRequest) Reset() {
-	*x = RemoveParticipantRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[30]
+func (x *GetEventGatekeepersRequest) Reset() {
+	*x = GetEventGatekeepersRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveParticipantRequest) String() string {
+func (x *GetEventGatekeepersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveParticipantRequest) ProtoMessage() {}
+func (*GetEventGatekeepersRequest) ProtoMessage() {}
 
-func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[30]
+func (x *GetEventGatekeepersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,46 +2512,40 @@ func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
-func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetEventGatekeepersRequest.ProtoReflect.Descriptor instead.
+func (*GetEventGatekeepersRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RemoveParticipantRequest) GetEventId() string {
+func (x *GetEventGatekeepersRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *RemoveParticipantRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type RemoveParticipantResponse struct {
+type GetEventGatekeepersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gatekeepers   []string               `protobuf:"bytes,1,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveParticipantResponse) Reset() {
-	*x = RemoveParticipantResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[31]
+func (x *GetEventGatekeepersResponse) Reset() {
+	*x = GetEventGatekeepersResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveParticipantResponse) String() string {
+func (x *GetEventGatekeepersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveParticipantResponse) ProtoMessage() {}
+func (*GetEventGatekeepersResponse) ProtoMessage() {}
 
-func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[31]
+func (x *GetEventGatekeepersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,33 +2556,41 @@ func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
-func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetEventGatekeepersResponse.ProtoReflect.Descriptor instead.
+func (*GetEventGatekeepersResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{36}
 }
 
-type ParticipateResponse struct {
+func (x *GetEventGatekeepersResponse) GetGatekeepers() []string {
+	if x != nil {
+		return x.Gatekeepers
+	}
+	return nil
+}
+
+type ValidatePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketSecret  string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParticipateResponse) Reset() {
-	*x = ParticipateResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[32]
+func (x *ValidatePasswordRequest) Reset() {
+	*x = ValidatePasswordRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParticipateResponse) String() string {
+func (x *ValidatePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParticipateResponse) ProtoMessage() {}
+func (*ValidatePasswordRequest) ProtoMessage() {}
 
-func (x *ParticipateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[32]
+func (x *ValidatePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,41 +2601,47 @@ func (x *ParticipateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParticipateResponse.ProtoReflect.Descriptor instead.
-func (*ParticipateResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ValidatePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ParticipateResponse) GetTicketSecret() string {
+func (x *ValidatePasswordRequest) GetEventId() string {
 	if x != nil {
-		return x.TicketSecret
+		return x.EventId
 	}
 	return ""
 }
 
-type StartTicketPaymentLineItem struct {
+func (x *ValidatePasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ValidatePasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PriceId       string                 `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
-	AttendeeEmail string                 `protobuf:"bytes,2,opt,name=attendee_email,json=attendeeEmail,proto3" json:"attendee_email,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTicketPaymentLineItem) Reset() {
-	*x = StartTicketPaymentLineItem{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[33]
+func (x *ValidatePasswordResponse) Reset() {
+	*x = ValidatePasswordResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTicketPaymentLineItem) String() string {
+func (x *ValidatePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTicketPaymentLineItem) ProtoMessage() {}
+func (*ValidatePasswordResponse) ProtoMessage() {}
 
-func (x *StartTicketPaymentLineItem) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[33]
+func (x *ValidatePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,51 +2652,46 @@ func (x *StartTicketPaymentLineItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTicketPaymentLineItem.ProtoReflect.Descriptor instead.
-func (*StartTicketPaymentLineItem) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *StartTicketPaymentLineItem) GetPriceId() string {
-	if x != nil {
-		return x.PriceId
-	}
-	return ""
+// Deprecated: Use ValidatePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *StartTicketPaymentLineItem) GetAttendeeEmail() string {
+func (x *ValidatePasswordResponse) GetValid() bool {
 	if x != nil {
-		return x.AttendeeEmail
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-type StartTicketPaymentRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	EventId       string                        `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	LineItems     []*StartTicketPaymentLineItem `protobuf:"bytes,2,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
-	Password      string                        `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	SuccessPath   string                        `protobuf:"bytes,4,opt,name=success_path,json=successPath,proto3" json:"success_path,omitempty"`
-	CancelPath    string                        `protobuf:"bytes,5,opt,name=cancel_path,json=cancelPath,proto3" json:"cancel_path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ParticipateRequest struct {
+	state           protoimpl.MessageState         `protogen:"open.v1"`
+	EventId         string                         `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Email           string                         `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Guests          []string                       `protobuf:"bytes,3,rep,name=guests,proto3" json:"guests,omitempty"`
+	Password        string                         `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Answers         []*RegistrationAnswer          `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"` // answers of the buyer
+	GuestsAnswers   []*AttendeeRegistrationAnswers `protobuf:"bytes,6,rep,name=guests_answers,json=guestsAnswers,proto3" json:"guests_answers,omitempty"`
+	InvitationToken string                         `protobuf:"bytes,7,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"` // required for invite-only events, consumed on success
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StartTicketPaymentRequest) Reset() {
-	*x = StartTicketPaymentRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[34]
+func (x *ParticipateRequest) Reset() {
+	*x = ParticipateRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTicketPaymentRequest) String() string {
+func (x *ParticipateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTicketPaymentRequest) ProtoMessage() {}
+func (*ParticipateRequest) ProtoMessage() {}
 
-func (x *StartTicketPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[34]
+func (x *ParticipateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2039,69 +2702,82 @@ func (x *StartTicketPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTicketPaymentRequest.ProtoReflect.Descriptor instead.
-func (*StartTicketPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ParticipateRequest.ProtoReflect.Descriptor instead.
+func (*ParticipateRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *StartTicketPaymentRequest) GetEventId() string {
+func (x *ParticipateRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *StartTicketPaymentRequest) GetLineItems() []*StartTicketPaymentLineItem {
+func (x *ParticipateRequest) GetEmail() string {
 	if x != nil {
-		return x.LineItems
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ParticipateRequest) GetGuests() []string {
+	if x != nil {
+		return x.Guests
 	}
 	return nil
 }
 
-func (x *StartTicketPaymentRequest) GetPassword() string {
+func (x *ParticipateRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *StartTicketPaymentRequest) GetSuccessPath() string {
+func (x *ParticipateRequest) GetAnswers() []*RegistrationAnswer {
 	if x != nil {
-		return x.SuccessPath
+		return x.Answers
 	}
-	return ""
+	return nil
 }
 
-func (x *StartTicketPaymentRequest) GetCancelPath() string {
+func (x *ParticipateRequest) GetGuestsAnswers() []*AttendeeRegistrationAnswers {
 	if x != nil {
-		return x.CancelPath
+		return x.GuestsAnswers
+	}
+	return nil
+}
+
+func (x *ParticipateRequest) GetInvitationToken() string {
+	if x != nil {
+		return x.InvitationToken
 	}
 	return ""
 }
 
-type StartTicketPaymentResponse struct {
+type CancelParticipationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CheckoutUrl   string                 `protobuf:"bytes,1,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTicketPaymentResponse) Reset() {
-	*x = StartTicketPaymentResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[35]
+func (x *CancelParticipationRequest) Reset() {
+	*x = CancelParticipationRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTicketPaymentResponse) String() string {
+func (x *CancelParticipationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTicketPaymentResponse) ProtoMessage() {}
+func (*CancelParticipationRequest) ProtoMessage() {}
 
-func (x *StartTicketPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[35]
+func (x *CancelParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2112,48 +2788,39 @@ func (x *StartTicketPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTicketPaymentResponse.ProtoReflect.Descriptor instead.
-func (*StartTicketPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *StartTicketPaymentResponse) GetCheckoutUrl() string {
-	if x != nil {
-		return x.CheckoutUrl
-	}
-	return ""
+// Deprecated: Use CancelParticipationRequest.ProtoReflect.Descriptor instead.
+func (*CancelParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *StartTicketPaymentResponse) GetOrderId() string {
+func (x *CancelParticipationRequest) GetEventId() string {
 	if x != nil {
-		return x.OrderId
+		return x.EventId
 	}
 	return ""
 }
 
-type ConfirmTicketPaymentRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	OrderId           string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CheckoutSessionId string                 `protobuf:"bytes,2,opt,name=checkout_session_id,json=checkoutSessionId,proto3" json:"checkout_session_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CancelParticipationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmTicketPaymentRequest) Reset() {
-	*x = ConfirmTicketPaymentRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[36]
+func (x *CancelParticipationResponse) Reset() {
+	*x = CancelParticipationResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmTicketPaymentRequest) String() string {
+func (x *CancelParticipationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmTicketPaymentRequest) ProtoMessage() {}
+func (*CancelParticipationResponse) ProtoMessage() {}
 
-func (x *ConfirmTicketPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[36]
+func (x *CancelParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,49 +2831,34 @@ func (x *ConfirmTicketPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmTicketPaymentRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmTicketPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *ConfirmTicketPaymentRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *ConfirmTicketPaymentRequest) GetCheckoutSessionId() string {
-	if x != nil {
-		return x.CheckoutSessionId
-	}
-	return ""
+// Deprecated: Use CancelParticipationResponse.ProtoReflect.Descriptor instead.
+func (*CancelParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{41}
 }
 
-type ConfirmTicketPaymentResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrderId          string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	ReceiptReference string                 `protobuf:"bytes,3,opt,name=receipt_reference,json=receiptReference,proto3" json:"receipt_reference,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type TransferTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // new holder of the ticket
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmTicketPaymentResponse) Reset() {
-	*x = ConfirmTicketPaymentResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[37]
+func (x *TransferTicketRequest) Reset() {
+	*x = TransferTicketRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmTicketPaymentResponse) String() string {
+func (x *TransferTicketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmTicketPaymentResponse) ProtoMessage() {}
+func (*TransferTicketRequest) ProtoMessage() {}
 
-func (x *ConfirmTicketPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[37]
+func (x *TransferTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2217,56 +2869,84 @@ func (x *ConfirmTicketPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmTicketPaymentResponse.ProtoReflect.Descriptor instead.
-func (*ConfirmTicketPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TransferTicketRequest.ProtoReflect.Descriptor instead.
+func (*TransferTicketRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ConfirmTicketPaymentResponse) GetOrderId() string {
+func (x *TransferTicketRequest) GetEventId() string {
 	if x != nil {
-		return x.OrderId
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *ConfirmTicketPaymentResponse) GetStatus() string {
+func (x *TransferTicketRequest) GetEmail() string {
 	if x != nil {
-		return x.Status
+		return x.Email
 	}
 	return ""
 }
 
-func (x *ConfirmTicketPaymentResponse) GetReceiptReference() string {
+type TransferTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTicketResponse) Reset() {
+	*x = TransferTicketResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTicketResponse) ProtoMessage() {}
+
+func (x *TransferTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[43]
 	if x != nil {
-		return x.ReceiptReference
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type BroadcastEventRequest struct {
+// Deprecated: Use TransferTicketResponse.ProtoReflect.Descriptor instead.
+func (*TransferTicketResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{43}
+}
+
+type RemoveParticipantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	AttachTicket  bool                   `protobuf:"varint,3,opt,name=attach_ticket,json=attachTicket,proto3" json:"attach_ticket,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastEventRequest) Reset() {
-	*x = BroadcastEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[38]
+func (x *RemoveParticipantRequest) Reset() {
+	*x = RemoveParticipantRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastEventRequest) String() string {
+func (x *RemoveParticipantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastEventRequest) ProtoMessage() {}
+func (*RemoveParticipantRequest) ProtoMessage() {}
 
-func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[38]
+func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,53 +2957,46 @@ func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastEventRequest.ProtoReflect.Descriptor instead.
-func (*BroadcastEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BroadcastEventRequest) GetEventId() string {
+func (x *RemoveParticipantRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *BroadcastEventRequest) GetMessage() string {
+func (x *RemoveParticipantRequest) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *BroadcastEventRequest) GetAttachTicket() bool {
-	if x != nil {
-		return x.AttachTicket
-	}
-	return false
-}
-
-type BroadcastEventResponse struct {
+type RemoveParticipantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BroadcastEventResponse) Reset() {
-	*x = BroadcastEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[39]
+func (x *RemoveParticipantResponse) Reset() {
+	*x = RemoveParticipantResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BroadcastEventResponse) String() string {
+func (x *RemoveParticipantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastEventResponse) ProtoMessage() {}
+func (*RemoveParticipantResponse) ProtoMessage() {}
 
-func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[39]
+func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2334,40 +3007,35 @@ func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastEventResponse.ProtoReflect.Descriptor instead.
-func (*BroadcastEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{45}
 }
 
-type EventLocation struct {
+type ParticipateResponse struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
-	VenueName    string                 `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3" json:"venue_name,omitempty"`
-	Instructions string                 `protobuf:"bytes,2,opt,name=instructions,proto3" json:"instructions,omitempty"` // markdown
-	// Types that are valid to be assigned to Address:
-	//
-	//	*EventLocation_Geo
-	//	*EventLocation_Virtual
-	//	*EventLocation_Custom
-	Address       isEventLocation_Address `protobuf_oneof:"address"`
+	TicketSecret string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
+	// pending applications created instead of tickets when the event requires approval
+	Applications  []*EventApplication `protobuf:"bytes,2,rep,name=applications,proto3" json:"applications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventLocation) Reset() {
-	*x = EventLocation{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[40]
+func (x *ParticipateResponse) Reset() {
+	*x = ParticipateResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventLocation) String() string {
+func (x *ParticipateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventLocation) ProtoMessage() {}
+func (*ParticipateResponse) ProtoMessage() {}
 
-func (x *EventLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[40]
+func (x *ParticipateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,103 +3046,4953 @@ func (x *EventLocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventLocation.ProtoReflect.Descriptor instead.
-func (*EventLocation) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{40}
-}
-
+// Deprecated: Use ParticipateResponse.ProtoReflect.Descriptor instead.
+func (*ParticipateResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ParticipateResponse) GetTicketSecret() string {
+	if x != nil {
+		return x.TicketSecret
+	}
+	return ""
+}
+
+func (x *ParticipateResponse) GetApplications() []*EventApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type StartTicketPaymentLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceId       string                 `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	AttendeeEmail string                 `protobuf:"bytes,2,opt,name=attendee_email,json=attendeeEmail,proto3" json:"attendee_email,omitempty"`
+	AmountMinor   int64                  `protobuf:"varint,3,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"` // amount chosen by the buyer for pay_what_you_want prices
+	Answers       []*RegistrationAnswer  `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`                             // answers of the attendee
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTicketPaymentLineItem) Reset() {
+	*x = StartTicketPaymentLineItem{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTicketPaymentLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTicketPaymentLineItem) ProtoMessage() {}
+
+func (x *StartTicketPaymentLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTicketPaymentLineItem.ProtoReflect.Descriptor instead.
+func (*StartTicketPaymentLineItem) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StartTicketPaymentLineItem) GetPriceId() string {
+	if x != nil {
+		return x.PriceId
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentLineItem) GetAttendeeEmail() string {
+	if x != nil {
+		return x.AttendeeEmail
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentLineItem) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *StartTicketPaymentLineItem) GetAnswers() []*RegistrationAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type StartTicketPaymentRequest struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	EventId             string                        `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	LineItems           []*StartTicketPaymentLineItem `protobuf:"bytes,2,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	Password            string                        `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SuccessPath         string                        `protobuf:"bytes,4,opt,name=success_path,json=successPath,proto3" json:"success_path,omitempty"`
+	CancelPath          string                        `protobuf:"bytes,5,opt,name=cancel_path,json=cancelPath,proto3" json:"cancel_path,omitempty"`
+	PromoCode           string                        `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	DonationAmountMinor int64                         `protobuf:"varint,7,opt,name=donation_amount_minor,json=donationAmountMinor,proto3" json:"donation_amount_minor,omitempty"` // optional donation added to the order, without ticket
+	InvitationToken     string                        `protobuf:"bytes,8,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`                // required for invite-only events, consumed on success
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartTicketPaymentRequest) Reset() {
+	*x = StartTicketPaymentRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTicketPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTicketPaymentRequest) ProtoMessage() {}
+
+func (x *StartTicketPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTicketPaymentRequest.ProtoReflect.Descriptor instead.
+func (*StartTicketPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StartTicketPaymentRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentRequest) GetLineItems() []*StartTicketPaymentLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *StartTicketPaymentRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentRequest) GetSuccessPath() string {
+	if x != nil {
+		return x.SuccessPath
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentRequest) GetCancelPath() string {
+	if x != nil {
+		return x.CancelPath
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentRequest) GetDonationAmountMinor() int64 {
+	if x != nil {
+		return x.DonationAmountMinor
+	}
+	return 0
+}
+
+func (x *StartTicketPaymentRequest) GetInvitationToken() string {
+	if x != nil {
+		return x.InvitationToken
+	}
+	return ""
+}
+
+type StartTicketPaymentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// orders with nothing to pay are confirmed right away and redirect to the success path
+	CheckoutUrl   string `protobuf:"bytes,1,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+	OrderId       string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTicketPaymentResponse) Reset() {
+	*x = StartTicketPaymentResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTicketPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTicketPaymentResponse) ProtoMessage() {}
+
+func (x *StartTicketPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTicketPaymentResponse.ProtoReflect.Descriptor instead.
+func (*StartTicketPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StartTicketPaymentResponse) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+func (x *StartTicketPaymentResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ConfirmTicketPaymentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CheckoutSessionId string                 `protobuf:"bytes,2,opt,name=checkout_session_id,json=checkoutSessionId,proto3" json:"checkout_session_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConfirmTicketPaymentRequest) Reset() {
+	*x = ConfirmTicketPaymentRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTicketPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTicketPaymentRequest) ProtoMessage() {}
+
+func (x *ConfirmTicketPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTicketPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTicketPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConfirmTicketPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ConfirmTicketPaymentRequest) GetCheckoutSessionId() string {
+	if x != nil {
+		return x.CheckoutSessionId
+	}
+	return ""
+}
+
+type ConfirmTicketPaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReceiptReference string                 `protobuf:"bytes,3,opt,name=receipt_reference,json=receiptReference,proto3" json:"receipt_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfirmTicketPaymentResponse) Reset() {
+	*x = ConfirmTicketPaymentResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTicketPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTicketPaymentResponse) ProtoMessage() {}
+
+func (x *ConfirmTicketPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTicketPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTicketPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConfirmTicketPaymentResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ConfirmTicketPaymentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConfirmTicketPaymentResponse) GetReceiptReference() string {
+	if x != nil {
+		return x.ReceiptReference
+	}
+	return ""
+}
+
+type BroadcastEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AttachTicket  bool                   `protobuf:"varint,3,opt,name=attach_ticket,json=attachTicket,proto3" json:"attach_ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastEventRequest) Reset() {
+	*x = BroadcastEventRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastEventRequest) ProtoMessage() {}
+
+func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastEventRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastEventRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BroadcastEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *BroadcastEventRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BroadcastEventRequest) GetAttachTicket() bool {
+	if x != nil {
+		return x.AttachTicket
+	}
+	return false
+}
+
+type BroadcastEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastEventResponse) Reset() {
+	*x = BroadcastEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastEventResponse) ProtoMessage() {}
+
+func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastEventResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastEventResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{53}
+}
+
+type EventLocation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	VenueName    string                 `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3" json:"venue_name,omitempty"`
+	Instructions string                 `protobuf:"bytes,2,opt,name=instructions,proto3" json:"instructions,omitempty"` // markdown
+	// Types that are valid to be assigned to Address:
+	//
+	//	*EventLocation_Geo
+	//	*EventLocation_Virtual
+	//	*EventLocation_Custom
+	Address       isEventLocation_Address `protobuf_oneof:"address"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventLocation) Reset() {
+	*x = EventLocation{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLocation) ProtoMessage() {}
+
+func (x *EventLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventLocation.ProtoReflect.Descriptor instead.
+func (*EventLocation) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{54}
+}
+
 func (x *EventLocation) GetVenueName() string {
 	if x != nil {
-		return x.VenueName
+		return x.VenueName
+	}
+	return ""
+}
+
+func (x *EventLocation) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *EventLocation) GetAddress() isEventLocation_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EventLocation) GetGeo() *AddressGeo {
+	if x != nil {
+		if x, ok := x.Address.(*EventLocation_Geo); ok {
+			return x.Geo
+		}
+	}
+	return nil
+}
+
+func (x *EventLocation) GetVirtual() *AddressVirtual {
+	if x != nil {
+		if x, ok := x.Address.(*EventLocation_Virtual); ok {
+			return x.Virtual
+		}
+	}
+	return nil
+}
+
+func (x *EventLocation) GetCustom() *AddressCustom {
+	if x != nil {
+		if x, ok := x.Address.(*EventLocation_Custom); ok {
+			return x.Custom
+		}
+	}
+	return nil
+}
+
+type isEventLocation_Address interface {
+	isEventLocation_Address()
+}
+
+type EventLocation_Geo struct {
+	Geo *AddressGeo `protobuf:"bytes,3,opt,name=geo,proto3,oneof"`
+}
+
+type EventLocation_Virtual struct {
+	Virtual *AddressVirtual `protobuf:"bytes,4,opt,name=virtual,proto3,oneof"`
+}
+
+type EventLocation_Custom struct {
+	Custom *AddressCustom `protobuf:"bytes,5,opt,name=custom,proto3,oneof"`
+}
+
+func (*EventLocation_Geo) isEventLocation_Address() {}
+
+func (*EventLocation_Virtual) isEventLocation_Address() {}
+
+func (*EventLocation_Custom) isEventLocation_Address() {}
+
+type AddressVirtual struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressVirtual) Reset() {
+	*x = AddressVirtual{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressVirtual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressVirtual) ProtoMessage() {}
+
+func (x *AddressVirtual) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressVirtual.ProtoReflect.Descriptor instead.
+func (*AddressVirtual) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddressVirtual) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type AddressGeo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Lat           float32                `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float32                `protobuf:"fixed32,3,opt,name=lng,proto3" json:"lng,omitempty"`
+	Size          float32                `protobuf:"fixed32,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressGeo) Reset() {
+	*x = AddressGeo{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressGeo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressGeo) ProtoMessage() {}
+
+func (x *AddressGeo) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressGeo.ProtoReflect.Descriptor instead.
+func (*AddressGeo) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddressGeo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressGeo) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *AddressGeo) GetLng() float32 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *AddressGeo) GetSize() float32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AddressCustom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressCustom) Reset() {
+	*x = AddressCustom{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressCustom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressCustom) ProtoMessage() {}
+
+func (x *AddressCustom) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressCustom.ProtoReflect.Descriptor instead.
+func (*AddressCustom) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddressCustom) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressCustom) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type EventPrivacy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to EventPrivacy:
+	//
+	//	*EventPrivacy_Public
+	//	*EventPrivacy_Guarded
+	//	*EventPrivacy_InviteOnly
+	EventPrivacy  isEventPrivacy_EventPrivacy `protobuf_oneof:"event_privacy"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventPrivacy) Reset() {
+	*x = EventPrivacy{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPrivacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPrivacy) ProtoMessage() {}
+
+func (x *EventPrivacy) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPrivacy.ProtoReflect.Descriptor instead.
+func (*EventPrivacy) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EventPrivacy) GetEventPrivacy() isEventPrivacy_EventPrivacy {
+	if x != nil {
+		return x.EventPrivacy
+	}
+	return nil
+}
+
+func (x *EventPrivacy) GetPublic() *EventPrivacyPublic {
+	if x != nil {
+		if x, ok := x.EventPrivacy.(*EventPrivacy_Public); ok {
+			return x.Public
+		}
+	}
+	return nil
+}
+
+func (x *EventPrivacy) GetGuarded() *EventPrivacyGuarded {
+	if x != nil {
+		if x, ok := x.EventPrivacy.(*EventPrivacy_Guarded); ok {
+			return x.Guarded
+		}
+	}
+	return nil
+}
+
+func (x *EventPrivacy) GetInviteOnly() *EventPrivacyInviteOnly {
+	if x != nil {
+		if x, ok := x.EventPrivacy.(*EventPrivacy_InviteOnly); ok {
+			return x.InviteOnly
+		}
+	}
+	return nil
+}
+
+type isEventPrivacy_EventPrivacy interface {
+	isEventPrivacy_EventPrivacy()
+}
+
+type EventPrivacy_Public struct {
+	Public *EventPrivacyPublic `protobuf:"bytes,1,opt,name=public,proto3,oneof"`
+}
+
+type EventPrivacy_Guarded struct {
+	Guarded *EventPrivacyGuarded `protobuf:"bytes,2,opt,name=guarded,proto3,oneof"`
+}
+
+type EventPrivacy_InviteOnly struct {
+	InviteOnly *EventPrivacyInviteOnly `protobuf:"bytes,3,opt,name=invite_only,json=inviteOnly,proto3,oneof"`
+}
+
+func (*EventPrivacy_Public) isEventPrivacy_EventPrivacy() {}
+
+func (*EventPrivacy_Guarded) isEventPrivacy_EventPrivacy() {}
+
+func (*EventPrivacy_InviteOnly) isEventPrivacy_EventPrivacy() {}
+
+type EventPrivacyPublic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventPrivacyPublic) Reset() {
+	*x = EventPrivacyPublic{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPrivacyPublic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPrivacyPublic) ProtoMessage() {}
+
+func (x *EventPrivacyPublic) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPrivacyPublic.ProtoReflect.Descriptor instead.
+func (*EventPrivacyPublic) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{59}
+}
+
+type EventPrivacyGuarded struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParticipationPubkey string                 `protobuf:"bytes,1,opt,name=participation_pubkey,json=participationPubkey,proto3" json:"participation_pubkey,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventPrivacyGuarded) Reset() {
+	*x = EventPrivacyGuarded{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPrivacyGuarded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPrivacyGuarded) ProtoMessage() {}
+
+func (x *EventPrivacyGuarded) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPrivacyGuarded.ProtoReflect.Descriptor instead.
+func (*EventPrivacyGuarded) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EventPrivacyGuarded) GetParticipationPubkey() string {
+	if x != nil {
+		return x.ParticipationPubkey
+	}
+	return ""
+}
+
+type EventPrivacyInviteOnly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventPrivacyInviteOnly) Reset() {
+	*x = EventPrivacyInviteOnly{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPrivacyInviteOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPrivacyInviteOnly) ProtoMessage() {}
+
+func (x *EventPrivacyInviteOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPrivacyInviteOnly.ProtoReflect.Descriptor instead.
+func (*EventPrivacyInviteOnly) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{61}
+}
+
+type EventInfo struct {
+	state                   protoimpl.MessageState  `protogen:"open.v1"`
+	Id                      string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                   string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description             string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUri                string                  `protobuf:"bytes,4,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Organizers              []string                `protobuf:"bytes,5,rep,name=organizers,proto3" json:"organizers,omitempty"`
+	Gatekeepers             []string                `protobuf:"bytes,6,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
+	StartDate               int64                   `protobuf:"varint,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // unix seconds
+	EndDate                 int64                   `protobuf:"varint,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // unix seconds
+	Capacity                uint32                  `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Location                *EventLocation          `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	Participants            uint32                  `protobuf:"varint,11,opt,name=participants,proto3" json:"participants,omitempty"`
+	Privacy                 *EventPrivacy           `protobuf:"bytes,12,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	CheckedIn               uint32                  `protobuf:"varint,13,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	Discoverable            bool                    `protobuf:"varint,14,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	PricesGroups            []*EventPriceGroup      `protobuf:"bytes,15,rep,name=prices_groups,json=pricesGroups,proto3" json:"prices_groups,omitempty"`
+	TicketTransfersDisabled bool                    `protobuf:"varint,16,opt,name=ticket_transfers_disabled,json=ticketTransfersDisabled,proto3" json:"ticket_transfers_disabled,omitempty"`
+	SeriesId                string                  `protobuf:"bytes,17,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"` // set for occurrences of a recurring series
+	RegistrationQuestions   []*RegistrationQuestion `protobuf:"bytes,18,rep,name=registration_questions,json=registrationQuestions,proto3" json:"registration_questions,omitempty"`
+	ApprovalRequired        bool                    `protobuf:"varint,19,opt,name=approval_required,json=approvalRequired,proto3" json:"approval_required,omitempty"`
+	ReminderOffsets         []uint32                `protobuf:"varint,20,rep,packed,name=reminder_offsets,json=reminderOffsets,proto3" json:"reminder_offsets,omitempty"` // seconds before the start date
+	Draft                   bool                    `protobuf:"varint,21,opt,name=draft,proto3" json:"draft,omitempty"`
+	PublishAt               int64                   `protobuf:"varint,22,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // unix seconds, 0 when the publication is not scheduled
+	Category                string                  `protobuf:"bytes,23,opt,name=category,proto3" json:"category,omitempty"`
+	Tags                    []string                `protobuf:"bytes,24,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EventInfo) Reset() {
+	*x = EventInfo{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInfo) ProtoMessage() {}
+
+func (x *EventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
+func (*EventInfo) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EventInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EventInfo) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *EventInfo) GetOrganizers() []string {
+	if x != nil {
+		return x.Organizers
+	}
+	return nil
+}
+
+func (x *EventInfo) GetGatekeepers() []string {
+	if x != nil {
+		return x.Gatekeepers
+	}
+	return nil
+}
+
+func (x *EventInfo) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *EventInfo) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *EventInfo) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *EventInfo) GetLocation() *EventLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *EventInfo) GetParticipants() uint32 {
+	if x != nil {
+		return x.Participants
+	}
+	return 0
+}
+
+func (x *EventInfo) GetPrivacy() *EventPrivacy {
+	if x != nil {
+		return x.Privacy
+	}
+	return nil
+}
+
+func (x *EventInfo) GetCheckedIn() uint32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *EventInfo) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+func (x *EventInfo) GetPricesGroups() []*EventPriceGroup {
+	if x != nil {
+		return x.PricesGroups
+	}
+	return nil
+}
+
+func (x *EventInfo) GetTicketTransfersDisabled() bool {
+	if x != nil {
+		return x.TicketTransfersDisabled
+	}
+	return false
+}
+
+func (x *EventInfo) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *EventInfo) GetRegistrationQuestions() []*RegistrationQuestion {
+	if x != nil {
+		return x.RegistrationQuestions
+	}
+	return nil
+}
+
+func (x *EventInfo) GetApprovalRequired() bool {
+	if x != nil {
+		return x.ApprovalRequired
+	}
+	return false
+}
+
+func (x *EventInfo) GetReminderOffsets() []uint32 {
+	if x != nil {
+		return x.ReminderOffsets
+	}
+	return nil
+}
+
+func (x *EventInfo) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *EventInfo) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *EventInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EventInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RegistrationQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // one of: text, single_choice, multi_choice or checkbox
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"` // choices of single_choice and multi_choice questions
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationQuestion) Reset() {
+	*x = RegistrationQuestion{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationQuestion) ProtoMessage() {}
+
+func (x *RegistrationQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationQuestion.ProtoReflect.Descriptor instead.
+func (*RegistrationQuestion) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RegistrationQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegistrationQuestion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RegistrationQuestion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RegistrationQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *RegistrationQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type RegistrationAnswer struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// the text, the chosen options or "true" for a checked checkbox
+	Values        []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationAnswer) Reset() {
+	*x = RegistrationAnswer{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationAnswer) ProtoMessage() {}
+
+func (x *RegistrationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationAnswer.ProtoReflect.Descriptor instead.
+func (*RegistrationAnswer) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RegistrationAnswer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *RegistrationAnswer) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AttendeeRegistrationAnswers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Answers       []*RegistrationAnswer  `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendeeRegistrationAnswers) Reset() {
+	*x = AttendeeRegistrationAnswers{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendeeRegistrationAnswers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendeeRegistrationAnswers) ProtoMessage() {}
+
+func (x *AttendeeRegistrationAnswers) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendeeRegistrationAnswers.ProtoReflect.Descriptor instead.
+func (*AttendeeRegistrationAnswers) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AttendeeRegistrationAnswers) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AttendeeRegistrationAnswers) GetAnswers() []*RegistrationAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type EventPriceGroup struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prices []*EventPrice          `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	// output only: first price on sale now, empty when none is
+	ActivePriceId string `protobuf:"bytes,4,opt,name=active_price_id,json=activePriceId,proto3" json:"active_price_id,omitempty"`
+	// output only: unix seconds of the next price sale window start or end, 0 when none is planned
+	NextPriceChangeAt int64 `protobuf:"varint,5,opt,name=next_price_change_at,json=nextPriceChangeAt,proto3" json:"next_price_change_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EventPriceGroup) Reset() {
+	*x = EventPriceGroup{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPriceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPriceGroup) ProtoMessage() {}
+
+func (x *EventPriceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPriceGroup.ProtoReflect.Descriptor instead.
+func (*EventPriceGroup) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EventPriceGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventPriceGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventPriceGroup) GetPrices() []*EventPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *EventPriceGroup) GetActivePriceId() string {
+	if x != nil {
+		return x.ActivePriceId
+	}
+	return ""
+}
+
+func (x *EventPriceGroup) GetNextPriceChangeAt() int64 {
+	if x != nil {
+		return x.NextPriceChangeAt
+	}
+	return 0
+}
+
+type EventPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// suggested amount for pay_what_you_want prices
+	AmountMinor        int64  `protobuf:"varint,2,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	CurrencyCode       string `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	PaymentAccountId   string `protobuf:"bytes,4,opt,name=payment_account_id,json=paymentAccountId,proto3" json:"payment_account_id,omitempty"`
+	PaymentAccountType string `protobuf:"bytes,5,opt,name=payment_account_type,json=paymentAccountType,proto3" json:"payment_account_type,omitempty"`
+	SaleStartsAt       int64  `protobuf:"varint,6,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`                   // unix seconds, 0 when on sale from the start
+	SaleEndsAt         int64  `protobuf:"varint,7,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`                         // unix seconds, exclusive, 0 when on sale until the event
+	Kind               string `protobuf:"bytes,8,opt,name=kind,proto3" json:"kind,omitempty"`                                                          // one of: "" (fixed), pay_what_you_want
+	MinimumAmountMinor int64  `protobuf:"varint,9,opt,name=minimum_amount_minor,json=minimumAmountMinor,proto3" json:"minimum_amount_minor,omitempty"` // lowest amount buyers can choose for pay_what_you_want prices
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EventPrice) Reset() {
+	*x = EventPrice{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPrice) ProtoMessage() {}
+
+func (x *EventPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPrice.ProtoReflect.Descriptor instead.
+func (*EventPrice) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EventPrice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventPrice) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *EventPrice) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *EventPrice) GetPaymentAccountId() string {
+	if x != nil {
+		return x.PaymentAccountId
+	}
+	return ""
+}
+
+func (x *EventPrice) GetPaymentAccountType() string {
+	if x != nil {
+		return x.PaymentAccountType
+	}
+	return ""
+}
+
+func (x *EventPrice) GetSaleStartsAt() int64 {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return 0
+}
+
+func (x *EventPrice) GetSaleEndsAt() int64 {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return 0
+}
+
+func (x *EventPrice) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EventPrice) GetMinimumAmountMinor() int64 {
+	if x != nil {
+		return x.MinimumAmountMinor
+	}
+	return 0
+}
+
+type BatchProfileField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProfileField) Reset() {
+	*x = BatchProfileField{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProfileField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProfileField) ProtoMessage() {}
+
+func (x *BatchProfileField) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProfileField.ProtoReflect.Descriptor instead.
+func (*BatchProfileField) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BatchProfileField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BatchProfileField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BatchProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*BatchProfileField   `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Addresses     []string               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProfileRequest) Reset() {
+	*x = BatchProfileRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProfileRequest) ProtoMessage() {}
+
+func (x *BatchProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProfileRequest.ProtoReflect.Descriptor instead.
+func (*BatchProfileRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BatchProfileRequest) GetFields() []*BatchProfileField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *BatchProfileRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type CreatePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgType       string                 `protobuf:"bytes,1,opt,name=org_type,json=orgType,proto3" json:"org_type,omitempty"` // one of: event, community
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Question      string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Duration      int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"` // unix seconds
+	Kind          v1.PollKind            `protobuf:"varint,6,opt,name=kind,proto3,enum=polls.v1.PollKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePollRequest) Reset() {
+	*x = CreatePollRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePollRequest) ProtoMessage() {}
+
+func (x *CreatePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePollRequest.ProtoReflect.Descriptor instead.
+func (*CreatePollRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreatePollRequest) GetOrgType() string {
+	if x != nil {
+		return x.OrgType
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreatePollRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreatePollRequest) GetKind() v1.PollKind {
+	if x != nil {
+		return x.Kind
+	}
+	return v1.PollKind(0)
+}
+
+type CreatePollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePollResponse) Reset() {
+	*x = CreatePollResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePollResponse) ProtoMessage() {}
+
+func (x *CreatePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePollResponse.ProtoReflect.Descriptor instead.
+func (*CreatePollResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreatePollResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type GetPollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has voted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPollRequest) Reset() {
+	*x = GetPollRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollRequest) ProtoMessage() {}
+
+func (x *GetPollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollRequest.ProtoReflect.Descriptor instead.
+func (*GetPollRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetPollRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *GetPollRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poll          *v1.Poll               `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPollResponse) Reset() {
+	*x = GetPollResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResponse) ProtoMessage() {}
+
+func (x *GetPollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResponse.ProtoReflect.Descriptor instead.
+func (*GetPollResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetPollResponse) GetPoll() *v1.Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Option        string                 `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *VotePollRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{75}
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgType       string                 `protobuf:"bytes,1,opt,name=org_type,json=orgType,proto3" json:"org_type,omitempty"` // one of: event, community
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreatePostRequest) GetOrgType() string {
+	if x != nil {
+		return x.OrgType
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreatePostResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *v11.PostView          `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostResponse) Reset() {
+	*x = GetPostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResponse) ProtoMessage() {}
+
+func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetPostResponse) GetPost() *v11.PostView {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetFeedPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedPostsRequest) Reset() {
+	*x = GetFeedPostsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedPostsRequest) ProtoMessage() {}
+
+func (x *GetFeedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetFeedPostsRequest) GetOrg() *Entity {
+	if x != nil {
+		return x.Org
+	}
+	return nil
+}
+
+func (x *GetFeedPostsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeedPostsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetFeedPostsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetFeedPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetFeedPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*v11.PostView        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedPostsResponse) Reset() {
+	*x = GetFeedPostsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedPostsResponse) ProtoMessage() {}
+
+func (x *GetFeedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetFeedPostsResponse) GetPosts() []*v11.PostView {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetChildrenPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildrenPostsRequest) Reset() {
+	*x = GetChildrenPostsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenPostsRequest) ProtoMessage() {}
+
+func (x *GetChildrenPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetChildrenPostsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetChildrenPostsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *GetChildrenPostsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetChildrenPostsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetChildrenPostsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetChildrenPostsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetChildrenPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*v11.PostView        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildrenPostsResponse) Reset() {
+	*x = GetChildrenPostsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenPostsResponse) ProtoMessage() {}
+
+func (x *GetChildrenPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetChildrenPostsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetChildrenPostsResponse) GetPosts() []*v11.PostView {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeletePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{85}
+}
+
+type ReactPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactPostRequest) Reset() {
+	*x = ReactPostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactPostRequest) ProtoMessage() {}
+
+func (x *ReactPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactPostRequest.ProtoReflect.Descriptor instead.
+func (*ReactPostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ReactPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReactPostRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type ReactPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactPostResponse) Reset() {
+	*x = ReactPostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactPostResponse) ProtoMessage() {}
+
+func (x *ReactPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactPostResponse.ProtoReflect.Descriptor instead.
+func (*ReactPostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{87}
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PinPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PinPostRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type PinPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResponse) ProtoMessage() {}
+
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{89}
+}
+
+type EditPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPostRequest) Reset() {
+	*x = EditPostRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRequest) ProtoMessage() {}
+
+func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
+func (*EditPostRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *EditPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type EditPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPostResponse) Reset() {
+	*x = EditPostResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResponse) ProtoMessage() {}
+
+func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
+func (*EditPostResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *EditPostResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type GetEventTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTicketsRequest) Reset() {
+	*x = GetEventTicketsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTicketsRequest) ProtoMessage() {}
+
+func (x *GetEventTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTicketsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetEventTicketsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetEventTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketsInfo   []*TicketInfo          `protobuf:"bytes,1,rep,name=tickets_info,json=ticketsInfo,proto3" json:"tickets_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTicketsResponse) Reset() {
+	*x = GetEventTicketsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTicketsResponse) ProtoMessage() {}
+
+func (x *GetEventTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTicketsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetEventTicketsResponse) GetTicketsInfo() []*TicketInfo {
+	if x != nil {
+		return x.TicketsInfo
+	}
+	return nil
+}
+
+type TicketInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketSecret  string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketInfo) Reset() {
+	*x = TicketInfo{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketInfo) ProtoMessage() {}
+
+func (x *TicketInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketInfo.ProtoReflect.Descriptor instead.
+func (*TicketInfo) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TicketInfo) GetTicketSecret() string {
+	if x != nil {
+		return x.TicketSecret
+	}
+	return ""
+}
+
+func (x *TicketInfo) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+type GetOrderDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderDetailsRequest) Reset() {
+	*x = GetOrderDetailsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDetailsRequest) ProtoMessage() {}
+
+func (x *GetOrderDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetOrderDetailsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type OrderSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	EventId             string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	BuyerId             string                 `protobuf:"bytes,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	AmountMinor         int64                  `protobuf:"varint,4,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	CurrencyCode        string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RefundStatus        string                 `protobuf:"bytes,7,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"` // one of: "", partially_refunded, refunded
+	RefundedAmountMinor int64                  `protobuf:"varint,8,opt,name=refunded_amount_minor,json=refundedAmountMinor,proto3" json:"refunded_amount_minor,omitempty"`
+	PromoCode           string                 `protobuf:"bytes,9,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	DiscountAmountMinor int64                  `protobuf:"varint,10,opt,name=discount_amount_minor,json=discountAmountMinor,proto3" json:"discount_amount_minor,omitempty"`
+	InvoiceId           string                 `protobuf:"bytes,11,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	DonationAmountMinor int64                  `protobuf:"varint,12,opt,name=donation_amount_minor,json=donationAmountMinor,proto3" json:"donation_amount_minor,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OrderSummary) Reset() {
+	*x = OrderSummary{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSummary) ProtoMessage() {}
+
+func (x *OrderSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSummary.ProtoReflect.Descriptor instead.
+func (*OrderSummary) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OrderSummary) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *OrderSummary) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrderSummary) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetRefundedAmountMinor() int64 {
+	if x != nil {
+		return x.RefundedAmountMinor
+	}
+	return 0
+}
+
+func (x *OrderSummary) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetDiscountAmountMinor() int64 {
+	if x != nil {
+		return x.DiscountAmountMinor
+	}
+	return 0
+}
+
+func (x *OrderSummary) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *OrderSummary) GetDonationAmountMinor() int64 {
+	if x != nil {
+		return x.DonationAmountMinor
+	}
+	return 0
+}
+
+type OrderTicketInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketSecret  string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTicketInfo) Reset() {
+	*x = OrderTicketInfo{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTicketInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTicketInfo) ProtoMessage() {}
+
+func (x *OrderTicketInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTicketInfo.ProtoReflect.Descriptor instead.
+func (*OrderTicketInfo) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *OrderTicketInfo) GetTicketSecret() string {
+	if x != nil {
+		return x.TicketSecret
+	}
+	return ""
+}
+
+func (x *OrderTicketInfo) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+type GetOrderDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *OrderSummary          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Tickets       []*OrderTicketInfo     `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderDetailsResponse) Reset() {
+	*x = GetOrderDetailsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDetailsResponse) ProtoMessage() {}
+
+func (x *GetOrderDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetOrderDetailsResponse) GetOrder() *OrderSummary {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *GetOrderDetailsResponse) GetTickets() []*OrderTicketInfo {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type GetOrderInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderInvoiceRequest) Reset() {
+	*x = GetOrderInvoiceRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderInvoiceRequest) ProtoMessage() {}
+
+func (x *GetOrderInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetOrderInvoiceRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Pdf           []byte                 `protobuf:"bytes,3,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderInvoiceResponse) Reset() {
+	*x = GetOrderInvoiceResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderInvoiceResponse) ProtoMessage() {}
+
+func (x *GetOrderInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetOrderInvoiceResponse) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *GetOrderInvoiceResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GetOrderInvoiceResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+type RefundOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// attendees to refund, empty means every attendee not refunded yet
+	AttendeeEmails []string `protobuf:"bytes,2,rep,name=attendee_emails,json=attendeeEmails,proto3" json:"attendee_emails,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderRequest) ProtoMessage() {}
+
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RefundOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetAttendeeEmails() []string {
+	if x != nil {
+		return x.AttendeeEmails
+	}
+	return nil
+}
+
+type RefundOrderResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RefundStatus        string                 `protobuf:"bytes,2,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
+	RefundedAmountMinor int64                  `protobuf:"varint,3,opt,name=refunded_amount_minor,json=refundedAmountMinor,proto3" json:"refunded_amount_minor,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefundOrderResponse) Reset() {
+	*x = RefundOrderResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderResponse) ProtoMessage() {}
+
+func (x *RefundOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RefundOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundOrderResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return ""
+}
+
+func (x *RefundOrderResponse) GetRefundedAmountMinor() int64 {
+	if x != nil {
+		return x.RefundedAmountMinor
+	}
+	return 0
+}
+
+type PromoCode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`          // one of: percent, fixed
+	PercentOff     uint32                 `protobuf:"varint,5,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`               // for percent discounts, 1 to 100
+	AmountOffMinor int64                  `protobuf:"varint,6,opt,name=amount_off_minor,json=amountOffMinor,proto3" json:"amount_off_minor,omitempty"` // for fixed discounts, applied to each ticket
+	CurrencyCode   string                 `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // for fixed discounts
+	PriceId        string                 `protobuf:"bytes,8,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`                         // empty means every price of the event
+	MaxRedemptions uint32                 `protobuf:"varint,9,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`   // orders, 0 means unlimited
+	Redemptions    uint32                 `protobuf:"varint,10,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	StartsAt       int64                  `protobuf:"varint,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // unix seconds, 0 means no start
+	EndsAt         int64                  `protobuf:"varint,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`       // unix seconds, 0 means no end
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PromoCode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromoCode) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *PromoCode) GetPercentOff() uint32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *PromoCode) GetAmountOffMinor() int64 {
+	if x != nil {
+		return x.AmountOffMinor
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *PromoCode) GetPriceId() string {
+	if x != nil {
+		return x.PriceId
+	}
+	return ""
+}
+
+func (x *PromoCode) GetMaxRedemptions() uint32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetRedemptions() uint32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *PromoCode) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type CreatePromoCodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"` // one of: percent, fixed
+	PercentOff     uint32                 `protobuf:"varint,4,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOffMinor int64                  `protobuf:"varint,5,opt,name=amount_off_minor,json=amountOffMinor,proto3" json:"amount_off_minor,omitempty"`
+	CurrencyCode   string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	PriceId        string                 `protobuf:"bytes,7,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	MaxRedemptions uint32                 `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	StartsAt       int64                  `protobuf:"varint,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         int64                  `protobuf:"varint,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreatePromoCodeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetPercentOff() uint32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetAmountOffMinor() int64 {
+	if x != nil {
+		return x.AmountOffMinor
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetPriceId() string {
+	if x != nil {
+		return x.PriceId
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetMaxRedemptions() uint32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type CreatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeResponse) Reset() {
+	*x = CreatePromoCodeResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeResponse) ProtoMessage() {}
+
+func (x *CreatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListPromoCodesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type DeletePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodeId   string                 `protobuf:"bytes,1,opt,name=promo_code_id,json=promoCodeId,proto3" json:"promo_code_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeRequest) Reset() {
+	*x = DeletePromoCodeRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeRequest) ProtoMessage() {}
+
+func (x *DeletePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DeletePromoCodeRequest) GetPromoCodeId() string {
+	if x != nil {
+		return x.PromoCodeId
+	}
+	return ""
+}
+
+type DeletePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeResponse) Reset() {
+	*x = DeletePromoCodeResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeResponse) ProtoMessage() {}
+
+func (x *DeletePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{109}
+}
+
+type WaitlistEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PriceGroupId   string                 `protobuf:"bytes,3,opt,name=price_group_id,json=priceGroupId,proto3" json:"price_group_id,omitempty"` // empty means the whole event
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Position       uint32                 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // one of: waiting, offered, claimed, expired
+	CreatedAt      int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OfferedAt      int64                  `protobuf:"varint,8,opt,name=offered_at,json=offeredAt,proto3" json:"offered_at,omitempty"`                  // unix seconds, 0 when no spot was offered yet
+	OfferExpiresAt int64                  `protobuf:"varint,9,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"` // unix seconds, end of the reserved hold
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPriceGroupId() string {
+	if x != nil {
+		return x.PriceGroupId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetOfferedAt() int64 {
+	if x != nil {
+		return x.OfferedAt
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() int64 {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return 0
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PriceId       string                 `protobuf:"bytes,2,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"` // empty for events without paid prices
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *JoinWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetPriceId() string {
+	if x != nil {
+		return x.PriceId
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *JoinWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *LeaveWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{114}
+}
+
+type GetEventWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventWaitlistRequest) Reset() {
+	*x = GetEventWaitlistRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventWaitlistRequest) ProtoMessage() {}
+
+func (x *GetEventWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetEventWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetEventWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetEventWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventWaitlistResponse) Reset() {
+	*x = GetEventWaitlistResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventWaitlistResponse) ProtoMessage() {}
+
+func (x *GetEventWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetEventWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetEventWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReorderWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EntryIds      []string               `protobuf:"bytes,2,rep,name=entry_ids,json=entryIds,proto3" json:"entry_ids,omitempty"` // waiting entries in their new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWaitlistRequest) Reset() {
+	*x = ReorderWaitlistRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWaitlistRequest) ProtoMessage() {}
+
+func (x *ReorderWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReorderWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReorderWaitlistRequest) GetEntryIds() []string {
+	if x != nil {
+		return x.EntryIds
+	}
+	return nil
+}
+
+type ReorderWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWaitlistResponse) Reset() {
+	*x = ReorderWaitlistResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWaitlistResponse) ProtoMessage() {}
+
+func (x *ReorderWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ReorderWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ReorderWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type EventApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BuyerId       string                 `protobuf:"bytes,4,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"` // user who applied, differs from user_id for guests
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                  // one of: pending, approved, rejected
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                // reason given by the organizer on rejection
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReviewedAt    int64                  `protobuf:"varint,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"` // unix seconds, 0 while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventApplication) Reset() {
+	*x = EventApplication{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventApplication) ProtoMessage() {}
+
+func (x *EventApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventApplication.ProtoReflect.Descriptor instead.
+func (*EventApplication) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *EventApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventApplication) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventApplication) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventApplication) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *EventApplication) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventApplication) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EventApplication) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EventApplication) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+type ListEventApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventApplicationsRequest) Reset() {
+	*x = ListEventApplicationsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventApplicationsRequest) ProtoMessage() {}
+
+func (x *ListEventApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListEventApplicationsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventApplicationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListEventApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*EventApplication    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventApplicationsResponse) Reset() {
+	*x = ListEventApplicationsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventApplicationsResponse) ProtoMessage() {}
+
+func (x *ListEventApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListEventApplicationsResponse) GetApplications() []*EventApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type ApproveEventApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEventApplicationRequest) Reset() {
+	*x = ApproveEventApplicationRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEventApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEventApplicationRequest) ProtoMessage() {}
+
+func (x *ApproveEventApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEventApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveEventApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ApproveEventApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ApproveEventApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *EventApplication      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEventApplicationResponse) Reset() {
+	*x = ApproveEventApplicationResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEventApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEventApplicationResponse) ProtoMessage() {}
+
+func (x *ApproveEventApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEventApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveEventApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ApproveEventApplicationResponse) GetApplication() *EventApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type RejectEventApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // sent to the applicant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEventApplicationRequest) Reset() {
+	*x = RejectEventApplicationRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEventApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEventApplicationRequest) ProtoMessage() {}
+
+func (x *RejectEventApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEventApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectEventApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RejectEventApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *RejectEventApplicationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RejectEventApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *EventApplication      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEventApplicationResponse) Reset() {
+	*x = RejectEventApplicationResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEventApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEventApplicationResponse) ProtoMessage() {}
+
+func (x *RejectEventApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEventApplicationResponse.ProtoReflect.Descriptor instead.
+func (*RejectEventApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RejectEventApplicationResponse) GetApplication() *EventApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type EventInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`               // one of: invited, opened, accepted, declined
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // set once the invitation is answered by a signed-in user
+	SentCount     uint32                 `protobuf:"varint,6,opt,name=sent_count,json=sentCount,proto3" json:"sent_count,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSentAt    int64                  `protobuf:"varint,8,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`   // unix seconds
+	OpenedAt      int64                  `protobuf:"varint,9,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`           // unix seconds, 0 until the recipient opens the invitation
+	RespondedAt   int64                  `protobuf:"varint,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"` // unix seconds, 0 until accepted or declined
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventInvitation) Reset() {
+	*x = EventInvitation{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInvitation) ProtoMessage() {}
+
+func (x *EventInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInvitation.ProtoReflect.Descriptor instead.
+func (*EventInvitation) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *EventInvitation) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *EventLocation) GetInstructions() string {
+func (x *EventInvitation) GetEventId() string {
 	if x != nil {
-		return x.Instructions
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *EventLocation) GetAddress() isEventLocation_Address {
+func (x *EventInvitation) GetEmail() string {
 	if x != nil {
-		return x.Address
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *EventLocation) GetGeo() *AddressGeo {
+func (x *EventInvitation) GetStatus() string {
 	if x != nil {
-		if x, ok := x.Address.(*EventLocation_Geo); ok {
-			return x.Geo
-		}
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *EventLocation) GetVirtual() *AddressVirtual {
+func (x *EventInvitation) GetUserId() string {
 	if x != nil {
-		if x, ok := x.Address.(*EventLocation_Virtual); ok {
-			return x.Virtual
-		}
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *EventLocation) GetCustom() *AddressCustom {
+func (x *EventInvitation) GetSentCount() uint32 {
 	if x != nil {
-		if x, ok := x.Address.(*EventLocation_Custom); ok {
-			return x.Custom
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *EventInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EventInvitation) GetLastSentAt() int64 {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return 0
+}
+
+func (x *EventInvitation) GetOpenedAt() int64 {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return 0
+}
+
+func (x *EventInvitation) GetRespondedAt() int64 {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return 0
+}
+
+type InviteToEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Emails        []string               `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"` // emails already invited are skipped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToEventRequest) Reset() {
+	*x = InviteToEventRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToEventRequest) ProtoMessage() {}
+
+func (x *InviteToEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isEventLocation_Address interface {
-	isEventLocation_Address()
+// Deprecated: Use InviteToEventRequest.ProtoReflect.Descriptor instead.
+func (*InviteToEventRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{127}
 }
 
-type EventLocation_Geo struct {
-	Geo *AddressGeo `protobuf:"bytes,3,opt,name=geo,proto3,oneof"`
+func (x *InviteToEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
 }
 
-type EventLocation_Virtual struct {
-	Virtual *AddressVirtual `protobuf:"bytes,4,opt,name=virtual,proto3,oneof"`
+func (x *InviteToEventRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
 }
 
-type EventLocation_Custom struct {
-	Custom *AddressCustom `protobuf:"bytes,5,opt,name=custom,proto3,oneof"`
+type InviteToEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*EventInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (*EventLocation_Geo) isEventLocation_Address() {}
+func (x *InviteToEventResponse) Reset() {
+	*x = InviteToEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (*EventLocation_Virtual) isEventLocation_Address() {}
+func (x *InviteToEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*EventLocation_Custom) isEventLocation_Address() {}
+func (*InviteToEventResponse) ProtoMessage() {}
 
-type AddressVirtual struct {
+func (x *InviteToEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToEventResponse.ProtoReflect.Descriptor instead.
+func (*InviteToEventResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *InviteToEventResponse) GetInvitations() []*EventInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type ListEventInvitationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddressVirtual) Reset() {
-	*x = AddressVirtual{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[41]
+func (x *ListEventInvitationsRequest) Reset() {
+	*x = ListEventInvitationsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddressVirtual) String() string {
+func (x *ListEventInvitationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressVirtual) ProtoMessage() {}
+func (*ListEventInvitationsRequest) ProtoMessage() {}
 
-func (x *AddressVirtual) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[41]
+func (x *ListEventInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,43 +8003,40 @@ func (x *AddressVirtual) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressVirtual.ProtoReflect.Descriptor instead.
-func (*AddressVirtual) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListEventInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *AddressVirtual) GetUri() string {
+func (x *ListEventInvitationsRequest) GetEventId() string {
 	if x != nil {
-		return x.Uri
+		return x.EventId
 	}
 	return ""
 }
 
-type AddressGeo struct {
+type ListEventInvitationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Lat           float32                `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lng           float32                `protobuf:"fixed32,3,opt,name=lng,proto3" json:"lng,omitempty"`
-	Size          float32                `protobuf:"fixed32,4,opt,name=size,proto3" json:"size,omitempty"`
+	Invitations   []*EventInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddressGeo) Reset() {
-	*x = AddressGeo{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[42]
+func (x *ListEventInvitationsResponse) Reset() {
+	*x = ListEventInvitationsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddressGeo) String() string {
+func (x *ListEventInvitationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressGeo) ProtoMessage() {}
+func (*ListEventInvitationsResponse) ProtoMessage() {}
 
-func (x *AddressGeo) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[42]
+func (x *ListEventInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,62 +8047,137 @@ func (x *AddressGeo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressGeo.ProtoReflect.Descriptor instead.
-func (*AddressGeo) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListEventInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *AddressGeo) GetAddress() string {
+func (x *ListEventInvitationsResponse) GetInvitations() []*EventInvitation {
 	if x != nil {
-		return x.Address
+		return x.Invitations
+	}
+	return nil
+}
+
+type ResendEventInvitationsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// empty resends every invitation that is not answered yet
+	InvitationIds []string `protobuf:"bytes,2,rep,name=invitation_ids,json=invitationIds,proto3" json:"invitation_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEventInvitationsRequest) Reset() {
+	*x = ResendEventInvitationsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEventInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEventInvitationsRequest) ProtoMessage() {}
+
+func (x *ResendEventInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEventInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ResendEventInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ResendEventInvitationsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *AddressGeo) GetLat() float32 {
+func (x *ResendEventInvitationsRequest) GetInvitationIds() []string {
 	if x != nil {
-		return x.Lat
+		return x.InvitationIds
 	}
-	return 0
+	return nil
 }
 
-func (x *AddressGeo) GetLng() float32 {
+type ResendEventInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*EventInvitation     `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEventInvitationsResponse) Reset() {
+	*x = ResendEventInvitationsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEventInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEventInvitationsResponse) ProtoMessage() {}
+
+func (x *ResendEventInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[132]
 	if x != nil {
-		return x.Lng
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AddressGeo) GetSize() float32 {
+// Deprecated: Use ResendEventInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ResendEventInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ResendEventInvitationsResponse) GetInvitations() []*EventInvitation {
 	if x != nil {
-		return x.Size
+		return x.Invitations
 	}
-	return 0
+	return nil
 }
 
-type AddressCustom struct {
+type GetInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddressCustom) Reset() {
-	*x = AddressCustom{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[43]
+func (x *GetInvitationRequest) Reset() {
+	*x = GetInvitationRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddressCustom) String() string {
+func (x *GetInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressCustom) ProtoMessage() {}
+func (*GetInvitationRequest) ProtoMessage() {}
 
-func (x *AddressCustom) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[43]
+func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,51 +8188,42 @@ func (x *AddressCustom) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressCustom.ProtoReflect.Descriptor instead.
-func (*AddressCustom) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *AddressCustom) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use GetInvitationRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *AddressCustom) GetTimezone() string {
+func (x *GetInvitationRequest) GetToken() string {
 	if x != nil {
-		return x.Timezone
+		return x.Token
 	}
 	return ""
 }
 
-type EventPrivacy struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to EventPrivacy:
-	//
-	//	*EventPrivacy_Public
-	//	*EventPrivacy_Guarded
-	EventPrivacy  isEventPrivacy_EventPrivacy `protobuf_oneof:"event_privacy"`
+type GetInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventPrivacy) Reset() {
-	*x = EventPrivacy{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[44]
+func (x *GetInvitationResponse) Reset() {
+	*x = GetInvitationResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventPrivacy) String() string {
+func (x *GetInvitationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventPrivacy) ProtoMessage() {}
+func (*GetInvitationResponse) ProtoMessage() {}
 
-func (x *EventPrivacy) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[44]
+func (x *GetInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,73 +8234,54 @@ func (x *EventPrivacy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventPrivacy.ProtoReflect.Descriptor instead.
-func (*EventPrivacy) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetInvitationResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *EventPrivacy) GetEventPrivacy() isEventPrivacy_EventPrivacy {
+func (x *GetInvitationResponse) GetEventId() string {
 	if x != nil {
-		return x.EventPrivacy
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-func (x *EventPrivacy) GetPublic() *EventPrivacyPublic {
+func (x *GetInvitationResponse) GetEmail() string {
 	if x != nil {
-		if x, ok := x.EventPrivacy.(*EventPrivacy_Public); ok {
-			return x.Public
-		}
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *EventPrivacy) GetGuarded() *EventPrivacyGuarded {
+func (x *GetInvitationResponse) GetStatus() string {
 	if x != nil {
-		if x, ok := x.EventPrivacy.(*EventPrivacy_Guarded); ok {
-			return x.Guarded
-		}
+		return x.Status
 	}
-	return nil
-}
-
-type isEventPrivacy_EventPrivacy interface {
-	isEventPrivacy_EventPrivacy()
-}
-
-type EventPrivacy_Public struct {
-	Public *EventPrivacyPublic `protobuf:"bytes,1,opt,name=public,proto3,oneof"`
-}
-
-type EventPrivacy_Guarded struct {
-	Guarded *EventPrivacyGuarded `protobuf:"bytes,2,opt,name=guarded,proto3,oneof"`
+	return ""
 }
 
-func (*EventPrivacy_Public) isEventPrivacy_EventPrivacy() {}
-
-func (*EventPrivacy_Guarded) isEventPrivacy_EventPrivacy() {}
-
-type EventPrivacyPublic struct {
+type DeclineInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventPrivacyPublic) Reset() {
-	*x = EventPrivacyPublic{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[45]
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventPrivacyPublic) String() string {
+func (x *DeclineInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventPrivacyPublic) ProtoMessage() {}
+func (*DeclineInvitationRequest) ProtoMessage() {}
 
-func (x *EventPrivacyPublic) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[45]
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,33 +8292,39 @@ func (x *EventPrivacyPublic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventPrivacyPublic.ProtoReflect.Descriptor instead.
-func (*EventPrivacyPublic) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{135}
 }
 
-type EventPrivacyGuarded struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ParticipationPubkey string                 `protobuf:"bytes,1,opt,name=participation_pubkey,json=participationPubkey,proto3" json:"participation_pubkey,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (x *EventPrivacyGuarded) Reset() {
-	*x = EventPrivacyGuarded{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[46]
+type DeclineInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationResponse) Reset() {
+	*x = DeclineInvitationResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventPrivacyGuarded) String() string {
+func (x *DeclineInvitationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventPrivacyGuarded) ProtoMessage() {}
+func (*DeclineInvitationResponse) ProtoMessage() {}
 
-func (x *EventPrivacyGuarded) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[46]
+func (x *DeclineInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,54 +8335,44 @@ func (x *EventPrivacyGuarded) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventPrivacyGuarded.ProtoReflect.Descriptor instead.
-func (*EventPrivacyGuarded) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *EventPrivacyGuarded) GetParticipationPubkey() string {
-	if x != nil {
-		return x.ParticipationPubkey
-	}
-	return ""
+// Deprecated: Use DeclineInvitationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{136}
 }
 
-type EventInfo struct {
+type EventSession struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUri      string                 `protobuf:"bytes,4,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
-	Organizers    []string               `protobuf:"bytes,5,rep,name=organizers,proto3" json:"organizers,omitempty"`
-	Gatekeepers   []string               `protobuf:"bytes,6,rep,name=gatekeepers,proto3" json:"gatekeepers,omitempty"`
-	StartDate     int64                  `protobuf:"varint,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // unix seconds
-	EndDate       int64                  `protobuf:"varint,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // unix seconds
-	Capacity      uint32                 `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Location      *EventLocation         `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
-	Participants  uint32                 `protobuf:"varint,11,opt,name=participants,proto3" json:"participants,omitempty"`
-	Privacy       *EventPrivacy          `protobuf:"bytes,12,opt,name=privacy,proto3" json:"privacy,omitempty"`
-	CheckedIn     uint32                 `protobuf:"varint,13,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
-	Discoverable  bool                   `protobuf:"varint,14,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
-	PricesGroups  []*EventPriceGroup     `protobuf:"bytes,15,rep,name=prices_groups,json=pricesGroups,proto3" json:"prices_groups,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // markdown
+	Speakers      []string               `protobuf:"bytes,5,rep,name=speakers,proto3" json:"speakers,omitempty"`
+	Room          string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`
+	Track         string                 `protobuf:"bytes,7,opt,name=track,proto3" json:"track,omitempty"`
+	StartDate     int64                  `protobuf:"varint,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // unix seconds, within the event
+	EndDate       int64                  `protobuf:"varint,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // unix seconds, within the event
+	Capacity      uint32                 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`                   // registrations limit, 0 means unlimited
+	Registrations uint32                 `protobuf:"varint,11,opt,name=registrations,proto3" json:"registrations,omitempty"`
+	Registered    bool                   `protobuf:"varint,12,opt,name=registered,proto3" json:"registered,omitempty"` // whether the caller registered to the session
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventInfo) Reset() {
-	*x = EventInfo{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[47]
+func (x *EventSession) Reset() {
+	*x = EventSession{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventInfo) String() string {
+func (x *EventSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventInfo) ProtoMessage() {}
+func (*EventSession) ProtoMessage() {}
 
-func (x *EventInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[47]
+func (x *EventSession) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2825,140 +8383,118 @@ func (x *EventInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
-func (*EventInfo) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use EventSession.ProtoReflect.Descriptor instead.
+func (*EventSession) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *EventInfo) GetId() string {
+func (x *EventSession) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *EventInfo) GetTitle() string {
+func (x *EventSession) GetEventId() string {
 	if x != nil {
-		return x.Title
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *EventInfo) GetDescription() string {
+func (x *EventSession) GetTitle() string {
 	if x != nil {
-		return x.Description
+		return x.Title
 	}
 	return ""
 }
 
-func (x *EventInfo) GetImageUri() string {
+func (x *EventSession) GetDescription() string {
 	if x != nil {
-		return x.ImageUri
+		return x.Description
 	}
 	return ""
 }
 
-func (x *EventInfo) GetOrganizers() []string {
+func (x *EventSession) GetSpeakers() []string {
 	if x != nil {
-		return x.Organizers
+		return x.Speakers
 	}
 	return nil
 }
 
-func (x *EventInfo) GetGatekeepers() []string {
+func (x *EventSession) GetRoom() string {
 	if x != nil {
-		return x.Gatekeepers
+		return x.Room
 	}
-	return nil
+	return ""
 }
 
-func (x *EventInfo) GetStartDate() int64 {
+func (x *EventSession) GetTrack() string {
 	if x != nil {
-		return x.StartDate
+		return x.Track
 	}
-	return 0
+	return ""
 }
 
-func (x *EventInfo) GetEndDate() int64 {
+func (x *EventSession) GetStartDate() int64 {
 	if x != nil {
-		return x.EndDate
+		return x.StartDate
 	}
 	return 0
 }
 
-func (x *EventInfo) GetCapacity() uint32 {
+func (x *EventSession) GetEndDate() int64 {
 	if x != nil {
-		return x.Capacity
+		return x.EndDate
 	}
 	return 0
 }
 
-func (x *EventInfo) GetLocation() *EventLocation {
-	if x != nil {
-		return x.Location
-	}
-	return nil
-}
-
-func (x *EventInfo) GetParticipants() uint32 {
+func (x *EventSession) GetCapacity() uint32 {
 	if x != nil {
-		return x.Participants
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *EventInfo) GetPrivacy() *EventPrivacy {
-	if x != nil {
-		return x.Privacy
-	}
-	return nil
-}
-
-func (x *EventInfo) GetCheckedIn() uint32 {
+func (x *EventSession) GetRegistrations() uint32 {
 	if x != nil {
-		return x.CheckedIn
+		return x.Registrations
 	}
 	return 0
 }
 
-func (x *EventInfo) GetDiscoverable() bool {
+func (x *EventSession) GetRegistered() bool {
 	if x != nil {
-		return x.Discoverable
+		return x.Registered
 	}
 	return false
 }
 
-func (x *EventInfo) GetPricesGroups() []*EventPriceGroup {
-	if x != nil {
-		return x.PricesGroups
-	}
-	return nil
-}
-
-type EventPriceGroup struct {
+type CreateEventSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Prices        []*EventPrice          `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Session       *EventSession          `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"` // id, event_id and registrations are ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventPriceGroup) Reset() {
-	*x = EventPriceGroup{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[48]
+func (x *CreateEventSessionRequest) Reset() {
+	*x = CreateEventSessionRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventPriceGroup) String() string {
+func (x *CreateEventSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventPriceGroup) ProtoMessage() {}
+func (*CreateEventSessionRequest) ProtoMessage() {}
 
-func (x *EventPriceGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[48]
+func (x *CreateEventSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2969,58 +8505,47 @@ func (x *EventPriceGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventPriceGroup.ProtoReflect.Descriptor instead.
-func (*EventPriceGroup) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *EventPriceGroup) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateEventSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventSessionRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *EventPriceGroup) GetName() string {
+func (x *CreateEventSessionRequest) GetEventId() string {
 	if x != nil {
-		return x.Name
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *EventPriceGroup) GetPrices() []*EventPrice {
+func (x *CreateEventSessionRequest) GetSession() *EventSession {
 	if x != nil {
-		return x.Prices
+		return x.Session
 	}
 	return nil
 }
 
-type EventPrice struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AmountMinor        int64                  `protobuf:"varint,2,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
-	CurrencyCode       string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	PaymentAccountId   string                 `protobuf:"bytes,4,opt,name=payment_account_id,json=paymentAccountId,proto3" json:"payment_account_id,omitempty"`
-	PaymentAccountType string                 `protobuf:"bytes,5,opt,name=payment_account_type,json=paymentAccountType,proto3" json:"payment_account_type,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreateEventSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *EventSession          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventPrice) Reset() {
-	*x = EventPrice{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[49]
+func (x *CreateEventSessionResponse) Reset() {
+	*x = CreateEventSessionResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventPrice) String() string {
+func (x *CreateEventSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventPrice) ProtoMessage() {}
+func (*CreateEventSessionResponse) ProtoMessage() {}
 
-func (x *EventPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[49]
+func (x *CreateEventSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3031,69 +8556,41 @@ func (x *EventPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventPrice.ProtoReflect.Descriptor instead.
-func (*EventPrice) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *EventPrice) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *EventPrice) GetAmountMinor() int64 {
-	if x != nil {
-		return x.AmountMinor
-	}
-	return 0
-}
-
-func (x *EventPrice) GetCurrencyCode() string {
-	if x != nil {
-		return x.CurrencyCode
-	}
-	return ""
-}
-
-func (x *EventPrice) GetPaymentAccountId() string {
-	if x != nil {
-		return x.PaymentAccountId
-	}
-	return ""
+// Deprecated: Use CreateEventSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventSessionResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *EventPrice) GetPaymentAccountType() string {
+func (x *CreateEventSessionResponse) GetSession() *EventSession {
 	if x != nil {
-		return x.PaymentAccountType
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-type BatchProfileField struct {
+type EditEventSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Session       *EventSession          `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"` // id, event_id and registrations are ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchProfileField) Reset() {
-	*x = BatchProfileField{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[50]
+func (x *EditEventSessionRequest) Reset() {
+	*x = EditEventSessionRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchProfileField) String() string {
+func (x *EditEventSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchProfileField) ProtoMessage() {}
+func (*EditEventSessionRequest) ProtoMessage() {}
 
-func (x *BatchProfileField) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[50]
+func (x *EditEventSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,48 +8601,47 @@ func (x *BatchProfileField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchProfileField.ProtoReflect.Descriptor instead.
-func (*BatchProfileField) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use EditEventSessionRequest.ProtoReflect.Descriptor instead.
+func (*EditEventSessionRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *BatchProfileField) GetType() string {
+func (x *EditEventSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Type
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *BatchProfileField) GetKey() string {
+func (x *EditEventSessionRequest) GetSession() *EventSession {
 	if x != nil {
-		return x.Key
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-type BatchProfileRequest struct {
+type EditEventSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fields        []*BatchProfileField   `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	Addresses     []string               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Session       *EventSession          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchProfileRequest) Reset() {
-	*x = BatchProfileRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[51]
+func (x *EditEventSessionResponse) Reset() {
+	*x = EditEventSessionResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchProfileRequest) String() string {
+func (x *EditEventSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchProfileRequest) ProtoMessage() {}
+func (*EditEventSessionResponse) ProtoMessage() {}
 
-func (x *BatchProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[51]
+func (x *EditEventSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,52 +8652,40 @@ func (x *BatchProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchProfileRequest.ProtoReflect.Descriptor instead.
-func (*BatchProfileRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *BatchProfileRequest) GetFields() []*BatchProfileField {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
+// Deprecated: Use EditEventSessionResponse.ProtoReflect.Descriptor instead.
+func (*EditEventSessionResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *BatchProfileRequest) GetAddresses() []string {
+func (x *EditEventSessionResponse) GetSession() *EventSession {
 	if x != nil {
-		return x.Addresses
+		return x.Session
 	}
 	return nil
 }
 
-type CreatePollRequest struct {
+type DeleteEventSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgType       string                 `protobuf:"bytes,1,opt,name=org_type,json=orgType,proto3" json:"org_type,omitempty"` // one of: event, community
-	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Question      string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
-	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	Duration      int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"` // unix seconds
-	Kind          v1.PollKind            `protobuf:"varint,6,opt,name=kind,proto3,enum=polls.v1.PollKind" json:"kind,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePollRequest) Reset() {
-	*x = CreatePollRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[52]
+func (x *DeleteEventSessionRequest) Reset() {
+	*x = DeleteEventSessionRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePollRequest) String() string {
+func (x *DeleteEventSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePollRequest) ProtoMessage() {}
+func (*DeleteEventSessionRequest) ProtoMessage() {}
 
-func (x *CreatePollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[52]
+func (x *DeleteEventSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3212,75 +8696,77 @@ func (x *CreatePollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePollRequest.ProtoReflect.Descriptor instead.
-func (*CreatePollRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use DeleteEventSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventSessionRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *CreatePollRequest) GetOrgType() string {
+func (x *DeleteEventSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.OrgType
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CreatePollRequest) GetOrgId() string {
-	if x != nil {
-		return x.OrgId
-	}
-	return ""
+type DeleteEventSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePollRequest) GetQuestion() string {
-	if x != nil {
-		return x.Question
-	}
-	return ""
+func (x *DeleteEventSessionResponse) Reset() {
+	*x = DeleteEventSessionResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePollRequest) GetOptions() []string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
+func (x *DeleteEventSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreatePollRequest) GetDuration() int64 {
+func (*DeleteEventSessionResponse) ProtoMessage() {}
+
+func (x *DeleteEventSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[143]
 	if x != nil {
-		return x.Duration
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePollRequest) GetKind() v1.PollKind {
-	if x != nil {
-		return x.Kind
-	}
-	return v1.PollKind(0)
+// Deprecated: Use DeleteEventSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventSessionResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{143}
 }
 
-type CreatePollResponse struct {
+type ListEventSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Track         string                 `protobuf:"bytes,2,opt,name=track,proto3" json:"track,omitempty"` // optional filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePollResponse) Reset() {
-	*x = CreatePollResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[53]
+func (x *ListEventSessionsRequest) Reset() {
+	*x = ListEventSessionsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePollResponse) String() string {
+func (x *ListEventSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePollResponse) ProtoMessage() {}
+func (*ListEventSessionsRequest) ProtoMessage() {}
 
-func (x *CreatePollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[53]
+func (x *ListEventSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3291,41 +8777,48 @@ func (x *CreatePollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePollResponse.ProtoReflect.Descriptor instead.
-func (*CreatePollResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ListEventSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *CreatePollResponse) GetPostId() string {
+func (x *ListEventSessionsRequest) GetEventId() string {
 	if x != nil {
-		return x.PostId
+		return x.EventId
 	}
 	return ""
 }
 
-type GetPollRequest struct {
+func (x *ListEventSessionsRequest) GetTrack() string {
+	if x != nil {
+		return x.Track
+	}
+	return ""
+}
+
+type ListEventSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has voted
+	Sessions      []*EventSession        `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // ordered by start date
+	Tracks        []string               `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`     // tracks of all the sessions of the event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPollRequest) Reset() {
-	*x = GetPollRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[54]
+func (x *ListEventSessionsResponse) Reset() {
+	*x = ListEventSessionsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPollRequest) String() string {
+func (x *ListEventSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPollRequest) ProtoMessage() {}
+func (*ListEventSessionsResponse) ProtoMessage() {}
 
-func (x *GetPollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[54]
+func (x *ListEventSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3336,47 +8829,47 @@ func (x *GetPollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPollRequest.ProtoReflect.Descriptor instead.
-func (*GetPollRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListEventSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *GetPollRequest) GetPollId() string {
+func (x *ListEventSessionsResponse) GetSessions() []*EventSession {
 	if x != nil {
-		return x.PollId
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-func (x *GetPollRequest) GetUserId() string {
+func (x *ListEventSessionsResponse) GetTracks() []string {
 	if x != nil {
-		return x.UserId
+		return x.Tracks
 	}
-	return ""
+	return nil
 }
 
-type GetPollResponse struct {
+type RegisterForSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Poll          *v1.Poll               `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPollResponse) Reset() {
-	*x = GetPollResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[55]
+func (x *RegisterForSessionRequest) Reset() {
+	*x = RegisterForSessionRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPollResponse) String() string {
+func (x *RegisterForSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPollResponse) ProtoMessage() {}
+func (*RegisterForSessionRequest) ProtoMessage() {}
 
-func (x *GetPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[55]
+func (x *RegisterForSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3387,41 +8880,40 @@ func (x *GetPollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPollResponse.ProtoReflect.Descriptor instead.
-func (*GetPollResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RegisterForSessionRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForSessionRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *GetPollResponse) GetPoll() *v1.Poll {
+func (x *RegisterForSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Poll
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type VotePollRequest struct {
+type RegisterForSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
-	Option        string                 `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	Session       *EventSession          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VotePollRequest) Reset() {
-	*x = VotePollRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[56]
+func (x *RegisterForSessionResponse) Reset() {
+	*x = RegisterForSessionResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VotePollRequest) String() string {
+func (x *RegisterForSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VotePollRequest) ProtoMessage() {}
+func (*RegisterForSessionResponse) ProtoMessage() {}
 
-func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[56]
+func (x *RegisterForSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,46 +8924,40 @@ func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
-func (*VotePollRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *VotePollRequest) GetPollId() string {
-	if x != nil {
-		return x.PollId
-	}
-	return ""
+// Deprecated: Use RegisterForSessionResponse.ProtoReflect.Descriptor instead.
+func (*RegisterForSessionResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *VotePollRequest) GetOption() string {
+func (x *RegisterForSessionResponse) GetSession() *EventSession {
 	if x != nil {
-		return x.Option
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-type VotePollResponse struct {
+type UnregisterFromSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VotePollResponse) Reset() {
-	*x = VotePollResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[57]
+func (x *UnregisterFromSessionRequest) Reset() {
+	*x = UnregisterFromSessionRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VotePollResponse) String() string {
+func (x *UnregisterFromSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VotePollResponse) ProtoMessage() {}
+func (*UnregisterFromSessionRequest) ProtoMessage() {}
 
-func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[57]
+func (x *UnregisterFromSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3482,37 +8968,39 @@ func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
-func (*VotePollResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use UnregisterFromSessionRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterFromSessionRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{148}
 }
 
-type CreatePostRequest struct {
+func (x *UnregisterFromSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type UnregisterFromSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgType       string                 `protobuf:"bytes,1,opt,name=org_type,json=orgType,proto3" json:"org_type,omitempty"` // one of: event, community
-	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[58]
+func (x *UnregisterFromSessionResponse) Reset() {
+	*x = UnregisterFromSessionResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *UnregisterFromSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*UnregisterFromSessionResponse) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[58]
+func (x *UnregisterFromSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3523,68 +9011,69 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UnregisterFromSessionResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterFromSessionResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *CreatePostRequest) GetOrgType() string {
-	if x != nil {
-		return x.OrgType
-	}
-	return ""
+type GetUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) GetOrgId() string {
-	if x != nil {
-		return x.OrgId
-	}
-	return ""
+func (x *GetUserOrdersRequest) Reset() {
+	*x = GetUserOrdersRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *GetUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreatePostRequest) GetParentId() string {
+func (*GetUserOrdersRequest) ProtoMessage() {}
+
+func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[150]
 	if x != nil {
-		return x.ParentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePostRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use GetUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{150}
 }
 
-type CreatePostResponse struct {
+type GetUserOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Orders        []*OrderSummary        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[59]
+func (x *GetUserOrdersResponse) Reset() {
+	*x = GetUserOrdersResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *GetUserOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*GetUserOrdersResponse) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[59]
+func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,41 +9084,41 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *CreatePostResponse) GetPostId() string {
+func (x *GetUserOrdersResponse) GetOrders() []*OrderSummary {
 	if x != nil {
-		return x.PostId
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-type GetPostRequest struct {
+type CheckinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	TicketPubkey  string                 `protobuf:"bytes,1,opt,name=ticket_pubkey,json=ticketPubkey,proto3" json:"ticket_pubkey,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[60]
+func (x *CheckinRequest) Reset() {
+	*x = CheckinRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *CheckinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*CheckinRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[60]
+func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3640,47 +9129,46 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CheckinRequest.ProtoReflect.Descriptor instead.
+func (*CheckinRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetPostRequest) GetPostId() string {
+func (x *CheckinRequest) GetTicketPubkey() string {
 	if x != nil {
-		return x.PostId
+		return x.TicketPubkey
 	}
 	return ""
 }
 
-func (x *GetPostRequest) GetUserId() string {
+func (x *CheckinRequest) GetSignature() string {
 	if x != nil {
-		return x.UserId
+		return x.Signature
 	}
 	return ""
 }
 
-type GetPostResponse struct {
+type CheckinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *v11.PostView          `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostResponse) Reset() {
-	*x = GetPostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[61]
+func (x *CheckinResponse) Reset() {
+	*x = CheckinResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostResponse) String() string {
+func (x *CheckinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostResponse) ProtoMessage() {}
+func (*CheckinResponse) ProtoMessage() {}
 
-func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[61]
+func (x *CheckinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,44 +9179,33 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
-func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *GetPostResponse) GetPost() *v11.PostView {
-	if x != nil {
-		return x.Post
-	}
-	return nil
+// Deprecated: Use CheckinResponse.ProtoReflect.Descriptor instead.
+func (*CheckinResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{153}
 }
 
-type GetFeedPostsRequest struct {
+type ExportParticipantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedPostsRequest) Reset() {
-	*x = GetFeedPostsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[62]
+func (x *ExportParticipantsRequest) Reset() {
+	*x = ExportParticipantsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedPostsRequest) String() string {
+func (x *ExportParticipantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedPostsRequest) ProtoMessage() {}
+func (*ExportParticipantsRequest) ProtoMessage() {}
 
-func (x *GetFeedPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[62]
+func (x *ExportParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3739,68 +9216,103 @@ func (x *GetFeedPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedPostsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ExportParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*ExportParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *GetFeedPostsRequest) GetOrg() *Entity {
+func (x *ExportParticipantsRequest) GetEventId() string {
 	if x != nil {
-		return x.Org
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFeedPostsRequest) GetLimit() uint32 {
+type ExportParticipantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// XXX: cannot use bytes because not handled in gno-protoc-gen
+	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// XXX: use this to change the mime type without re-generating the proto
+	MimeType      string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportParticipantsResponse) Reset() {
+	*x = ExportParticipantsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportParticipantsResponse) ProtoMessage() {}
+
+func (x *ExportParticipantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[155]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetFeedPostsRequest) GetOffset() uint32 {
+// Deprecated: Use ExportParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*ExportParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ExportParticipantsResponse) GetContent() string {
 	if x != nil {
-		return x.Offset
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFeedPostsRequest) GetTags() []string {
+func (x *ExportParticipantsResponse) GetFilename() string {
 	if x != nil {
-		return x.Tags
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFeedPostsRequest) GetUserId() string {
+func (x *ExportParticipantsResponse) GetMimeType() string {
 	if x != nil {
-		return x.UserId
+		return x.MimeType
 	}
 	return ""
 }
 
-type GetFeedPostsResponse struct {
+type Entity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*v11.PostView        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // one of: user, event
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedPostsResponse) Reset() {
-	*x = GetFeedPostsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[63]
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedPostsResponse) String() string {
+func (x *Entity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedPostsResponse) ProtoMessage() {}
+func (*Entity) ProtoMessage() {}
 
-func (x *GetFeedPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[63]
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3811,44 +9323,48 @@ func (x *GetFeedPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetFeedPostsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *GetFeedPostsResponse) GetPosts() []*v11.PostView {
+func (x *Entity) GetEntityType() string {
 	if x != nil {
-		return x.Posts
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-type GetChildrenPostsRequest struct {
+func (x *Entity) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type EntityRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, to check if the user has reacted
+	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`       // one of: community, event
+	Entity        *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"` // one of: user, event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChildrenPostsRequest) Reset() {
-	*x = GetChildrenPostsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[64]
+func (x *EntityRolesRequest) Reset() {
+	*x = EntityRolesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChildrenPostsRequest) String() string {
+func (x *EntityRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildrenPostsRequest) ProtoMessage() {}
+func (*EntityRolesRequest) ProtoMessage() {}
 
-func (x *GetChildrenPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[64]
+func (x *EntityRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3859,68 +9375,47 @@ func (x *GetChildrenPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildrenPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetChildrenPostsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *GetChildrenPostsRequest) GetParentId() string {
-	if x != nil {
-		return x.ParentId
-	}
-	return ""
-}
-
-func (x *GetChildrenPostsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetChildrenPostsRequest) GetOffset() uint32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use EntityRolesRequest.ProtoReflect.Descriptor instead.
+func (*EntityRolesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *GetChildrenPostsRequest) GetTags() []string {
+func (x *EntityRolesRequest) GetOrg() *Entity {
 	if x != nil {
-		return x.Tags
+		return x.Org
 	}
 	return nil
 }
 
-func (x *GetChildrenPostsRequest) GetUserId() string {
+func (x *EntityRolesRequest) GetEntity() *Entity {
 	if x != nil {
-		return x.UserId
+		return x.Entity
 	}
-	return ""
+	return nil
 }
 
-type GetChildrenPostsResponse struct {
+type EntityRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*v11.PostView        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, organizer, gatekeeper, member, participant
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChildrenPostsResponse) Reset() {
-	*x = GetChildrenPostsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[65]
+func (x *EntityRolesResponse) Reset() {
+	*x = EntityRolesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChildrenPostsResponse) String() string {
+func (x *EntityRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildrenPostsResponse) ProtoMessage() {}
+func (*EntityRolesResponse) ProtoMessage() {}
 
-func (x *GetChildrenPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[65]
+func (x *EntityRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3931,40 +9426,41 @@ func (x *GetChildrenPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildrenPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetChildrenPostsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use EntityRolesResponse.ProtoReflect.Descriptor instead.
+func (*EntityRolesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *GetChildrenPostsResponse) GetPosts() []*v11.PostView {
+func (x *EntityRolesResponse) GetRoles() []string {
 	if x != nil {
-		return x.Posts
+		return x.Roles
 	}
 	return nil
 }
 
-type DeletePostRequest struct {
+type EntitiesWithRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`     // one of: community, event
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, organizer, gatekeeper, member, participant
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[66]
+func (x *EntitiesWithRolesRequest) Reset() {
+	*x = EntitiesWithRolesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *EntitiesWithRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*EntitiesWithRolesRequest) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[66]
+func (x *EntitiesWithRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3975,39 +9471,49 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use EntitiesWithRolesRequest.ProtoReflect.Descriptor instead.
+func (*EntitiesWithRolesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *DeletePostRequest) GetPostId() string {
+func (x *EntitiesWithRolesRequest) GetOrg() *Entity {
 	if x != nil {
-		return x.PostId
+		return x.Org
 	}
-	return ""
+	return nil
 }
 
-type DeletePostResponse struct {
+func (x *EntitiesWithRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type EntityWithRoles struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[67]
+func (x *EntityWithRoles) Reset() {
+	*x = EntityWithRoles{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *EntityWithRoles) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*EntityWithRoles) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[67]
+func (x *EntityWithRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4018,34 +9524,54 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use EntityWithRoles.ProtoReflect.Descriptor instead.
+func (*EntityWithRoles) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{160}
 }
 
-type ReactPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EntityWithRoles) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
 }
 
-func (x *ReactPostRequest) Reset() {
-	*x = ReactPostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[68]
+func (x *EntityWithRoles) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EntityWithRoles) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type EntitiesWithRolesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EntitiesWithRoles []*EntityWithRoles     `protobuf:"bytes,1,rep,name=entities_with_roles,json=entitiesWithRoles,proto3" json:"entities_with_roles,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EntitiesWithRolesResponse) Reset() {
+	*x = EntitiesWithRolesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReactPostRequest) String() string {
+func (x *EntitiesWithRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReactPostRequest) ProtoMessage() {}
+func (*EntitiesWithRolesResponse) ProtoMessage() {}
 
-func (x *ReactPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[68]
+func (x *EntitiesWithRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,46 +9582,40 @@ func (x *ReactPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReactPostRequest.ProtoReflect.Descriptor instead.
-func (*ReactPostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *ReactPostRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
+// Deprecated: Use EntitiesWithRolesResponse.ProtoReflect.Descriptor instead.
+func (*EntitiesWithRolesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ReactPostRequest) GetIcon() string {
+func (x *EntitiesWithRolesResponse) GetEntitiesWithRoles() []*EntityWithRoles {
 	if x != nil {
-		return x.Icon
+		return x.EntitiesWithRoles
 	}
-	return ""
+	return nil
 }
 
-type ReactPostResponse struct {
+type GetCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReactPostResponse) Reset() {
-	*x = ReactPostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[69]
+func (x *GetCommunityRequest) Reset() {
+	*x = GetCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReactPostResponse) String() string {
+func (x *GetCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReactPostResponse) ProtoMessage() {}
+func (*GetCommunityRequest) ProtoMessage() {}
 
-func (x *ReactPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[69]
+func (x *GetCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,34 +9626,40 @@ func (x *ReactPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReactPostResponse.ProtoReflect.Descriptor instead.
-func (*ReactPostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetCommunityRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{162}
 }
 
-type PinPostRequest struct {
+func (x *GetCommunityRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+type GetCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Community     *CommunityInfo         `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PinPostRequest) Reset() {
-	*x = PinPostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[70]
+func (x *GetCommunityResponse) Reset() {
+	*x = GetCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PinPostRequest) String() string {
+func (x *GetCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinPostRequest) ProtoMessage() {}
+func (*GetCommunityResponse) ProtoMessage() {}
 
-func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[70]
+func (x *GetCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4144,46 +9670,48 @@ func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
-func (*PinPostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *PinPostRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
+// Deprecated: Use GetCommunityResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *PinPostRequest) GetPinned() bool {
+func (x *GetCommunityResponse) GetCommunity() *CommunityInfo {
 	if x != nil {
-		return x.Pinned
+		return x.Community
 	}
-	return false
+	return nil
 }
 
-type PinPostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CommunityInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AvatarUri      string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
+	BannerUri      string                 `protobuf:"bytes,5,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
+	Administrators []string               `protobuf:"bytes,6,rep,name=administrators,proto3" json:"administrators,omitempty"`
+	CountMembers   uint32                 `protobuf:"varint,7,opt,name=count_members,json=countMembers,proto3" json:"count_members,omitempty"`
+	Category       string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags           []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PinPostResponse) Reset() {
-	*x = PinPostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[71]
+func (x *CommunityInfo) Reset() {
+	*x = CommunityInfo{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PinPostResponse) String() string {
+func (x *CommunityInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinPostResponse) ProtoMessage() {}
+func (*CommunityInfo) ProtoMessage() {}
 
-func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[71]
+func (x *CommunityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4194,93 +9722,99 @@ func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
-func (*PinPostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use CommunityInfo.ProtoReflect.Descriptor instead.
+func (*CommunityInfo) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{164}
 }
 
-type EditPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CommunityInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommunityInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (x *EditPostRequest) Reset() {
-	*x = EditPostRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[72]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CommunityInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *EditPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CommunityInfo) GetAvatarUri() string {
+	if x != nil {
+		return x.AvatarUri
+	}
+	return ""
 }
 
-func (*EditPostRequest) ProtoMessage() {}
-
-func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[72]
+func (x *CommunityInfo) GetBannerUri() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BannerUri
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
-func (*EditPostRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{72}
+func (x *CommunityInfo) GetAdministrators() []string {
+	if x != nil {
+		return x.Administrators
+	}
+	return nil
 }
 
-func (x *EditPostRequest) GetPostId() string {
+func (x *CommunityInfo) GetCountMembers() uint32 {
 	if x != nil {
-		return x.PostId
+		return x.CountMembers
 	}
-	return ""
+	return 0
 }
 
-func (x *EditPostRequest) GetContent() string {
+func (x *CommunityInfo) GetCategory() string {
 	if x != nil {
-		return x.Content
+		return x.Category
 	}
 	return ""
 }
 
-func (x *EditPostRequest) GetTags() []string {
+func (x *CommunityInfo) GetTags() []string {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-type EditPostResponse struct {
+type ListCommunitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // optional category filter
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`         // only communities having all these tags
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditPostResponse) Reset() {
-	*x = EditPostResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[73]
+func (x *ListCommunitiesRequest) Reset() {
+	*x = ListCommunitiesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditPostResponse) String() string {
+func (x *ListCommunitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditPostResponse) ProtoMessage() {}
+func (*ListCommunitiesRequest) ProtoMessage() {}
 
-func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[73]
+func (x *ListCommunitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4291,40 +9825,61 @@ func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
-func (*EditPostResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ListCommunitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *EditPostResponse) GetPostId() string {
+func (x *ListCommunitiesRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.PostId
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommunitiesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCommunitiesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
 	return ""
 }
 
-type GetEventTicketsRequest struct {
+func (x *ListCommunitiesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListCommunitiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Communities   []*CommunityInfo       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventTicketsRequest) Reset() {
-	*x = GetEventTicketsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[74]
+func (x *ListCommunitiesResponse) Reset() {
+	*x = ListCommunitiesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTicketsRequest) String() string {
+func (x *ListCommunitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTicketsRequest) ProtoMessage() {}
+func (*ListCommunitiesResponse) ProtoMessage() {}
 
-func (x *GetEventTicketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[74]
+func (x *ListCommunitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4335,40 +9890,42 @@ func (x *GetEventTicketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTicketsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventTicketsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ListCommunitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetEventTicketsRequest) GetEventId() string {
+func (x *ListCommunitiesResponse) GetCommunities() []*CommunityInfo {
 	if x != nil {
-		return x.EventId
+		return x.Communities
 	}
-	return ""
+	return nil
 }
 
-type GetEventTicketsResponse struct {
+// Categories and most used tags of the discoverable events or of the communities, for discovery pages
+type ListTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketsInfo   []*TicketInfo          `protobuf:"bytes,1,rep,name=tickets_info,json=ticketsInfo,proto3" json:"tickets_info,omitempty"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // one of: event, community
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                            // max number of tags
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventTicketsResponse) Reset() {
-	*x = GetEventTicketsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[75]
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTicketsResponse) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTicketsResponse) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *GetEventTicketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[75]
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4379,41 +9936,48 @@ func (x *GetEventTicketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTicketsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventTicketsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *GetEventTicketsResponse) GetTicketsInfo() []*TicketInfo {
+func (x *ListTagsRequest) GetEntityType() string {
 	if x != nil {
-		return x.TicketsInfo
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-type TicketInfo struct {
+func (x *ListTagsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketSecret  string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
-	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Categories    []*TagCount            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // every curated category, in display order
+	Tags          []*TagCount            `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`             // most used first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TicketInfo) Reset() {
-	*x = TicketInfo{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[76]
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TicketInfo) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TicketInfo) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *TicketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[76]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4424,47 +9988,48 @@ func (x *TicketInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TicketInfo.ProtoReflect.Descriptor instead.
-func (*TicketInfo) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *TicketInfo) GetTicketSecret() string {
+func (x *ListTagsResponse) GetCategories() []*TagCount {
 	if x != nil {
-		return x.TicketSecret
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *TicketInfo) GetUserEmail() string {
+func (x *ListTagsResponse) GetTags() []*TagCount {
 	if x != nil {
-		return x.UserEmail
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type GetOrderDetailsRequest struct {
+type TagCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderDetailsRequest) Reset() {
-	*x = GetOrderDetailsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[77]
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderDetailsRequest) String() string {
+func (x *TagCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderDetailsRequest) ProtoMessage() {}
+func (*TagCount) ProtoMessage() {}
 
-func (x *GetOrderDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[77]
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4475,45 +10040,49 @@ func (x *GetOrderDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *GetOrderDetailsRequest) GetOrderId() string {
+func (x *TagCount) GetName() string {
 	if x != nil {
-		return x.OrderId
+		return x.Name
 	}
 	return ""
 }
 
-type OrderSummary struct {
+func (x *TagCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListCommunitiesByEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	BuyerId       string                 `protobuf:"bytes,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	AmountMinor   int64                  `protobuf:"varint,4,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
-	CurrencyCode  string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderSummary) Reset() {
-	*x = OrderSummary{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[78]
+func (x *ListCommunitiesByEventRequest) Reset() {
+	*x = ListCommunitiesByEventRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderSummary) String() string {
+func (x *ListCommunitiesByEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderSummary) ProtoMessage() {}
+func (*ListCommunitiesByEventRequest) ProtoMessage() {}
 
-func (x *OrderSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[78]
+func (x *ListCommunitiesByEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4524,76 +10093,54 @@ func (x *OrderSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderSummary.ProtoReflect.Descriptor instead.
-func (*OrderSummary) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *OrderSummary) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
+// Deprecated: Use ListCommunitiesByEventRequest.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesByEventRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *OrderSummary) GetEventId() string {
+func (x *ListCommunitiesByEventRequest) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *OrderSummary) GetBuyerId() string {
-	if x != nil {
-		return x.BuyerId
-	}
-	return ""
-}
-
-func (x *OrderSummary) GetAmountMinor() int64 {
+func (x *ListCommunitiesByEventRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.AmountMinor
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *OrderSummary) GetCurrencyCode() string {
-	if x != nil {
-		return x.CurrencyCode
-	}
-	return ""
-}
-
-func (x *OrderSummary) GetCreatedAt() int64 {
+func (x *ListCommunitiesByEventRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Offset
 	}
 	return 0
 }
 
-type OrderTicketInfo struct {
+type ListCommunitiesByEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketSecret  string                 `protobuf:"bytes,1,opt,name=ticket_secret,json=ticketSecret,proto3" json:"ticket_secret,omitempty"`
-	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Communities   []*CommunityInfo       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderTicketInfo) Reset() {
-	*x = OrderTicketInfo{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[79]
+func (x *ListCommunitiesByEventResponse) Reset() {
+	*x = ListCommunitiesByEventResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderTicketInfo) String() string {
+func (x *ListCommunitiesByEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderTicketInfo) ProtoMessage() {}
+func (*ListCommunitiesByEventResponse) ProtoMessage() {}
 
-func (x *OrderTicketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[79]
+func (x *ListCommunitiesByEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4604,48 +10151,41 @@ func (x *OrderTicketInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderTicketInfo.ProtoReflect.Descriptor instead.
-func (*OrderTicketInfo) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *OrderTicketInfo) GetTicketSecret() string {
-	if x != nil {
-		return x.TicketSecret
-	}
-	return ""
+// Deprecated: Use ListCommunitiesByEventResponse.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesByEventResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *OrderTicketInfo) GetUserEmail() string {
+func (x *ListCommunitiesByEventResponse) GetCommunities() []*CommunityInfo {
 	if x != nil {
-		return x.UserEmail
+		return x.Communities
 	}
-	return ""
+	return nil
 }
 
-type GetOrderDetailsResponse struct {
+type CommunityUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *OrderSummary          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	Tickets       []*OrderTicketInfo     `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Community     *CommunityInfo         `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, member
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderDetailsResponse) Reset() {
-	*x = GetOrderDetailsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[80]
+func (x *CommunityUser) Reset() {
+	*x = CommunityUser{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderDetailsResponse) String() string {
+func (x *CommunityUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderDetailsResponse) ProtoMessage() {}
+func (*CommunityUser) ProtoMessage() {}
 
-func (x *GetOrderDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[80]
+func (x *CommunityUser) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,46 +10196,50 @@ func (x *GetOrderDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use CommunityUser.ProtoReflect.Descriptor instead.
+func (*CommunityUser) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *GetOrderDetailsResponse) GetOrder() *OrderSummary {
+func (x *CommunityUser) GetCommunity() *CommunityInfo {
 	if x != nil {
-		return x.Order
+		return x.Community
 	}
 	return nil
 }
 
-func (x *GetOrderDetailsResponse) GetTickets() []*OrderTicketInfo {
+func (x *CommunityUser) GetRoles() []string {
 	if x != nil {
-		return x.Tickets
+		return x.Roles
 	}
 	return nil
 }
 
-type GetUserOrdersRequest struct {
+type ListCommunitiesByUserRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, member
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOrdersRequest) Reset() {
-	*x = GetUserOrdersRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[81]
+func (x *ListCommunitiesByUserRolesRequest) Reset() {
+	*x = ListCommunitiesByUserRolesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOrdersRequest) String() string {
+func (x *ListCommunitiesByUserRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOrdersRequest) ProtoMessage() {}
+func (*ListCommunitiesByUserRolesRequest) ProtoMessage() {}
 
-func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[81]
+func (x *ListCommunitiesByUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4706,33 +10250,61 @@ func (x *GetUserOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetUserOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use ListCommunitiesByUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesByUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{173}
 }
 
-type GetUserOrdersResponse struct {
+func (x *ListCommunitiesByUserRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCommunitiesByUserRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListCommunitiesByUserRolesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommunitiesByUserRolesRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCommunitiesByUserRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*OrderSummary        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Communities   []*CommunityUser       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOrdersResponse) Reset() {
-	*x = GetUserOrdersResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[82]
+func (x *ListCommunitiesByUserRolesResponse) Reset() {
+	*x = ListCommunitiesByUserRolesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOrdersResponse) String() string {
+func (x *ListCommunitiesByUserRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOrdersResponse) ProtoMessage() {}
+func (*ListCommunitiesByUserRolesResponse) ProtoMessage() {}
 
-func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[82]
+func (x *ListCommunitiesByUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4743,41 +10315,46 @@ func (x *GetUserOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetUserOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ListCommunitiesByUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommunitiesByUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *GetUserOrdersResponse) GetOrders() []*OrderSummary {
+func (x *ListCommunitiesByUserRolesResponse) GetCommunities() []*CommunityUser {
 	if x != nil {
-		return x.Orders
+		return x.Communities
 	}
 	return nil
 }
 
-type CheckinRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketPubkey  string                 `protobuf:"bytes,1,opt,name=ticket_pubkey,json=ticketPubkey,proto3" json:"ticket_pubkey,omitempty"`
-	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateCommunityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName    string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AvatarUri      string                 `protobuf:"bytes,3,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
+	BannerUri      string                 `protobuf:"bytes,4,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
+	Administrators []string               `protobuf:"bytes,5,rep,name=administrators,proto3" json:"administrators,omitempty"`
+	Category       string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"` // optional, one of the curated categories
+	Tags           []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CheckinRequest) Reset() {
-	*x = CheckinRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[83]
+func (x *CreateCommunityRequest) Reset() {
+	*x = CreateCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckinRequest) String() string {
+func (x *CreateCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckinRequest) ProtoMessage() {}
+func (*CreateCommunityRequest) ProtoMessage() {}
 
-func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[83]
+func (x *CreateCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4788,83 +10365,82 @@ func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckinRequest.ProtoReflect.Descriptor instead.
-func (*CheckinRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use CreateCommunityRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *CheckinRequest) GetTicketPubkey() string {
+func (x *CreateCommunityRequest) GetDisplayName() string {
 	if x != nil {
-		return x.TicketPubkey
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *CheckinRequest) GetSignature() string {
+func (x *CreateCommunityRequest) GetDescription() string {
 	if x != nil {
-		return x.Signature
+		return x.Description
 	}
 	return ""
 }
 
-type CheckinResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateCommunityRequest) GetAvatarUri() string {
+	if x != nil {
+		return x.AvatarUri
+	}
+	return ""
 }
 
-func (x *CheckinResponse) Reset() {
-	*x = CheckinResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[84]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateCommunityRequest) GetBannerUri() string {
+	if x != nil {
+		return x.BannerUri
+	}
+	return ""
 }
 
-func (x *CheckinResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCommunityRequest) GetAdministrators() []string {
+	if x != nil {
+		return x.Administrators
+	}
+	return nil
 }
 
-func (*CheckinResponse) ProtoMessage() {}
-
-func (x *CheckinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[84]
+func (x *CreateCommunityRequest) GetCategory() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Category
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CheckinResponse.ProtoReflect.Descriptor instead.
-func (*CheckinResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{84}
+func (x *CreateCommunityRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-type ExportParticipantsRequest struct {
+type CreateCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportParticipantsRequest) Reset() {
-	*x = ExportParticipantsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[85]
+func (x *CreateCommunityResponse) Reset() {
+	*x = CreateCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportParticipantsRequest) String() string {
+func (x *CreateCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportParticipantsRequest) ProtoMessage() {}
+func (*CreateCommunityResponse) ProtoMessage() {}
 
-func (x *ExportParticipantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[85]
+func (x *CreateCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4875,44 +10451,49 @@ func (x *ExportParticipantsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportParticipantsRequest.ProtoReflect.Descriptor instead.
-func (*ExportParticipantsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use CreateCommunityResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *ExportParticipantsRequest) GetEventId() string {
+func (x *CreateCommunityResponse) GetCommunityId() string {
 	if x != nil {
-		return x.EventId
+		return x.CommunityId
 	}
 	return ""
 }
 
-type ExportParticipantsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// XXX: cannot use bytes because not handled in gno-protoc-gen
-	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	// XXX: use this to change the mime type without re-generating the proto
-	MimeType      string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+type EditCommunityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId    string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AvatarUri      string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
+	BannerUri      string                 `protobuf:"bytes,5,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
+	Administrators []string               `protobuf:"bytes,6,rep,name=administrators,proto3" json:"administrators,omitempty"`
+	// replaces the category and the tags of the community when update_tags is set
+	Category      string   `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Tags          []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdateTags    bool     `protobuf:"varint,9,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportParticipantsResponse) Reset() {
-	*x = ExportParticipantsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[86]
+func (x *EditCommunityRequest) Reset() {
+	*x = EditCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportParticipantsResponse) String() string {
+func (x *EditCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportParticipantsResponse) ProtoMessage() {}
+func (*EditCommunityRequest) ProtoMessage() {}
 
-func (x *ExportParticipantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[86]
+func (x *EditCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4923,55 +10504,95 @@ func (x *ExportParticipantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportParticipantsResponse.ProtoReflect.Descriptor instead.
-func (*ExportParticipantsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use EditCommunityRequest.ProtoReflect.Descriptor instead.
+func (*EditCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *ExportParticipantsResponse) GetContent() string {
+func (x *EditCommunityRequest) GetCommunityId() string {
 	if x != nil {
-		return x.Content
+		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *ExportParticipantsResponse) GetFilename() string {
+func (x *EditCommunityRequest) GetDisplayName() string {
 	if x != nil {
-		return x.Filename
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *ExportParticipantsResponse) GetMimeType() string {
+func (x *EditCommunityRequest) GetDescription() string {
 	if x != nil {
-		return x.MimeType
+		return x.Description
 	}
 	return ""
 }
 
-type Entity struct {
+func (x *EditCommunityRequest) GetAvatarUri() string {
+	if x != nil {
+		return x.AvatarUri
+	}
+	return ""
+}
+
+func (x *EditCommunityRequest) GetBannerUri() string {
+	if x != nil {
+		return x.BannerUri
+	}
+	return ""
+}
+
+func (x *EditCommunityRequest) GetAdministrators() []string {
+	if x != nil {
+		return x.Administrators
+	}
+	return nil
+}
+
+func (x *EditCommunityRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EditCommunityRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EditCommunityRequest) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
+type EditCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // one of: user, event
-	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Entity) Reset() {
-	*x = Entity{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[87]
+func (x *EditCommunityResponse) Reset() {
+	*x = EditCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Entity) String() string {
+func (x *EditCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Entity) ProtoMessage() {}
+func (*EditCommunityResponse) ProtoMessage() {}
 
-func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[87]
+func (x *EditCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4982,48 +10603,35 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
-func (*Entity) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *Entity) GetEntityType() string {
-	if x != nil {
-		return x.EntityType
-	}
-	return ""
-}
-
-func (x *Entity) GetEntityId() string {
-	if x != nil {
-		return x.EntityId
-	}
-	return ""
+// Deprecated: Use EditCommunityResponse.ProtoReflect.Descriptor instead.
+func (*EditCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{178}
 }
 
-type EntityRolesRequest struct {
+type StartCommunityStripeOnboardingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`       // one of: community, event
-	Entity        *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"` // one of: user, event
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ReturnPath    string                 `protobuf:"bytes,2,opt,name=return_path,json=returnPath,proto3" json:"return_path,omitempty"`
+	RefreshPath   string                 `protobuf:"bytes,3,opt,name=refresh_path,json=refreshPath,proto3" json:"refresh_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityRolesRequest) Reset() {
-	*x = EntityRolesRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[88]
+func (x *StartCommunityStripeOnboardingRequest) Reset() {
+	*x = StartCommunityStripeOnboardingRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityRolesRequest) String() string {
+func (x *StartCommunityStripeOnboardingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityRolesRequest) ProtoMessage() {}
+func (*StartCommunityStripeOnboardingRequest) ProtoMessage() {}
 
-func (x *EntityRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[88]
+func (x *StartCommunityStripeOnboardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5034,47 +10642,54 @@ func (x *EntityRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityRolesRequest.ProtoReflect.Descriptor instead.
-func (*EntityRolesRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use StartCommunityStripeOnboardingRequest.ProtoReflect.Descriptor instead.
+func (*StartCommunityStripeOnboardingRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *EntityRolesRequest) GetOrg() *Entity {
+func (x *StartCommunityStripeOnboardingRequest) GetCommunityId() string {
 	if x != nil {
-		return x.Org
+		return x.CommunityId
 	}
-	return nil
+	return ""
 }
 
-func (x *EntityRolesRequest) GetEntity() *Entity {
+func (x *StartCommunityStripeOnboardingRequest) GetReturnPath() string {
 	if x != nil {
-		return x.Entity
+		return x.ReturnPath
 	}
-	return nil
+	return ""
 }
 
-type EntityRolesResponse struct {
+func (x *StartCommunityStripeOnboardingRequest) GetRefreshPath() string {
+	if x != nil {
+		return x.RefreshPath
+	}
+	return ""
+}
+
+type StartCommunityStripeOnboardingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, organizer, gatekeeper, member, participant
+	OnboardingUrl string                 `protobuf:"bytes,1,opt,name=onboarding_url,json=onboardingUrl,proto3" json:"onboarding_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityRolesResponse) Reset() {
-	*x = EntityRolesResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[89]
+func (x *StartCommunityStripeOnboardingResponse) Reset() {
+	*x = StartCommunityStripeOnboardingResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityRolesResponse) String() string {
+func (x *StartCommunityStripeOnboardingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityRolesResponse) ProtoMessage() {}
+func (*StartCommunityStripeOnboardingResponse) ProtoMessage() {}
 
-func (x *EntityRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[89]
+func (x *StartCommunityStripeOnboardingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5085,41 +10700,40 @@ func (x *EntityRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityRolesResponse.ProtoReflect.Descriptor instead.
-func (*EntityRolesResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use StartCommunityStripeOnboardingResponse.ProtoReflect.Descriptor instead.
+func (*StartCommunityStripeOnboardingResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *EntityRolesResponse) GetRoles() []string {
+func (x *StartCommunityStripeOnboardingResponse) GetOnboardingUrl() string {
 	if x != nil {
-		return x.Roles
+		return x.OnboardingUrl
 	}
-	return nil
+	return ""
 }
 
-type EntitiesWithRolesRequest struct {
+type GetCommunityPayoutStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Org           *Entity                `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`     // one of: community, event
-	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, organizer, gatekeeper, member, participant
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntitiesWithRolesRequest) Reset() {
-	*x = EntitiesWithRolesRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[90]
+func (x *GetCommunityPayoutStatusRequest) Reset() {
+	*x = GetCommunityPayoutStatusRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntitiesWithRolesRequest) String() string {
+func (x *GetCommunityPayoutStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntitiesWithRolesRequest) ProtoMessage() {}
+func (*GetCommunityPayoutStatusRequest) ProtoMessage() {}
 
-func (x *EntitiesWithRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[90]
+func (x *GetCommunityPayoutStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5130,49 +10744,46 @@ func (x *EntitiesWithRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntitiesWithRolesRequest.ProtoReflect.Descriptor instead.
-func (*EntitiesWithRolesRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *EntitiesWithRolesRequest) GetOrg() *Entity {
-	if x != nil {
-		return x.Org
-	}
-	return nil
+// Deprecated: Use GetCommunityPayoutStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityPayoutStatusRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *EntitiesWithRolesRequest) GetRoles() []string {
+func (x *GetCommunityPayoutStatusRequest) GetCommunityId() string {
 	if x != nil {
-		return x.Roles
+		return x.CommunityId
 	}
-	return nil
+	return ""
 }
 
-type EntityWithRoles struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCommunityPayoutStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	VerificationState string                 `protobuf:"bytes,1,opt,name=verification_state,json=verificationState,proto3" json:"verification_state,omitempty"`
+	LastVerifiedAt    int64                  `protobuf:"varint,2,opt,name=last_verified_at,json=lastVerifiedAt,proto3" json:"last_verified_at,omitempty"`
+	IsStale           bool                   `protobuf:"varint,3,opt,name=is_stale,json=isStale,proto3" json:"is_stale,omitempty"`
+	RefreshError      string                 `protobuf:"bytes,4,opt,name=refresh_error,json=refreshError,proto3" json:"refresh_error,omitempty"`
+	OnboardingState   string                 `protobuf:"bytes,5,opt,name=onboarding_state,json=onboardingState,proto3" json:"onboarding_state,omitempty"`
+	PlatformAccountId string                 `protobuf:"bytes,6,opt,name=platform_account_id,json=platformAccountId,proto3" json:"platform_account_id,omitempty"`
+	Currencies        []string               `protobuf:"bytes,7,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *EntityWithRoles) Reset() {
-	*x = EntityWithRoles{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[91]
+func (x *GetCommunityPayoutStatusResponse) Reset() {
+	*x = GetCommunityPayoutStatusResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityWithRoles) String() string {
+func (x *GetCommunityPayoutStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityWithRoles) ProtoMessage() {}
+func (*GetCommunityPayoutStatusResponse) ProtoMessage() {}
 
-func (x *EntityWithRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[91]
+func (x *GetCommunityPayoutStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5180,57 +10791,88 @@ func (x *EntityWithRoles) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityPayoutStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityPayoutStatusResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetCommunityPayoutStatusResponse) GetVerificationState() string {
+	if x != nil {
+		return x.VerificationState
+	}
+	return ""
+}
+
+func (x *GetCommunityPayoutStatusResponse) GetLastVerifiedAt() int64 {
+	if x != nil {
+		return x.LastVerifiedAt
+	}
+	return 0
+}
+
+func (x *GetCommunityPayoutStatusResponse) GetIsStale() bool {
+	if x != nil {
+		return x.IsStale
+	}
+	return false
 }
 
-// Deprecated: Use EntityWithRoles.ProtoReflect.Descriptor instead.
-func (*EntityWithRoles) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{91}
+func (x *GetCommunityPayoutStatusResponse) GetRefreshError() string {
+	if x != nil {
+		return x.RefreshError
+	}
+	return ""
 }
 
-func (x *EntityWithRoles) GetEntityType() string {
+func (x *GetCommunityPayoutStatusResponse) GetOnboardingState() string {
 	if x != nil {
-		return x.EntityType
+		return x.OnboardingState
 	}
 	return ""
 }
 
-func (x *EntityWithRoles) GetEntityId() string {
+func (x *GetCommunityPayoutStatusResponse) GetPlatformAccountId() string {
 	if x != nil {
-		return x.EntityId
+		return x.PlatformAccountId
 	}
 	return ""
 }
 
-func (x *EntityWithRoles) GetRoles() []string {
+func (x *GetCommunityPayoutStatusResponse) GetCurrencies() []string {
 	if x != nil {
-		return x.Roles
+		return x.Currencies
 	}
 	return nil
 }
 
-type EntitiesWithRolesResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	EntitiesWithRoles []*EntityWithRoles     `protobuf:"bytes,1,rep,name=entities_with_roles,json=entitiesWithRoles,proto3" json:"entities_with_roles,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CommunityLegalDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LegalName     string                 `protobuf:"bytes,1,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	LegalAddress  string                 `protobuf:"bytes,2,opt,name=legal_address,json=legalAddress,proto3" json:"legal_address,omitempty"`
+	TaxId         string                 `protobuf:"bytes,3,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`                   // e.g. VAT number
+	TaxRateBps    uint32                 `protobuf:"varint,4,opt,name=tax_rate_bps,json=taxRateBps,proto3" json:"tax_rate_bps,omitempty"` // tax included in prices, in basis points
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntitiesWithRolesResponse) Reset() {
-	*x = EntitiesWithRolesResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[92]
+func (x *CommunityLegalDetails) Reset() {
+	*x = CommunityLegalDetails{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntitiesWithRolesResponse) String() string {
+func (x *CommunityLegalDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntitiesWithRolesResponse) ProtoMessage() {}
+func (*CommunityLegalDetails) ProtoMessage() {}
 
-func (x *EntitiesWithRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[92]
+func (x *CommunityLegalDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5241,40 +10883,61 @@ func (x *EntitiesWithRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntitiesWithRolesResponse.ProtoReflect.Descriptor instead.
-func (*EntitiesWithRolesResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use CommunityLegalDetails.ProtoReflect.Descriptor instead.
+func (*CommunityLegalDetails) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *EntitiesWithRolesResponse) GetEntitiesWithRoles() []*EntityWithRoles {
+func (x *CommunityLegalDetails) GetLegalName() string {
 	if x != nil {
-		return x.EntitiesWithRoles
+		return x.LegalName
 	}
-	return nil
+	return ""
 }
 
-type GetCommunityRequest struct {
+func (x *CommunityLegalDetails) GetLegalAddress() string {
+	if x != nil {
+		return x.LegalAddress
+	}
+	return ""
+}
+
+func (x *CommunityLegalDetails) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *CommunityLegalDetails) GetTaxRateBps() uint32 {
+	if x != nil {
+		return x.TaxRateBps
+	}
+	return 0
+}
+
+type GetCommunityLegalDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityRequest) Reset() {
-	*x = GetCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[93]
+func (x *GetCommunityLegalDetailsRequest) Reset() {
+	*x = GetCommunityLegalDetailsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityRequest) String() string {
+func (x *GetCommunityLegalDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityRequest) ProtoMessage() {}
+func (*GetCommunityLegalDetailsRequest) ProtoMessage() {}
 
-func (x *GetCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[93]
+func (x *GetCommunityLegalDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5285,40 +10948,40 @@ func (x *GetCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityRequest.ProtoReflect.Descriptor instead.
-func (*GetCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use GetCommunityLegalDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityLegalDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetCommunityRequest) GetCommunityId() string {
+func (x *GetCommunityLegalDetailsRequest) GetCommunityId() string {
 	if x != nil {
 		return x.CommunityId
 	}
 	return ""
 }
 
-type GetCommunityResponse struct {
+type GetCommunityLegalDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Community     *CommunityInfo         `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	Details       *CommunityLegalDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityResponse) Reset() {
-	*x = GetCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[94]
+func (x *GetCommunityLegalDetailsResponse) Reset() {
+	*x = GetCommunityLegalDetailsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityResponse) String() string {
+func (x *GetCommunityLegalDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityResponse) ProtoMessage() {}
+func (*GetCommunityLegalDetailsResponse) ProtoMessage() {}
 
-func (x *GetCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[94]
+func (x *GetCommunityLegalDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5329,46 +10992,41 @@ func (x *GetCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityResponse.ProtoReflect.Descriptor instead.
-func (*GetCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use GetCommunityLegalDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityLegalDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *GetCommunityResponse) GetCommunity() *CommunityInfo {
+func (x *GetCommunityLegalDetailsResponse) GetDetails() *CommunityLegalDetails {
 	if x != nil {
-		return x.Community
+		return x.Details
 	}
 	return nil
 }
 
-type CommunityInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	AvatarUri      string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	BannerUri      string                 `protobuf:"bytes,5,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
-	Administrators []string               `protobuf:"bytes,6,rep,name=administrators,proto3" json:"administrators,omitempty"`
-	CountMembers   uint32                 `protobuf:"varint,7,opt,name=count_members,json=countMembers,proto3" json:"count_members,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type EditCommunityLegalDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Details       *CommunityLegalDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommunityInfo) Reset() {
-	*x = CommunityInfo{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[95]
+func (x *EditCommunityLegalDetailsRequest) Reset() {
+	*x = EditCommunityLegalDetailsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommunityInfo) String() string {
+func (x *EditCommunityLegalDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommunityInfo) ProtoMessage() {}
+func (*EditCommunityLegalDetailsRequest) ProtoMessage() {}
 
-func (x *CommunityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[95]
+func (x *EditCommunityLegalDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5379,83 +11037,46 @@ func (x *CommunityInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommunityInfo.ProtoReflect.Descriptor instead.
-func (*CommunityInfo) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *CommunityInfo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CommunityInfo) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *CommunityInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CommunityInfo) GetAvatarUri() string {
-	if x != nil {
-		return x.AvatarUri
-	}
-	return ""
+// Deprecated: Use EditCommunityLegalDetailsRequest.ProtoReflect.Descriptor instead.
+func (*EditCommunityLegalDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *CommunityInfo) GetBannerUri() string {
+func (x *EditCommunityLegalDetailsRequest) GetCommunityId() string {
 	if x != nil {
-		return x.BannerUri
+		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *CommunityInfo) GetAdministrators() []string {
+func (x *EditCommunityLegalDetailsRequest) GetDetails() *CommunityLegalDetails {
 	if x != nil {
-		return x.Administrators
+		return x.Details
 	}
 	return nil
 }
 
-func (x *CommunityInfo) GetCountMembers() uint32 {
-	if x != nil {
-		return x.CountMembers
-	}
-	return 0
-}
-
-type ListCommunitiesRequest struct {
+type EditCommunityLegalDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunitiesRequest) Reset() {
-	*x = ListCommunitiesRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[96]
+func (x *EditCommunityLegalDetailsResponse) Reset() {
+	*x = EditCommunityLegalDetailsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunitiesRequest) String() string {
+func (x *EditCommunityLegalDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunitiesRequest) ProtoMessage() {}
+func (*EditCommunityLegalDetailsResponse) ProtoMessage() {}
 
-func (x *ListCommunitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[96]
+func (x *EditCommunityLegalDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5466,47 +11087,35 @@ func (x *ListCommunitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *ListCommunitiesRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListCommunitiesRequest) GetOffset() uint32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use EditCommunityLegalDetailsResponse.ProtoReflect.Descriptor instead.
+func (*EditCommunityLegalDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{187}
 }
 
-type ListCommunitiesResponse struct {
+type GetCommunitySalesReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Communities   []*CommunityInfo       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"` // inclusive, unix seconds of the payment confirmation, 0 for no lower bound
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`     // exclusive, 0 for no upper bound
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunitiesResponse) Reset() {
-	*x = ListCommunitiesResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[97]
+func (x *GetCommunitySalesReportRequest) Reset() {
+	*x = GetCommunitySalesReportRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunitiesResponse) String() string {
+func (x *GetCommunitySalesReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunitiesResponse) ProtoMessage() {}
+func (*GetCommunitySalesReportRequest) ProtoMessage() {}
 
-func (x *ListCommunitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[97]
+func (x *GetCommunitySalesReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5517,42 +11126,65 @@ func (x *ListCommunitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use GetCommunitySalesReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunitySalesReportRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListCommunitiesResponse) GetCommunities() []*CommunityInfo {
+func (x *GetCommunitySalesReportRequest) GetCommunityId() string {
 	if x != nil {
-		return x.Communities
+		return x.CommunityId
 	}
-	return nil
+	return ""
 }
 
-type ListCommunitiesByEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint32                 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCommunitySalesReportRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
 }
 
-func (x *ListCommunitiesByEventRequest) Reset() {
-	*x = ListCommunitiesByEventRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[98]
+func (x *GetCommunitySalesReportRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type SalesReportRow struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EventId             string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTitle          string                 `protobuf:"bytes,2,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	PriceGroupId        string                 `protobuf:"bytes,3,opt,name=price_group_id,json=priceGroupId,proto3" json:"price_group_id,omitempty"`
+	CurrencyCode        string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	TicketsSold         int64                  `protobuf:"varint,5,opt,name=tickets_sold,json=ticketsSold,proto3" json:"tickets_sold,omitempty"`
+	TicketsRefunded     int64                  `protobuf:"varint,6,opt,name=tickets_refunded,json=ticketsRefunded,proto3" json:"tickets_refunded,omitempty"`
+	GrossAmountMinor    int64                  `protobuf:"varint,7,opt,name=gross_amount_minor,json=grossAmountMinor,proto3" json:"gross_amount_minor,omitempty"`
+	RefundedAmountMinor int64                  `protobuf:"varint,8,opt,name=refunded_amount_minor,json=refundedAmountMinor,proto3" json:"refunded_amount_minor,omitempty"`
+	NetAmountMinor      int64                  `protobuf:"varint,9,opt,name=net_amount_minor,json=netAmountMinor,proto3" json:"net_amount_minor,omitempty"` // gross and donations minus their refunds
+	// donations are not tied to a price group, they are reported on a row of the event without price group
+	DonationAmountMinor         int64 `protobuf:"varint,10,opt,name=donation_amount_minor,json=donationAmountMinor,proto3" json:"donation_amount_minor,omitempty"`
+	RefundedDonationAmountMinor int64 `protobuf:"varint,11,opt,name=refunded_donation_amount_minor,json=refundedDonationAmountMinor,proto3" json:"refunded_donation_amount_minor,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SalesReportRow) Reset() {
+	*x = SalesReportRow{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunitiesByEventRequest) String() string {
+func (x *SalesReportRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunitiesByEventRequest) ProtoMessage() {}
+func (*SalesReportRow) ProtoMessage() {}
 
-func (x *ListCommunitiesByEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[98]
+func (x *SalesReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5563,99 +11195,117 @@ func (x *ListCommunitiesByEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunitiesByEventRequest.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesByEventRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use SalesReportRow.ProtoReflect.Descriptor instead.
+func (*SalesReportRow) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *ListCommunitiesByEventRequest) GetEventId() string {
+func (x *SalesReportRow) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *ListCommunitiesByEventRequest) GetLimit() uint32 {
+func (x *SalesReportRow) GetEventTitle() string {
 	if x != nil {
-		return x.Limit
+		return x.EventTitle
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCommunitiesByEventRequest) GetOffset() uint32 {
+func (x *SalesReportRow) GetPriceGroupId() string {
 	if x != nil {
-		return x.Offset
+		return x.PriceGroupId
 	}
-	return 0
+	return ""
 }
 
-type ListCommunitiesByEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Communities   []*CommunityInfo       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SalesReportRow) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
 }
 
-func (x *ListCommunitiesByEventResponse) Reset() {
-	*x = ListCommunitiesByEventResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[99]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SalesReportRow) GetTicketsSold() int64 {
+	if x != nil {
+		return x.TicketsSold
+	}
+	return 0
+}
+
+func (x *SalesReportRow) GetTicketsRefunded() int64 {
+	if x != nil {
+		return x.TicketsRefunded
+	}
+	return 0
 }
 
-func (x *ListCommunitiesByEventResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SalesReportRow) GetGrossAmountMinor() int64 {
+	if x != nil {
+		return x.GrossAmountMinor
+	}
+	return 0
 }
 
-func (*ListCommunitiesByEventResponse) ProtoMessage() {}
+func (x *SalesReportRow) GetRefundedAmountMinor() int64 {
+	if x != nil {
+		return x.RefundedAmountMinor
+	}
+	return 0
+}
 
-func (x *ListCommunitiesByEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[99]
+func (x *SalesReportRow) GetNetAmountMinor() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NetAmountMinor
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListCommunitiesByEventResponse.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesByEventResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{99}
+func (x *SalesReportRow) GetDonationAmountMinor() int64 {
+	if x != nil {
+		return x.DonationAmountMinor
+	}
+	return 0
 }
 
-func (x *ListCommunitiesByEventResponse) GetCommunities() []*CommunityInfo {
+func (x *SalesReportRow) GetRefundedDonationAmountMinor() int64 {
 	if x != nil {
-		return x.Communities
+		return x.RefundedDonationAmountMinor
 	}
-	return nil
+	return 0
 }
 
-type CommunityUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Community     *CommunityInfo         `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
-	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, member
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SalesReportTotal struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode                string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	TicketsSold                 int64                  `protobuf:"varint,2,opt,name=tickets_sold,json=ticketsSold,proto3" json:"tickets_sold,omitempty"`
+	TicketsRefunded             int64                  `protobuf:"varint,3,opt,name=tickets_refunded,json=ticketsRefunded,proto3" json:"tickets_refunded,omitempty"`
+	GrossAmountMinor            int64                  `protobuf:"varint,4,opt,name=gross_amount_minor,json=grossAmountMinor,proto3" json:"gross_amount_minor,omitempty"`
+	RefundedAmountMinor         int64                  `protobuf:"varint,5,opt,name=refunded_amount_minor,json=refundedAmountMinor,proto3" json:"refunded_amount_minor,omitempty"`
+	NetAmountMinor              int64                  `protobuf:"varint,6,opt,name=net_amount_minor,json=netAmountMinor,proto3" json:"net_amount_minor,omitempty"`
+	DonationAmountMinor         int64                  `protobuf:"varint,7,opt,name=donation_amount_minor,json=donationAmountMinor,proto3" json:"donation_amount_minor,omitempty"`
+	RefundedDonationAmountMinor int64                  `protobuf:"varint,8,opt,name=refunded_donation_amount_minor,json=refundedDonationAmountMinor,proto3" json:"refunded_donation_amount_minor,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *CommunityUser) Reset() {
-	*x = CommunityUser{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[100]
+func (x *SalesReportTotal) Reset() {
+	*x = SalesReportTotal{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommunityUser) String() string {
+func (x *SalesReportTotal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommunityUser) ProtoMessage() {}
+func (*SalesReportTotal) ProtoMessage() {}
 
-func (x *CommunityUser) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[100]
+func (x *SalesReportTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5666,115 +11316,90 @@ func (x *CommunityUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommunityUser.ProtoReflect.Descriptor instead.
-func (*CommunityUser) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use SalesReportTotal.ProtoReflect.Descriptor instead.
+func (*SalesReportTotal) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *CommunityUser) GetCommunity() *CommunityInfo {
+func (x *SalesReportTotal) GetCurrencyCode() string {
 	if x != nil {
-		return x.Community
+		return x.CurrencyCode
 	}
-	return nil
+	return ""
 }
 
-func (x *CommunityUser) GetRoles() []string {
+func (x *SalesReportTotal) GetTicketsSold() int64 {
 	if x != nil {
-		return x.Roles
+		return x.TicketsSold
 	}
-	return nil
-}
-
-type ListCommunitiesByUserRolesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"` // one of: administrator, member
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint32                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListCommunitiesByUserRolesRequest) Reset() {
-	*x = ListCommunitiesByUserRolesRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[101]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListCommunitiesByUserRolesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ListCommunitiesByUserRolesRequest) ProtoMessage() {}
-
-func (x *ListCommunitiesByUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[101]
+func (x *SalesReportTotal) GetTicketsRefunded() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TicketsRefunded
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListCommunitiesByUserRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesByUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{101}
+func (x *SalesReportTotal) GetGrossAmountMinor() int64 {
+	if x != nil {
+		return x.GrossAmountMinor
+	}
+	return 0
 }
 
-func (x *ListCommunitiesByUserRolesRequest) GetUserId() string {
+func (x *SalesReportTotal) GetRefundedAmountMinor() int64 {
 	if x != nil {
-		return x.UserId
+		return x.RefundedAmountMinor
 	}
-	return ""
+	return 0
 }
 
-func (x *ListCommunitiesByUserRolesRequest) GetRoles() []string {
+func (x *SalesReportTotal) GetNetAmountMinor() int64 {
 	if x != nil {
-		return x.Roles
+		return x.NetAmountMinor
 	}
-	return nil
+	return 0
 }
 
-func (x *ListCommunitiesByUserRolesRequest) GetLimit() uint32 {
+func (x *SalesReportTotal) GetDonationAmountMinor() int64 {
 	if x != nil {
-		return x.Limit
+		return x.DonationAmountMinor
 	}
 	return 0
 }
 
-func (x *ListCommunitiesByUserRolesRequest) GetOffset() uint32 {
+func (x *SalesReportTotal) GetRefundedDonationAmountMinor() int64 {
 	if x != nil {
-		return x.Offset
+		return x.RefundedDonationAmountMinor
 	}
 	return 0
 }
 
-type ListCommunitiesByUserRolesResponse struct {
+type GetCommunitySalesReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Communities   []*CommunityUser       `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	Rows          []*SalesReportRow      `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Totals        []*SalesReportTotal    `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"` // one per currency
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommunitiesByUserRolesResponse) Reset() {
-	*x = ListCommunitiesByUserRolesResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[102]
+func (x *GetCommunitySalesReportResponse) Reset() {
+	*x = GetCommunitySalesReportResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommunitiesByUserRolesResponse) String() string {
+func (x *GetCommunitySalesReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommunitiesByUserRolesResponse) ProtoMessage() {}
+func (*GetCommunitySalesReportResponse) ProtoMessage() {}
 
-func (x *ListCommunitiesByUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[102]
+func (x *GetCommunitySalesReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5785,44 +11410,50 @@ func (x *ListCommunitiesByUserRolesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommunitiesByUserRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListCommunitiesByUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use GetCommunitySalesReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunitySalesReportResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *ListCommunitiesByUserRolesResponse) GetCommunities() []*CommunityUser {
+func (x *GetCommunitySalesReportResponse) GetRows() []*SalesReportRow {
 	if x != nil {
-		return x.Communities
+		return x.Rows
 	}
 	return nil
 }
 
-type CreateCommunityRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName    string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AvatarUri      string                 `protobuf:"bytes,3,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	BannerUri      string                 `protobuf:"bytes,4,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
-	Administrators []string               `protobuf:"bytes,5,rep,name=administrators,proto3" json:"administrators,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCommunitySalesReportResponse) GetTotals() []*SalesReportTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
 }
 
-func (x *CreateCommunityRequest) Reset() {
-	*x = CreateCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[103]
+type ExportCommunitySalesReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"` // one of: csv, xlsx
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCommunitySalesReportRequest) Reset() {
+	*x = ExportCommunitySalesReportRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommunityRequest) String() string {
+func (x *ExportCommunitySalesReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommunityRequest) ProtoMessage() {}
+func (*ExportCommunitySalesReportRequest) ProtoMessage() {}
 
-func (x *CreateCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[103]
+func (x *ExportCommunitySalesReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5833,68 +11464,63 @@ func (x *CreateCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommunityRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use ExportCommunitySalesReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportCommunitySalesReportRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *CreateCommunityRequest) GetDisplayName() string {
+func (x *ExportCommunitySalesReportRequest) GetCommunityId() string {
 	if x != nil {
-		return x.DisplayName
+		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *CreateCommunityRequest) GetDescription() string {
+func (x *ExportCommunitySalesReportRequest) GetFrom() int64 {
 	if x != nil {
-		return x.Description
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCommunityRequest) GetAvatarUri() string {
+func (x *ExportCommunitySalesReportRequest) GetTo() int64 {
 	if x != nil {
-		return x.AvatarUri
+		return x.To
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCommunityRequest) GetBannerUri() string {
+func (x *ExportCommunitySalesReportRequest) GetFormat() string {
 	if x != nil {
-		return x.BannerUri
+		return x.Format
 	}
 	return ""
 }
 
-func (x *CreateCommunityRequest) GetAdministrators() []string {
-	if x != nil {
-		return x.Administrators
-	}
-	return nil
-}
-
-type CreateCommunityResponse struct {
+type ExportCommunitySalesReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommunityResponse) Reset() {
-	*x = CreateCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[104]
+func (x *ExportCommunitySalesReportResponse) Reset() {
+	*x = ExportCommunitySalesReportResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommunityResponse) String() string {
+func (x *ExportCommunitySalesReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommunityResponse) ProtoMessage() {}
+func (*ExportCommunitySalesReportResponse) ProtoMessage() {}
 
-func (x *CreateCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[104]
+func (x *ExportCommunitySalesReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5905,45 +11531,54 @@ func (x *CreateCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommunityResponse.ProtoReflect.Descriptor instead.
-func (*CreateCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use ExportCommunitySalesReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportCommunitySalesReportResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *CreateCommunityResponse) GetCommunityId() string {
+func (x *ExportCommunitySalesReportResponse) GetContent() []byte {
 	if x != nil {
-		return x.CommunityId
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportCommunitySalesReportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-type EditCommunityRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId    string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	AvatarUri      string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	BannerUri      string                 `protobuf:"bytes,5,opt,name=banner_uri,json=bannerUri,proto3" json:"banner_uri,omitempty"`
-	Administrators []string               `protobuf:"bytes,6,rep,name=administrators,proto3" json:"administrators,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ExportCommunitySalesReportResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
 }
 
-func (x *EditCommunityRequest) Reset() {
-	*x = EditCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[105]
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditCommunityRequest) String() string {
+func (x *CreateTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditCommunityRequest) ProtoMessage() {}
+func (*CreateTeamRequest) ProtoMessage() {}
 
-func (x *EditCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[105]
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5951,77 +11586,43 @@ func (x *EditCommunityRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EditCommunityRequest.ProtoReflect.Descriptor instead.
-func (*EditCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{105}
-}
-
-func (x *EditCommunityRequest) GetCommunityId() string {
-	if x != nil {
-		return x.CommunityId
-	}
-	return ""
-}
-
-func (x *EditCommunityRequest) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *EditCommunityRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *EditCommunityRequest) GetAvatarUri() string {
-	if x != nil {
-		return x.AvatarUri
-	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EditCommunityRequest) GetBannerUri() string {
-	if x != nil {
-		return x.BannerUri
-	}
-	return ""
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *EditCommunityRequest) GetAdministrators() []string {
+func (x *CreateTeamRequest) GetDisplayName() string {
 	if x != nil {
-		return x.Administrators
+		return x.DisplayName
 	}
-	return nil
+	return ""
 }
 
-type EditCommunityResponse struct {
+type CreateTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditCommunityResponse) Reset() {
-	*x = EditCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[106]
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditCommunityResponse) String() string {
+func (x *CreateTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditCommunityResponse) ProtoMessage() {}
+func (*CreateTeamResponse) ProtoMessage() {}
 
-func (x *EditCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[106]
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6032,35 +11633,44 @@ func (x *EditCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditCommunityResponse.ProtoReflect.Descriptor instead.
-func (*EditCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{195}
 }
 
-type StartCommunityStripeOnboardingRequest struct {
+func (x *CreateTeamResponse) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type EditTeamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	ReturnPath    string                 `protobuf:"bytes,2,opt,name=return_path,json=returnPath,proto3" json:"return_path,omitempty"`
-	RefreshPath   string                 `protobuf:"bytes,3,opt,name=refresh_path,json=refreshPath,proto3" json:"refresh_path,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUri     string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
+	Members       []string               `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartCommunityStripeOnboardingRequest) Reset() {
-	*x = StartCommunityStripeOnboardingRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[107]
+func (x *EditTeamRequest) Reset() {
+	*x = EditTeamRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartCommunityStripeOnboardingRequest) String() string {
+func (x *EditTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCommunityStripeOnboardingRequest) ProtoMessage() {}
+func (*EditTeamRequest) ProtoMessage() {}
 
-func (x *StartCommunityStripeOnboardingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[107]
+func (x *EditTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6071,54 +11681,67 @@ func (x *StartCommunityStripeOnboardingRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCommunityStripeOnboardingRequest.ProtoReflect.Descriptor instead.
-func (*StartCommunityStripeOnboardingRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use EditTeamRequest.ProtoReflect.Descriptor instead.
+func (*EditTeamRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *StartCommunityStripeOnboardingRequest) GetCommunityId() string {
+func (x *EditTeamRequest) GetTeamId() string {
 	if x != nil {
-		return x.CommunityId
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *StartCommunityStripeOnboardingRequest) GetReturnPath() string {
+func (x *EditTeamRequest) GetDisplayName() string {
 	if x != nil {
-		return x.ReturnPath
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *StartCommunityStripeOnboardingRequest) GetRefreshPath() string {
+func (x *EditTeamRequest) GetBio() string {
 	if x != nil {
-		return x.RefreshPath
+		return x.Bio
 	}
 	return ""
 }
 
-type StartCommunityStripeOnboardingResponse struct {
+func (x *EditTeamRequest) GetAvatarUri() string {
+	if x != nil {
+		return x.AvatarUri
+	}
+	return ""
+}
+
+func (x *EditTeamRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type EditTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OnboardingUrl string                 `protobuf:"bytes,1,opt,name=onboarding_url,json=onboardingUrl,proto3" json:"onboarding_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartCommunityStripeOnboardingResponse) Reset() {
-	*x = StartCommunityStripeOnboardingResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[108]
+func (x *EditTeamResponse) Reset() {
+	*x = EditTeamResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartCommunityStripeOnboardingResponse) String() string {
+func (x *EditTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCommunityStripeOnboardingResponse) ProtoMessage() {}
+func (*EditTeamResponse) ProtoMessage() {}
 
-func (x *StartCommunityStripeOnboardingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[108]
+func (x *EditTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6129,40 +11752,33 @@ func (x *StartCommunityStripeOnboardingResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCommunityStripeOnboardingResponse.ProtoReflect.Descriptor instead.
-func (*StartCommunityStripeOnboardingResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{108}
-}
-
-func (x *StartCommunityStripeOnboardingResponse) GetOnboardingUrl() string {
-	if x != nil {
-		return x.OnboardingUrl
-	}
-	return ""
+// Deprecated: Use EditTeamResponse.ProtoReflect.Descriptor instead.
+func (*EditTeamResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{197}
 }
 
-type GetCommunityPayoutStatusRequest struct {
+type DeleteTeamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityPayoutStatusRequest) Reset() {
-	*x = GetCommunityPayoutStatusRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[109]
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityPayoutStatusRequest) String() string {
+func (x *DeleteTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityPayoutStatusRequest) ProtoMessage() {}
+func (*DeleteTeamRequest) ProtoMessage() {}
 
-func (x *GetCommunityPayoutStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[109]
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6173,46 +11789,39 @@ func (x *GetCommunityPayoutStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityPayoutStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetCommunityPayoutStatusRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *GetCommunityPayoutStatusRequest) GetCommunityId() string {
+func (x *DeleteTeamRequest) GetTeamId() string {
 	if x != nil {
-		return x.CommunityId
+		return x.TeamId
 	}
 	return ""
 }
 
-type GetCommunityPayoutStatusResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	VerificationState string                 `protobuf:"bytes,1,opt,name=verification_state,json=verificationState,proto3" json:"verification_state,omitempty"`
-	LastVerifiedAt    int64                  `protobuf:"varint,2,opt,name=last_verified_at,json=lastVerifiedAt,proto3" json:"last_verified_at,omitempty"`
-	IsStale           bool                   `protobuf:"varint,3,opt,name=is_stale,json=isStale,proto3" json:"is_stale,omitempty"`
-	RefreshError      string                 `protobuf:"bytes,4,opt,name=refresh_error,json=refreshError,proto3" json:"refresh_error,omitempty"`
-	OnboardingState   string                 `protobuf:"bytes,5,opt,name=onboarding_state,json=onboardingState,proto3" json:"onboarding_state,omitempty"`
-	PlatformAccountId string                 `protobuf:"bytes,6,opt,name=platform_account_id,json=platformAccountId,proto3" json:"platform_account_id,omitempty"`
-	Currencies        []string               `protobuf:"bytes,7,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityPayoutStatusResponse) Reset() {
-	*x = GetCommunityPayoutStatusResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[110]
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityPayoutStatusResponse) String() string {
+func (x *DeleteTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityPayoutStatusResponse) ProtoMessage() {}
+func (*DeleteTeamResponse) ProtoMessage() {}
 
-func (x *GetCommunityPayoutStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[110]
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6223,82 +11832,32 @@ func (x *GetCommunityPayoutStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityPayoutStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetCommunityPayoutStatusResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{110}
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetVerificationState() string {
-	if x != nil {
-		return x.VerificationState
-	}
-	return ""
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetLastVerifiedAt() int64 {
-	if x != nil {
-		return x.LastVerifiedAt
-	}
-	return 0
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetIsStale() bool {
-	if x != nil {
-		return x.IsStale
-	}
-	return false
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetRefreshError() string {
-	if x != nil {
-		return x.RefreshError
-	}
-	return ""
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetOnboardingState() string {
-	if x != nil {
-		return x.OnboardingState
-	}
-	return ""
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetPlatformAccountId() string {
-	if x != nil {
-		return x.PlatformAccountId
-	}
-	return ""
-}
-
-func (x *GetCommunityPayoutStatusResponse) GetCurrencies() []string {
-	if x != nil {
-		return x.Currencies
-	}
-	return nil
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{199}
 }
 
-type CreateTeamRequest struct {
+type GetUserTeamsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTeamRequest) Reset() {
-	*x = CreateTeamRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[111]
+func (x *GetUserTeamsRequest) Reset() {
+	*x = GetUserTeamsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTeamRequest) String() string {
+func (x *GetUserTeamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTeamRequest) ProtoMessage() {}
+func (*GetUserTeamsRequest) ProtoMessage() {}
 
-func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[111]
+func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6309,40 +11868,33 @@ func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
-func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{111}
-}
-
-func (x *CreateTeamRequest) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+// Deprecated: Use GetUserTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{200}
 }
 
-type CreateTeamResponse struct {
+type GetUserTeamsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Teams         []*UserTeam            `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTeamResponse) Reset() {
-	*x = CreateTeamResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[112]
+func (x *GetUserTeamsResponse) Reset() {
+	*x = GetUserTeamsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTeamResponse) String() string {
+func (x *GetUserTeamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTeamResponse) ProtoMessage() {}
+func (*GetUserTeamsResponse) ProtoMessage() {}
 
-func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[112]
+func (x *GetUserTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6353,44 +11905,45 @@ func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
-func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use GetUserTeamsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CreateTeamResponse) GetTeamId() string {
+func (x *GetUserTeamsResponse) GetTeams() []*UserTeam {
 	if x != nil {
-		return x.TeamId
+		return x.Teams
 	}
-	return ""
+	return nil
 }
 
-type EditTeamRequest struct {
+type UserTeam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
 	AvatarUri     string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	Members       []string               `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Plan          string                 `protobuf:"bytes,6,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditTeamRequest) Reset() {
-	*x = EditTeamRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[113]
+func (x *UserTeam) Reset() {
+	*x = UserTeam{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditTeamRequest) String() string {
+func (x *UserTeam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditTeamRequest) ProtoMessage() {}
+func (*UserTeam) ProtoMessage() {}
 
-func (x *EditTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[113]
+func (x *UserTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6401,67 +11954,75 @@ func (x *EditTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditTeamRequest.ProtoReflect.Descriptor instead.
-func (*EditTeamRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use UserTeam.ProtoReflect.Descriptor instead.
+func (*UserTeam) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *EditTeamRequest) GetTeamId() string {
+func (x *UserTeam) GetTeamId() string {
 	if x != nil {
 		return x.TeamId
 	}
 	return ""
 }
 
-func (x *EditTeamRequest) GetDisplayName() string {
+func (x *UserTeam) GetDisplayName() string {
 	if x != nil {
 		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *EditTeamRequest) GetBio() string {
+func (x *UserTeam) GetBio() string {
 	if x != nil {
 		return x.Bio
 	}
 	return ""
 }
 
-func (x *EditTeamRequest) GetAvatarUri() string {
+func (x *UserTeam) GetAvatarUri() string {
 	if x != nil {
 		return x.AvatarUri
 	}
 	return ""
 }
 
-func (x *EditTeamRequest) GetMembers() []string {
+func (x *UserTeam) GetRole() string {
 	if x != nil {
-		return x.Members
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type EditTeamResponse struct {
+func (x *UserTeam) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type GetTeamMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditTeamResponse) Reset() {
-	*x = EditTeamResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[114]
+func (x *GetTeamMembersRequest) Reset() {
+	*x = GetTeamMembersRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditTeamResponse) String() string {
+func (x *GetTeamMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditTeamResponse) ProtoMessage() {}
+func (*GetTeamMembersRequest) ProtoMessage() {}
 
-func (x *EditTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[114]
+func (x *GetTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6472,33 +12033,40 @@ func (x *EditTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditTeamResponse.ProtoReflect.Descriptor instead.
-func (*EditTeamResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use GetTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{203}
 }
 
-type DeleteTeamRequest struct {
+func (x *GetTeamMembersRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type GetTeamMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeamRequest) Reset() {
-	*x = DeleteTeamRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[115]
+func (x *GetTeamMembersResponse) Reset() {
+	*x = GetTeamMembersResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamRequest) String() string {
+func (x *GetTeamMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamRequest) ProtoMessage() {}
+func (*GetTeamMembersResponse) ProtoMessage() {}
 
-func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[115]
+func (x *GetTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6509,39 +12077,44 @@ func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GetTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *DeleteTeamRequest) GetTeamId() string {
+func (x *GetTeamMembersResponse) GetMembers() []*TeamMember {
 	if x != nil {
-		return x.TeamId
+		return x.Members
 	}
-	return ""
+	return nil
 }
 
-type DeleteTeamResponse struct {
+type TeamMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUri     string                 `protobuf:"bytes,3,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeamResponse) Reset() {
-	*x = DeleteTeamResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[116]
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeamResponse) String() string {
+func (x *TeamMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeamResponse) ProtoMessage() {}
+func (*TeamMember) ProtoMessage() {}
 
-func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[116]
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6552,32 +12125,68 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{205}
 }
 
-type GetUserTeamsRequest struct {
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TeamMember) GetAvatarUri() string {
+	if x != nil {
+		return x.AvatarUri
+	}
+	return ""
+}
+
+func (x *TeamMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TeamMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type GetCommunityAdministratorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTeamsRequest) Reset() {
-	*x = GetUserTeamsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[117]
+func (x *GetCommunityAdministratorsRequest) Reset() {
+	*x = GetCommunityAdministratorsRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTeamsRequest) String() string {
+func (x *GetCommunityAdministratorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTeamsRequest) ProtoMessage() {}
+func (*GetCommunityAdministratorsRequest) ProtoMessage() {}
 
-func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[117]
+func (x *GetCommunityAdministratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6588,33 +12197,40 @@ func (x *GetUserTeamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTeamsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use GetCommunityAdministratorsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityAdministratorsRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{206}
 }
 
-type GetUserTeamsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*UserTeam            `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCommunityAdministratorsRequest) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
 }
 
-func (x *GetUserTeamsResponse) Reset() {
-	*x = GetUserTeamsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[118]
+type GetCommunityAdministratorsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Administrators []string               `protobuf:"bytes,1,rep,name=administrators,proto3" json:"administrators,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCommunityAdministratorsResponse) Reset() {
+	*x = GetCommunityAdministratorsResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTeamsResponse) String() string {
+func (x *GetCommunityAdministratorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTeamsResponse) ProtoMessage() {}
+func (*GetCommunityAdministratorsResponse) ProtoMessage() {}
 
-func (x *GetUserTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[118]
+func (x *GetCommunityAdministratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6625,45 +12241,40 @@ func (x *GetUserTeamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTeamsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use GetCommunityAdministratorsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityAdministratorsResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *GetUserTeamsResponse) GetTeams() []*UserTeam {
+func (x *GetCommunityAdministratorsResponse) GetAdministrators() []string {
 	if x != nil {
-		return x.Teams
+		return x.Administrators
 	}
 	return nil
 }
 
-type UserTeam struct {
+type JoinCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
-	AvatarUri     string                 `protobuf:"bytes,4,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	Plan          string                 `protobuf:"bytes,6,opt,name=plan,proto3" json:"plan,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserTeam) Reset() {
-	*x = UserTeam{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[119]
+func (x *JoinCommunityRequest) Reset() {
+	*x = JoinCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserTeam) String() string {
+func (x *JoinCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserTeam) ProtoMessage() {}
+func (*JoinCommunityRequest) ProtoMessage() {}
 
-func (x *UserTeam) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[119]
+func (x *JoinCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6674,75 +12285,76 @@ func (x *UserTeam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserTeam.ProtoReflect.Descriptor instead.
-func (*UserTeam) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use JoinCommunityRequest.ProtoReflect.Descriptor instead.
+func (*JoinCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *UserTeam) GetTeamId() string {
+func (x *JoinCommunityRequest) GetCommunityId() string {
 	if x != nil {
-		return x.TeamId
+		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *UserTeam) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+type JoinCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserTeam) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+func (x *JoinCommunityResponse) Reset() {
+	*x = JoinCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserTeam) GetAvatarUri() string {
-	if x != nil {
-		return x.AvatarUri
-	}
-	return ""
+func (x *JoinCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserTeam) GetRole() string {
+func (*JoinCommunityResponse) ProtoMessage() {}
+
+func (x *JoinCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[209]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserTeam) GetPlan() string {
-	if x != nil {
-		return x.Plan
-	}
-	return ""
+// Deprecated: Use JoinCommunityResponse.ProtoReflect.Descriptor instead.
+func (*JoinCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{209}
 }
 
-type GetTeamMembersRequest struct {
+type LeaveCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamMembersRequest) Reset() {
-	*x = GetTeamMembersRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[120]
+func (x *LeaveCommunityRequest) Reset() {
+	*x = LeaveCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamMembersRequest) String() string {
+func (x *LeaveCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamMembersRequest) ProtoMessage() {}
+func (*LeaveCommunityRequest) ProtoMessage() {}
 
-func (x *GetTeamMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[120]
+func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6753,40 +12365,39 @@ func (x *GetTeamMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamMembersRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamMembersRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use LeaveCommunityRequest.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *GetTeamMembersRequest) GetTeamId() string {
+func (x *LeaveCommunityRequest) GetCommunityId() string {
 	if x != nil {
-		return x.TeamId
+		return x.CommunityId
 	}
 	return ""
 }
 
-type GetTeamMembersResponse struct {
+type LeaveCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*TeamMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamMembersResponse) Reset() {
-	*x = GetTeamMembersResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[121]
+func (x *LeaveCommunityResponse) Reset() {
+	*x = LeaveCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamMembersResponse) String() string {
+func (x *LeaveCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamMembersResponse) ProtoMessage() {}
+func (*LeaveCommunityResponse) ProtoMessage() {}
 
-func (x *GetTeamMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[121]
+func (x *LeaveCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6797,44 +12408,34 @@ func (x *GetTeamMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamMembersResponse.ProtoReflect.Descriptor instead.
-func (*GetTeamMembersResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{121}
-}
-
-func (x *GetTeamMembersResponse) GetMembers() []*TeamMember {
-	if x != nil {
-		return x.Members
-	}
-	return nil
+// Deprecated: Use LeaveCommunityResponse.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{211}
 }
 
-type TeamMember struct {
+type RemoveCommunityMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	AvatarUri     string                 `protobuf:"bytes,3,opt,name=avatar_uri,json=avatarUri,proto3" json:"avatar_uri,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeamMember) Reset() {
-	*x = TeamMember{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[122]
+func (x *RemoveCommunityMemberRequest) Reset() {
+	*x = RemoveCommunityMemberRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeamMember) String() string {
+func (x *RemoveCommunityMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TeamMember) ProtoMessage() {}
+func (*RemoveCommunityMemberRequest) ProtoMessage() {}
 
-func (x *TeamMember) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[122]
+func (x *RemoveCommunityMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6845,68 +12446,84 @@ func (x *TeamMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
-func (*TeamMember) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use RemoveCommunityMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityMemberRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *TeamMember) GetUserId() string {
+func (x *RemoveCommunityMemberRequest) GetCommunityId() string {
 	if x != nil {
-		return x.UserId
+		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *TeamMember) GetDisplayName() string {
+func (x *RemoveCommunityMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.DisplayName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *TeamMember) GetAvatarUri() string {
-	if x != nil {
-		return x.AvatarUri
-	}
-	return ""
+type RemoveCommunityMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeamMember) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *RemoveCommunityMemberResponse) Reset() {
+	*x = RemoveCommunityMemberResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeamMember) GetRole() string {
+func (x *RemoveCommunityMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommunityMemberResponse) ProtoMessage() {}
+
+func (x *RemoveCommunityMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[213]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type GetCommunityAdministratorsRequest struct {
+// Deprecated: Use RemoveCommunityMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCommunityMemberResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{213}
+}
+
+type AddEventToCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityAdministratorsRequest) Reset() {
-	*x = GetCommunityAdministratorsRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[123]
+func (x *AddEventToCommunityRequest) Reset() {
+	*x = AddEventToCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityAdministratorsRequest) String() string {
+func (x *AddEventToCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityAdministratorsRequest) ProtoMessage() {}
+func (*AddEventToCommunityRequest) ProtoMessage() {}
 
-func (x *GetCommunityAdministratorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[123]
+func (x *AddEventToCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6917,40 +12534,46 @@ func (x *GetCommunityAdministratorsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityAdministratorsRequest.ProtoReflect.Descriptor instead.
-func (*GetCommunityAdministratorsRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use AddEventToCommunityRequest.ProtoReflect.Descriptor instead.
+func (*AddEventToCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *GetCommunityAdministratorsRequest) GetCommunityId() string {
+func (x *AddEventToCommunityRequest) GetCommunityId() string {
 	if x != nil {
 		return x.CommunityId
 	}
 	return ""
 }
 
-type GetCommunityAdministratorsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Administrators []string               `protobuf:"bytes,1,rep,name=administrators,proto3" json:"administrators,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AddEventToCommunityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
 }
 
-func (x *GetCommunityAdministratorsResponse) Reset() {
-	*x = GetCommunityAdministratorsResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[124]
+type AddEventToCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventToCommunityResponse) Reset() {
+	*x = AddEventToCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityAdministratorsResponse) String() string {
+func (x *AddEventToCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityAdministratorsResponse) ProtoMessage() {}
+func (*AddEventToCommunityResponse) ProtoMessage() {}
 
-func (x *GetCommunityAdministratorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[124]
+func (x *AddEventToCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6961,40 +12584,34 @@ func (x *GetCommunityAdministratorsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityAdministratorsResponse.ProtoReflect.Descriptor instead.
-func (*GetCommunityAdministratorsResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{124}
-}
-
-func (x *GetCommunityAdministratorsResponse) GetAdministrators() []string {
-	if x != nil {
-		return x.Administrators
-	}
-	return nil
+// Deprecated: Use AddEventToCommunityResponse.ProtoReflect.Descriptor instead.
+func (*AddEventToCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{215}
 }
 
-type JoinCommunityRequest struct {
+type RemoveEventFromCommunityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinCommunityRequest) Reset() {
-	*x = JoinCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[125]
+func (x *RemoveEventFromCommunityRequest) Reset() {
+	*x = RemoveEventFromCommunityRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinCommunityRequest) String() string {
+func (x *RemoveEventFromCommunityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinCommunityRequest) ProtoMessage() {}
+func (*RemoveEventFromCommunityRequest) ProtoMessage() {}
 
-func (x *JoinCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[125]
+func (x *RemoveEventFromCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7005,39 +12622,46 @@ func (x *JoinCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinCommunityRequest.ProtoReflect.Descriptor instead.
-func (*JoinCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use RemoveEventFromCommunityRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEventFromCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *JoinCommunityRequest) GetCommunityId() string {
+func (x *RemoveEventFromCommunityRequest) GetCommunityId() string {
 	if x != nil {
 		return x.CommunityId
 	}
 	return ""
 }
 
-type JoinCommunityResponse struct {
+func (x *RemoveEventFromCommunityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type RemoveEventFromCommunityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinCommunityResponse) Reset() {
-	*x = JoinCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[126]
+func (x *RemoveEventFromCommunityResponse) Reset() {
+	*x = RemoveEventFromCommunityResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinCommunityResponse) String() string {
+func (x *RemoveEventFromCommunityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinCommunityResponse) ProtoMessage() {}
+func (*RemoveEventFromCommunityResponse) ProtoMessage() {}
 
-func (x *JoinCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[126]
+func (x *RemoveEventFromCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7048,33 +12672,40 @@ func (x *JoinCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinCommunityResponse.ProtoReflect.Descriptor instead.
-func (*JoinCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use RemoveEventFromCommunityResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEventFromCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{217}
 }
 
-type LeaveCommunityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventTemplate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommunityId string                 `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatorId   string                 `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedAt   int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	// settings of the events created from the template, without dates nor password
+	Event           *CreateEventRequest `protobuf:"bytes,6,opt,name=event,proto3" json:"event,omitempty"`
+	DurationSeconds uint32              `protobuf:"varint,7,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LeaveCommunityRequest) Reset() {
-	*x = LeaveCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[127]
+func (x *EventTemplate) Reset() {
+	*x = EventTemplate{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveCommunityRequest) String() string {
+func (x *EventTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveCommunityRequest) ProtoMessage() {}
+func (*EventTemplate) ProtoMessage() {}
 
-func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[127]
+func (x *EventTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7085,77 +12716,87 @@ func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveCommunityRequest.ProtoReflect.Descriptor instead.
-func (*LeaveCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use EventTemplate.ProtoReflect.Descriptor instead.
+func (*EventTemplate) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *LeaveCommunityRequest) GetCommunityId() string {
+func (x *EventTemplate) GetId() string {
 	if x != nil {
-		return x.CommunityId
+		return x.Id
 	}
 	return ""
 }
 
-type LeaveCommunityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventTemplate) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
 }
 
-func (x *LeaveCommunityResponse) Reset() {
-	*x = LeaveCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[128]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *LeaveCommunityResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventTemplate) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
 }
 
-func (*LeaveCommunityResponse) ProtoMessage() {}
+func (x *EventTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
 
-func (x *LeaveCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[128]
+func (x *EventTemplate) GetEvent() *CreateEventRequest {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Event
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LeaveCommunityResponse.ProtoReflect.Descriptor instead.
-func (*LeaveCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{128}
+func (x *EventTemplate) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
 }
 
-type RemoveCommunityMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateEventTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// the event whose settings are saved, event is used when empty
+	EventId         string              `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event           *CreateEventRequest `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	DurationSeconds uint32              `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // ignored when event_id is set
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RemoveCommunityMemberRequest) Reset() {
-	*x = RemoveCommunityMemberRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[129]
+func (x *CreateEventTemplateRequest) Reset() {
+	*x = CreateEventTemplateRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCommunityMemberRequest) String() string {
+func (x *CreateEventTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCommunityMemberRequest) ProtoMessage() {}
+func (*CreateEventTemplateRequest) ProtoMessage() {}
 
-func (x *RemoveCommunityMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[129]
+func (x *CreateEventTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7166,46 +12807,68 @@ func (x *RemoveCommunityMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCommunityMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCommunityMemberRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use CreateEventTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *RemoveCommunityMemberRequest) GetCommunityId() string {
+func (x *CreateEventTemplateRequest) GetCommunityId() string {
 	if x != nil {
 		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *RemoveCommunityMemberRequest) GetUserId() string {
+func (x *CreateEventTemplateRequest) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-type RemoveCommunityMemberResponse struct {
+func (x *CreateEventTemplateRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateEventTemplateRequest) GetEvent() *CreateEventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CreateEventTemplateRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CreateEventTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *EventTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCommunityMemberResponse) Reset() {
-	*x = RemoveCommunityMemberResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[130]
+func (x *CreateEventTemplateResponse) Reset() {
+	*x = CreateEventTemplateResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCommunityMemberResponse) String() string {
+func (x *CreateEventTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCommunityMemberResponse) ProtoMessage() {}
+func (*CreateEventTemplateResponse) ProtoMessage() {}
 
-func (x *RemoveCommunityMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[130]
+func (x *CreateEventTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7216,34 +12879,40 @@ func (x *RemoveCommunityMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCommunityMemberResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCommunityMemberResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use CreateEventTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{220}
 }
 
-type AddEventToCommunityRequest struct {
+func (x *CreateEventTemplateResponse) GetTemplate() *EventTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListEventTemplatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventToCommunityRequest) Reset() {
-	*x = AddEventToCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[131]
+func (x *ListEventTemplatesRequest) Reset() {
+	*x = ListEventTemplatesRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventToCommunityRequest) String() string {
+func (x *ListEventTemplatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventToCommunityRequest) ProtoMessage() {}
+func (*ListEventTemplatesRequest) ProtoMessage() {}
 
-func (x *AddEventToCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[131]
+func (x *ListEventTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7254,46 +12923,40 @@ func (x *AddEventToCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventToCommunityRequest.ProtoReflect.Descriptor instead.
-func (*AddEventToCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use ListEventTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *AddEventToCommunityRequest) GetCommunityId() string {
+func (x *ListEventTemplatesRequest) GetCommunityId() string {
 	if x != nil {
 		return x.CommunityId
 	}
 	return ""
 }
 
-func (x *AddEventToCommunityRequest) GetEventId() string {
-	if x != nil {
-		return x.EventId
-	}
-	return ""
-}
-
-type AddEventToCommunityResponse struct {
+type ListEventTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*EventTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // ordered by name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventToCommunityResponse) Reset() {
-	*x = AddEventToCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[132]
+func (x *ListEventTemplatesResponse) Reset() {
+	*x = ListEventTemplatesResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventToCommunityResponse) String() string {
+func (x *ListEventTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventToCommunityResponse) ProtoMessage() {}
+func (*ListEventTemplatesResponse) ProtoMessage() {}
 
-func (x *AddEventToCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[132]
+func (x *ListEventTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7304,34 +12967,40 @@ func (x *AddEventToCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventToCommunityResponse.ProtoReflect.Descriptor instead.
-func (*AddEventToCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use ListEventTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{222}
 }
 
-type RemoveEventFromCommunityRequest struct {
+func (x *ListEventTemplatesResponse) GetTemplates() []*EventTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteEventTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveEventFromCommunityRequest) Reset() {
-	*x = RemoveEventFromCommunityRequest{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[133]
+func (x *DeleteEventTemplateRequest) Reset() {
+	*x = DeleteEventTemplateRequest{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEventFromCommunityRequest) String() string {
+func (x *DeleteEventTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEventFromCommunityRequest) ProtoMessage() {}
+func (*DeleteEventTemplateRequest) ProtoMessage() {}
 
-func (x *RemoveEventFromCommunityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[133]
+func (x *DeleteEventTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7342,46 +13011,39 @@ func (x *RemoveEventFromCommunityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEventFromCommunityRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEventFromCommunityRequest) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{133}
-}
-
-func (x *RemoveEventFromCommunityRequest) GetCommunityId() string {
-	if x != nil {
-		return x.CommunityId
-	}
-	return ""
+// Deprecated: Use DeleteEventTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *RemoveEventFromCommunityRequest) GetEventId() string {
+func (x *DeleteEventTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.EventId
+		return x.TemplateId
 	}
 	return ""
 }
 
-type RemoveEventFromCommunityResponse struct {
+type DeleteEventTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveEventFromCommunityResponse) Reset() {
-	*x = RemoveEventFromCommunityResponse{}
-	mi := &file_zenao_v1_zenao_proto_msgTypes[134]
+func (x *DeleteEventTemplateResponse) Reset() {
+	*x = DeleteEventTemplateResponse{}
+	mi := &file_zenao_v1_zenao_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEventFromCommunityResponse) String() string {
+func (x *DeleteEventTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEventFromCommunityResponse) ProtoMessage() {}
+func (*DeleteEventTemplateResponse) ProtoMessage() {}
 
-func (x *RemoveEventFromCommunityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zenao_v1_zenao_proto_msgTypes[134]
+func (x *DeleteEventTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zenao_v1_zenao_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7392,9 +13054,9 @@ func (x *RemoveEventFromCommunityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEventFromCommunityResponse.ProtoReflect.Descriptor instead.
-func (*RemoveEventFromCommunityResponse) Descriptor() ([]byte, []int) {
-	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use DeleteEventTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_zenao_v1_zenao_proto_rawDescGZIP(), []int{224}
 }
 
 var File_zenao_v1_zenao_proto protoreflect.FileDescriptor
@@ -7418,7 +13080,12 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x04plan\x18\x02 \x01(\tR\x04plan\x12\x19\n" +
 	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1d\n" +
 	"\n" +
-	"actor_plan\x18\x04 \x01(\tR\tactorPlan\"\x8f\x01\n" +
+	"actor_plan\x18\x04 \x01(\tR\tactorPlan\"4\n" +
+	"\x1aGetUserCalendarFeedRequest\x12\x16\n" +
+	"\x06rotate\x18\x01 \x01(\bR\x06rotate\"G\n" +
+	"\x1bGetUserCalendarFeedResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\x8f\x01\n" +
 	"\aProfile\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x10\n" +
@@ -7433,20 +13100,41 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x0fGetEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"=\n" +
 	"\x10GetEventResponse\x12)\n" +
-	"\x05event\x18\x01 \x01(\v2\x13.zenao.v1.EventInfoR\x05event\"\xf7\x01\n" +
+	"\x05event\x18\x01 \x01(\v2\x13.zenao.v1.EventInfoR\x05event\"\xa7\x02\n" +
 	"\x11ListEventsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x12\n" +
 	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
 	"\x02to\x18\x04 \x01(\x03R\x02to\x12M\n" +
 	"\x13discoverable_filter\x18\x05 \x01(\x0e2\x1c.zenao.v1.DiscoverableFilterR\x12discoverableFilter\x12A\n" +
-	"\x0flocation_filter\x18\x06 \x01(\v2\x18.zenao.v1.LocationFilterR\x0elocationFilter\"Q\n" +
+	"\x0flocation_filter\x18\x06 \x01(\v2\x18.zenao.v1.LocationFilterR\x0elocationFilter\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\"Q\n" +
 	"\x0eLocationFilter\x12\x10\n" +
 	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
 	"\x03lng\x18\x02 \x01(\x01R\x03lng\x12\x1b\n" +
 	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\"A\n" +
 	"\x12ListEventsResponse\x12+\n" +
-	"\x06events\x18\x01 \x03(\v2\x13.zenao.v1.EventInfoR\x06events\"L\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.zenao.v1.EventInfoR\x06events\"\xba\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\rR\x06offset\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\x03R\x02to\x12A\n" +
+	"\x0flocation_filter\x18\x06 \x01(\v2\x18.zenao.v1.LocationFilterR\x0elocationFilter\"\x88\x01\n" +
+	"\x0eSearchResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.zenao.v1.EventSearchResultR\x06events\x12A\n" +
+	"\vcommunities\x18\x02 \x03(\v2\x1f.zenao.v1.CommunitySearchResultR\vcommunities\"\xc1\x01\n" +
+	"\x11EventSearchResult\x12)\n" +
+	"\x05event\x18\x01 \x01(\v2\x13.zenao.v1.EventInfoR\x05event\x12'\n" +
+	"\x0ftitle_highlight\x18\x02 \x01(\tR\x0etitleHighlight\x12/\n" +
+	"\x13description_snippet\x18\x03 \x01(\tR\x12descriptionSnippet\x12'\n" +
+	"\x0fvenue_highlight\x18\x04 \x01(\tR\x0evenueHighlight\"\xa6\x01\n" +
+	"\x15CommunitySearchResult\x125\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x17.zenao.v1.CommunityInfoR\tcommunity\x12%\n" +
+	"\x0ename_highlight\x18\x02 \x01(\tR\rnameHighlight\x12/\n" +
+	"\x13description_snippet\x18\x03 \x01(\tR\x12descriptionSnippet\"L\n" +
 	"\tEventUser\x12)\n" +
 	"\x05event\x18\x01 \x01(\v2\x13.zenao.v1.EventInfoR\x05event\x12\x14\n" +
 	"\x05roles\x18\x02 \x03(\tR\x05roles\"\xee\x01\n" +
@@ -7459,7 +13147,7 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x02to\x18\x06 \x01(\x03R\x02to\x12M\n" +
 	"\x13discoverable_filter\x18\a \x01(\x0e2\x1c.zenao.v1.DiscoverableFilterR\x12discoverableFilter\"L\n" +
 	"\x1dListEventsByUserRolesResponse\x12+\n" +
-	"\x06events\x18\x01 \x03(\v2\x13.zenao.v1.EventUserR\x06events\"\xa5\x04\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.zenao.v1.EventUserR\x06events\"\xcd\a\n" +
 	"\x12CreateEventRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
@@ -7479,12 +13167,31 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\fdiscoverable\x18\r \x01(\bR\fdiscoverable\x12!\n" +
 	"\fcommunity_id\x18\x0e \x01(\tR\vcommunityId\x12'\n" +
 	"\x0fcommunity_email\x18\x0f \x01(\bR\x0ecommunityEmail\x12>\n" +
-	"\rprices_groups\x18\x10 \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\"%\n" +
+	"\rprices_groups\x18\x10 \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\x12:\n" +
+	"\x19ticket_transfers_disabled\x18\x11 \x01(\bR\x17ticketTransfersDisabled\x12\x14\n" +
+	"\x05rrule\x18\x12 \x01(\tR\x05rrule\x12U\n" +
+	"\x16registration_questions\x18\x13 \x03(\v2\x1e.zenao.v1.RegistrationQuestionR\x15registrationQuestions\x12+\n" +
+	"\x11approval_required\x18\x14 \x01(\bR\x10approvalRequired\x12)\n" +
+	"\x10reminder_offsets\x18\x15 \x03(\rR\x0freminderOffsets\x12\x1f\n" +
+	"\vtemplate_id\x18\x16 \x01(\tR\n" +
+	"templateId\x12\x14\n" +
+	"\x05draft\x18\x17 \x01(\bR\x05draft\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x18 \x01(\x03R\tpublishAt\x12\x1a\n" +
+	"\bcategory\x18\x19 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x1a \x03(\tR\x04tags\x12\x1f\n" +
+	"\vinvite_only\x18\x1b \x01(\bR\n" +
+	"inviteOnly\"i\n" +
 	"\x13CreateEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12%\n" +
+	"\x0eoccurrence_ids\x18\x03 \x03(\tR\roccurrenceIds\"T\n" +
 	"\x12CancelEventRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x15\n" +
-	"\x13CancelEventResponse\"\xe7\x04\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12#\n" +
+	"\rrefund_orders\x18\x02 \x01(\bR\frefundOrders\"L\n" +
+	"\x13CancelEventResponse\x125\n" +
+	"\x17refund_failed_order_ids\x18\x01 \x03(\tR\x14refundFailedOrderIds\"\xb4\n" +
+	"\n" +
 	"\x10EditEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -7506,9 +13213,45 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\fdiscoverable\x18\x0e \x01(\bR\fdiscoverable\x12!\n" +
 	"\fcommunity_id\x18\x0f \x01(\tR\vcommunityId\x12'\n" +
 	"\x0fcommunity_email\x18\x10 \x01(\bR\x0ecommunityEmail\x12>\n" +
-	"\rprices_groups\x18\x11 \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\"#\n" +
+	"\rprices_groups\x18\x11 \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\x12:\n" +
+	"\x19ticket_transfers_disabled\x18\x12 \x01(\bR\x17ticketTransfersDisabled\x12A\n" +
+	"\fseries_scope\x18\x13 \x01(\x0e2\x1e.zenao.v1.EventSeriesEditScopeR\vseriesScope\x12U\n" +
+	"\x16registration_questions\x18\x14 \x03(\v2\x1e.zenao.v1.RegistrationQuestionR\x15registrationQuestions\x12B\n" +
+	"\x1dupdate_registration_questions\x18\x15 \x01(\bR\x1bupdateRegistrationQuestions\x12+\n" +
+	"\x11approval_required\x18\x16 \x01(\bR\x10approvalRequired\x12)\n" +
+	"\x10reminder_offsets\x18\x17 \x03(\rR\x0freminderOffsets\x126\n" +
+	"\x17update_reminder_offsets\x18\x18 \x01(\bR\x15updateReminderOffsets\x12\x1a\n" +
+	"\bcategory\x18\x19 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x1a \x03(\tR\x04tags\x12\x1f\n" +
+	"\vupdate_tags\x18\x1b \x01(\bR\n" +
+	"updateTags\x12\x1f\n" +
+	"\vinvite_only\x18\x1c \x01(\bR\n" +
+	"inviteOnly\x12G\n" +
+	" update_ticket_transfers_disabled\x18\x1d \x01(\bR\x1dupdateTicketTransfersDisabled\x128\n" +
+	"\x18update_approval_required\x18\x1e \x01(\bR\x16updateApprovalRequired\x12,\n" +
+	"\x12update_invite_only\x18\x1f \x01(\bR\x10updateInviteOnly\"@\n" +
 	"\x11EditEventResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\"Q\n" +
+	"\x15DuplicateEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\x04R\tstartDate\"(\n" +
+	"\x16DuplicateEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x13PublishEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\x03R\tpublishAt\"4\n" +
+	"\x14PublishEventResponse\x12\x1c\n" +
+	"\tpublished\x18\x01 \x01(\bR\tpublished\"4\n" +
+	"\x15GetEventSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\"z\n" +
+	"\x16GetEventSeriesResponse\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05rrule\x18\x02 \x01(\tR\x05rrule\x12\x1b\n" +
+	"\tevent_ids\x18\x03 \x03(\tR\beventIds\x12\x10\n" +
+	"\x03ics\x18\x04 \x01(\fR\x03ics\"7\n" +
 	"\x1aGetEventGatekeepersRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"?\n" +
 	"\x1bGetEventGatekeepersResponse\x12 \n" +
@@ -7517,24 +13260,34 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"0\n" +
 	"\x18ValidatePasswordResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\"y\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\"\xaa\x02\n" +
 	"\x12ParticipateRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
 	"\x06guests\x18\x03 \x03(\tR\x06guests\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"7\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x126\n" +
+	"\aanswers\x18\x05 \x03(\v2\x1c.zenao.v1.RegistrationAnswerR\aanswers\x12L\n" +
+	"\x0eguests_answers\x18\x06 \x03(\v2%.zenao.v1.AttendeeRegistrationAnswersR\rguestsAnswers\x12)\n" +
+	"\x10invitation_token\x18\a \x01(\tR\x0finvitationToken\"7\n" +
 	"\x1aCancelParticipationRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x1d\n" +
-	"\x1bCancelParticipationResponse\"N\n" +
+	"\x1bCancelParticipationResponse\"H\n" +
+	"\x15TransferTicketRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"\x18\n" +
+	"\x16TransferTicketResponse\"N\n" +
 	"\x18RemoveParticipantRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
-	"\x19RemoveParticipantResponse\":\n" +
+	"\x19RemoveParticipantResponse\"z\n" +
 	"\x13ParticipateResponse\x12#\n" +
-	"\rticket_secret\x18\x01 \x01(\tR\fticketSecret\"^\n" +
+	"\rticket_secret\x18\x01 \x01(\tR\fticketSecret\x12>\n" +
+	"\fapplications\x18\x02 \x03(\v2\x1a.zenao.v1.EventApplicationR\fapplications\"\xb9\x01\n" +
 	"\x1aStartTicketPaymentLineItem\x12\x19\n" +
 	"\bprice_id\x18\x01 \x01(\tR\apriceId\x12%\n" +
-	"\x0eattendee_email\x18\x02 \x01(\tR\rattendeeEmail\"\xdb\x01\n" +
+	"\x0eattendee_email\x18\x02 \x01(\tR\rattendeeEmail\x12!\n" +
+	"\famount_minor\x18\x03 \x01(\x03R\vamountMinor\x126\n" +
+	"\aanswers\x18\x04 \x03(\v2\x1c.zenao.v1.RegistrationAnswerR\aanswers\"\xd9\x02\n" +
 	"\x19StartTicketPaymentRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12C\n" +
 	"\n" +
@@ -7542,7 +13295,11 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12!\n" +
 	"\fsuccess_path\x18\x04 \x01(\tR\vsuccessPath\x12\x1f\n" +
 	"\vcancel_path\x18\x05 \x01(\tR\n" +
-	"cancelPath\"Z\n" +
+	"cancelPath\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x06 \x01(\tR\tpromoCode\x122\n" +
+	"\x15donation_amount_minor\x18\a \x01(\x03R\x13donationAmountMinor\x12)\n" +
+	"\x10invitation_token\x18\b \x01(\tR\x0finvitationToken\"Z\n" +
 	"\x1aStartTicketPaymentResponse\x12!\n" +
 	"\fcheckout_url\x18\x01 \x01(\tR\vcheckoutUrl\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\"h\n" +
@@ -7576,14 +13333,17 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x04size\x18\x04 \x01(\x02R\x04size\"E\n" +
 	"\rAddressCustom\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
-	"\btimezone\x18\x02 \x01(\tR\btimezone\"\x92\x01\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\xd7\x01\n" +
 	"\fEventPrivacy\x126\n" +
 	"\x06public\x18\x01 \x01(\v2\x1c.zenao.v1.EventPrivacyPublicH\x00R\x06public\x129\n" +
-	"\aguarded\x18\x02 \x01(\v2\x1d.zenao.v1.EventPrivacyGuardedH\x00R\aguardedB\x0f\n" +
+	"\aguarded\x18\x02 \x01(\v2\x1d.zenao.v1.EventPrivacyGuardedH\x00R\aguarded\x12C\n" +
+	"\vinvite_only\x18\x03 \x01(\v2 .zenao.v1.EventPrivacyInviteOnlyH\x00R\n" +
+	"inviteOnlyB\x0f\n" +
 	"\revent_privacy\"\x14\n" +
 	"\x12EventPrivacyPublic\"H\n" +
 	"\x13EventPrivacyGuarded\x121\n" +
-	"\x14participation_pubkey\x18\x01 \x01(\tR\x13participationPubkey\"\x96\x04\n" +
+	"\x14participation_pubkey\x18\x01 \x01(\tR\x13participationPubkey\"\x18\n" +
+	"\x16EventPrivacyInviteOnly\"\x83\a\n" +
 	"\tEventInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -7604,18 +13364,48 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\n" +
 	"checked_in\x18\r \x01(\rR\tcheckedIn\x12\"\n" +
 	"\fdiscoverable\x18\x0e \x01(\bR\fdiscoverable\x12>\n" +
-	"\rprices_groups\x18\x0f \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\"c\n" +
+	"\rprices_groups\x18\x0f \x03(\v2\x19.zenao.v1.EventPriceGroupR\fpricesGroups\x12:\n" +
+	"\x19ticket_transfers_disabled\x18\x10 \x01(\bR\x17ticketTransfersDisabled\x12\x1b\n" +
+	"\tseries_id\x18\x11 \x01(\tR\bseriesId\x12U\n" +
+	"\x16registration_questions\x18\x12 \x03(\v2\x1e.zenao.v1.RegistrationQuestionR\x15registrationQuestions\x12+\n" +
+	"\x11approval_required\x18\x13 \x01(\bR\x10approvalRequired\x12)\n" +
+	"\x10reminder_offsets\x18\x14 \x03(\rR\x0freminderOffsets\x12\x14\n" +
+	"\x05draft\x18\x15 \x01(\bR\x05draft\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x16 \x01(\x03R\tpublishAt\x12\x1a\n" +
+	"\bcategory\x18\x17 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x18 \x03(\tR\x04tags\"\x86\x01\n" +
+	"\x14RegistrationQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\"M\n" +
+	"\x12RegistrationAnswer\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"k\n" +
+	"\x1bAttendeeRegistrationAnswers\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x126\n" +
+	"\aanswers\x18\x02 \x03(\v2\x1c.zenao.v1.RegistrationAnswerR\aanswers\"\xbc\x01\n" +
 	"\x0fEventPriceGroup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
-	"\x06prices\x18\x03 \x03(\v2\x14.zenao.v1.EventPriceR\x06prices\"\xc4\x01\n" +
+	"\x06prices\x18\x03 \x03(\v2\x14.zenao.v1.EventPriceR\x06prices\x12&\n" +
+	"\x0factive_price_id\x18\x04 \x01(\tR\ractivePriceId\x12/\n" +
+	"\x14next_price_change_at\x18\x05 \x01(\x03R\x11nextPriceChangeAt\"\xd2\x02\n" +
 	"\n" +
 	"EventPrice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\famount_minor\x18\x02 \x01(\x03R\vamountMinor\x12#\n" +
 	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12,\n" +
 	"\x12payment_account_id\x18\x04 \x01(\tR\x10paymentAccountId\x120\n" +
-	"\x14payment_account_type\x18\x05 \x01(\tR\x12paymentAccountType\"9\n" +
+	"\x14payment_account_type\x18\x05 \x01(\tR\x12paymentAccountType\x12$\n" +
+	"\x0esale_starts_at\x18\x06 \x01(\x03R\fsaleStartsAt\x12 \n" +
+	"\fsale_ends_at\x18\a \x01(\x03R\n" +
+	"saleEndsAt\x12\x12\n" +
+	"\x04kind\x18\b \x01(\tR\x04kind\x120\n" +
+	"\x14minimum_amount_minor\x18\t \x01(\x03R\x12minimumAmountMinor\"9\n" +
 	"\x11BatchProfileField\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\"h\n" +
@@ -7696,7 +13486,7 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\n" +
 	"user_email\x18\x02 \x01(\tR\tuserEmail\"3\n" +
 	"\x16GetOrderDetailsRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"\xc6\x01\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\xc5\x03\n" +
 	"\fOrderSummary\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x19\n" +
@@ -7704,14 +13494,215 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\famount_minor\x18\x04 \x01(\x03R\vamountMinor\x12#\n" +
 	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"U\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12#\n" +
+	"\rrefund_status\x18\a \x01(\tR\frefundStatus\x122\n" +
+	"\x15refunded_amount_minor\x18\b \x01(\x03R\x13refundedAmountMinor\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\t \x01(\tR\tpromoCode\x122\n" +
+	"\x15discount_amount_minor\x18\n" +
+	" \x01(\x03R\x13discountAmountMinor\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\v \x01(\tR\tinvoiceId\x122\n" +
+	"\x15donation_amount_minor\x18\f \x01(\x03R\x13donationAmountMinor\"U\n" +
 	"\x0fOrderTicketInfo\x12#\n" +
 	"\rticket_secret\x18\x01 \x01(\tR\fticketSecret\x12\x1d\n" +
 	"\n" +
 	"user_email\x18\x02 \x01(\tR\tuserEmail\"|\n" +
 	"\x17GetOrderDetailsResponse\x12,\n" +
 	"\x05order\x18\x01 \x01(\v2\x16.zenao.v1.OrderSummaryR\x05order\x123\n" +
-	"\atickets\x18\x02 \x03(\v2\x19.zenao.v1.OrderTicketInfoR\atickets\"\x16\n" +
+	"\atickets\x18\x02 \x03(\v2\x19.zenao.v1.OrderTicketInfoR\atickets\"3\n" +
+	"\x16GetOrderInvoiceRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"f\n" +
+	"\x17GetOrderInvoiceResponse\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x10\n" +
+	"\x03pdf\x18\x03 \x01(\fR\x03pdf\"X\n" +
+	"\x12RefundOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
+	"\x0fattendee_emails\x18\x02 \x03(\tR\x0eattendeeEmails\"\x89\x01\n" +
+	"\x13RefundOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12#\n" +
+	"\rrefund_status\x18\x02 \x01(\tR\frefundStatus\x122\n" +
+	"\x15refunded_amount_minor\x18\x03 \x01(\x03R\x13refundedAmountMinor\"\xfb\x02\n" +
+	"\tPromoCode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x05 \x01(\rR\n" +
+	"percentOff\x12(\n" +
+	"\x10amount_off_minor\x18\x06 \x01(\x03R\x0eamountOffMinor\x12#\n" +
+	"\rcurrency_code\x18\a \x01(\tR\fcurrencyCode\x12\x19\n" +
+	"\bprice_id\x18\b \x01(\tR\apriceId\x12'\n" +
+	"\x0fmax_redemptions\x18\t \x01(\rR\x0emaxRedemptions\x12 \n" +
+	"\vredemptions\x18\n" +
+	" \x01(\rR\vredemptions\x12\x1b\n" +
+	"\tstarts_at\x18\v \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\f \x01(\x03R\x06endsAt\"\xd6\x02\n" +
+	"\x16CreatePromoCodeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x03 \x01(\tR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x04 \x01(\rR\n" +
+	"percentOff\x12(\n" +
+	"\x10amount_off_minor\x18\x05 \x01(\x03R\x0eamountOffMinor\x12#\n" +
+	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x12\x19\n" +
+	"\bprice_id\x18\a \x01(\tR\apriceId\x12'\n" +
+	"\x0fmax_redemptions\x18\b \x01(\rR\x0emaxRedemptions\x12\x1b\n" +
+	"\tstarts_at\x18\t \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\n" +
+	" \x01(\x03R\x06endsAt\"M\n" +
+	"\x17CreatePromoCodeResponse\x122\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x13.zenao.v1.PromoCodeR\tpromoCode\"2\n" +
+	"\x15ListPromoCodesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"N\n" +
+	"\x16ListPromoCodesResponse\x124\n" +
+	"\vpromo_codes\x18\x01 \x03(\v2\x13.zenao.v1.PromoCodeR\n" +
+	"promoCodes\"<\n" +
+	"\x16DeletePromoCodeRequest\x12\"\n" +
+	"\rpromo_code_id\x18\x01 \x01(\tR\vpromoCodeId\"\x19\n" +
+	"\x17DeletePromoCodeResponse\"\x95\x02\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12$\n" +
+	"\x0eprice_group_id\x18\x03 \x01(\tR\fpriceGroupId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\rR\bposition\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"offered_at\x18\b \x01(\x03R\tofferedAt\x12(\n" +
+	"\x10offer_expires_at\x18\t \x01(\x03R\x0eofferExpiresAt\"K\n" +
+	"\x13JoinWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x19\n" +
+	"\bprice_id\x18\x02 \x01(\tR\apriceId\"E\n" +
+	"\x14JoinWaitlistResponse\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.zenao.v1.WaitlistEntryR\x05entry\"1\n" +
+	"\x14LeaveWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x17\n" +
+	"\x15LeaveWaitlistResponse\"4\n" +
+	"\x17GetEventWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"M\n" +
+	"\x18GetEventWaitlistResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.zenao.v1.WaitlistEntryR\aentries\"P\n" +
+	"\x16ReorderWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tentry_ids\x18\x02 \x03(\tR\bentryIds\"L\n" +
+	"\x17ReorderWaitlistResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.zenao.v1.WaitlistEntryR\aentries\"\xe3\x01\n" +
+	"\x10EventApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\bbuyer_id\x18\x04 \x01(\tR\abuyerId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vreviewed_at\x18\b \x01(\x03R\n" +
+	"reviewedAt\"Q\n" +
+	"\x1cListEventApplicationsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"_\n" +
+	"\x1dListEventApplicationsResponse\x12>\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1a.zenao.v1.EventApplicationR\fapplications\"G\n" +
+	"\x1eApproveEventApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"_\n" +
+	"\x1fApproveEventApplicationResponse\x12<\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1a.zenao.v1.EventApplicationR\vapplication\"`\n" +
+	"\x1dRejectEventApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
+	"\x1eRejectEventApplicationResponse\x12<\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1a.zenao.v1.EventApplicationR\vapplication\"\xa3\x02\n" +
+	"\x0fEventInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"sent_count\x18\x06 \x01(\rR\tsentCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12 \n" +
+	"\flast_sent_at\x18\b \x01(\x03R\n" +
+	"lastSentAt\x12\x1b\n" +
+	"\topened_at\x18\t \x01(\x03R\bopenedAt\x12!\n" +
+	"\fresponded_at\x18\n" +
+	" \x01(\x03R\vrespondedAt\"I\n" +
+	"\x14InviteToEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06emails\x18\x02 \x03(\tR\x06emails\"T\n" +
+	"\x15InviteToEventResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.zenao.v1.EventInvitationR\vinvitations\"8\n" +
+	"\x1bListEventInvitationsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"[\n" +
+	"\x1cListEventInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.zenao.v1.EventInvitationR\vinvitations\"a\n" +
+	"\x1dResendEventInvitationsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0einvitation_ids\x18\x02 \x03(\tR\rinvitationIds\"]\n" +
+	"\x1eResendEventInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.zenao.v1.EventInvitationR\vinvitations\",\n" +
+	"\x14GetInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"`\n" +
+	"\x15GetInvitationResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"0\n" +
+	"\x18DeclineInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19DeclineInvitationResponse\"\xd3\x02\n" +
+	"\fEventSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bspeakers\x18\x05 \x03(\tR\bspeakers\x12\x12\n" +
+	"\x04room\x18\x06 \x01(\tR\x04room\x12\x14\n" +
+	"\x05track\x18\a \x01(\tR\x05track\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\b \x01(\x03R\tstartDate\x12\x19\n" +
+	"\bend_date\x18\t \x01(\x03R\aendDate\x12\x1a\n" +
+	"\bcapacity\x18\n" +
+	" \x01(\rR\bcapacity\x12$\n" +
+	"\rregistrations\x18\v \x01(\rR\rregistrations\x12\x1e\n" +
+	"\n" +
+	"registered\x18\f \x01(\bR\n" +
+	"registered\"h\n" +
+	"\x19CreateEventSessionRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x120\n" +
+	"\asession\x18\x02 \x01(\v2\x16.zenao.v1.EventSessionR\asession\"N\n" +
+	"\x1aCreateEventSessionResponse\x120\n" +
+	"\asession\x18\x01 \x01(\v2\x16.zenao.v1.EventSessionR\asession\"j\n" +
+	"\x17EditEventSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x120\n" +
+	"\asession\x18\x02 \x01(\v2\x16.zenao.v1.EventSessionR\asession\"L\n" +
+	"\x18EditEventSessionResponse\x120\n" +
+	"\asession\x18\x01 \x01(\v2\x16.zenao.v1.EventSessionR\asession\":\n" +
+	"\x19DeleteEventSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x1c\n" +
+	"\x1aDeleteEventSessionResponse\"K\n" +
+	"\x18ListEventSessionsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05track\x18\x02 \x01(\tR\x05track\"g\n" +
+	"\x19ListEventSessionsResponse\x122\n" +
+	"\bsessions\x18\x01 \x03(\v2\x16.zenao.v1.EventSessionR\bsessions\x12\x16\n" +
+	"\x06tracks\x18\x02 \x03(\tR\x06tracks\":\n" +
+	"\x19RegisterForSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"N\n" +
+	"\x1aRegisterForSessionResponse\x120\n" +
+	"\asession\x18\x01 \x01(\v2\x16.zenao.v1.EventSessionR\asession\"=\n" +
+	"\x1cUnregisterFromSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x1f\n" +
+	"\x1dUnregisterFromSessionResponse\"\x16\n" +
 	"\x14GetUserOrdersRequest\"G\n" +
 	"\x15GetUserOrdersResponse\x12.\n" +
 	"\x06orders\x18\x01 \x03(\v2\x16.zenao.v1.OrderSummaryR\x06orders\"S\n" +
@@ -7747,7 +13738,7 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x13GetCommunityRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"M\n" +
 	"\x14GetCommunityResponse\x125\n" +
-	"\tcommunity\x18\x01 \x01(\v2\x17.zenao.v1.CommunityInfoR\tcommunity\"\xef\x01\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x17.zenao.v1.CommunityInfoR\tcommunity\"\x9f\x02\n" +
 	"\rCommunityInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -7757,12 +13748,28 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\n" +
 	"banner_uri\x18\x05 \x01(\tR\tbannerUri\x12&\n" +
 	"\x0eadministrators\x18\x06 \x03(\tR\x0eadministrators\x12#\n" +
-	"\rcount_members\x18\a \x01(\rR\fcountMembers\"F\n" +
+	"\rcount_members\x18\a \x01(\rR\fcountMembers\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\"v\n" +
 	"\x16ListCommunitiesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\rR\x06offset\"T\n" +
+	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"T\n" +
 	"\x17ListCommunitiesResponse\x129\n" +
-	"\vcommunities\x18\x01 \x03(\v2\x17.zenao.v1.CommunityInfoR\vcommunities\"h\n" +
+	"\vcommunities\x18\x01 \x03(\v2\x17.zenao.v1.CommunityInfoR\vcommunities\"H\n" +
+	"\x0fListTagsRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"n\n" +
+	"\x10ListTagsResponse\x122\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x12.zenao.v1.TagCountR\n" +
+	"categories\x12&\n" +
+	"\x04tags\x18\x02 \x03(\v2\x12.zenao.v1.TagCountR\x04tags\"4\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"h\n" +
 	"\x1dListCommunitiesByEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
@@ -7778,7 +13785,7 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x16\n" +
 	"\x06offset\x18\x04 \x01(\rR\x06offset\"_\n" +
 	"\"ListCommunitiesByUserRolesResponse\x129\n" +
-	"\vcommunities\x18\x01 \x03(\v2\x17.zenao.v1.CommunityUserR\vcommunities\"\xc3\x01\n" +
+	"\vcommunities\x18\x01 \x03(\v2\x17.zenao.v1.CommunityUserR\vcommunities\"\xf3\x01\n" +
 	"\x16CreateCommunityRequest\x12!\n" +
 	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
@@ -7786,9 +13793,11 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"avatar_uri\x18\x03 \x01(\tR\tavatarUri\x12\x1d\n" +
 	"\n" +
 	"banner_uri\x18\x04 \x01(\tR\tbannerUri\x12&\n" +
-	"\x0eadministrators\x18\x05 \x03(\tR\x0eadministrators\"<\n" +
+	"\x0eadministrators\x18\x05 \x03(\tR\x0eadministrators\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"<\n" +
 	"\x17CreateCommunityResponse\x12!\n" +
-	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\xe4\x01\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\xb5\x02\n" +
 	"\x14EditCommunityRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -7797,7 +13806,11 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"avatar_uri\x18\x04 \x01(\tR\tavatarUri\x12\x1d\n" +
 	"\n" +
 	"banner_uri\x18\x05 \x01(\tR\tbannerUri\x12&\n" +
-	"\x0eadministrators\x18\x06 \x03(\tR\x0eadministrators\"\x17\n" +
+	"\x0eadministrators\x18\x06 \x03(\tR\x0eadministrators\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vupdate_tags\x18\t \x01(\bR\n" +
+	"updateTags\"\x17\n" +
 	"\x15EditCommunityResponse\"\x8e\x01\n" +
 	"%StartCommunityStripeOnboardingRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x1f\n" +
@@ -7817,7 +13830,61 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x13platform_account_id\x18\x06 \x01(\tR\x11platformAccountId\x12\x1e\n" +
 	"\n" +
 	"currencies\x18\a \x03(\tR\n" +
-	"currencies\"6\n" +
+	"currencies\"\x94\x01\n" +
+	"\x15CommunityLegalDetails\x12\x1d\n" +
+	"\n" +
+	"legal_name\x18\x01 \x01(\tR\tlegalName\x12#\n" +
+	"\rlegal_address\x18\x02 \x01(\tR\flegalAddress\x12\x15\n" +
+	"\x06tax_id\x18\x03 \x01(\tR\x05taxId\x12 \n" +
+	"\ftax_rate_bps\x18\x04 \x01(\rR\n" +
+	"taxRateBps\"D\n" +
+	"\x1fGetCommunityLegalDetailsRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"]\n" +
+	" GetCommunityLegalDetailsResponse\x129\n" +
+	"\adetails\x18\x01 \x01(\v2\x1f.zenao.v1.CommunityLegalDetailsR\adetails\"\x80\x01\n" +
+	" EditCommunityLegalDetailsRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x129\n" +
+	"\adetails\x18\x02 \x01(\v2\x1f.zenao.v1.CommunityLegalDetailsR\adetails\"#\n" +
+	"!EditCommunityLegalDetailsResponse\"g\n" +
+	"\x1eGetCommunitySalesReportRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"\xea\x03\n" +
+	"\x0eSalesReportRow\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vevent_title\x18\x02 \x01(\tR\n" +
+	"eventTitle\x12$\n" +
+	"\x0eprice_group_id\x18\x03 \x01(\tR\fpriceGroupId\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12!\n" +
+	"\ftickets_sold\x18\x05 \x01(\x03R\vticketsSold\x12)\n" +
+	"\x10tickets_refunded\x18\x06 \x01(\x03R\x0fticketsRefunded\x12,\n" +
+	"\x12gross_amount_minor\x18\a \x01(\x03R\x10grossAmountMinor\x122\n" +
+	"\x15refunded_amount_minor\x18\b \x01(\x03R\x13refundedAmountMinor\x12(\n" +
+	"\x10net_amount_minor\x18\t \x01(\x03R\x0enetAmountMinor\x122\n" +
+	"\x15donation_amount_minor\x18\n" +
+	" \x01(\x03R\x13donationAmountMinor\x12C\n" +
+	"\x1erefunded_donation_amount_minor\x18\v \x01(\x03R\x1brefundedDonationAmountMinor\"\x8a\x03\n" +
+	"\x10SalesReportTotal\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12!\n" +
+	"\ftickets_sold\x18\x02 \x01(\x03R\vticketsSold\x12)\n" +
+	"\x10tickets_refunded\x18\x03 \x01(\x03R\x0fticketsRefunded\x12,\n" +
+	"\x12gross_amount_minor\x18\x04 \x01(\x03R\x10grossAmountMinor\x122\n" +
+	"\x15refunded_amount_minor\x18\x05 \x01(\x03R\x13refundedAmountMinor\x12(\n" +
+	"\x10net_amount_minor\x18\x06 \x01(\x03R\x0enetAmountMinor\x122\n" +
+	"\x15donation_amount_minor\x18\a \x01(\x03R\x13donationAmountMinor\x12C\n" +
+	"\x1erefunded_donation_amount_minor\x18\b \x01(\x03R\x1brefundedDonationAmountMinor\"\x83\x01\n" +
+	"\x1fGetCommunitySalesReportResponse\x12,\n" +
+	"\x04rows\x18\x01 \x03(\v2\x18.zenao.v1.SalesReportRowR\x04rows\x122\n" +
+	"\x06totals\x18\x02 \x03(\v2\x1a.zenao.v1.SalesReportTotalR\x06totals\"\x82\x01\n" +
+	"!ExportCommunitySalesReportRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"w\n" +
+	"\"ExportCommunitySalesReportResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\"6\n" +
 	"\x11CreateTeamRequest\x12!\n" +
 	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\"-\n" +
 	"\x12CreateTeamResponse\x12\x17\n" +
@@ -7877,40 +13944,104 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\x1fRemoveEventFromCommunityRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\"\n" +
-	" RemoveEventFromCommunityResponse*\x87\x01\n" +
+	" RemoveEventFromCommunityResponse\"\xf3\x01\n" +
+	"\rEventTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tR\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x122\n" +
+	"\x05event\x18\x06 \x01(\v2\x1c.zenao.v1.CreateEventRequestR\x05event\x12)\n" +
+	"\x10duration_seconds\x18\a \x01(\rR\x0fdurationSeconds\"\xcd\x01\n" +
+	"\x1aCreateEventTemplateRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x122\n" +
+	"\x05event\x18\x04 \x01(\v2\x1c.zenao.v1.CreateEventRequestR\x05event\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\rR\x0fdurationSeconds\"R\n" +
+	"\x1bCreateEventTemplateResponse\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.zenao.v1.EventTemplateR\btemplate\">\n" +
+	"\x19ListEventTemplatesRequest\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"S\n" +
+	"\x1aListEventTemplatesResponse\x125\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x17.zenao.v1.EventTemplateR\ttemplates\"=\n" +
+	"\x1aDeleteEventTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"\x1d\n" +
+	"\x1bDeleteEventTemplateResponse*\x89\x01\n" +
+	"\x14EventSeriesEditScope\x12 \n" +
+	"\x1cEVENT_SERIES_EDIT_SCOPE_THIS\x10\x00\x12.\n" +
+	"*EVENT_SERIES_EDIT_SCOPE_THIS_AND_FOLLOWING\x10\x01\x12\x1f\n" +
+	"\x1bEVENT_SERIES_EDIT_SCOPE_ALL\x10\x02*\x87\x01\n" +
 	"\x12DiscoverableFilter\x12#\n" +
 	"\x1fDISCOVERABLE_FILTER_UNSPECIFIED\x10\x00\x12$\n" +
 	" DISCOVERABLE_FILTER_DISCOVERABLE\x10\x01\x12&\n" +
-	"\"DISCOVERABLE_FILTER_UNDISCOVERABLE\x10\x022\xd8$\n" +
+	"\"DISCOVERABLE_FILTER_UNDISCOVERABLE\x10\x022\xe5?\n" +
 	"\fZenaoService\x12A\n" +
 	"\bEditUser\x12\x19.zenao.v1.EditUserRequest\x1a\x1a.zenao.v1.EditUserResponse\x12J\n" +
-	"\vGetUserInfo\x12\x1c.zenao.v1.GetUserInfoRequest\x1a\x1d.zenao.v1.GetUserInfoResponse\x12J\n" +
+	"\vGetUserInfo\x12\x1c.zenao.v1.GetUserInfoRequest\x1a\x1d.zenao.v1.GetUserInfoResponse\x12b\n" +
+	"\x13GetUserCalendarFeed\x12$.zenao.v1.GetUserCalendarFeedRequest\x1a%.zenao.v1.GetUserCalendarFeedResponse\x12J\n" +
 	"\vCreateEvent\x12\x1c.zenao.v1.CreateEventRequest\x1a\x1d.zenao.v1.CreateEventResponse\x12J\n" +
 	"\vCancelEvent\x12\x1c.zenao.v1.CancelEventRequest\x1a\x1d.zenao.v1.CancelEventResponse\x12D\n" +
-	"\tEditEvent\x12\x1a.zenao.v1.EditEventRequest\x1a\x1b.zenao.v1.EditEventResponse\x12b\n" +
+	"\tEditEvent\x12\x1a.zenao.v1.EditEventRequest\x1a\x1b.zenao.v1.EditEventResponse\x12S\n" +
+	"\x0eDuplicateEvent\x12\x1f.zenao.v1.DuplicateEventRequest\x1a .zenao.v1.DuplicateEventResponse\x12M\n" +
+	"\fPublishEvent\x12\x1d.zenao.v1.PublishEventRequest\x1a\x1e.zenao.v1.PublishEventResponse\x12b\n" +
 	"\x13GetEventGatekeepers\x12$.zenao.v1.GetEventGatekeepersRequest\x1a%.zenao.v1.GetEventGatekeepersResponse\x12Y\n" +
 	"\x10ValidatePassword\x12!.zenao.v1.ValidatePasswordRequest\x1a\".zenao.v1.ValidatePasswordResponse\x12S\n" +
 	"\x0eBroadcastEvent\x12\x1f.zenao.v1.BroadcastEventRequest\x1a .zenao.v1.BroadcastEventResponse\x12J\n" +
 	"\vParticipate\x12\x1c.zenao.v1.ParticipateRequest\x1a\x1d.zenao.v1.ParticipateResponse\x12_\n" +
 	"\x12StartTicketPayment\x12#.zenao.v1.StartTicketPaymentRequest\x1a$.zenao.v1.StartTicketPaymentResponse\x12e\n" +
 	"\x14ConfirmTicketPayment\x12%.zenao.v1.ConfirmTicketPaymentRequest\x1a&.zenao.v1.ConfirmTicketPaymentResponse\x12b\n" +
-	"\x13CancelParticipation\x12$.zenao.v1.CancelParticipationRequest\x1a%.zenao.v1.CancelParticipationResponse\x12V\n" +
+	"\x13CancelParticipation\x12$.zenao.v1.CancelParticipationRequest\x1a%.zenao.v1.CancelParticipationResponse\x12S\n" +
+	"\x0eTransferTicket\x12\x1f.zenao.v1.TransferTicketRequest\x1a .zenao.v1.TransferTicketResponse\x12V\n" +
 	"\x0fGetEventTickets\x12 .zenao.v1.GetEventTicketsRequest\x1a!.zenao.v1.GetEventTicketsResponse\x12P\n" +
 	"\rGetUserOrders\x12\x1e.zenao.v1.GetUserOrdersRequest\x1a\x1f.zenao.v1.GetUserOrdersResponse\x12V\n" +
-	"\x0fGetOrderDetails\x12 .zenao.v1.GetOrderDetailsRequest\x1a!.zenao.v1.GetOrderDetailsResponse\x12>\n" +
+	"\x0fGetOrderDetails\x12 .zenao.v1.GetOrderDetailsRequest\x1a!.zenao.v1.GetOrderDetailsResponse\x12V\n" +
+	"\x0fGetOrderInvoice\x12 .zenao.v1.GetOrderInvoiceRequest\x1a!.zenao.v1.GetOrderInvoiceResponse\x12J\n" +
+	"\vRefundOrder\x12\x1c.zenao.v1.RefundOrderRequest\x1a\x1d.zenao.v1.RefundOrderResponse\x12V\n" +
+	"\x0fCreatePromoCode\x12 .zenao.v1.CreatePromoCodeRequest\x1a!.zenao.v1.CreatePromoCodeResponse\x12S\n" +
+	"\x0eListPromoCodes\x12\x1f.zenao.v1.ListPromoCodesRequest\x1a .zenao.v1.ListPromoCodesResponse\x12V\n" +
+	"\x0fDeletePromoCode\x12 .zenao.v1.DeletePromoCodeRequest\x1a!.zenao.v1.DeletePromoCodeResponse\x12M\n" +
+	"\fJoinWaitlist\x12\x1d.zenao.v1.JoinWaitlistRequest\x1a\x1e.zenao.v1.JoinWaitlistResponse\x12P\n" +
+	"\rLeaveWaitlist\x12\x1e.zenao.v1.LeaveWaitlistRequest\x1a\x1f.zenao.v1.LeaveWaitlistResponse\x12Y\n" +
+	"\x10GetEventWaitlist\x12!.zenao.v1.GetEventWaitlistRequest\x1a\".zenao.v1.GetEventWaitlistResponse\x12V\n" +
+	"\x0fReorderWaitlist\x12 .zenao.v1.ReorderWaitlistRequest\x1a!.zenao.v1.ReorderWaitlistResponse\x12h\n" +
+	"\x15ListEventApplications\x12&.zenao.v1.ListEventApplicationsRequest\x1a'.zenao.v1.ListEventApplicationsResponse\x12n\n" +
+	"\x17ApproveEventApplication\x12(.zenao.v1.ApproveEventApplicationRequest\x1a).zenao.v1.ApproveEventApplicationResponse\x12k\n" +
+	"\x16RejectEventApplication\x12'.zenao.v1.RejectEventApplicationRequest\x1a(.zenao.v1.RejectEventApplicationResponse\x12P\n" +
+	"\rInviteToEvent\x12\x1e.zenao.v1.InviteToEventRequest\x1a\x1f.zenao.v1.InviteToEventResponse\x12e\n" +
+	"\x14ListEventInvitations\x12%.zenao.v1.ListEventInvitationsRequest\x1a&.zenao.v1.ListEventInvitationsResponse\x12k\n" +
+	"\x16ResendEventInvitations\x12'.zenao.v1.ResendEventInvitationsRequest\x1a(.zenao.v1.ResendEventInvitationsResponse\x12P\n" +
+	"\rGetInvitation\x12\x1e.zenao.v1.GetInvitationRequest\x1a\x1f.zenao.v1.GetInvitationResponse\x12\\\n" +
+	"\x11DeclineInvitation\x12\".zenao.v1.DeclineInvitationRequest\x1a#.zenao.v1.DeclineInvitationResponse\x12_\n" +
+	"\x12CreateEventSession\x12#.zenao.v1.CreateEventSessionRequest\x1a$.zenao.v1.CreateEventSessionResponse\x12Y\n" +
+	"\x10EditEventSession\x12!.zenao.v1.EditEventSessionRequest\x1a\".zenao.v1.EditEventSessionResponse\x12_\n" +
+	"\x12DeleteEventSession\x12#.zenao.v1.DeleteEventSessionRequest\x1a$.zenao.v1.DeleteEventSessionResponse\x12\\\n" +
+	"\x11ListEventSessions\x12\".zenao.v1.ListEventSessionsRequest\x1a#.zenao.v1.ListEventSessionsResponse\x12_\n" +
+	"\x12RegisterForSession\x12#.zenao.v1.RegisterForSessionRequest\x1a$.zenao.v1.RegisterForSessionResponse\x12h\n" +
+	"\x15UnregisterFromSession\x12&.zenao.v1.UnregisterFromSessionRequest\x1a'.zenao.v1.UnregisterFromSessionResponse\x12>\n" +
 	"\aCheckin\x12\x18.zenao.v1.CheckinRequest\x1a\x19.zenao.v1.CheckinResponse\x12_\n" +
 	"\x12ExportParticipants\x12#.zenao.v1.ExportParticipantsRequest\x1a$.zenao.v1.ExportParticipantsResponse\x12\\\n" +
 	"\x11RemoveParticipant\x12\".zenao.v1.RemoveParticipantRequest\x1a#.zenao.v1.RemoveParticipantResponse\x12V\n" +
 	"\x0fCreateCommunity\x12 .zenao.v1.CreateCommunityRequest\x1a!.zenao.v1.CreateCommunityResponse\x12P\n" +
 	"\rEditCommunity\x12\x1e.zenao.v1.EditCommunityRequest\x1a\x1f.zenao.v1.EditCommunityResponse\x12\x83\x01\n" +
 	"\x1eStartCommunityStripeOnboarding\x12/.zenao.v1.StartCommunityStripeOnboardingRequest\x1a0.zenao.v1.StartCommunityStripeOnboardingResponse\x12q\n" +
-	"\x18GetCommunityPayoutStatus\x12).zenao.v1.GetCommunityPayoutStatusRequest\x1a*.zenao.v1.GetCommunityPayoutStatusResponse\x12w\n" +
+	"\x18GetCommunityPayoutStatus\x12).zenao.v1.GetCommunityPayoutStatusRequest\x1a*.zenao.v1.GetCommunityPayoutStatusResponse\x12q\n" +
+	"\x18GetCommunityLegalDetails\x12).zenao.v1.GetCommunityLegalDetailsRequest\x1a*.zenao.v1.GetCommunityLegalDetailsResponse\x12t\n" +
+	"\x19EditCommunityLegalDetails\x12*.zenao.v1.EditCommunityLegalDetailsRequest\x1a+.zenao.v1.EditCommunityLegalDetailsResponse\x12n\n" +
+	"\x17GetCommunitySalesReport\x12(.zenao.v1.GetCommunitySalesReportRequest\x1a).zenao.v1.GetCommunitySalesReportResponse\x12w\n" +
+	"\x1aExportCommunitySalesReport\x12+.zenao.v1.ExportCommunitySalesReportRequest\x1a,.zenao.v1.ExportCommunitySalesReportResponse\x12w\n" +
 	"\x1aGetCommunityAdministrators\x12+.zenao.v1.GetCommunityAdministratorsRequest\x1a,.zenao.v1.GetCommunityAdministratorsResponse\x12P\n" +
 	"\rJoinCommunity\x12\x1e.zenao.v1.JoinCommunityRequest\x1a\x1f.zenao.v1.JoinCommunityResponse\x12S\n" +
 	"\x0eLeaveCommunity\x12\x1f.zenao.v1.LeaveCommunityRequest\x1a .zenao.v1.LeaveCommunityResponse\x12h\n" +
 	"\x15RemoveCommunityMember\x12&.zenao.v1.RemoveCommunityMemberRequest\x1a'.zenao.v1.RemoveCommunityMemberResponse\x12b\n" +
 	"\x13AddEventToCommunity\x12$.zenao.v1.AddEventToCommunityRequest\x1a%.zenao.v1.AddEventToCommunityResponse\x12q\n" +
-	"\x18RemoveEventFromCommunity\x12).zenao.v1.RemoveEventFromCommunityRequest\x1a*.zenao.v1.RemoveEventFromCommunityResponse\x12G\n" +
+	"\x18RemoveEventFromCommunity\x12).zenao.v1.RemoveEventFromCommunityRequest\x1a*.zenao.v1.RemoveEventFromCommunityResponse\x12b\n" +
+	"\x13CreateEventTemplate\x12$.zenao.v1.CreateEventTemplateRequest\x1a%.zenao.v1.CreateEventTemplateResponse\x12_\n" +
+	"\x12ListEventTemplates\x12#.zenao.v1.ListEventTemplatesRequest\x1a$.zenao.v1.ListEventTemplatesResponse\x12b\n" +
+	"\x13DeleteEventTemplate\x12$.zenao.v1.DeleteEventTemplateRequest\x1a%.zenao.v1.DeleteEventTemplateResponse\x12G\n" +
 	"\n" +
 	"CreateTeam\x12\x1b.zenao.v1.CreateTeamRequest\x1a\x1c.zenao.v1.CreateTeamResponse\x12A\n" +
 	"\bEditTeam\x12\x19.zenao.v1.EditTeamRequest\x1a\x1a.zenao.v1.EditTeamResponse\x12G\n" +
@@ -7921,13 +14052,16 @@ const file_zenao_v1_zenao_proto_rawDesc = "" +
 	"\vEntityRoles\x12\x1c.zenao.v1.EntityRolesRequest\x1a\x1d.zenao.v1.EntityRolesResponse\x12\\\n" +
 	"\x11EntitiesWithRoles\x12\".zenao.v1.EntitiesWithRolesRequest\x1a#.zenao.v1.EntitiesWithRolesResponse\x12M\n" +
 	"\fGetCommunity\x12\x1d.zenao.v1.GetCommunityRequest\x1a\x1e.zenao.v1.GetCommunityResponse\x12V\n" +
-	"\x0fListCommunities\x12 .zenao.v1.ListCommunitiesRequest\x1a!.zenao.v1.ListCommunitiesResponse\x12k\n" +
+	"\x0fListCommunities\x12 .zenao.v1.ListCommunitiesRequest\x1a!.zenao.v1.ListCommunitiesResponse\x12A\n" +
+	"\bListTags\x12\x19.zenao.v1.ListTagsRequest\x1a\x1a.zenao.v1.ListTagsResponse\x12k\n" +
 	"\x16ListCommunitiesByEvent\x12'.zenao.v1.ListCommunitiesByEventRequest\x1a(.zenao.v1.ListCommunitiesByEventResponse\x12w\n" +
 	"\x1aListCommunitiesByUserRoles\x12+.zenao.v1.ListCommunitiesByUserRolesRequest\x1a,.zenao.v1.ListCommunitiesByUserRolesResponse\x12A\n" +
-	"\bGetEvent\x12\x19.zenao.v1.GetEventRequest\x1a\x1a.zenao.v1.GetEventResponse\x12G\n" +
+	"\bGetEvent\x12\x19.zenao.v1.GetEventRequest\x1a\x1a.zenao.v1.GetEventResponse\x12S\n" +
+	"\x0eGetEventSeries\x12\x1f.zenao.v1.GetEventSeriesRequest\x1a .zenao.v1.GetEventSeriesResponse\x12G\n" +
 	"\n" +
 	"ListEvents\x12\x1b.zenao.v1.ListEventsRequest\x1a\x1c.zenao.v1.ListEventsResponse\x12h\n" +
-	"\x15ListEventsByUserRoles\x12&.zenao.v1.ListEventsByUserRolesRequest\x1a'.zenao.v1.ListEventsByUserRolesResponse\x12>\n" +
+	"\x15ListEventsByUserRoles\x12&.zenao.v1.ListEventsByUserRolesRequest\x1a'.zenao.v1.ListEventsByUserRolesResponse\x12;\n" +
+	"\x06Search\x12\x17.zenao.v1.SearchRequest\x1a\x18.zenao.v1.SearchResponse\x12>\n" +
 	"\aGetPost\x12\x18.zenao.v1.GetPostRequest\x1a\x19.zenao.v1.GetPostResponse\x12M\n" +
 	"\fGetFeedPosts\x12\x1d.zenao.v1.GetFeedPostsRequest\x1a\x1e.zenao.v1.GetFeedPostsResponse\x12Y\n" +
 	"\x10GetChildrenPosts\x12!.zenao.v1.GetChildrenPostsRequest\x1a\".zenao.v1.GetChildrenPostsResponse\x12>\n" +
@@ -7957,309 +14091,516 @@ func file_zenao_v1_zenao_proto_rawDescGZIP() []byte {
 	return file_zenao_v1_zenao_proto_rawDescData
 }
 
-var file_zenao_v1_zenao_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_zenao_v1_zenao_proto_msgTypes = make([]protoimpl.MessageInfo, 135)
+var file_zenao_v1_zenao_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_zenao_v1_zenao_proto_msgTypes = make([]protoimpl.MessageInfo, 225)
 var file_zenao_v1_zenao_proto_goTypes = []any{
-	(DiscoverableFilter)(0),                        // 0: zenao.v1.DiscoverableFilter
-	(*HealthRequest)(nil),                          // 1: zenao.v1.HealthRequest
-	(*HealthResponse)(nil),                         // 2: zenao.v1.HealthResponse
-	(*EditUserRequest)(nil),                        // 3: zenao.v1.EditUserRequest
-	(*EditUserResponse)(nil),                       // 4: zenao.v1.EditUserResponse
-	(*GetUserInfoRequest)(nil),                     // 5: zenao.v1.GetUserInfoRequest
-	(*GetUserInfoResponse)(nil),                    // 6: zenao.v1.GetUserInfoResponse
-	(*Profile)(nil),                                // 7: zenao.v1.Profile
-	(*GetUsersProfileRequest)(nil),                 // 8: zenao.v1.GetUsersProfileRequest
-	(*GetUsersProfileResponse)(nil),                // 9: zenao.v1.GetUsersProfileResponse
-	(*GetEventRequest)(nil),                        // 10: zenao.v1.GetEventRequest
-	(*GetEventResponse)(nil),                       // 11: zenao.v1.GetEventResponse
-	(*ListEventsRequest)(nil),                      // 12: zenao.v1.ListEventsRequest
-	(*LocationFilter)(nil),                         // 13: zenao.v1.LocationFilter
-	(*ListEventsResponse)(nil),                     // 14: zenao.v1.ListEventsResponse
-	(*EventUser)(nil),                              // 15: zenao.v1.EventUser
-	(*ListEventsByUserRolesRequest)(nil),           // 16: zenao.v1.ListEventsByUserRolesRequest
-	(*ListEventsByUserRolesResponse)(nil),          // 17: zenao.v1.ListEventsByUserRolesResponse
-	(*CreateEventRequest)(nil),                     // 18: zenao.v1.CreateEventRequest
-	(*CreateEventResponse)(nil),                    // 19: zenao.v1.CreateEventResponse
-	(*CancelEventRequest)(nil),                     // 20: zenao.v1.CancelEventRequest
-	(*CancelEventResponse)(nil),                    // 21: zenao.v1.CancelEventResponse
-	(*EditEventRequest)(nil),                       // 22: zenao.v1.EditEventRequest
-	(*EditEventResponse)(nil),                      // 23: zenao.v1.EditEventResponse
-	(*GetEventGatekeepersRequest)(nil),             // 24: zenao.v1.GetEventGatekeepersRequest
-	(*GetEventGatekeepersResponse)(nil),            // 25: zenao.v1.GetEventGatekeepersResponse
-	(*ValidatePasswordRequest)(nil),                // 26: zenao.v1.ValidatePasswordRequest
-	(*ValidatePasswordResponse)(nil),               // 27: zenao.v1.ValidatePasswordResponse
-	(*ParticipateRequest)(nil),                     // 28: zenao.v1.ParticipateRequest
-	(*CancelParticipationRequest)(nil),             // 29: zenao.v1.CancelParticipationRequest
-	(*CancelParticipationResponse)(nil),            // 30: zenao.v1.CancelParticipationResponse
-	(*RemoveParticipantRequest)(nil),               // 31: zenao.v1.RemoveParticipantRequest
-	(*RemoveParticipantResponse)(nil),              // 32: zenao.v1.RemoveParticipantResponse
-	(*ParticipateResponse)(nil),                    // 33: zenao.v1.ParticipateResponse
-	(*StartTicketPaymentLineItem)(nil),             // 34: zenao.v1.StartTicketPaymentLineItem
-	(*StartTicketPaymentRequest)(nil),              // 35: zenao.v1.StartTicketPaymentRequest
-	(*StartTicketPaymentResponse)(nil),             // 36: zenao.v1.StartTicketPaymentResponse
-	(*ConfirmTicketPaymentRequest)(nil),            // 37: zenao.v1.ConfirmTicketPaymentRequest
-	(*ConfirmTicketPaymentResponse)(nil),           // 38: zenao.v1.ConfirmTicketPaymentResponse
-	(*BroadcastEventRequest)(nil),                  // 39: zenao.v1.BroadcastEventRequest
-	(*BroadcastEventResponse)(nil),                 // 40: zenao.v1.BroadcastEventResponse
-	(*EventLocation)(nil),                          // 41: zenao.v1.EventLocation
-	(*AddressVirtual)(nil),                         // 42: zenao.v1.AddressVirtual
-	(*AddressGeo)(nil),                             // 43: zenao.v1.AddressGeo
-	(*AddressCustom)(nil),                          // 44: zenao.v1.AddressCustom
-	(*EventPrivacy)(nil),                           // 45: zenao.v1.EventPrivacy
-	(*EventPrivacyPublic)(nil),                     // 46: zenao.v1.EventPrivacyPublic
-	(*EventPrivacyGuarded)(nil),                    // 47: zenao.v1.EventPrivacyGuarded
-	(*EventInfo)(nil),                              // 48: zenao.v1.EventInfo
-	(*EventPriceGroup)(nil),                        // 49: zenao.v1.EventPriceGroup
-	(*EventPrice)(nil),                             // 50: zenao.v1.EventPrice
-	(*BatchProfileField)(nil),                      // 51: zenao.v1.BatchProfileField
-	(*BatchProfileRequest)(nil),                    // 52: zenao.v1.BatchProfileRequest
-	(*CreatePollRequest)(nil),                      // 53: zenao.v1.CreatePollRequest
-	(*CreatePollResponse)(nil),                     // 54: zenao.v1.CreatePollResponse
-	(*GetPollRequest)(nil),                         // 55: zenao.v1.GetPollRequest
-	(*GetPollResponse)(nil),                        // 56: zenao.v1.GetPollResponse
-	(*VotePollRequest)(nil),                        // 57: zenao.v1.VotePollRequest
-	(*VotePollResponse)(nil),                       // 58: zenao.v1.VotePollResponse
-	(*CreatePostRequest)(nil),                      // 59: zenao.v1.CreatePostRequest
-	(*CreatePostResponse)(nil),                     // 60: zenao.v1.CreatePostResponse
-	(*GetPostRequest)(nil),                         // 61: zenao.v1.GetPostRequest
-	(*GetPostResponse)(nil),                        // 62: zenao.v1.GetPostResponse
-	(*GetFeedPostsRequest)(nil),                    // 63: zenao.v1.GetFeedPostsRequest
-	(*GetFeedPostsResponse)(nil),                   // 64: zenao.v1.GetFeedPostsResponse
-	(*GetChildrenPostsRequest)(nil),                // 65: zenao.v1.GetChildrenPostsRequest
-	(*GetChildrenPostsResponse)(nil),               // 66: zenao.v1.GetChildrenPostsResponse
-	(*DeletePostRequest)(nil),                      // 67: zenao.v1.DeletePostRequest
-	(*DeletePostResponse)(nil),                     // 68: zenao.v1.DeletePostResponse
-	(*ReactPostRequest)(nil),                       // 69: zenao.v1.ReactPostRequest
-	(*ReactPostResponse)(nil),                      // 70: zenao.v1.ReactPostResponse
-	(*PinPostRequest)(nil),                         // 71: zenao.v1.PinPostRequest
-	(*PinPostResponse)(nil),                        // 72: zenao.v1.PinPostResponse
-	(*EditPostRequest)(nil),                        // 73: zenao.v1.EditPostRequest
-	(*EditPostResponse)(nil),                       // 74: zenao.v1.EditPostResponse
-	(*GetEventTicketsRequest)(nil),                 // 75: zenao.v1.GetEventTicketsRequest
-	(*GetEventTicketsResponse)(nil),                // 76: zenao.v1.GetEventTicketsResponse
-	(*TicketInfo)(nil),                             // 77: zenao.v1.TicketInfo
-	(*GetOrderDetailsRequest)(nil),                 // 78: zenao.v1.GetOrderDetailsRequest
-	(*OrderSummary)(nil),                           // 79: zenao.v1.OrderSummary
-	(*OrderTicketInfo)(nil),                        // 80: zenao.v1.OrderTicketInfo
-	(*GetOrderDetailsResponse)(nil),                // 81: zenao.v1.GetOrderDetailsResponse
-	(*GetUserOrdersRequest)(nil),                   // 82: zenao.v1.GetUserOrdersRequest
-	(*GetUserOrdersResponse)(nil),                  // 83: zenao.v1.GetUserOrdersResponse
-	(*CheckinRequest)(nil),                         // 84: zenao.v1.CheckinRequest
-	(*CheckinResponse)(nil),                        // 85: zenao.v1.CheckinResponse
-	(*ExportParticipantsRequest)(nil),              // 86: zenao.v1.ExportParticipantsRequest
-	(*ExportParticipantsResponse)(nil),             // 87: zenao.v1.ExportParticipantsResponse
-	(*Entity)(nil),                                 // 88: zenao.v1.Entity
-	(*EntityRolesRequest)(nil),                     // 89: zenao.v1.EntityRolesRequest
-	(*EntityRolesResponse)(nil),                    // 90: zenao.v1.EntityRolesResponse
-	(*EntitiesWithRolesRequest)(nil),               // 91: zenao.v1.EntitiesWithRolesRequest
-	(*EntityWithRoles)(nil),                        // 92: zenao.v1.EntityWithRoles
-	(*EntitiesWithRolesResponse)(nil),              // 93: zenao.v1.EntitiesWithRolesResponse
-	(*GetCommunityRequest)(nil),                    // 94: zenao.v1.GetCommunityRequest
-	(*GetCommunityResponse)(nil),                   // 95: zenao.v1.GetCommunityResponse
-	(*CommunityInfo)(nil),                          // 96: zenao.v1.CommunityInfo
-	(*ListCommunitiesRequest)(nil),                 // 97: zenao.v1.ListCommunitiesRequest
-	(*ListCommunitiesResponse)(nil),                // 98: zenao.v1.ListCommunitiesResponse
-	(*ListCommunitiesByEventRequest)(nil),          // 99: zenao.v1.ListCommunitiesByEventRequest
-	(*ListCommunitiesByEventResponse)(nil),         // 100: zenao.v1.ListCommunitiesByEventResponse
-	(*CommunityUser)(nil),                          // 101: zenao.v1.CommunityUser
-	(*ListCommunitiesByUserRolesRequest)(nil),      // 102: zenao.v1.ListCommunitiesByUserRolesRequest
-	(*ListCommunitiesByUserRolesResponse)(nil),     // 103: zenao.v1.ListCommunitiesByUserRolesResponse
-	(*CreateCommunityRequest)(nil),                 // 104: zenao.v1.CreateCommunityRequest
-	(*CreateCommunityResponse)(nil),                // 105: zenao.v1.CreateCommunityResponse
-	(*EditCommunityRequest)(nil),                   // 106: zenao.v1.EditCommunityRequest
-	(*EditCommunityResponse)(nil),                  // 107: zenao.v1.EditCommunityResponse
-	(*StartCommunityStripeOnboardingRequest)(nil),  // 108: zenao.v1.StartCommunityStripeOnboardingRequest
-	(*StartCommunityStripeOnboardingResponse)(nil), // 109: zenao.v1.StartCommunityStripeOnboardingResponse
-	(*GetCommunityPayoutStatusRequest)(nil),        // 110: zenao.v1.GetCommunityPayoutStatusRequest
-	(*GetCommunityPayoutStatusResponse)(nil),       // 111: zenao.v1.GetCommunityPayoutStatusResponse
-	(*CreateTeamRequest)(nil),                      // 112: zenao.v1.CreateTeamRequest
-	(*CreateTeamResponse)(nil),                     // 113: zenao.v1.CreateTeamResponse
-	(*EditTeamRequest)(nil),                        // 114: zenao.v1.EditTeamRequest
-	(*EditTeamResponse)(nil),                       // 115: zenao.v1.EditTeamResponse
-	(*DeleteTeamRequest)(nil),                      // 116: zenao.v1.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),                     // 117: zenao.v1.DeleteTeamResponse
-	(*GetUserTeamsRequest)(nil),                    // 118: zenao.v1.GetUserTeamsRequest
-	(*GetUserTeamsResponse)(nil),                   // 119: zenao.v1.GetUserTeamsResponse
-	(*UserTeam)(nil),                               // 120: zenao.v1.UserTeam
-	(*GetTeamMembersRequest)(nil),                  // 121: zenao.v1.GetTeamMembersRequest
-	(*GetTeamMembersResponse)(nil),                 // 122: zenao.v1.GetTeamMembersResponse
-	(*TeamMember)(nil),                             // 123: zenao.v1.TeamMember
-	(*GetCommunityAdministratorsRequest)(nil),      // 124: zenao.v1.GetCommunityAdministratorsRequest
-	(*GetCommunityAdministratorsResponse)(nil),     // 125: zenao.v1.GetCommunityAdministratorsResponse
-	(*JoinCommunityRequest)(nil),                   // 126: zenao.v1.JoinCommunityRequest
-	(*JoinCommunityResponse)(nil),                  // 127: zenao.v1.JoinCommunityResponse
-	(*LeaveCommunityRequest)(nil),                  // 128: zenao.v1.LeaveCommunityRequest
-	(*LeaveCommunityResponse)(nil),                 // 129: zenao.v1.LeaveCommunityResponse
-	(*RemoveCommunityMemberRequest)(nil),           // 130: zenao.v1.RemoveCommunityMemberRequest
-	(*RemoveCommunityMemberResponse)(nil),          // 131: zenao.v1.RemoveCommunityMemberResponse
-	(*AddEventToCommunityRequest)(nil),             // 132: zenao.v1.AddEventToCommunityRequest
-	(*AddEventToCommunityResponse)(nil),            // 133: zenao.v1.AddEventToCommunityResponse
-	(*RemoveEventFromCommunityRequest)(nil),        // 134: zenao.v1.RemoveEventFromCommunityRequest
-	(*RemoveEventFromCommunityResponse)(nil),       // 135: zenao.v1.RemoveEventFromCommunityResponse
-	(v1.PollKind)(0),                               // 136: polls.v1.PollKind
-	(*v1.Poll)(nil),                                // 137: polls.v1.Poll
-	(*v11.PostView)(nil),                           // 138: feeds.v1.PostView
+	(EventSeriesEditScope)(0),                      // 0: zenao.v1.EventSeriesEditScope
+	(DiscoverableFilter)(0),                        // 1: zenao.v1.DiscoverableFilter
+	(*HealthRequest)(nil),                          // 2: zenao.v1.HealthRequest
+	(*HealthResponse)(nil),                         // 3: zenao.v1.HealthResponse
+	(*EditUserRequest)(nil),                        // 4: zenao.v1.EditUserRequest
+	(*EditUserResponse)(nil),                       // 5: zenao.v1.EditUserResponse
+	(*GetUserInfoRequest)(nil),                     // 6: zenao.v1.GetUserInfoRequest
+	(*GetUserInfoResponse)(nil),                    // 7: zenao.v1.GetUserInfoResponse
+	(*GetUserCalendarFeedRequest)(nil),             // 8: zenao.v1.GetUserCalendarFeedRequest
+	(*GetUserCalendarFeedResponse)(nil),            // 9: zenao.v1.GetUserCalendarFeedResponse
+	(*Profile)(nil),                                // 10: zenao.v1.Profile
+	(*GetUsersProfileRequest)(nil),                 // 11: zenao.v1.GetUsersProfileRequest
+	(*GetUsersProfileResponse)(nil),                // 12: zenao.v1.GetUsersProfileResponse
+	(*GetEventRequest)(nil),                        // 13: zenao.v1.GetEventRequest
+	(*GetEventResponse)(nil),                       // 14: zenao.v1.GetEventResponse
+	(*ListEventsRequest)(nil),                      // 15: zenao.v1.ListEventsRequest
+	(*LocationFilter)(nil),                         // 16: zenao.v1.LocationFilter
+	(*ListEventsResponse)(nil),                     // 17: zenao.v1.ListEventsResponse
+	(*SearchRequest)(nil),                          // 18: zenao.v1.SearchRequest
+	(*SearchResponse)(nil),                         // 19: zenao.v1.SearchResponse
+	(*EventSearchResult)(nil),                      // 20: zenao.v1.EventSearchResult
+	(*CommunitySearchResult)(nil),                  // 21: zenao.v1.CommunitySearchResult
+	(*EventUser)(nil),                              // 22: zenao.v1.EventUser
+	(*ListEventsByUserRolesRequest)(nil),           // 23: zenao.v1.ListEventsByUserRolesRequest
+	(*ListEventsByUserRolesResponse)(nil),          // 24: zenao.v1.ListEventsByUserRolesResponse
+	(*CreateEventRequest)(nil),                     // 25: zenao.v1.CreateEventRequest
+	(*CreateEventResponse)(nil),                    // 26: zenao.v1.CreateEventResponse
+	(*CancelEventRequest)(nil),                     // 27: zenao.v1.CancelEventRequest
+	(*CancelEventResponse)(nil),                    // 28: zenao.v1.CancelEventResponse
+	(*EditEventRequest)(nil),                       // 29: zenao.v1.EditEventRequest
+	(*EditEventResponse)(nil),                      // 30: zenao.v1.EditEventResponse
+	(*DuplicateEventRequest)(nil),                  // 31: zenao.v1.DuplicateEventRequest
+	(*DuplicateEventResponse)(nil),                 // 32: zenao.v1.DuplicateEventResponse
+	(*PublishEventRequest)(nil),                    // 33: zenao.v1.PublishEventRequest
+	(*PublishEventResponse)(nil),                   // 34: zenao.v1.PublishEventResponse
+	(*GetEventSeriesRequest)(nil),                  // 35: zenao.v1.GetEventSeriesRequest
+	(*GetEventSeriesResponse)(nil),                 // 36: zenao.v1.GetEventSeriesResponse
+	(*GetEventGatekeepersRequest)(nil),             // 37: zenao.v1.GetEventGatekeepersRequest
+	(*GetEventGatekeepersResponse)(nil),            // 38: zenao.v1.GetEventGatekeepersResponse
+	(*ValidatePasswordRequest)(nil),                // 39: zenao.v1.ValidatePasswordRequest
+	(*ValidatePasswordResponse)(nil),               // 40: zenao.v1.ValidatePasswordResponse
+	(*ParticipateRequest)(nil),                     // 41: zenao.v1.ParticipateRequest
+	(*CancelParticipationRequest)(nil),             // 42: zenao.v1.CancelParticipationRequest
+	(*CancelParticipationResponse)(nil),            // 43: zenao.v1.CancelParticipationResponse
+	(*TransferTicketRequest)(nil),                  // 44: zenao.v1.TransferTicketRequest
+	(*TransferTicketResponse)(nil),                 // 45: zenao.v1.TransferTicketResponse
+	(*RemoveParticipantRequest)(nil),               // 46: zenao.v1.RemoveParticipantRequest
+	(*RemoveParticipantResponse)(nil),              // 47: zenao.v1.RemoveParticipantResponse
+	(*ParticipateResponse)(nil),                    // 48: zenao.v1.ParticipateResponse
+	(*StartTicketPaymentLineItem)(nil),             // 49: zenao.v1.StartTicketPaymentLineItem
+	(*StartTicketPaymentRequest)(nil),              // 50: zenao.v1.StartTicketPaymentRequest
+	(*StartTicketPaymentResponse)(nil),             // 51: zenao.v1.StartTicketPaymentResponse
+	(*ConfirmTicketPaymentRequest)(nil),            // 52: zenao.v1.ConfirmTicketPaymentRequest
+	(*ConfirmTicketPaymentResponse)(nil),           // 53: zenao.v1.ConfirmTicketPaymentResponse
+	(*BroadcastEventRequest)(nil),                  // 54: zenao.v1.BroadcastEventRequest
+	(*BroadcastEventResponse)(nil),                 // 55: zenao.v1.BroadcastEventResponse
+	(*EventLocation)(nil),                          // 56: zenao.v1.EventLocation
+	(*AddressVirtual)(nil),                         // 57: zenao.v1.AddressVirtual
+	(*AddressGeo)(nil),                             // 58: zenao.v1.AddressGeo
+	(*AddressCustom)(nil),                          // 59: zenao.v1.AddressCustom
+	(*EventPrivacy)(nil),                           // 60: zenao.v1.EventPrivacy
+	(*EventPrivacyPublic)(nil),                     // 61: zenao.v1.EventPrivacyPublic
+	(*EventPrivacyGuarded)(nil),                    // 62: zenao.v1.EventPrivacyGuarded
+	(*EventPrivacyInviteOnly)(nil),                 // 63: zenao.v1.EventPrivacyInviteOnly
+	(*EventInfo)(nil),                              // 64: zenao.v1.EventInfo
+	(*RegistrationQuestion)(nil),                   // 65: zenao.v1.RegistrationQuestion
+	(*RegistrationAnswer)(nil),                     // 66: zenao.v1.RegistrationAnswer
+	(*AttendeeRegistrationAnswers)(nil),            // 67: zenao.v1.AttendeeRegistrationAnswers
+	(*EventPriceGroup)(nil),                        // 68: zenao.v1.EventPriceGroup
+	(*EventPrice)(nil),                             // 69: zenao.v1.EventPrice
+	(*BatchProfileField)(nil),                      // 70: zenao.v1.BatchProfileField
+	(*BatchProfileRequest)(nil),                    // 71: zenao.v1.BatchProfileRequest
+	(*CreatePollRequest)(nil),                      // 72: zenao.v1.CreatePollRequest
+	(*CreatePollResponse)(nil),                     // 73: zenao.v1.CreatePollResponse
+	(*GetPollRequest)(nil),                         // 74: zenao.v1.GetPollRequest
+	(*GetPollResponse)(nil),                        // 75: zenao.v1.GetPollResponse
+	(*VotePollRequest)(nil),                        // 76: zenao.v1.VotePollRequest
+	(*VotePollResponse)(nil),                       // 77: zenao.v1.VotePollResponse
+	(*CreatePostRequest)(nil),                      // 78: zenao.v1.CreatePostRequest
+	(*CreatePostResponse)(nil),                     // 79: zenao.v1.CreatePostResponse
+	(*GetPostRequest)(nil),                         // 80: zenao.v1.GetPostRequest
+	(*GetPostResponse)(nil),                        // 81: zenao.v1.GetPostResponse
+	(*GetFeedPostsRequest)(nil),                    // 82: zenao.v1.GetFeedPostsRequest
+	(*GetFeedPostsResponse)(nil),                   // 83: zenao.v1.GetFeedPostsResponse
+	(*GetChildrenPostsRequest)(nil),                // 84: zenao.v1.GetChildrenPostsRequest
+	(*GetChildrenPostsResponse)(nil),               // 85: zenao.v1.GetChildrenPostsResponse
+	(*DeletePostRequest)(nil),                      // 86: zenao.v1.DeletePostRequest
+	(*DeletePostResponse)(nil),                     // 87: zenao.v1.DeletePostResponse
+	(*ReactPostRequest)(nil),                       // 88: zenao.v1.ReactPostRequest
+	(*ReactPostResponse)(nil),                      // 89: zenao.v1.ReactPostResponse
+	(*PinPostRequest)(nil),                         // 90: zenao.v1.PinPostRequest
+	(*PinPostResponse)(nil),                        // 91: zenao.v1.PinPostResponse
+	(*EditPostRequest)(nil),                        // 92: zenao.v1.EditPostRequest
+	(*EditPostResponse)(nil),                       // 93: zenao.v1.EditPostResponse
+	(*GetEventTicketsRequest)(nil),                 // 94: zenao.v1.GetEventTicketsRequest
+	(*GetEventTicketsResponse)(nil),                // 95: zenao.v1.GetEventTicketsResponse
+	(*TicketInfo)(nil),                             // 96: zenao.v1.TicketInfo
+	(*GetOrderDetailsRequest)(nil),                 // 97: zenao.v1.GetOrderDetailsRequest
+	(*OrderSummary)(nil),                           // 98: zenao.v1.OrderSummary
+	(*OrderTicketInfo)(nil),                        // 99: zenao.v1.OrderTicketInfo
+	(*GetOrderDetailsResponse)(nil),                // 100: zenao.v1.GetOrderDetailsResponse
+	(*GetOrderInvoiceRequest)(nil),                 // 101: zenao.v1.GetOrderInvoiceRequest
+	(*GetOrderInvoiceResponse)(nil),                // 102: zenao.v1.GetOrderInvoiceResponse
+	(*RefundOrderRequest)(nil),                     // 103: zenao.v1.RefundOrderRequest
+	(*RefundOrderResponse)(nil),                    // 104: zenao.v1.RefundOrderResponse
+	(*PromoCode)(nil),                              // 105: zenao.v1.PromoCode
+	(*CreatePromoCodeRequest)(nil),                 // 106: zenao.v1.CreatePromoCodeRequest
+	(*CreatePromoCodeResponse)(nil),                // 107: zenao.v1.CreatePromoCodeResponse
+	(*ListPromoCodesRequest)(nil),                  // 108: zenao.v1.ListPromoCodesRequest
+	(*ListPromoCodesResponse)(nil),                 // 109: zenao.v1.ListPromoCodesResponse
+	(*DeletePromoCodeRequest)(nil),                 // 110: zenao.v1.DeletePromoCodeRequest
+	(*DeletePromoCodeResponse)(nil),                // 111: zenao.v1.DeletePromoCodeResponse
+	(*WaitlistEntry)(nil),                          // 112: zenao.v1.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),                    // 113: zenao.v1.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),                   // 114: zenao.v1.JoinWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),                   // 115: zenao.v1.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),                  // 116: zenao.v1.LeaveWaitlistResponse
+	(*GetEventWaitlistRequest)(nil),                // 117: zenao.v1.GetEventWaitlistRequest
+	(*GetEventWaitlistResponse)(nil),               // 118: zenao.v1.GetEventWaitlistResponse
+	(*ReorderWaitlistRequest)(nil),                 // 119: zenao.v1.ReorderWaitlistRequest
+	(*ReorderWaitlistResponse)(nil),                // 120: zenao.v1.ReorderWaitlistResponse
+	(*EventApplication)(nil),                       // 121: zenao.v1.EventApplication
+	(*ListEventApplicationsRequest)(nil),           // 122: zenao.v1.ListEventApplicationsRequest
+	(*ListEventApplicationsResponse)(nil),          // 123: zenao.v1.ListEventApplicationsResponse
+	(*ApproveEventApplicationRequest)(nil),         // 124: zenao.v1.ApproveEventApplicationRequest
+	(*ApproveEventApplicationResponse)(nil),        // 125: zenao.v1.ApproveEventApplicationResponse
+	(*RejectEventApplicationRequest)(nil),          // 126: zenao.v1.RejectEventApplicationRequest
+	(*RejectEventApplicationResponse)(nil),         // 127: zenao.v1.RejectEventApplicationResponse
+	(*EventInvitation)(nil),                        // 128: zenao.v1.EventInvitation
+	(*InviteToEventRequest)(nil),                   // 129: zenao.v1.InviteToEventRequest
+	(*InviteToEventResponse)(nil),                  // 130: zenao.v1.InviteToEventResponse
+	(*ListEventInvitationsRequest)(nil),            // 131: zenao.v1.ListEventInvitationsRequest
+	(*ListEventInvitationsResponse)(nil),           // 132: zenao.v1.ListEventInvitationsResponse
+	(*ResendEventInvitationsRequest)(nil),          // 133: zenao.v1.ResendEventInvitationsRequest
+	(*ResendEventInvitationsResponse)(nil),         // 134: zenao.v1.ResendEventInvitationsResponse
+	(*GetInvitationRequest)(nil),                   // 135: zenao.v1.GetInvitationRequest
+	(*GetInvitationResponse)(nil),                  // 136: zenao.v1.GetInvitationResponse
+	(*DeclineInvitationRequest)(nil),               // 137: zenao.v1.DeclineInvitationRequest
+	(*DeclineInvitationResponse)(nil),              // 138: zenao.v1.DeclineInvitationResponse
+	(*EventSession)(nil),                           // 139: zenao.v1.EventSession
+	(*CreateEventSessionRequest)(nil),              // 140: zenao.v1.CreateEventSessionRequest
+	(*CreateEventSessionResponse)(nil),             // 141: zenao.v1.CreateEventSessionResponse
+	(*EditEventSessionRequest)(nil),                // 142: zenao.v1.EditEventSessionRequest
+	(*EditEventSessionResponse)(nil),               // 143: zenao.v1.EditEventSessionResponse
+	(*DeleteEventSessionRequest)(nil),              // 144: zenao.v1.DeleteEventSessionRequest
+	(*DeleteEventSessionResponse)(nil),             // 145: zenao.v1.DeleteEventSessionResponse
+	(*ListEventSessionsRequest)(nil),               // 146: zenao.v1.ListEventSessionsRequest
+	(*ListEventSessionsResponse)(nil),              // 147: zenao.v1.ListEventSessionsResponse
+	(*RegisterForSessionRequest)(nil),              // 148: zenao.v1.RegisterForSessionRequest
+	(*RegisterForSessionResponse)(nil),             // 149: zenao.v1.RegisterForSessionResponse
+	(*UnregisterFromSessionRequest)(nil),           // 150: zenao.v1.UnregisterFromSessionRequest
+	(*UnregisterFromSessionResponse)(nil),          // 151: zenao.v1.UnregisterFromSessionResponse
+	(*GetUserOrdersRequest)(nil),                   // 152: zenao.v1.GetUserOrdersRequest
+	(*GetUserOrdersResponse)(nil),                  // 153: zenao.v1.GetUserOrdersResponse
+	(*CheckinRequest)(nil),                         // 154: zenao.v1.CheckinRequest
+	(*CheckinResponse)(nil),                        // 155: zenao.v1.CheckinResponse
+	(*ExportParticipantsRequest)(nil),              // 156: zenao.v1.ExportParticipantsRequest
+	(*ExportParticipantsResponse)(nil),             // 157: zenao.v1.ExportParticipantsResponse
+	(*Entity)(nil),                                 // 158: zenao.v1.Entity
+	(*EntityRolesRequest)(nil),                     // 159: zenao.v1.EntityRolesRequest
+	(*EntityRolesResponse)(nil),                    // 160: zenao.v1.EntityRolesResponse
+	(*EntitiesWithRolesRequest)(nil),               // 161: zenao.v1.EntitiesWithRolesRequest
+	(*EntityWithRoles)(nil),                        // 162: zenao.v1.EntityWithRoles
+	(*EntitiesWithRolesResponse)(nil),              // 163: zenao.v1.EntitiesWithRolesResponse
+	(*GetCommunityRequest)(nil),                    // 164: zenao.v1.GetCommunityRequest
+	(*GetCommunityResponse)(nil),                   // 165: zenao.v1.GetCommunityResponse
+	(*CommunityInfo)(nil),                          // 166: zenao.v1.CommunityInfo
+	(*ListCommunitiesRequest)(nil),                 // 167: zenao.v1.ListCommunitiesRequest
+	(*ListCommunitiesResponse)(nil),                // 168: zenao.v1.ListCommunitiesResponse
+	(*ListTagsRequest)(nil),                        // 169: zenao.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                       // 170: zenao.v1.ListTagsResponse
+	(*TagCount)(nil),                               // 171: zenao.v1.TagCount
+	(*ListCommunitiesByEventRequest)(nil),          // 172: zenao.v1.ListCommunitiesByEventRequest
+	(*ListCommunitiesByEventResponse)(nil),         // 173: zenao.v1.ListCommunitiesByEventResponse
+	(*CommunityUser)(nil),                          // 174: zenao.v1.CommunityUser
+	(*ListCommunitiesByUserRolesRequest)(nil),      // 175: zenao.v1.ListCommunitiesByUserRolesRequest
+	(*ListCommunitiesByUserRolesResponse)(nil),     // 176: zenao.v1.ListCommunitiesByUserRolesResponse
+	(*CreateCommunityRequest)(nil),                 // 177: zenao.v1.CreateCommunityRequest
+	(*CreateCommunityResponse)(nil),                // 178: zenao.v1.CreateCommunityResponse
+	(*EditCommunityRequest)(nil),                   // 179: zenao.v1.EditCommunityRequest
+	(*EditCommunityResponse)(nil),                  // 180: zenao.v1.EditCommunityResponse
+	(*StartCommunityStripeOnboardingRequest)(nil),  // 181: zenao.v1.StartCommunityStripeOnboardingRequest
+	(*StartCommunityStripeOnboardingResponse)(nil), // 182: zenao.v1.StartCommunityStripeOnboardingResponse
+	(*GetCommunityPayoutStatusRequest)(nil),        // 183: zenao.v1.GetCommunityPayoutStatusRequest
+	(*GetCommunityPayoutStatusResponse)(nil),       // 184: zenao.v1.GetCommunityPayoutStatusResponse
+	(*CommunityLegalDetails)(nil),                  // 185: zenao.v1.CommunityLegalDetails
+	(*GetCommunityLegalDetailsRequest)(nil),        // 186: zenao.v1.GetCommunityLegalDetailsRequest
+	(*GetCommunityLegalDetailsResponse)(nil),       // 187: zenao.v1.GetCommunityLegalDetailsResponse
+	(*EditCommunityLegalDetailsRequest)(nil),       // 188: zenao.v1.EditCommunityLegalDetailsRequest
+	(*EditCommunityLegalDetailsResponse)(nil),      // 189: zenao.v1.EditCommunityLegalDetailsResponse
+	(*GetCommunitySalesReportRequest)(nil),         // 190: zenao.v1.GetCommunitySalesReportRequest
+	(*SalesReportRow)(nil),                         // 191: zenao.v1.SalesReportRow
+	(*SalesReportTotal)(nil),                       // 192: zenao.v1.SalesReportTotal
+	(*GetCommunitySalesReportResponse)(nil),        // 193: zenao.v1.GetCommunitySalesReportResponse
+	(*ExportCommunitySalesReportRequest)(nil),      // 194: zenao.v1.ExportCommunitySalesReportRequest
+	(*ExportCommunitySalesReportResponse)(nil),     // 195: zenao.v1.ExportCommunitySalesReportResponse
+	(*CreateTeamRequest)(nil),                      // 196: zenao.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),                     // 197: zenao.v1.CreateTeamResponse
+	(*EditTeamRequest)(nil),                        // 198: zenao.v1.EditTeamRequest
+	(*EditTeamResponse)(nil),                       // 199: zenao.v1.EditTeamResponse
+	(*DeleteTeamRequest)(nil),                      // 200: zenao.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                     // 201: zenao.v1.DeleteTeamResponse
+	(*GetUserTeamsRequest)(nil),                    // 202: zenao.v1.GetUserTeamsRequest
+	(*GetUserTeamsResponse)(nil),                   // 203: zenao.v1.GetUserTeamsResponse
+	(*UserTeam)(nil),                               // 204: zenao.v1.UserTeam
+	(*GetTeamMembersRequest)(nil),                  // 205: zenao.v1.GetTeamMembersRequest
+	(*GetTeamMembersResponse)(nil),                 // 206: zenao.v1.GetTeamMembersResponse
+	(*TeamMember)(nil),                             // 207: zenao.v1.TeamMember
+	(*GetCommunityAdministratorsRequest)(nil),      // 208: zenao.v1.GetCommunityAdministratorsRequest
+	(*GetCommunityAdministratorsResponse)(nil),     // 209: zenao.v1.GetCommunityAdministratorsResponse
+	(*JoinCommunityRequest)(nil),                   // 210: zenao.v1.JoinCommunityRequest
+	(*JoinCommunityResponse)(nil),                  // 211: zenao.v1.JoinCommunityResponse
+	(*LeaveCommunityRequest)(nil),                  // 212: zenao.v1.LeaveCommunityRequest
+	(*LeaveCommunityResponse)(nil),                 // 213: zenao.v1.LeaveCommunityResponse
+	(*RemoveCommunityMemberRequest)(nil),           // 214: zenao.v1.RemoveCommunityMemberRequest
+	(*RemoveCommunityMemberResponse)(nil),          // 215: zenao.v1.RemoveCommunityMemberResponse
+	(*AddEventToCommunityRequest)(nil),             // 216: zenao.v1.AddEventToCommunityRequest
+	(*AddEventToCommunityResponse)(nil),            // 217: zenao.v1.AddEventToCommunityResponse
+	(*RemoveEventFromCommunityRequest)(nil),        // 218: zenao.v1.RemoveEventFromCommunityRequest
+	(*RemoveEventFromCommunityResponse)(nil),       // 219: zenao.v1.RemoveEventFromCommunityResponse
+	(*EventTemplate)(nil),                          // 220: zenao.v1.EventTemplate
+	(*CreateEventTemplateRequest)(nil),             // 221: zenao.v1.CreateEventTemplateRequest
+	(*CreateEventTemplateResponse)(nil),            // 222: zenao.v1.CreateEventTemplateResponse
+	(*ListEventTemplatesRequest)(nil),              // 223: zenao.v1.ListEventTemplatesRequest
+	(*ListEventTemplatesResponse)(nil),             // 224: zenao.v1.ListEventTemplatesResponse
+	(*DeleteEventTemplateRequest)(nil),             // 225: zenao.v1.DeleteEventTemplateRequest
+	(*DeleteEventTemplateResponse)(nil),            // 226: zenao.v1.DeleteEventTemplateResponse
+	(v1.PollKind)(0),                               // 227: polls.v1.PollKind
+	(*v1.Poll)(nil),                                // 228: polls.v1.Poll
+	(*v11.PostView)(nil),                           // 229: feeds.v1.PostView
 }
 var file_zenao_v1_zenao_proto_depIdxs = []int32{
-	7,   // 0: zenao.v1.GetUsersProfileResponse.profiles:type_name -> zenao.v1.Profile
-	48,  // 1: zenao.v1.GetEventResponse.event:type_name -> zenao.v1.EventInfo
-	0,   // 2: zenao.v1.ListEventsRequest.discoverable_filter:type_name -> zenao.v1.DiscoverableFilter
-	13,  // 3: zenao.v1.ListEventsRequest.location_filter:type_name -> zenao.v1.LocationFilter
-	48,  // 4: zenao.v1.ListEventsResponse.events:type_name -> zenao.v1.EventInfo
-	48,  // 5: zenao.v1.EventUser.event:type_name -> zenao.v1.EventInfo
-	0,   // 6: zenao.v1.ListEventsByUserRolesRequest.discoverable_filter:type_name -> zenao.v1.DiscoverableFilter
-	15,  // 7: zenao.v1.ListEventsByUserRolesResponse.events:type_name -> zenao.v1.EventUser
-	41,  // 8: zenao.v1.CreateEventRequest.location:type_name -> zenao.v1.EventLocation
-	49,  // 9: zenao.v1.CreateEventRequest.prices_groups:type_name -> zenao.v1.EventPriceGroup
-	41,  // 10: zenao.v1.EditEventRequest.location:type_name -> zenao.v1.EventLocation
-	49,  // 11: zenao.v1.EditEventRequest.prices_groups:type_name -> zenao.v1.EventPriceGroup
-	34,  // 12: zenao.v1.StartTicketPaymentRequest.line_items:type_name -> zenao.v1.StartTicketPaymentLineItem
-	43,  // 13: zenao.v1.EventLocation.geo:type_name -> zenao.v1.AddressGeo
-	42,  // 14: zenao.v1.EventLocation.virtual:type_name -> zenao.v1.AddressVirtual
-	44,  // 15: zenao.v1.EventLocation.custom:type_name -> zenao.v1.AddressCustom
-	46,  // 16: zenao.v1.EventPrivacy.public:type_name -> zenao.v1.EventPrivacyPublic
-	47,  // 17: zenao.v1.EventPrivacy.guarded:type_name -> zenao.v1.EventPrivacyGuarded
-	41,  // 18: zenao.v1.EventInfo.location:type_name -> zenao.v1.EventLocation
-	45,  // 19: zenao.v1.EventInfo.privacy:type_name -> zenao.v1.EventPrivacy
-	49,  // 20: zenao.v1.EventInfo.prices_groups:type_name -> zenao.v1.EventPriceGroup
-	50,  // 21: zenao.v1.EventPriceGroup.prices:type_name -> zenao.v1.EventPrice
-	51,  // 22: zenao.v1.BatchProfileRequest.fields:type_name -> zenao.v1.BatchProfileField
-	136, // 23: zenao.v1.CreatePollRequest.kind:type_name -> polls.v1.PollKind
-	137, // 24: zenao.v1.GetPollResponse.poll:type_name -> polls.v1.Poll
-	138, // 25: zenao.v1.GetPostResponse.post:type_name -> feeds.v1.PostView
-	88,  // 26: zenao.v1.GetFeedPostsRequest.org:type_name -> zenao.v1.Entity
-	138, // 27: zenao.v1.GetFeedPostsResponse.posts:type_name -> feeds.v1.PostView
-	138, // 28: zenao.v1.GetChildrenPostsResponse.posts:type_name -> feeds.v1.PostView
-	77,  // 29: zenao.v1.GetEventTicketsResponse.tickets_info:type_name -> zenao.v1.TicketInfo
-	79,  // 30: zenao.v1.GetOrderDetailsResponse.order:type_name -> zenao.v1.OrderSummary
-	80,  // 31: zenao.v1.GetOrderDetailsResponse.tickets:type_name -> zenao.v1.OrderTicketInfo
-	79,  // 32: zenao.v1.GetUserOrdersResponse.orders:type_name -> zenao.v1.OrderSummary
-	88,  // 33: zenao.v1.EntityRolesRequest.org:type_name -> zenao.v1.Entity
-	88,  // 34: zenao.v1.EntityRolesRequest.entity:type_name -> zenao.v1.Entity
-	88,  // 35: zenao.v1.EntitiesWithRolesRequest.org:type_name -> zenao.v1.Entity
-	92,  // 36: zenao.v1.EntitiesWithRolesResponse.entities_with_roles:type_name -> zenao.v1.EntityWithRoles
-	96,  // 37: zenao.v1.GetCommunityResponse.community:type_name -> zenao.v1.CommunityInfo
-	96,  // 38: zenao.v1.ListCommunitiesResponse.communities:type_name -> zenao.v1.CommunityInfo
-	96,  // 39: zenao.v1.ListCommunitiesByEventResponse.communities:type_name -> zenao.v1.CommunityInfo
-	96,  // 40: zenao.v1.CommunityUser.community:type_name -> zenao.v1.CommunityInfo
-	101, // 41: zenao.v1.ListCommunitiesByUserRolesResponse.communities:type_name -> zenao.v1.CommunityUser
-	120, // 42: zenao.v1.GetUserTeamsResponse.teams:type_name -> zenao.v1.UserTeam
-	123, // 43: zenao.v1.GetTeamMembersResponse.members:type_name -> zenao.v1.TeamMember
-	3,   // 44: zenao.v1.ZenaoService.EditUser:input_type -> zenao.v1.EditUserRequest
-	5,   // 45: zenao.v1.ZenaoService.GetUserInfo:input_type -> zenao.v1.GetUserInfoRequest
-	18,  // 46: zenao.v1.ZenaoService.CreateEvent:input_type -> zenao.v1.CreateEventRequest
-	20,  // 47: zenao.v1.ZenaoService.CancelEvent:input_type -> zenao.v1.CancelEventRequest
-	22,  // 48: zenao.v1.ZenaoService.EditEvent:input_type -> zenao.v1.EditEventRequest
-	24,  // 49: zenao.v1.ZenaoService.GetEventGatekeepers:input_type -> zenao.v1.GetEventGatekeepersRequest
-	26,  // 50: zenao.v1.ZenaoService.ValidatePassword:input_type -> zenao.v1.ValidatePasswordRequest
-	39,  // 51: zenao.v1.ZenaoService.BroadcastEvent:input_type -> zenao.v1.BroadcastEventRequest
-	28,  // 52: zenao.v1.ZenaoService.Participate:input_type -> zenao.v1.ParticipateRequest
-	35,  // 53: zenao.v1.ZenaoService.StartTicketPayment:input_type -> zenao.v1.StartTicketPaymentRequest
-	37,  // 54: zenao.v1.ZenaoService.ConfirmTicketPayment:input_type -> zenao.v1.ConfirmTicketPaymentRequest
-	29,  // 55: zenao.v1.ZenaoService.CancelParticipation:input_type -> zenao.v1.CancelParticipationRequest
-	75,  // 56: zenao.v1.ZenaoService.GetEventTickets:input_type -> zenao.v1.GetEventTicketsRequest
-	82,  // 57: zenao.v1.ZenaoService.GetUserOrders:input_type -> zenao.v1.GetUserOrdersRequest
-	78,  // 58: zenao.v1.ZenaoService.GetOrderDetails:input_type -> zenao.v1.GetOrderDetailsRequest
-	84,  // 59: zenao.v1.ZenaoService.Checkin:input_type -> zenao.v1.CheckinRequest
-	86,  // 60: zenao.v1.ZenaoService.ExportParticipants:input_type -> zenao.v1.ExportParticipantsRequest
-	31,  // 61: zenao.v1.ZenaoService.RemoveParticipant:input_type -> zenao.v1.RemoveParticipantRequest
-	104, // 62: zenao.v1.ZenaoService.CreateCommunity:input_type -> zenao.v1.CreateCommunityRequest
-	106, // 63: zenao.v1.ZenaoService.EditCommunity:input_type -> zenao.v1.EditCommunityRequest
-	108, // 64: zenao.v1.ZenaoService.StartCommunityStripeOnboarding:input_type -> zenao.v1.StartCommunityStripeOnboardingRequest
-	110, // 65: zenao.v1.ZenaoService.GetCommunityPayoutStatus:input_type -> zenao.v1.GetCommunityPayoutStatusRequest
-	124, // 66: zenao.v1.ZenaoService.GetCommunityAdministrators:input_type -> zenao.v1.GetCommunityAdministratorsRequest
-	126, // 67: zenao.v1.ZenaoService.JoinCommunity:input_type -> zenao.v1.JoinCommunityRequest
-	128, // 68: zenao.v1.ZenaoService.LeaveCommunity:input_type -> zenao.v1.LeaveCommunityRequest
-	130, // 69: zenao.v1.ZenaoService.RemoveCommunityMember:input_type -> zenao.v1.RemoveCommunityMemberRequest
-	132, // 70: zenao.v1.ZenaoService.AddEventToCommunity:input_type -> zenao.v1.AddEventToCommunityRequest
-	134, // 71: zenao.v1.ZenaoService.RemoveEventFromCommunity:input_type -> zenao.v1.RemoveEventFromCommunityRequest
-	112, // 72: zenao.v1.ZenaoService.CreateTeam:input_type -> zenao.v1.CreateTeamRequest
-	114, // 73: zenao.v1.ZenaoService.EditTeam:input_type -> zenao.v1.EditTeamRequest
-	116, // 74: zenao.v1.ZenaoService.DeleteTeam:input_type -> zenao.v1.DeleteTeamRequest
-	118, // 75: zenao.v1.ZenaoService.GetUserTeams:input_type -> zenao.v1.GetUserTeamsRequest
-	121, // 76: zenao.v1.ZenaoService.GetTeamMembers:input_type -> zenao.v1.GetTeamMembersRequest
-	89,  // 77: zenao.v1.ZenaoService.EntityRoles:input_type -> zenao.v1.EntityRolesRequest
-	91,  // 78: zenao.v1.ZenaoService.EntitiesWithRoles:input_type -> zenao.v1.EntitiesWithRolesRequest
-	94,  // 79: zenao.v1.ZenaoService.GetCommunity:input_type -> zenao.v1.GetCommunityRequest
-	97,  // 80: zenao.v1.ZenaoService.ListCommunities:input_type -> zenao.v1.ListCommunitiesRequest
-	99,  // 81: zenao.v1.ZenaoService.ListCommunitiesByEvent:input_type -> zenao.v1.ListCommunitiesByEventRequest
-	102, // 82: zenao.v1.ZenaoService.ListCommunitiesByUserRoles:input_type -> zenao.v1.ListCommunitiesByUserRolesRequest
-	10,  // 83: zenao.v1.ZenaoService.GetEvent:input_type -> zenao.v1.GetEventRequest
-	12,  // 84: zenao.v1.ZenaoService.ListEvents:input_type -> zenao.v1.ListEventsRequest
-	16,  // 85: zenao.v1.ZenaoService.ListEventsByUserRoles:input_type -> zenao.v1.ListEventsByUserRolesRequest
-	61,  // 86: zenao.v1.ZenaoService.GetPost:input_type -> zenao.v1.GetPostRequest
-	63,  // 87: zenao.v1.ZenaoService.GetFeedPosts:input_type -> zenao.v1.GetFeedPostsRequest
-	65,  // 88: zenao.v1.ZenaoService.GetChildrenPosts:input_type -> zenao.v1.GetChildrenPostsRequest
-	55,  // 89: zenao.v1.ZenaoService.GetPoll:input_type -> zenao.v1.GetPollRequest
-	8,   // 90: zenao.v1.ZenaoService.GetUsersProfile:input_type -> zenao.v1.GetUsersProfileRequest
-	53,  // 91: zenao.v1.ZenaoService.CreatePoll:input_type -> zenao.v1.CreatePollRequest
-	57,  // 92: zenao.v1.ZenaoService.VotePoll:input_type -> zenao.v1.VotePollRequest
-	59,  // 93: zenao.v1.ZenaoService.CreatePost:input_type -> zenao.v1.CreatePostRequest
-	67,  // 94: zenao.v1.ZenaoService.DeletePost:input_type -> zenao.v1.DeletePostRequest
-	69,  // 95: zenao.v1.ZenaoService.ReactPost:input_type -> zenao.v1.ReactPostRequest
-	71,  // 96: zenao.v1.ZenaoService.PinPost:input_type -> zenao.v1.PinPostRequest
-	73,  // 97: zenao.v1.ZenaoService.EditPost:input_type -> zenao.v1.EditPostRequest
-	1,   // 98: zenao.v1.ZenaoService.Health:input_type -> zenao.v1.HealthRequest
-	4,   // 99: zenao.v1.ZenaoService.EditUser:output_type -> zenao.v1.EditUserResponse
-	6,   // 100: zenao.v1.ZenaoService.GetUserInfo:output_type -> zenao.v1.GetUserInfoResponse
-	19,  // 101: zenao.v1.ZenaoService.CreateEvent:output_type -> zenao.v1.CreateEventResponse
-	21,  // 102: zenao.v1.ZenaoService.CancelEvent:output_type -> zenao.v1.CancelEventResponse
-	23,  // 103: zenao.v1.ZenaoService.EditEvent:output_type -> zenao.v1.EditEventResponse
-	25,  // 104: zenao.v1.ZenaoService.GetEventGatekeepers:output_type -> zenao.v1.GetEventGatekeepersResponse
-	27,  // 105: zenao.v1.ZenaoService.ValidatePassword:output_type -> zenao.v1.ValidatePasswordResponse
-	40,  // 106: zenao.v1.ZenaoService.BroadcastEvent:output_type -> zenao.v1.BroadcastEventResponse
-	33,  // 107: zenao.v1.ZenaoService.Participate:output_type -> zenao.v1.ParticipateResponse
-	36,  // 108: zenao.v1.ZenaoService.StartTicketPayment:output_type -> zenao.v1.StartTicketPaymentResponse
-	38,  // 109: zenao.v1.ZenaoService.ConfirmTicketPayment:output_type -> zenao.v1.ConfirmTicketPaymentResponse
-	30,  // 110: zenao.v1.ZenaoService.CancelParticipation:output_type -> zenao.v1.CancelParticipationResponse
-	76,  // 111: zenao.v1.ZenaoService.GetEventTickets:output_type -> zenao.v1.GetEventTicketsResponse
-	83,  // 112: zenao.v1.ZenaoService.GetUserOrders:output_type -> zenao.v1.GetUserOrdersResponse
-	81,  // 113: zenao.v1.ZenaoService.GetOrderDetails:output_type -> zenao.v1.GetOrderDetailsResponse
-	85,  // 114: zenao.v1.ZenaoService.Checkin:output_type -> zenao.v1.CheckinResponse
-	87,  // 115: zenao.v1.ZenaoService.ExportParticipants:output_type -> zenao.v1.ExportParticipantsResponse
-	32,  // 116: zenao.v1.ZenaoService.RemoveParticipant:output_type -> zenao.v1.RemoveParticipantResponse
-	105, // 117: zenao.v1.ZenaoService.CreateCommunity:output_type -> zenao.v1.CreateCommunityResponse
-	107, // 118: zenao.v1.ZenaoService.EditCommunity:output_type -> zenao.v1.EditCommunityResponse
-	109, // 119: zenao.v1.ZenaoService.StartCommunityStripeOnboarding:output_type -> zenao.v1.StartCommunityStripeOnboardingResponse
-	111, // 120: zenao.v1.ZenaoService.GetCommunityPayoutStatus:output_type -> zenao.v1.GetCommunityPayoutStatusResponse
-	125, // 121: zenao.v1.ZenaoService.GetCommunityAdministrators:output_type -> zenao.v1.GetCommunityAdministratorsResponse
-	127, // 122: zenao.v1.ZenaoService.JoinCommunity:output_type -> zenao.v1.JoinCommunityResponse
-	129, // 123: zenao.v1.ZenaoService.LeaveCommunity:output_type -> zenao.v1.LeaveCommunityResponse
-	131, // 124: zenao.v1.ZenaoService.RemoveCommunityMember:output_type -> zenao.v1.RemoveCommunityMemberResponse
-	133, // 125: zenao.v1.ZenaoService.AddEventToCommunity:output_type -> zenao.v1.AddEventToCommunityResponse
-	135, // 126: zenao.v1.ZenaoService.RemoveEventFromCommunity:output_type -> zenao.v1.RemoveEventFromCommunityResponse
-	113, // 127: zenao.v1.ZenaoService.CreateTeam:output_type -> zenao.v1.CreateTeamResponse
-	115, // 128: zenao.v1.ZenaoService.EditTeam:output_type -> zenao.v1.EditTeamResponse
-	117, // 129: zenao.v1.ZenaoService.DeleteTeam:output_type -> zenao.v1.DeleteTeamResponse
-	119, // 130: zenao.v1.ZenaoService.GetUserTeams:output_type -> zenao.v1.GetUserTeamsResponse
-	122, // 131: zenao.v1.ZenaoService.GetTeamMembers:output_type -> zenao.v1.GetTeamMembersResponse
-	90,  // 132: zenao.v1.ZenaoService.EntityRoles:output_type -> zenao.v1.EntityRolesResponse
-	93,  // 133: zenao.v1.ZenaoService.EntitiesWithRoles:output_type -> zenao.v1.EntitiesWithRolesResponse
-	95,  // 134: zenao.v1.ZenaoService.GetCommunity:output_type -> zenao.v1.GetCommunityResponse
-	98,  // 135: zenao.v1.ZenaoService.ListCommunities:output_type -> zenao.v1.ListCommunitiesResponse
-	100, // 136: zenao.v1.ZenaoService.ListCommunitiesByEvent:output_type -> zenao.v1.ListCommunitiesByEventResponse
-	103, // 137: zenao.v1.ZenaoService.ListCommunitiesByUserRoles:output_type -> zenao.v1.ListCommunitiesByUserRolesResponse
-	11,  // 138: zenao.v1.ZenaoService.GetEvent:output_type -> zenao.v1.GetEventResponse
-	14,  // 139: zenao.v1.ZenaoService.ListEvents:output_type -> zenao.v1.ListEventsResponse
-	17,  // 140: zenao.v1.ZenaoService.ListEventsByUserRoles:output_type -> zenao.v1.ListEventsByUserRolesResponse
-	62,  // 141: zenao.v1.ZenaoService.GetPost:output_type -> zenao.v1.GetPostResponse
-	64,  // 142: zenao.v1.ZenaoService.GetFeedPosts:output_type -> zenao.v1.GetFeedPostsResponse
-	66,  // 143: zenao.v1.ZenaoService.GetChildrenPosts:output_type -> zenao.v1.GetChildrenPostsResponse
-	56,  // 144: zenao.v1.ZenaoService.GetPoll:output_type -> zenao.v1.GetPollResponse
-	9,   // 145: zenao.v1.ZenaoService.GetUsersProfile:output_type -> zenao.v1.GetUsersProfileResponse
-	54,  // 146: zenao.v1.ZenaoService.CreatePoll:output_type -> zenao.v1.CreatePollResponse
-	58,  // 147: zenao.v1.ZenaoService.VotePoll:output_type -> zenao.v1.VotePollResponse
-	60,  // 148: zenao.v1.ZenaoService.CreatePost:output_type -> zenao.v1.CreatePostResponse
-	68,  // 149: zenao.v1.ZenaoService.DeletePost:output_type -> zenao.v1.DeletePostResponse
-	70,  // 150: zenao.v1.ZenaoService.ReactPost:output_type -> zenao.v1.ReactPostResponse
-	72,  // 151: zenao.v1.ZenaoService.PinPost:output_type -> zenao.v1.PinPostResponse
-	74,  // 152: zenao.v1.ZenaoService.EditPost:output_type -> zenao.v1.EditPostResponse
-	2,   // 153: zenao.v1.ZenaoService.Health:output_type -> zenao.v1.HealthResponse
-	99,  // [99:154] is the sub-list for method output_type
-	44,  // [44:99] is the sub-list for method input_type
-	44,  // [44:44] is the sub-list for extension type_name
-	44,  // [44:44] is the sub-list for extension extendee
-	0,   // [0:44] is the sub-list for field type_name
+	10,  // 0: zenao.v1.GetUsersProfileResponse.profiles:type_name -> zenao.v1.Profile
+	64,  // 1: zenao.v1.GetEventResponse.event:type_name -> zenao.v1.EventInfo
+	1,   // 2: zenao.v1.ListEventsRequest.discoverable_filter:type_name -> zenao.v1.DiscoverableFilter
+	16,  // 3: zenao.v1.ListEventsRequest.location_filter:type_name -> zenao.v1.LocationFilter
+	64,  // 4: zenao.v1.ListEventsResponse.events:type_name -> zenao.v1.EventInfo
+	16,  // 5: zenao.v1.SearchRequest.location_filter:type_name -> zenao.v1.LocationFilter
+	20,  // 6: zenao.v1.SearchResponse.events:type_name -> zenao.v1.EventSearchResult
+	21,  // 7: zenao.v1.SearchResponse.communities:type_name -> zenao.v1.CommunitySearchResult
+	64,  // 8: zenao.v1.EventSearchResult.event:type_name -> zenao.v1.EventInfo
+	166, // 9: zenao.v1.CommunitySearchResult.community:type_name -> zenao.v1.CommunityInfo
+	64,  // 10: zenao.v1.EventUser.event:type_name -> zenao.v1.EventInfo
+	1,   // 11: zenao.v1.ListEventsByUserRolesRequest.discoverable_filter:type_name -> zenao.v1.DiscoverableFilter
+	22,  // 12: zenao.v1.ListEventsByUserRolesResponse.events:type_name -> zenao.v1.EventUser
+	56,  // 13: zenao.v1.CreateEventRequest.location:type_name -> zenao.v1.EventLocation
+	68,  // 14: zenao.v1.CreateEventRequest.prices_groups:type_name -> zenao.v1.EventPriceGroup
+	65,  // 15: zenao.v1.CreateEventRequest.registration_questions:type_name -> zenao.v1.RegistrationQuestion
+	56,  // 16: zenao.v1.EditEventRequest.location:type_name -> zenao.v1.EventLocation
+	68,  // 17: zenao.v1.EditEventRequest.prices_groups:type_name -> zenao.v1.EventPriceGroup
+	0,   // 18: zenao.v1.EditEventRequest.series_scope:type_name -> zenao.v1.EventSeriesEditScope
+	65,  // 19: zenao.v1.EditEventRequest.registration_questions:type_name -> zenao.v1.RegistrationQuestion
+	66,  // 20: zenao.v1.ParticipateRequest.answers:type_name -> zenao.v1.RegistrationAnswer
+	67,  // 21: zenao.v1.ParticipateRequest.guests_answers:type_name -> zenao.v1.AttendeeRegistrationAnswers
+	121, // 22: zenao.v1.ParticipateResponse.applications:type_name -> zenao.v1.EventApplication
+	66,  // 23: zenao.v1.StartTicketPaymentLineItem.answers:type_name -> zenao.v1.RegistrationAnswer
+	49,  // 24: zenao.v1.StartTicketPaymentRequest.line_items:type_name -> zenao.v1.StartTicketPaymentLineItem
+	58,  // 25: zenao.v1.EventLocation.geo:type_name -> zenao.v1.AddressGeo
+	57,  // 26: zenao.v1.EventLocation.virtual:type_name -> zenao.v1.AddressVirtual
+	59,  // 27: zenao.v1.EventLocation.custom:type_name -> zenao.v1.AddressCustom
+	61,  // 28: zenao.v1.EventPrivacy.public:type_name -> zenao.v1.EventPrivacyPublic
+	62,  // 29: zenao.v1.EventPrivacy.guarded:type_name -> zenao.v1.EventPrivacyGuarded
+	63,  // 30: zenao.v1.EventPrivacy.invite_only:type_name -> zenao.v1.EventPrivacyInviteOnly
+	56,  // 31: zenao.v1.EventInfo.location:type_name -> zenao.v1.EventLocation
+	60,  // 32: zenao.v1.EventInfo.privacy:type_name -> zenao.v1.EventPrivacy
+	68,  // 33: zenao.v1.EventInfo.prices_groups:type_name -> zenao.v1.EventPriceGroup
+	65,  // 34: zenao.v1.EventInfo.registration_questions:type_name -> zenao.v1.RegistrationQuestion
+	66,  // 35: zenao.v1.AttendeeRegistrationAnswers.answers:type_name -> zenao.v1.RegistrationAnswer
+	69,  // 36: zenao.v1.EventPriceGroup.prices:type_name -> zenao.v1.EventPrice
+	70,  // 37: zenao.v1.BatchProfileRequest.fields:type_name -> zenao.v1.BatchProfileField
+	227, // 38: zenao.v1.CreatePollRequest.kind:type_name -> polls.v1.PollKind
+	228, // 39: zenao.v1.GetPollResponse.poll:type_name -> polls.v1.Poll
+	229, // 40: zenao.v1.GetPostResponse.post:type_name -> feeds.v1.PostView
+	158, // 41: zenao.v1.GetFeedPostsRequest.org:type_name -> zenao.v1.Entity
+	229, // 42: zenao.v1.GetFeedPostsResponse.posts:type_name -> feeds.v1.PostView
+	229, // 43: zenao.v1.GetChildrenPostsResponse.posts:type_name -> feeds.v1.PostView
+	96,  // 44: zenao.v1.GetEventTicketsResponse.tickets_info:type_name -> zenao.v1.TicketInfo
+	98,  // 45: zenao.v1.GetOrderDetailsResponse.order:type_name -> zenao.v1.OrderSummary
+	99,  // 46: zenao.v1.GetOrderDetailsResponse.tickets:type_name -> zenao.v1.OrderTicketInfo
+	105, // 47: zenao.v1.CreatePromoCodeResponse.promo_code:type_name -> zenao.v1.PromoCode
+	105, // 48: zenao.v1.ListPromoCodesResponse.promo_codes:type_name -> zenao.v1.PromoCode
+	112, // 49: zenao.v1.JoinWaitlistResponse.entry:type_name -> zenao.v1.WaitlistEntry
+	112, // 50: zenao.v1.GetEventWaitlistResponse.entries:type_name -> zenao.v1.WaitlistEntry
+	112, // 51: zenao.v1.ReorderWaitlistResponse.entries:type_name -> zenao.v1.WaitlistEntry
+	121, // 52: zenao.v1.ListEventApplicationsResponse.applications:type_name -> zenao.v1.EventApplication
+	121, // 53: zenao.v1.ApproveEventApplicationResponse.application:type_name -> zenao.v1.EventApplication
+	121, // 54: zenao.v1.RejectEventApplicationResponse.application:type_name -> zenao.v1.EventApplication
+	128, // 55: zenao.v1.InviteToEventResponse.invitations:type_name -> zenao.v1.EventInvitation
+	128, // 56: zenao.v1.ListEventInvitationsResponse.invitations:type_name -> zenao.v1.EventInvitation
+	128, // 57: zenao.v1.ResendEventInvitationsResponse.invitations:type_name -> zenao.v1.EventInvitation
+	139, // 58: zenao.v1.CreateEventSessionRequest.session:type_name -> zenao.v1.EventSession
+	139, // 59: zenao.v1.CreateEventSessionResponse.session:type_name -> zenao.v1.EventSession
+	139, // 60: zenao.v1.EditEventSessionRequest.session:type_name -> zenao.v1.EventSession
+	139, // 61: zenao.v1.EditEventSessionResponse.session:type_name -> zenao.v1.EventSession
+	139, // 62: zenao.v1.ListEventSessionsResponse.sessions:type_name -> zenao.v1.EventSession
+	139, // 63: zenao.v1.RegisterForSessionResponse.session:type_name -> zenao.v1.EventSession
+	98,  // 64: zenao.v1.GetUserOrdersResponse.orders:type_name -> zenao.v1.OrderSummary
+	158, // 65: zenao.v1.EntityRolesRequest.org:type_name -> zenao.v1.Entity
+	158, // 66: zenao.v1.EntityRolesRequest.entity:type_name -> zenao.v1.Entity
+	158, // 67: zenao.v1.EntitiesWithRolesRequest.org:type_name -> zenao.v1.Entity
+	162, // 68: zenao.v1.EntitiesWithRolesResponse.entities_with_roles:type_name -> zenao.v1.EntityWithRoles
+	166, // 69: zenao.v1.GetCommunityResponse.community:type_name -> zenao.v1.CommunityInfo
+	166, // 70: zenao.v1.ListCommunitiesResponse.communities:type_name -> zenao.v1.CommunityInfo
+	171, // 71: zenao.v1.ListTagsResponse.categories:type_name -> zenao.v1.TagCount
+	171, // 72: zenao.v1.ListTagsResponse.tags:type_name -> zenao.v1.TagCount
+	166, // 73: zenao.v1.ListCommunitiesByEventResponse.communities:type_name -> zenao.v1.CommunityInfo
+	166, // 74: zenao.v1.CommunityUser.community:type_name -> zenao.v1.CommunityInfo
+	174, // 75: zenao.v1.ListCommunitiesByUserRolesResponse.communities:type_name -> zenao.v1.CommunityUser
+	185, // 76: zenao.v1.GetCommunityLegalDetailsResponse.details:type_name -> zenao.v1.CommunityLegalDetails
+	185, // 77: zenao.v1.EditCommunityLegalDetailsRequest.details:type_name -> zenao.v1.CommunityLegalDetails
+	191, // 78: zenao.v1.GetCommunitySalesReportResponse.rows:type_name -> zenao.v1.SalesReportRow
+	192, // 79: zenao.v1.GetCommunitySalesReportResponse.totals:type_name -> zenao.v1.SalesReportTotal
+	204, // 80: zenao.v1.GetUserTeamsResponse.teams:type_name -> zenao.v1.UserTeam
+	207, // 81: zenao.v1.GetTeamMembersResponse.members:type_name -> zenao.v1.TeamMember
+	25,  // 82: zenao.v1.EventTemplate.event:type_name -> zenao.v1.CreateEventRequest
+	25,  // 83: zenao.v1.CreateEventTemplateRequest.event:type_name -> zenao.v1.CreateEventRequest
+	220, // 84: zenao.v1.CreateEventTemplateResponse.template:type_name -> zenao.v1.EventTemplate
+	220, // 85: zenao.v1.ListEventTemplatesResponse.templates:type_name -> zenao.v1.EventTemplate
+	4,   // 86: zenao.v1.ZenaoService.EditUser:input_type -> zenao.v1.EditUserRequest
+	6,   // 87: zenao.v1.ZenaoService.GetUserInfo:input_type -> zenao.v1.GetUserInfoRequest
+	8,   // 88: zenao.v1.ZenaoService.GetUserCalendarFeed:input_type -> zenao.v1.GetUserCalendarFeedRequest
+	25,  // 89: zenao.v1.ZenaoService.CreateEvent:input_type -> zenao.v1.CreateEventRequest
+	27,  // 90: zenao.v1.ZenaoService.CancelEvent:input_type -> zenao.v1.CancelEventRequest
+	29,  // 91: zenao.v1.ZenaoService.EditEvent:input_type -> zenao.v1.EditEventRequest
+	31,  // 92: zenao.v1.ZenaoService.DuplicateEvent:input_type -> zenao.v1.DuplicateEventRequest
+	33,  // 93: zenao.v1.ZenaoService.PublishEvent:input_type -> zenao.v1.PublishEventRequest
+	37,  // 94: zenao.v1.ZenaoService.GetEventGatekeepers:input_type -> zenao.v1.GetEventGatekeepersRequest
+	39,  // 95: zenao.v1.ZenaoService.ValidatePassword:input_type -> zenao.v1.ValidatePasswordRequest
+	54,  // 96: zenao.v1.ZenaoService.BroadcastEvent:input_type -> zenao.v1.BroadcastEventRequest
+	41,  // 97: zenao.v1.ZenaoService.Participate:input_type -> zenao.v1.ParticipateRequest
+	50,  // 98: zenao.v1.ZenaoService.StartTicketPayment:input_type -> zenao.v1.StartTicketPaymentRequest
+	52,  // 99: zenao.v1.ZenaoService.ConfirmTicketPayment:input_type -> zenao.v1.ConfirmTicketPaymentRequest
+	42,  // 100: zenao.v1.ZenaoService.CancelParticipation:input_type -> zenao.v1.CancelParticipationRequest
+	44,  // 101: zenao.v1.ZenaoService.TransferTicket:input_type -> zenao.v1.TransferTicketRequest
+	94,  // 102: zenao.v1.ZenaoService.GetEventTickets:input_type -> zenao.v1.GetEventTicketsRequest
+	152, // 103: zenao.v1.ZenaoService.GetUserOrders:input_type -> zenao.v1.GetUserOrdersRequest
+	97,  // 104: zenao.v1.ZenaoService.GetOrderDetails:input_type -> zenao.v1.GetOrderDetailsRequest
+	101, // 105: zenao.v1.ZenaoService.GetOrderInvoice:input_type -> zenao.v1.GetOrderInvoiceRequest
+	103, // 106: zenao.v1.ZenaoService.RefundOrder:input_type -> zenao.v1.RefundOrderRequest
+	106, // 107: zenao.v1.ZenaoService.CreatePromoCode:input_type -> zenao.v1.CreatePromoCodeRequest
+	108, // 108: zenao.v1.ZenaoService.ListPromoCodes:input_type -> zenao.v1.ListPromoCodesRequest
+	110, // 109: zenao.v1.ZenaoService.DeletePromoCode:input_type -> zenao.v1.DeletePromoCodeRequest
+	113, // 110: zenao.v1.ZenaoService.JoinWaitlist:input_type -> zenao.v1.JoinWaitlistRequest
+	115, // 111: zenao.v1.ZenaoService.LeaveWaitlist:input_type -> zenao.v1.LeaveWaitlistRequest
+	117, // 112: zenao.v1.ZenaoService.GetEventWaitlist:input_type -> zenao.v1.GetEventWaitlistRequest
+	119, // 113: zenao.v1.ZenaoService.ReorderWaitlist:input_type -> zenao.v1.ReorderWaitlistRequest
+	122, // 114: zenao.v1.ZenaoService.ListEventApplications:input_type -> zenao.v1.ListEventApplicationsRequest
+	124, // 115: zenao.v1.ZenaoService.ApproveEventApplication:input_type -> zenao.v1.ApproveEventApplicationRequest
+	126, // 116: zenao.v1.ZenaoService.RejectEventApplication:input_type -> zenao.v1.RejectEventApplicationRequest
+	129, // 117: zenao.v1.ZenaoService.InviteToEvent:input_type -> zenao.v1.InviteToEventRequest
+	131, // 118: zenao.v1.ZenaoService.ListEventInvitations:input_type -> zenao.v1.ListEventInvitationsRequest
+	133, // 119: zenao.v1.ZenaoService.ResendEventInvitations:input_type -> zenao.v1.ResendEventInvitationsRequest
+	135, // 120: zenao.v1.ZenaoService.GetInvitation:input_type -> zenao.v1.GetInvitationRequest
+	137, // 121: zenao.v1.ZenaoService.DeclineInvitation:input_type -> zenao.v1.DeclineInvitationRequest
+	140, // 122: zenao.v1.ZenaoService.CreateEventSession:input_type -> zenao.v1.CreateEventSessionRequest
+	142, // 123: zenao.v1.ZenaoService.EditEventSession:input_type -> zenao.v1.EditEventSessionRequest
+	144, // 124: zenao.v1.ZenaoService.DeleteEventSession:input_type -> zenao.v1.DeleteEventSessionRequest
+	146, // 125: zenao.v1.ZenaoService.ListEventSessions:input_type -> zenao.v1.ListEventSessionsRequest
+	148, // 126: zenao.v1.ZenaoService.RegisterForSession:input_type -> zenao.v1.RegisterForSessionRequest
+	150, // 127: zenao.v1.ZenaoService.UnregisterFromSession:input_type -> zenao.v1.UnregisterFromSessionRequest
+	154, // 128: zenao.v1.ZenaoService.Checkin:input_type -> zenao.v1.CheckinRequest
+	156, // 129: zenao.v1.ZenaoService.ExportParticipants:input_type -> zenao.v1.ExportParticipantsRequest
+	46,  // 130: zenao.v1.ZenaoService.RemoveParticipant:input_type -> zenao.v1.RemoveParticipantRequest
+	177, // 131: zenao.v1.ZenaoService.CreateCommunity:input_type -> zenao.v1.CreateCommunityRequest
+	179, // 132: zenao.v1.ZenaoService.EditCommunity:input_type -> zenao.v1.EditCommunityRequest
+	181, // 133: zenao.v1.ZenaoService.StartCommunityStripeOnboarding:input_type -> zenao.v1.StartCommunityStripeOnboardingRequest
+	183, // 134: zenao.v1.ZenaoService.GetCommunityPayoutStatus:input_type -> zenao.v1.GetCommunityPayoutStatusRequest
+	186, // 135: zenao.v1.ZenaoService.GetCommunityLegalDetails:input_type -> zenao.v1.GetCommunityLegalDetailsRequest
+	188, // 136: zenao.v1.ZenaoService.EditCommunityLegalDetails:input_type -> zenao.v1.EditCommunityLegalDetailsRequest
+	190, // 137: zenao.v1.ZenaoService.GetCommunitySalesReport:input_type -> zenao.v1.GetCommunitySalesReportRequest
+	194, // 138: zenao.v1.ZenaoService.ExportCommunitySalesReport:input_type -> zenao.v1.ExportCommunitySalesReportRequest
+	208, // 139: zenao.v1.ZenaoService.GetCommunityAdministrators:input_type -> zenao.v1.GetCommunityAdministratorsRequest
+	210, // 140: zenao.v1.ZenaoService.JoinCommunity:input_type -> zenao.v1.JoinCommunityRequest
+	212, // 141: zenao.v1.ZenaoService.LeaveCommunity:input_type -> zenao.v1.LeaveCommunityRequest
+	214, // 142: zenao.v1.ZenaoService.RemoveCommunityMember:input_type -> zenao.v1.RemoveCommunityMemberRequest
+	216, // 143: zenao.v1.ZenaoService.AddEventToCommunity:input_type -> zenao.v1.AddEventToCommunityRequest
+	218, // 144: zenao.v1.ZenaoService.RemoveEventFromCommunity:input_type -> zenao.v1.RemoveEventFromCommunityRequest
+	221, // 145: zenao.v1.ZenaoService.CreateEventTemplate:input_type -> zenao.v1.CreateEventTemplateRequest
+	223, // 146: zenao.v1.ZenaoService.ListEventTemplates:input_type -> zenao.v1.ListEventTemplatesRequest
+	225, // 147: zenao.v1.ZenaoService.DeleteEventTemplate:input_type -> zenao.v1.DeleteEventTemplateRequest
+	196, // 148: zenao.v1.ZenaoService.CreateTeam:input_type -> zenao.v1.CreateTeamRequest
+	198, // 149: zenao.v1.ZenaoService.EditTeam:input_type -> zenao.v1.EditTeamRequest
+	200, // 150: zenao.v1.ZenaoService.DeleteTeam:input_type -> zenao.v1.DeleteTeamRequest
+	202, // 151: zenao.v1.ZenaoService.GetUserTeams:input_type -> zenao.v1.GetUserTeamsRequest
+	205, // 152: zenao.v1.ZenaoService.GetTeamMembers:input_type -> zenao.v1.GetTeamMembersRequest
+	159, // 153: zenao.v1.ZenaoService.EntityRoles:input_type -> zenao.v1.EntityRolesRequest
+	161, // 154: zenao.v1.ZenaoService.EntitiesWithRoles:input_type -> zenao.v1.EntitiesWithRolesRequest
+	164, // 155: zenao.v1.ZenaoService.GetCommunity:input_type -> zenao.v1.GetCommunityRequest
+	167, // 156: zenao.v1.ZenaoService.ListCommunities:input_type -> zenao.v1.ListCommunitiesRequest
+	169, // 157: zenao.v1.ZenaoService.ListTags:input_type -> zenao.v1.ListTagsRequest
+	172, // 158: zenao.v1.ZenaoService.ListCommunitiesByEvent:input_type -> zenao.v1.ListCommunitiesByEventRequest
+	175, // 159: zenao.v1.ZenaoService.ListCommunitiesByUserRoles:input_type -> zenao.v1.ListCommunitiesByUserRolesRequest
+	13,  // 160: zenao.v1.ZenaoService.GetEvent:input_type -> zenao.v1.GetEventRequest
+	35,  // 161: zenao.v1.ZenaoService.GetEventSeries:input_type -> zenao.v1.GetEventSeriesRequest
+	15,  // 162: zenao.v1.ZenaoService.ListEvents:input_type -> zenao.v1.ListEventsRequest
+	23,  // 163: zenao.v1.ZenaoService.ListEventsByUserRoles:input_type -> zenao.v1.ListEventsByUserRolesRequest
+	18,  // 164: zenao.v1.ZenaoService.Search:input_type -> zenao.v1.SearchRequest
+	80,  // 165: zenao.v1.ZenaoService.GetPost:input_type -> zenao.v1.GetPostRequest
+	82,  // 166: zenao.v1.ZenaoService.GetFeedPosts:input_type -> zenao.v1.GetFeedPostsRequest
+	84,  // 167: zenao.v1.ZenaoService.GetChildrenPosts:input_type -> zenao.v1.GetChildrenPostsRequest
+	74,  // 168: zenao.v1.ZenaoService.GetPoll:input_type -> zenao.v1.GetPollRequest
+	11,  // 169: zenao.v1.ZenaoService.GetUsersProfile:input_type -> zenao.v1.GetUsersProfileRequest
+	72,  // 170: zenao.v1.ZenaoService.CreatePoll:input_type -> zenao.v1.CreatePollRequest
+	76,  // 171: zenao.v1.ZenaoService.VotePoll:input_type -> zenao.v1.VotePollRequest
+	78,  // 172: zenao.v1.ZenaoService.CreatePost:input_type -> zenao.v1.CreatePostRequest
+	86,  // 173: zenao.v1.ZenaoService.DeletePost:input_type -> zenao.v1.DeletePostRequest
+	88,  // 174: zenao.v1.ZenaoService.ReactPost:input_type -> zenao.v1.ReactPostRequest
+	90,  // 175: zenao.v1.ZenaoService.PinPost:input_type -> zenao.v1.PinPostRequest
+	92,  // 176: zenao.v1.ZenaoService.EditPost:input_type -> zenao.v1.EditPostRequest
+	2,   // 177: zenao.v1.ZenaoService.Health:input_type -> zenao.v1.HealthRequest
+	5,   // 178: zenao.v1.ZenaoService.EditUser:output_type -> zenao.v1.EditUserResponse
+	7,   // 179: zenao.v1.ZenaoService.GetUserInfo:output_type -> zenao.v1.GetUserInfoResponse
+	9,   // 180: zenao.v1.ZenaoService.GetUserCalendarFeed:output_type -> zenao.v1.GetUserCalendarFeedResponse
+	26,  // 181: zenao.v1.ZenaoService.CreateEvent:output_type -> zenao.v1.CreateEventResponse
+	28,  // 182: zenao.v1.ZenaoService.CancelEvent:output_type -> zenao.v1.CancelEventResponse
+	30,  // 183: zenao.v1.ZenaoService.EditEvent:output_type -> zenao.v1.EditEventResponse
+	32,  // 184: zenao.v1.ZenaoService.DuplicateEvent:output_type -> zenao.v1.DuplicateEventResponse
+	34,  // 185: zenao.v1.ZenaoService.PublishEvent:output_type -> zenao.v1.PublishEventResponse
+	38,  // 186: zenao.v1.ZenaoService.GetEventGatekeepers:output_type -> zenao.v1.GetEventGatekeepersResponse
+	40,  // 187: zenao.v1.ZenaoService.ValidatePassword:output_type -> zenao.v1.ValidatePasswordResponse
+	55,  // 188: zenao.v1.ZenaoService.BroadcastEvent:output_type -> zenao.v1.BroadcastEventResponse
+	48,  // 189: zenao.v1.ZenaoService.Participate:output_type -> zenao.v1.ParticipateResponse
+	51,  // 190: zenao.v1.ZenaoService.StartTicketPayment:output_type -> zenao.v1.StartTicketPaymentResponse
+	53,  // 191: zenao.v1.ZenaoService.ConfirmTicketPayment:output_type -> zenao.v1.ConfirmTicketPaymentResponse
+	43,  // 192: zenao.v1.ZenaoService.CancelParticipation:output_type -> zenao.v1.CancelParticipationResponse
+	45,  // 193: zenao.v1.ZenaoService.TransferTicket:output_type -> zenao.v1.TransferTicketResponse
+	95,  // 194: zenao.v1.ZenaoService.GetEventTickets:output_type -> zenao.v1.GetEventTicketsResponse
+	153, // 195: zenao.v1.ZenaoService.GetUserOrders:output_type -> zenao.v1.GetUserOrdersResponse
+	100, // 196: zenao.v1.ZenaoService.GetOrderDetails:output_type -> zenao.v1.GetOrderDetailsResponse
+	102, // 197: zenao.v1.ZenaoService.GetOrderInvoice:output_type -> zenao.v1.GetOrderInvoiceResponse
+	104, // 198: zenao.v1.ZenaoService.RefundOrder:output_type -> zenao.v1.RefundOrderResponse
+	107, // 199: zenao.v1.ZenaoService.CreatePromoCode:output_type -> zenao.v1.CreatePromoCodeResponse
+	109, // 200: zenao.v1.ZenaoService.ListPromoCodes:output_type -> zenao.v1.ListPromoCodesResponse
+	111, // 201: zenao.v1.ZenaoService.DeletePromoCode:output_type -> zenao.v1.DeletePromoCodeResponse
+	114, // 202: zenao.v1.ZenaoService.JoinWaitlist:output_type -> zenao.v1.JoinWaitlistResponse
+	116, // 203: zenao.v1.ZenaoService.LeaveWaitlist:output_type -> zenao.v1.LeaveWaitlistResponse
+	118, // 204: zenao.v1.ZenaoService.GetEventWaitlist:output_type -> zenao.v1.GetEventWaitlistResponse
+	120, // 205: zenao.v1.ZenaoService.ReorderWaitlist:output_type -> zenao.v1.ReorderWaitlistResponse
+	123, // 206: zenao.v1.ZenaoService.ListEventApplications:output_type -> zenao.v1.ListEventApplicationsResponse
+	125, // 207: zenao.v1.ZenaoService.ApproveEventApplication:output_type -> zenao.v1.ApproveEventApplicationResponse
+	127, // 208: zenao.v1.ZenaoService.RejectEventApplication:output_type -> zenao.v1.RejectEventApplicationResponse
+	130, // 209: zenao.v1.ZenaoService.InviteToEvent:output_type -> zenao.v1.InviteToEventResponse
+	132, // 210: zenao.v1.ZenaoService.ListEventInvitations:output_type -> zenao.v1.ListEventInvitationsResponse
+	134, // 211: zenao.v1.ZenaoService.ResendEventInvitations:output_type -> zenao.v1.ResendEventInvitationsResponse
+	136, // 212: zenao.v1.ZenaoService.GetInvitation:output_type -> zenao.v1.GetInvitationResponse
+	138, // 213: zenao.v1.ZenaoService.DeclineInvitation:output_type -> zenao.v1.DeclineInvitationResponse
+	141, // 214: zenao.v1.ZenaoService.CreateEventSession:output_type -> zenao.v1.CreateEventSessionResponse
+	143, // 215: zenao.v1.ZenaoService.EditEventSession:output_type -> zenao.v1.EditEventSessionResponse
+	145, // 216: zenao.v1.ZenaoService.DeleteEventSession:output_type -> zenao.v1.DeleteEventSessionResponse
+	147, // 217: zenao.v1.ZenaoService.ListEventSessions:output_type -> zenao.v1.ListEventSessionsResponse
+	149, // 218: zenao.v1.ZenaoService.RegisterForSession:output_type -> zenao.v1.RegisterForSessionResponse
+	151, // 219: zenao.v1.ZenaoService.UnregisterFromSession:output_type -> zenao.v1.UnregisterFromSessionResponse
+	155, // 220: zenao.v1.ZenaoService.Checkin:output_type -> zenao.v1.CheckinResponse
+	157, // 221: zenao.v1.ZenaoService.ExportParticipants:output_type -> zenao.v1.ExportParticipantsResponse
+	47,  // 222: zenao.v1.ZenaoService.RemoveParticipant:output_type -> zenao.v1.RemoveParticipantResponse
+	178, // 223: zenao.v1.ZenaoService.CreateCommunity:output_type -> zenao.v1.CreateCommunityResponse
+	180, // 224: zenao.v1.ZenaoService.EditCommunity:output_type -> zenao.v1.EditCommunityResponse
+	182, // 225: zenao.v1.ZenaoService.StartCommunityStripeOnboarding:output_type -> zenao.v1.StartCommunityStripeOnboardingResponse
+	184, // 226: zenao.v1.ZenaoService.GetCommunityPayoutStatus:output_type -> zenao.v1.GetCommunityPayoutStatusResponse
+	187, // 227: zenao.v1.ZenaoService.GetCommunityLegalDetails:output_type -> zenao.v1.GetCommunityLegalDetailsResponse
+	189, // 228: zenao.v1.ZenaoService.EditCommunityLegalDetails:output_type -> zenao.v1.EditCommunityLegalDetailsResponse
+	193, // 229: zenao.v1.ZenaoService.GetCommunitySalesReport:output_type -> zenao.v1.GetCommunitySalesReportResponse
+	195, // 230: zenao.v1.ZenaoService.ExportCommunitySalesReport:output_type -> zenao.v1.ExportCommunitySalesReportResponse
+	209, // 231: zenao.v1.ZenaoService.GetCommunityAdministrators:output_type -> zenao.v1.GetCommunityAdministratorsResponse
+	211, // 232: zenao.v1.ZenaoService.JoinCommunity:output_type -> zenao.v1.JoinCommunityResponse
+	213, // 233: zenao.v1.ZenaoService.LeaveCommunity:output_type -> zenao.v1.LeaveCommunityResponse
+	215, // 234: zenao.v1.ZenaoService.RemoveCommunityMember:output_type -> zenao.v1.RemoveCommunityMemberResponse
+	217, // 235: zenao.v1.ZenaoService.AddEventToCommunity:output_type -> zenao.v1.AddEventToCommunityResponse
+	219, // 236: zenao.v1.ZenaoService.RemoveEventFromCommunity:output_type -> zenao.v1.RemoveEventFromCommunityResponse
+	222, // 237: zenao.v1.ZenaoService.CreateEventTemplate:output_type -> zenao.v1.CreateEventTemplateResponse
+	224, // 238: zenao.v1.ZenaoService.ListEventTemplates:output_type -> zenao.v1.ListEventTemplatesResponse
+	226, // 239: zenao.v1.ZenaoService.DeleteEventTemplate:output_type -> zenao.v1.DeleteEventTemplateResponse
+	197, // 240: zenao.v1.ZenaoService.CreateTeam:output_type -> zenao.v1.CreateTeamResponse
+	199, // 241: zenao.v1.ZenaoService.EditTeam:output_type -> zenao.v1.EditTeamResponse
+	201, // 242: zenao.v1.ZenaoService.DeleteTeam:output_type -> zenao.v1.DeleteTeamResponse
+	203, // 243: zenao.v1.ZenaoService.GetUserTeams:output_type -> zenao.v1.GetUserTeamsResponse
+	206, // 244: zenao.v1.ZenaoService.GetTeamMembers:output_type -> zenao.v1.GetTeamMembersResponse
+	160, // 245: zenao.v1.ZenaoService.EntityRoles:output_type -> zenao.v1.EntityRolesResponse
+	163, // 246: zenao.v1.ZenaoService.EntitiesWithRoles:output_type -> zenao.v1.EntitiesWithRolesResponse
+	165, // 247: zenao.v1.ZenaoService.GetCommunity:output_type -> zenao.v1.GetCommunityResponse
+	168, // 248: zenao.v1.ZenaoService.ListCommunities:output_type -> zenao.v1.ListCommunitiesResponse
+	170, // 249: zenao.v1.ZenaoService.ListTags:output_type -> zenao.v1.ListTagsResponse
+	173, // 250: zenao.v1.ZenaoService.ListCommunitiesByEvent:output_type -> zenao.v1.ListCommunitiesByEventResponse
+	176, // 251: zenao.v1.ZenaoService.ListCommunitiesByUserRoles:output_type -> zenao.v1.ListCommunitiesByUserRolesResponse
+	14,  // 252: zenao.v1.ZenaoService.GetEvent:output_type -> zenao.v1.GetEventResponse
+	36,  // 253: zenao.v1.ZenaoService.GetEventSeries:output_type -> zenao.v1.GetEventSeriesResponse
+	17,  // 254: zenao.v1.ZenaoService.ListEvents:output_type -> zenao.v1.ListEventsResponse
+	24,  // 255: zenao.v1.ZenaoService.ListEventsByUserRoles:output_type -> zenao.v1.ListEventsByUserRolesResponse
+	19,  // 256: zenao.v1.ZenaoService.Search:output_type -> zenao.v1.SearchResponse
+	81,  // 257: zenao.v1.ZenaoService.GetPost:output_type -> zenao.v1.GetPostResponse
+	83,  // 258: zenao.v1.ZenaoService.GetFeedPosts:output_type -> zenao.v1.GetFeedPostsResponse
+	85,  // 259: zenao.v1.ZenaoService.GetChildrenPosts:output_type -> zenao.v1.GetChildrenPostsResponse
+	75,  // 260: zenao.v1.ZenaoService.GetPoll:output_type -> zenao.v1.GetPollResponse
+	12,  // 261: zenao.v1.ZenaoService.GetUsersProfile:output_type -> zenao.v1.GetUsersProfileResponse
+	73,  // 262: zenao.v1.ZenaoService.CreatePoll:output_type -> zenao.v1.CreatePollResponse
+	77,  // 263: zenao.v1.ZenaoService.VotePoll:output_type -> zenao.v1.VotePollResponse
+	79,  // 264: zenao.v1.ZenaoService.CreatePost:output_type -> zenao.v1.CreatePostResponse
+	87,  // 265: zenao.v1.ZenaoService.DeletePost:output_type -> zenao.v1.DeletePostResponse
+	89,  // 266: zenao.v1.ZenaoService.ReactPost:output_type -> zenao.v1.ReactPostResponse
+	91,  // 267: zenao.v1.ZenaoService.PinPost:output_type -> zenao.v1.PinPostResponse
+	93,  // 268: zenao.v1.ZenaoService.EditPost:output_type -> zenao.v1.EditPostResponse
+	3,   // 269: zenao.v1.ZenaoService.Health:output_type -> zenao.v1.HealthResponse
+	178, // [178:270] is the sub-list for method output_type
+	86,  // [86:178] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_zenao_v1_zenao_proto_init() }
@@ -8267,22 +14608,23 @@ func file_zenao_v1_zenao_proto_init() {
 	if File_zenao_v1_zenao_proto != nil {
 		return
 	}
-	file_zenao_v1_zenao_proto_msgTypes[40].OneofWrappers = []any{
+	file_zenao_v1_zenao_proto_msgTypes[54].OneofWrappers = []any{
 		(*EventLocation_Geo)(nil),
 		(*EventLocation_Virtual)(nil),
 		(*EventLocation_Custom)(nil),
 	}
-	file_zenao_v1_zenao_proto_msgTypes[44].OneofWrappers = []any{
+	file_zenao_v1_zenao_proto_msgTypes[58].OneofWrappers = []any{
 		(*EventPrivacy_Public)(nil),
 		(*EventPrivacy_Guarded)(nil),
+		(*EventPrivacy_InviteOnly)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_zenao_v1_zenao_proto_rawDesc), len(file_zenao_v1_zenao_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   135,
+			NumEnums:      2,
+			NumMessages:   225,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
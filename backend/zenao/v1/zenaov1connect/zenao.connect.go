@@ -39,6 +39,9 @@ const (
 	// ZenaoServiceGetUserInfoProcedure is the fully-qualified name of the ZenaoService's GetUserInfo
 	// RPC.
 	ZenaoServiceGetUserInfoProcedure = "/zenao.v1.ZenaoService/GetUserInfo"
+	// ZenaoServiceGetUserCalendarFeedProcedure is the fully-qualified name of the ZenaoService's
+	// GetUserCalendarFeed RPC.
+	ZenaoServiceGetUserCalendarFeedProcedure = "/zenao.v1.ZenaoService/GetUserCalendarFeed"
 	// ZenaoServiceCreateEventProcedure is the fully-qualified name of the ZenaoService's CreateEvent
 	// RPC.
 	ZenaoServiceCreateEventProcedure = "/zenao.v1.ZenaoService/CreateEvent"
@@ -47,6 +50,12 @@ const (
 	ZenaoServiceCancelEventProcedure = "/zenao.v1.ZenaoService/CancelEvent"
 	// ZenaoServiceEditEventProcedure is the fully-qualified name of the ZenaoService's EditEvent RPC.
 	ZenaoServiceEditEventProcedure = "/zenao.v1.ZenaoService/EditEvent"
+	// ZenaoServiceDuplicateEventProcedure is the fully-qualified name of the ZenaoService's
+	// DuplicateEvent RPC.
+	ZenaoServiceDuplicateEventProcedure = "/zenao.v1.ZenaoService/DuplicateEvent"
+	// ZenaoServicePublishEventProcedure is the fully-qualified name of the ZenaoService's PublishEvent
+	// RPC.
+	ZenaoServicePublishEventProcedure = "/zenao.v1.ZenaoService/PublishEvent"
 	// ZenaoServiceGetEventGatekeepersProcedure is the fully-qualified name of the ZenaoService's
 	// GetEventGatekeepers RPC.
 	ZenaoServiceGetEventGatekeepersProcedure = "/zenao.v1.ZenaoService/GetEventGatekeepers"
@@ -68,6 +77,9 @@ const (
 	// ZenaoServiceCancelParticipationProcedure is the fully-qualified name of the ZenaoService's
 	// CancelParticipation RPC.
 	ZenaoServiceCancelParticipationProcedure = "/zenao.v1.ZenaoService/CancelParticipation"
+	// ZenaoServiceTransferTicketProcedure is the fully-qualified name of the ZenaoService's
+	// TransferTicket RPC.
+	ZenaoServiceTransferTicketProcedure = "/zenao.v1.ZenaoService/TransferTicket"
 	// ZenaoServiceGetEventTicketsProcedure is the fully-qualified name of the ZenaoService's
 	// GetEventTickets RPC.
 	ZenaoServiceGetEventTicketsProcedure = "/zenao.v1.ZenaoService/GetEventTickets"
@@ -77,6 +89,75 @@ const (
 	// ZenaoServiceGetOrderDetailsProcedure is the fully-qualified name of the ZenaoService's
 	// GetOrderDetails RPC.
 	ZenaoServiceGetOrderDetailsProcedure = "/zenao.v1.ZenaoService/GetOrderDetails"
+	// ZenaoServiceGetOrderInvoiceProcedure is the fully-qualified name of the ZenaoService's
+	// GetOrderInvoice RPC.
+	ZenaoServiceGetOrderInvoiceProcedure = "/zenao.v1.ZenaoService/GetOrderInvoice"
+	// ZenaoServiceRefundOrderProcedure is the fully-qualified name of the ZenaoService's RefundOrder
+	// RPC.
+	ZenaoServiceRefundOrderProcedure = "/zenao.v1.ZenaoService/RefundOrder"
+	// ZenaoServiceCreatePromoCodeProcedure is the fully-qualified name of the ZenaoService's
+	// CreatePromoCode RPC.
+	ZenaoServiceCreatePromoCodeProcedure = "/zenao.v1.ZenaoService/CreatePromoCode"
+	// ZenaoServiceListPromoCodesProcedure is the fully-qualified name of the ZenaoService's
+	// ListPromoCodes RPC.
+	ZenaoServiceListPromoCodesProcedure = "/zenao.v1.ZenaoService/ListPromoCodes"
+	// ZenaoServiceDeletePromoCodeProcedure is the fully-qualified name of the ZenaoService's
+	// DeletePromoCode RPC.
+	ZenaoServiceDeletePromoCodeProcedure = "/zenao.v1.ZenaoService/DeletePromoCode"
+	// ZenaoServiceJoinWaitlistProcedure is the fully-qualified name of the ZenaoService's JoinWaitlist
+	// RPC.
+	ZenaoServiceJoinWaitlistProcedure = "/zenao.v1.ZenaoService/JoinWaitlist"
+	// ZenaoServiceLeaveWaitlistProcedure is the fully-qualified name of the ZenaoService's
+	// LeaveWaitlist RPC.
+	ZenaoServiceLeaveWaitlistProcedure = "/zenao.v1.ZenaoService/LeaveWaitlist"
+	// ZenaoServiceGetEventWaitlistProcedure is the fully-qualified name of the ZenaoService's
+	// GetEventWaitlist RPC.
+	ZenaoServiceGetEventWaitlistProcedure = "/zenao.v1.ZenaoService/GetEventWaitlist"
+	// ZenaoServiceReorderWaitlistProcedure is the fully-qualified name of the ZenaoService's
+	// ReorderWaitlist RPC.
+	ZenaoServiceReorderWaitlistProcedure = "/zenao.v1.ZenaoService/ReorderWaitlist"
+	// ZenaoServiceListEventApplicationsProcedure is the fully-qualified name of the ZenaoService's
+	// ListEventApplications RPC.
+	ZenaoServiceListEventApplicationsProcedure = "/zenao.v1.ZenaoService/ListEventApplications"
+	// ZenaoServiceApproveEventApplicationProcedure is the fully-qualified name of the ZenaoService's
+	// ApproveEventApplication RPC.
+	ZenaoServiceApproveEventApplicationProcedure = "/zenao.v1.ZenaoService/ApproveEventApplication"
+	// ZenaoServiceRejectEventApplicationProcedure is the fully-qualified name of the ZenaoService's
+	// RejectEventApplication RPC.
+	ZenaoServiceRejectEventApplicationProcedure = "/zenao.v1.ZenaoService/RejectEventApplication"
+	// ZenaoServiceInviteToEventProcedure is the fully-qualified name of the ZenaoService's
+	// InviteToEvent RPC.
+	ZenaoServiceInviteToEventProcedure = "/zenao.v1.ZenaoService/InviteToEvent"
+	// ZenaoServiceListEventInvitationsProcedure is the fully-qualified name of the ZenaoService's
+	// ListEventInvitations RPC.
+	ZenaoServiceListEventInvitationsProcedure = "/zenao.v1.ZenaoService/ListEventInvitations"
+	// ZenaoServiceResendEventInvitationsProcedure is the fully-qualified name of the ZenaoService's
+	// ResendEventInvitations RPC.
+	ZenaoServiceResendEventInvitationsProcedure = "/zenao.v1.ZenaoService/ResendEventInvitations"
+	// ZenaoServiceGetInvitationProcedure is the fully-qualified name of the ZenaoService's
+	// GetInvitation RPC.
+	ZenaoServiceGetInvitationProcedure = "/zenao.v1.ZenaoService/GetInvitation"
+	// ZenaoServiceDeclineInvitationProcedure is the fully-qualified name of the ZenaoService's
+	// DeclineInvitation RPC.
+	ZenaoServiceDeclineInvitationProcedure = "/zenao.v1.ZenaoService/DeclineInvitation"
+	// ZenaoServiceCreateEventSessionProcedure is the fully-qualified name of the ZenaoService's
+	// CreateEventSession RPC.
+	ZenaoServiceCreateEventSessionProcedure = "/zenao.v1.ZenaoService/CreateEventSession"
+	// ZenaoServiceEditEventSessionProcedure is the fully-qualified name of the ZenaoService's
+	// EditEventSession RPC.
+	ZenaoServiceEditEventSessionProcedure = "/zenao.v1.ZenaoService/EditEventSession"
+	// ZenaoServiceDeleteEventSessionProcedure is the fully-qualified name of the ZenaoService's
+	// DeleteEventSession RPC.
+	ZenaoServiceDeleteEventSessionProcedure = "/zenao.v1.ZenaoService/DeleteEventSession"
+	// ZenaoServiceListEventSessionsProcedure is the fully-qualified name of the ZenaoService's
+	// ListEventSessions RPC.
+	ZenaoServiceListEventSessionsProcedure = "/zenao.v1.ZenaoService/ListEventSessions"
+	// ZenaoServiceRegisterForSessionProcedure is the fully-qualified name of the ZenaoService's
+	// RegisterForSession RPC.
+	ZenaoServiceRegisterForSessionProcedure = "/zenao.v1.ZenaoService/RegisterForSession"
+	// ZenaoServiceUnregisterFromSessionProcedure is the fully-qualified name of the ZenaoService's
+	// UnregisterFromSession RPC.
+	ZenaoServiceUnregisterFromSessionProcedure = "/zenao.v1.ZenaoService/UnregisterFromSession"
 	// ZenaoServiceCheckinProcedure is the fully-qualified name of the ZenaoService's Checkin RPC.
 	ZenaoServiceCheckinProcedure = "/zenao.v1.ZenaoService/Checkin"
 	// ZenaoServiceExportParticipantsProcedure is the fully-qualified name of the ZenaoService's
@@ -97,6 +178,18 @@ const (
 	// ZenaoServiceGetCommunityPayoutStatusProcedure is the fully-qualified name of the ZenaoService's
 	// GetCommunityPayoutStatus RPC.
 	ZenaoServiceGetCommunityPayoutStatusProcedure = "/zenao.v1.ZenaoService/GetCommunityPayoutStatus"
+	// ZenaoServiceGetCommunityLegalDetailsProcedure is the fully-qualified name of the ZenaoService's
+	// GetCommunityLegalDetails RPC.
+	ZenaoServiceGetCommunityLegalDetailsProcedure = "/zenao.v1.ZenaoService/GetCommunityLegalDetails"
+	// ZenaoServiceEditCommunityLegalDetailsProcedure is the fully-qualified name of the ZenaoService's
+	// EditCommunityLegalDetails RPC.
+	ZenaoServiceEditCommunityLegalDetailsProcedure = "/zenao.v1.ZenaoService/EditCommunityLegalDetails"
+	// ZenaoServiceGetCommunitySalesReportProcedure is the fully-qualified name of the ZenaoService's
+	// GetCommunitySalesReport RPC.
+	ZenaoServiceGetCommunitySalesReportProcedure = "/zenao.v1.ZenaoService/GetCommunitySalesReport"
+	// ZenaoServiceExportCommunitySalesReportProcedure is the fully-qualified name of the ZenaoService's
+	// ExportCommunitySalesReport RPC.
+	ZenaoServiceExportCommunitySalesReportProcedure = "/zenao.v1.ZenaoService/ExportCommunitySalesReport"
 	// ZenaoServiceGetCommunityAdministratorsProcedure is the fully-qualified name of the ZenaoService's
 	// GetCommunityAdministrators RPC.
 	ZenaoServiceGetCommunityAdministratorsProcedure = "/zenao.v1.ZenaoService/GetCommunityAdministrators"
@@ -115,6 +208,15 @@ const (
 	// ZenaoServiceRemoveEventFromCommunityProcedure is the fully-qualified name of the ZenaoService's
 	// RemoveEventFromCommunity RPC.
 	ZenaoServiceRemoveEventFromCommunityProcedure = "/zenao.v1.ZenaoService/RemoveEventFromCommunity"
+	// ZenaoServiceCreateEventTemplateProcedure is the fully-qualified name of the ZenaoService's
+	// CreateEventTemplate RPC.
+	ZenaoServiceCreateEventTemplateProcedure = "/zenao.v1.ZenaoService/CreateEventTemplate"
+	// ZenaoServiceListEventTemplatesProcedure is the fully-qualified name of the ZenaoService's
+	// ListEventTemplates RPC.
+	ZenaoServiceListEventTemplatesProcedure = "/zenao.v1.ZenaoService/ListEventTemplates"
+	// ZenaoServiceDeleteEventTemplateProcedure is the fully-qualified name of the ZenaoService's
+	// DeleteEventTemplate RPC.
+	ZenaoServiceDeleteEventTemplateProcedure = "/zenao.v1.ZenaoService/DeleteEventTemplate"
 	// ZenaoServiceCreateTeamProcedure is the fully-qualified name of the ZenaoService's CreateTeam RPC.
 	ZenaoServiceCreateTeamProcedure = "/zenao.v1.ZenaoService/CreateTeam"
 	// ZenaoServiceEditTeamProcedure is the fully-qualified name of the ZenaoService's EditTeam RPC.
@@ -139,6 +241,8 @@ const (
 	// ZenaoServiceListCommunitiesProcedure is the fully-qualified name of the ZenaoService's
 	// ListCommunities RPC.
 	ZenaoServiceListCommunitiesProcedure = "/zenao.v1.ZenaoService/ListCommunities"
+	// ZenaoServiceListTagsProcedure is the fully-qualified name of the ZenaoService's ListTags RPC.
+	ZenaoServiceListTagsProcedure = "/zenao.v1.ZenaoService/ListTags"
 	// ZenaoServiceListCommunitiesByEventProcedure is the fully-qualified name of the ZenaoService's
 	// ListCommunitiesByEvent RPC.
 	ZenaoServiceListCommunitiesByEventProcedure = "/zenao.v1.ZenaoService/ListCommunitiesByEvent"
@@ -147,11 +251,16 @@ const (
 	ZenaoServiceListCommunitiesByUserRolesProcedure = "/zenao.v1.ZenaoService/ListCommunitiesByUserRoles"
 	// ZenaoServiceGetEventProcedure is the fully-qualified name of the ZenaoService's GetEvent RPC.
 	ZenaoServiceGetEventProcedure = "/zenao.v1.ZenaoService/GetEvent"
+	// ZenaoServiceGetEventSeriesProcedure is the fully-qualified name of the ZenaoService's
+	// GetEventSeries RPC.
+	ZenaoServiceGetEventSeriesProcedure = "/zenao.v1.ZenaoService/GetEventSeries"
 	// ZenaoServiceListEventsProcedure is the fully-qualified name of the ZenaoService's ListEvents RPC.
 	ZenaoServiceListEventsProcedure = "/zenao.v1.ZenaoService/ListEvents"
 	// ZenaoServiceListEventsByUserRolesProcedure is the fully-qualified name of the ZenaoService's
 	// ListEventsByUserRoles RPC.
 	ZenaoServiceListEventsByUserRolesProcedure = "/zenao.v1.ZenaoService/ListEventsByUserRoles"
+	// ZenaoServiceSearchProcedure is the fully-qualified name of the ZenaoService's Search RPC.
+	ZenaoServiceSearchProcedure = "/zenao.v1.ZenaoService/Search"
 	// ZenaoServiceGetPostProcedure is the fully-qualified name of the ZenaoService's GetPost RPC.
 	ZenaoServiceGetPostProcedure = "/zenao.v1.ZenaoService/GetPost"
 	// ZenaoServiceGetFeedPostsProcedure is the fully-qualified name of the ZenaoService's GetFeedPosts
@@ -188,10 +297,13 @@ type ZenaoServiceClient interface {
 	// USER
 	EditUser(context.Context, *connect.Request[v1.EditUserRequest]) (*connect.Response[v1.EditUserResponse], error)
 	GetUserInfo(context.Context, *connect.Request[v1.GetUserInfoRequest]) (*connect.Response[v1.GetUserInfoResponse], error)
+	GetUserCalendarFeed(context.Context, *connect.Request[v1.GetUserCalendarFeedRequest]) (*connect.Response[v1.GetUserCalendarFeedResponse], error)
 	// EVENT
 	CreateEvent(context.Context, *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error)
 	CancelEvent(context.Context, *connect.Request[v1.CancelEventRequest]) (*connect.Response[v1.CancelEventResponse], error)
 	EditEvent(context.Context, *connect.Request[v1.EditEventRequest]) (*connect.Response[v1.EditEventResponse], error)
+	DuplicateEvent(context.Context, *connect.Request[v1.DuplicateEventRequest]) (*connect.Response[v1.DuplicateEventResponse], error)
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
 	GetEventGatekeepers(context.Context, *connect.Request[v1.GetEventGatekeepersRequest]) (*connect.Response[v1.GetEventGatekeepersResponse], error)
 	ValidatePassword(context.Context, *connect.Request[v1.ValidatePasswordRequest]) (*connect.Response[v1.ValidatePasswordResponse], error)
 	BroadcastEvent(context.Context, *connect.Request[v1.BroadcastEventRequest]) (*connect.Response[v1.BroadcastEventResponse], error)
@@ -199,9 +311,33 @@ type ZenaoServiceClient interface {
 	StartTicketPayment(context.Context, *connect.Request[v1.StartTicketPaymentRequest]) (*connect.Response[v1.StartTicketPaymentResponse], error)
 	ConfirmTicketPayment(context.Context, *connect.Request[v1.ConfirmTicketPaymentRequest]) (*connect.Response[v1.ConfirmTicketPaymentResponse], error)
 	CancelParticipation(context.Context, *connect.Request[v1.CancelParticipationRequest]) (*connect.Response[v1.CancelParticipationResponse], error)
+	TransferTicket(context.Context, *connect.Request[v1.TransferTicketRequest]) (*connect.Response[v1.TransferTicketResponse], error)
 	GetEventTickets(context.Context, *connect.Request[v1.GetEventTicketsRequest]) (*connect.Response[v1.GetEventTicketsResponse], error)
 	GetUserOrders(context.Context, *connect.Request[v1.GetUserOrdersRequest]) (*connect.Response[v1.GetUserOrdersResponse], error)
 	GetOrderDetails(context.Context, *connect.Request[v1.GetOrderDetailsRequest]) (*connect.Response[v1.GetOrderDetailsResponse], error)
+	GetOrderInvoice(context.Context, *connect.Request[v1.GetOrderInvoiceRequest]) (*connect.Response[v1.GetOrderInvoiceResponse], error)
+	RefundOrder(context.Context, *connect.Request[v1.RefundOrderRequest]) (*connect.Response[v1.RefundOrderResponse], error)
+	CreatePromoCode(context.Context, *connect.Request[v1.CreatePromoCodeRequest]) (*connect.Response[v1.CreatePromoCodeResponse], error)
+	ListPromoCodes(context.Context, *connect.Request[v1.ListPromoCodesRequest]) (*connect.Response[v1.ListPromoCodesResponse], error)
+	DeletePromoCode(context.Context, *connect.Request[v1.DeletePromoCodeRequest]) (*connect.Response[v1.DeletePromoCodeResponse], error)
+	JoinWaitlist(context.Context, *connect.Request[v1.JoinWaitlistRequest]) (*connect.Response[v1.JoinWaitlistResponse], error)
+	LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistRequest]) (*connect.Response[v1.LeaveWaitlistResponse], error)
+	GetEventWaitlist(context.Context, *connect.Request[v1.GetEventWaitlistRequest]) (*connect.Response[v1.GetEventWaitlistResponse], error)
+	ReorderWaitlist(context.Context, *connect.Request[v1.ReorderWaitlistRequest]) (*connect.Response[v1.ReorderWaitlistResponse], error)
+	ListEventApplications(context.Context, *connect.Request[v1.ListEventApplicationsRequest]) (*connect.Response[v1.ListEventApplicationsResponse], error)
+	ApproveEventApplication(context.Context, *connect.Request[v1.ApproveEventApplicationRequest]) (*connect.Response[v1.ApproveEventApplicationResponse], error)
+	RejectEventApplication(context.Context, *connect.Request[v1.RejectEventApplicationRequest]) (*connect.Response[v1.RejectEventApplicationResponse], error)
+	InviteToEvent(context.Context, *connect.Request[v1.InviteToEventRequest]) (*connect.Response[v1.InviteToEventResponse], error)
+	ListEventInvitations(context.Context, *connect.Request[v1.ListEventInvitationsRequest]) (*connect.Response[v1.ListEventInvitationsResponse], error)
+	ResendEventInvitations(context.Context, *connect.Request[v1.ResendEventInvitationsRequest]) (*connect.Response[v1.ResendEventInvitationsResponse], error)
+	GetInvitation(context.Context, *connect.Request[v1.GetInvitationRequest]) (*connect.Response[v1.GetInvitationResponse], error)
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+	CreateEventSession(context.Context, *connect.Request[v1.CreateEventSessionRequest]) (*connect.Response[v1.CreateEventSessionResponse], error)
+	EditEventSession(context.Context, *connect.Request[v1.EditEventSessionRequest]) (*connect.Response[v1.EditEventSessionResponse], error)
+	DeleteEventSession(context.Context, *connect.Request[v1.DeleteEventSessionRequest]) (*connect.Response[v1.DeleteEventSessionResponse], error)
+	ListEventSessions(context.Context, *connect.Request[v1.ListEventSessionsRequest]) (*connect.Response[v1.ListEventSessionsResponse], error)
+	RegisterForSession(context.Context, *connect.Request[v1.RegisterForSessionRequest]) (*connect.Response[v1.RegisterForSessionResponse], error)
+	UnregisterFromSession(context.Context, *connect.Request[v1.UnregisterFromSessionRequest]) (*connect.Response[v1.UnregisterFromSessionResponse], error)
 	Checkin(context.Context, *connect.Request[v1.CheckinRequest]) (*connect.Response[v1.CheckinResponse], error)
 	ExportParticipants(context.Context, *connect.Request[v1.ExportParticipantsRequest]) (*connect.Response[v1.ExportParticipantsResponse], error)
 	RemoveParticipant(context.Context, *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error)
@@ -210,12 +346,19 @@ type ZenaoServiceClient interface {
 	EditCommunity(context.Context, *connect.Request[v1.EditCommunityRequest]) (*connect.Response[v1.EditCommunityResponse], error)
 	StartCommunityStripeOnboarding(context.Context, *connect.Request[v1.StartCommunityStripeOnboardingRequest]) (*connect.Response[v1.StartCommunityStripeOnboardingResponse], error)
 	GetCommunityPayoutStatus(context.Context, *connect.Request[v1.GetCommunityPayoutStatusRequest]) (*connect.Response[v1.GetCommunityPayoutStatusResponse], error)
+	GetCommunityLegalDetails(context.Context, *connect.Request[v1.GetCommunityLegalDetailsRequest]) (*connect.Response[v1.GetCommunityLegalDetailsResponse], error)
+	EditCommunityLegalDetails(context.Context, *connect.Request[v1.EditCommunityLegalDetailsRequest]) (*connect.Response[v1.EditCommunityLegalDetailsResponse], error)
+	GetCommunitySalesReport(context.Context, *connect.Request[v1.GetCommunitySalesReportRequest]) (*connect.Response[v1.GetCommunitySalesReportResponse], error)
+	ExportCommunitySalesReport(context.Context, *connect.Request[v1.ExportCommunitySalesReportRequest]) (*connect.Response[v1.ExportCommunitySalesReportResponse], error)
 	GetCommunityAdministrators(context.Context, *connect.Request[v1.GetCommunityAdministratorsRequest]) (*connect.Response[v1.GetCommunityAdministratorsResponse], error)
 	JoinCommunity(context.Context, *connect.Request[v1.JoinCommunityRequest]) (*connect.Response[v1.JoinCommunityResponse], error)
 	LeaveCommunity(context.Context, *connect.Request[v1.LeaveCommunityRequest]) (*connect.Response[v1.LeaveCommunityResponse], error)
 	RemoveCommunityMember(context.Context, *connect.Request[v1.RemoveCommunityMemberRequest]) (*connect.Response[v1.RemoveCommunityMemberResponse], error)
 	AddEventToCommunity(context.Context, *connect.Request[v1.AddEventToCommunityRequest]) (*connect.Response[v1.AddEventToCommunityResponse], error)
 	RemoveEventFromCommunity(context.Context, *connect.Request[v1.RemoveEventFromCommunityRequest]) (*connect.Response[v1.RemoveEventFromCommunityResponse], error)
+	CreateEventTemplate(context.Context, *connect.Request[v1.CreateEventTemplateRequest]) (*connect.Response[v1.CreateEventTemplateResponse], error)
+	ListEventTemplates(context.Context, *connect.Request[v1.ListEventTemplatesRequest]) (*connect.Response[v1.ListEventTemplatesResponse], error)
+	DeleteEventTemplate(context.Context, *connect.Request[v1.DeleteEventTemplateRequest]) (*connect.Response[v1.DeleteEventTemplateResponse], error)
 	// TEAM
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
 	EditTeam(context.Context, *connect.Request[v1.EditTeamRequest]) (*connect.Response[v1.EditTeamResponse], error)
@@ -227,11 +370,14 @@ type ZenaoServiceClient interface {
 	EntitiesWithRoles(context.Context, *connect.Request[v1.EntitiesWithRolesRequest]) (*connect.Response[v1.EntitiesWithRolesResponse], error)
 	GetCommunity(context.Context, *connect.Request[v1.GetCommunityRequest]) (*connect.Response[v1.GetCommunityResponse], error)
 	ListCommunities(context.Context, *connect.Request[v1.ListCommunitiesRequest]) (*connect.Response[v1.ListCommunitiesResponse], error)
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
 	ListCommunitiesByEvent(context.Context, *connect.Request[v1.ListCommunitiesByEventRequest]) (*connect.Response[v1.ListCommunitiesByEventResponse], error)
 	ListCommunitiesByUserRoles(context.Context, *connect.Request[v1.ListCommunitiesByUserRolesRequest]) (*connect.Response[v1.ListCommunitiesByUserRolesResponse], error)
 	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
+	GetEventSeries(context.Context, *connect.Request[v1.GetEventSeriesRequest]) (*connect.Response[v1.GetEventSeriesResponse], error)
 	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
 	ListEventsByUserRoles(context.Context, *connect.Request[v1.ListEventsByUserRolesRequest]) (*connect.Response[v1.ListEventsByUserRolesResponse], error)
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
 	GetPost(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostResponse], error)
 	GetFeedPosts(context.Context, *connect.Request[v1.GetFeedPostsRequest]) (*connect.Response[v1.GetFeedPostsResponse], error)
 	GetChildrenPosts(context.Context, *connect.Request[v1.GetChildrenPostsRequest]) (*connect.Response[v1.GetChildrenPostsResponse], error)
@@ -272,6 +418,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("GetUserInfo")),
 			connect.WithClientOptions(opts...),
 		),
+		getUserCalendarFeed: connect.NewClient[v1.GetUserCalendarFeedRequest, v1.GetUserCalendarFeedResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetUserCalendarFeedProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetUserCalendarFeed")),
+			connect.WithClientOptions(opts...),
+		),
 		createEvent: connect.NewClient[v1.CreateEventRequest, v1.CreateEventResponse](
 			httpClient,
 			baseURL+ZenaoServiceCreateEventProcedure,
@@ -290,6 +442,18 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("EditEvent")),
 			connect.WithClientOptions(opts...),
 		),
+		duplicateEvent: connect.NewClient[v1.DuplicateEventRequest, v1.DuplicateEventResponse](
+			httpClient,
+			baseURL+ZenaoServiceDuplicateEventProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("DuplicateEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		publishEvent: connect.NewClient[v1.PublishEventRequest, v1.PublishEventResponse](
+			httpClient,
+			baseURL+ZenaoServicePublishEventProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("PublishEvent")),
+			connect.WithClientOptions(opts...),
+		),
 		getEventGatekeepers: connect.NewClient[v1.GetEventGatekeepersRequest, v1.GetEventGatekeepersResponse](
 			httpClient,
 			baseURL+ZenaoServiceGetEventGatekeepersProcedure,
@@ -332,6 +496,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("CancelParticipation")),
 			connect.WithClientOptions(opts...),
 		),
+		transferTicket: connect.NewClient[v1.TransferTicketRequest, v1.TransferTicketResponse](
+			httpClient,
+			baseURL+ZenaoServiceTransferTicketProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("TransferTicket")),
+			connect.WithClientOptions(opts...),
+		),
 		getEventTickets: connect.NewClient[v1.GetEventTicketsRequest, v1.GetEventTicketsResponse](
 			httpClient,
 			baseURL+ZenaoServiceGetEventTicketsProcedure,
@@ -350,6 +520,144 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("GetOrderDetails")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrderInvoice: connect.NewClient[v1.GetOrderInvoiceRequest, v1.GetOrderInvoiceResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetOrderInvoiceProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetOrderInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		refundOrder: connect.NewClient[v1.RefundOrderRequest, v1.RefundOrderResponse](
+			httpClient,
+			baseURL+ZenaoServiceRefundOrderProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("RefundOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		createPromoCode: connect.NewClient[v1.CreatePromoCodeRequest, v1.CreatePromoCodeResponse](
+			httpClient,
+			baseURL+ZenaoServiceCreatePromoCodeProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("CreatePromoCode")),
+			connect.WithClientOptions(opts...),
+		),
+		listPromoCodes: connect.NewClient[v1.ListPromoCodesRequest, v1.ListPromoCodesResponse](
+			httpClient,
+			baseURL+ZenaoServiceListPromoCodesProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListPromoCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePromoCode: connect.NewClient[v1.DeletePromoCodeRequest, v1.DeletePromoCodeResponse](
+			httpClient,
+			baseURL+ZenaoServiceDeletePromoCodeProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("DeletePromoCode")),
+			connect.WithClientOptions(opts...),
+		),
+		joinWaitlist: connect.NewClient[v1.JoinWaitlistRequest, v1.JoinWaitlistResponse](
+			httpClient,
+			baseURL+ZenaoServiceJoinWaitlistProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("JoinWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveWaitlist: connect.NewClient[v1.LeaveWaitlistRequest, v1.LeaveWaitlistResponse](
+			httpClient,
+			baseURL+ZenaoServiceLeaveWaitlistProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("LeaveWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		getEventWaitlist: connect.NewClient[v1.GetEventWaitlistRequest, v1.GetEventWaitlistResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetEventWaitlistProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetEventWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderWaitlist: connect.NewClient[v1.ReorderWaitlistRequest, v1.ReorderWaitlistResponse](
+			httpClient,
+			baseURL+ZenaoServiceReorderWaitlistProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ReorderWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventApplications: connect.NewClient[v1.ListEventApplicationsRequest, v1.ListEventApplicationsResponse](
+			httpClient,
+			baseURL+ZenaoServiceListEventApplicationsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListEventApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		approveEventApplication: connect.NewClient[v1.ApproveEventApplicationRequest, v1.ApproveEventApplicationResponse](
+			httpClient,
+			baseURL+ZenaoServiceApproveEventApplicationProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ApproveEventApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectEventApplication: connect.NewClient[v1.RejectEventApplicationRequest, v1.RejectEventApplicationResponse](
+			httpClient,
+			baseURL+ZenaoServiceRejectEventApplicationProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("RejectEventApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteToEvent: connect.NewClient[v1.InviteToEventRequest, v1.InviteToEventResponse](
+			httpClient,
+			baseURL+ZenaoServiceInviteToEventProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("InviteToEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventInvitations: connect.NewClient[v1.ListEventInvitationsRequest, v1.ListEventInvitationsResponse](
+			httpClient,
+			baseURL+ZenaoServiceListEventInvitationsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListEventInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		resendEventInvitations: connect.NewClient[v1.ResendEventInvitationsRequest, v1.ResendEventInvitationsResponse](
+			httpClient,
+			baseURL+ZenaoServiceResendEventInvitationsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ResendEventInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		getInvitation: connect.NewClient[v1.GetInvitationRequest, v1.GetInvitationResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetInvitationProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		declineInvitation: connect.NewClient[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse](
+			httpClient,
+			baseURL+ZenaoServiceDeclineInvitationProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("DeclineInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		createEventSession: connect.NewClient[v1.CreateEventSessionRequest, v1.CreateEventSessionResponse](
+			httpClient,
+			baseURL+ZenaoServiceCreateEventSessionProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("CreateEventSession")),
+			connect.WithClientOptions(opts...),
+		),
+		editEventSession: connect.NewClient[v1.EditEventSessionRequest, v1.EditEventSessionResponse](
+			httpClient,
+			baseURL+ZenaoServiceEditEventSessionProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("EditEventSession")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteEventSession: connect.NewClient[v1.DeleteEventSessionRequest, v1.DeleteEventSessionResponse](
+			httpClient,
+			baseURL+ZenaoServiceDeleteEventSessionProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("DeleteEventSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventSessions: connect.NewClient[v1.ListEventSessionsRequest, v1.ListEventSessionsResponse](
+			httpClient,
+			baseURL+ZenaoServiceListEventSessionsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListEventSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		registerForSession: connect.NewClient[v1.RegisterForSessionRequest, v1.RegisterForSessionResponse](
+			httpClient,
+			baseURL+ZenaoServiceRegisterForSessionProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("RegisterForSession")),
+			connect.WithClientOptions(opts...),
+		),
+		unregisterFromSession: connect.NewClient[v1.UnregisterFromSessionRequest, v1.UnregisterFromSessionResponse](
+			httpClient,
+			baseURL+ZenaoServiceUnregisterFromSessionProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("UnregisterFromSession")),
+			connect.WithClientOptions(opts...),
+		),
 		checkin: connect.NewClient[v1.CheckinRequest, v1.CheckinResponse](
 			httpClient,
 			baseURL+ZenaoServiceCheckinProcedure,
@@ -392,6 +700,30 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("GetCommunityPayoutStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getCommunityLegalDetails: connect.NewClient[v1.GetCommunityLegalDetailsRequest, v1.GetCommunityLegalDetailsResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetCommunityLegalDetailsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetCommunityLegalDetails")),
+			connect.WithClientOptions(opts...),
+		),
+		editCommunityLegalDetails: connect.NewClient[v1.EditCommunityLegalDetailsRequest, v1.EditCommunityLegalDetailsResponse](
+			httpClient,
+			baseURL+ZenaoServiceEditCommunityLegalDetailsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("EditCommunityLegalDetails")),
+			connect.WithClientOptions(opts...),
+		),
+		getCommunitySalesReport: connect.NewClient[v1.GetCommunitySalesReportRequest, v1.GetCommunitySalesReportResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetCommunitySalesReportProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetCommunitySalesReport")),
+			connect.WithClientOptions(opts...),
+		),
+		exportCommunitySalesReport: connect.NewClient[v1.ExportCommunitySalesReportRequest, v1.ExportCommunitySalesReportResponse](
+			httpClient,
+			baseURL+ZenaoServiceExportCommunitySalesReportProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ExportCommunitySalesReport")),
+			connect.WithClientOptions(opts...),
+		),
 		getCommunityAdministrators: connect.NewClient[v1.GetCommunityAdministratorsRequest, v1.GetCommunityAdministratorsResponse](
 			httpClient,
 			baseURL+ZenaoServiceGetCommunityAdministratorsProcedure,
@@ -428,6 +760,24 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("RemoveEventFromCommunity")),
 			connect.WithClientOptions(opts...),
 		),
+		createEventTemplate: connect.NewClient[v1.CreateEventTemplateRequest, v1.CreateEventTemplateResponse](
+			httpClient,
+			baseURL+ZenaoServiceCreateEventTemplateProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("CreateEventTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventTemplates: connect.NewClient[v1.ListEventTemplatesRequest, v1.ListEventTemplatesResponse](
+			httpClient,
+			baseURL+ZenaoServiceListEventTemplatesProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListEventTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteEventTemplate: connect.NewClient[v1.DeleteEventTemplateRequest, v1.DeleteEventTemplateResponse](
+			httpClient,
+			baseURL+ZenaoServiceDeleteEventTemplateProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("DeleteEventTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 		createTeam: connect.NewClient[v1.CreateTeamRequest, v1.CreateTeamResponse](
 			httpClient,
 			baseURL+ZenaoServiceCreateTeamProcedure,
@@ -482,6 +832,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("ListCommunities")),
 			connect.WithClientOptions(opts...),
 		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+ZenaoServiceListTagsProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
 		listCommunitiesByEvent: connect.NewClient[v1.ListCommunitiesByEventRequest, v1.ListCommunitiesByEventResponse](
 			httpClient,
 			baseURL+ZenaoServiceListCommunitiesByEventProcedure,
@@ -500,6 +856,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("GetEvent")),
 			connect.WithClientOptions(opts...),
 		),
+		getEventSeries: connect.NewClient[v1.GetEventSeriesRequest, v1.GetEventSeriesResponse](
+			httpClient,
+			baseURL+ZenaoServiceGetEventSeriesProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("GetEventSeries")),
+			connect.WithClientOptions(opts...),
+		),
 		listEvents: connect.NewClient[v1.ListEventsRequest, v1.ListEventsResponse](
 			httpClient,
 			baseURL+ZenaoServiceListEventsProcedure,
@@ -512,6 +874,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(zenaoServiceMethods.ByName("ListEventsByUserRoles")),
 			connect.WithClientOptions(opts...),
 		),
+		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+ZenaoServiceSearchProcedure,
+			connect.WithSchema(zenaoServiceMethods.ByName("Search")),
+			connect.WithClientOptions(opts...),
+		),
 		getPost: connect.NewClient[v1.GetPostRequest, v1.GetPostResponse](
 			httpClient,
 			baseURL+ZenaoServiceGetPostProcedure,
@@ -597,9 +965,12 @@ func NewZenaoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 type zenaoServiceClient struct {
 	editUser                       *connect.Client[v1.EditUserRequest, v1.EditUserResponse]
 	getUserInfo                    *connect.Client[v1.GetUserInfoRequest, v1.GetUserInfoResponse]
+	getUserCalendarFeed            *connect.Client[v1.GetUserCalendarFeedRequest, v1.GetUserCalendarFeedResponse]
 	createEvent                    *connect.Client[v1.CreateEventRequest, v1.CreateEventResponse]
 	cancelEvent                    *connect.Client[v1.CancelEventRequest, v1.CancelEventResponse]
 	editEvent                      *connect.Client[v1.EditEventRequest, v1.EditEventResponse]
+	duplicateEvent                 *connect.Client[v1.DuplicateEventRequest, v1.DuplicateEventResponse]
+	publishEvent                   *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
 	getEventGatekeepers            *connect.Client[v1.GetEventGatekeepersRequest, v1.GetEventGatekeepersResponse]
 	validatePassword               *connect.Client[v1.ValidatePasswordRequest, v1.ValidatePasswordResponse]
 	broadcastEvent                 *connect.Client[v1.BroadcastEventRequest, v1.BroadcastEventResponse]
@@ -607,9 +978,33 @@ type zenaoServiceClient struct {
 	startTicketPayment             *connect.Client[v1.StartTicketPaymentRequest, v1.StartTicketPaymentResponse]
 	confirmTicketPayment           *connect.Client[v1.ConfirmTicketPaymentRequest, v1.ConfirmTicketPaymentResponse]
 	cancelParticipation            *connect.Client[v1.CancelParticipationRequest, v1.CancelParticipationResponse]
+	transferTicket                 *connect.Client[v1.TransferTicketRequest, v1.TransferTicketResponse]
 	getEventTickets                *connect.Client[v1.GetEventTicketsRequest, v1.GetEventTicketsResponse]
 	getUserOrders                  *connect.Client[v1.GetUserOrdersRequest, v1.GetUserOrdersResponse]
 	getOrderDetails                *connect.Client[v1.GetOrderDetailsRequest, v1.GetOrderDetailsResponse]
+	getOrderInvoice                *connect.Client[v1.GetOrderInvoiceRequest, v1.GetOrderInvoiceResponse]
+	refundOrder                    *connect.Client[v1.RefundOrderRequest, v1.RefundOrderResponse]
+	createPromoCode                *connect.Client[v1.CreatePromoCodeRequest, v1.CreatePromoCodeResponse]
+	listPromoCodes                 *connect.Client[v1.ListPromoCodesRequest, v1.ListPromoCodesResponse]
+	deletePromoCode                *connect.Client[v1.DeletePromoCodeRequest, v1.DeletePromoCodeResponse]
+	joinWaitlist                   *connect.Client[v1.JoinWaitlistRequest, v1.JoinWaitlistResponse]
+	leaveWaitlist                  *connect.Client[v1.LeaveWaitlistRequest, v1.LeaveWaitlistResponse]
+	getEventWaitlist               *connect.Client[v1.GetEventWaitlistRequest, v1.GetEventWaitlistResponse]
+	reorderWaitlist                *connect.Client[v1.ReorderWaitlistRequest, v1.ReorderWaitlistResponse]
+	listEventApplications          *connect.Client[v1.ListEventApplicationsRequest, v1.ListEventApplicationsResponse]
+	approveEventApplication        *connect.Client[v1.ApproveEventApplicationRequest, v1.ApproveEventApplicationResponse]
+	rejectEventApplication         *connect.Client[v1.RejectEventApplicationRequest, v1.RejectEventApplicationResponse]
+	inviteToEvent                  *connect.Client[v1.InviteToEventRequest, v1.InviteToEventResponse]
+	listEventInvitations           *connect.Client[v1.ListEventInvitationsRequest, v1.ListEventInvitationsResponse]
+	resendEventInvitations         *connect.Client[v1.ResendEventInvitationsRequest, v1.ResendEventInvitationsResponse]
+	getInvitation                  *connect.Client[v1.GetInvitationRequest, v1.GetInvitationResponse]
+	declineInvitation              *connect.Client[v1.DeclineInvitationRequest, v1.DeclineInvitationResponse]
+	createEventSession             *connect.Client[v1.CreateEventSessionRequest, v1.CreateEventSessionResponse]
+	editEventSession               *connect.Client[v1.EditEventSessionRequest, v1.EditEventSessionResponse]
+	deleteEventSession             *connect.Client[v1.DeleteEventSessionRequest, v1.DeleteEventSessionResponse]
+	listEventSessions              *connect.Client[v1.ListEventSessionsRequest, v1.ListEventSessionsResponse]
+	registerForSession             *connect.Client[v1.RegisterForSessionRequest, v1.RegisterForSessionResponse]
+	unregisterFromSession          *connect.Client[v1.UnregisterFromSessionRequest, v1.UnregisterFromSessionResponse]
 	checkin                        *connect.Client[v1.CheckinRequest, v1.CheckinResponse]
 	exportParticipants             *connect.Client[v1.ExportParticipantsRequest, v1.ExportParticipantsResponse]
 	removeParticipant              *connect.Client[v1.RemoveParticipantRequest, v1.RemoveParticipantResponse]
@@ -617,12 +1012,19 @@ type zenaoServiceClient struct {
 	editCommunity                  *connect.Client[v1.EditCommunityRequest, v1.EditCommunityResponse]
 	startCommunityStripeOnboarding *connect.Client[v1.StartCommunityStripeOnboardingRequest, v1.StartCommunityStripeOnboardingResponse]
 	getCommunityPayoutStatus       *connect.Client[v1.GetCommunityPayoutStatusRequest, v1.GetCommunityPayoutStatusResponse]
+	getCommunityLegalDetails       *connect.Client[v1.GetCommunityLegalDetailsRequest, v1.GetCommunityLegalDetailsResponse]
+	editCommunityLegalDetails      *connect.Client[v1.EditCommunityLegalDetailsRequest, v1.EditCommunityLegalDetailsResponse]
+	getCommunitySalesReport        *connect.Client[v1.GetCommunitySalesReportRequest, v1.GetCommunitySalesReportResponse]
+	exportCommunitySalesReport     *connect.Client[v1.ExportCommunitySalesReportRequest, v1.ExportCommunitySalesReportResponse]
 	getCommunityAdministrators     *connect.Client[v1.GetCommunityAdministratorsRequest, v1.GetCommunityAdministratorsResponse]
 	joinCommunity                  *connect.Client[v1.JoinCommunityRequest, v1.JoinCommunityResponse]
 	leaveCommunity                 *connect.Client[v1.LeaveCommunityRequest, v1.LeaveCommunityResponse]
 	removeCommunityMember          *connect.Client[v1.RemoveCommunityMemberRequest, v1.RemoveCommunityMemberResponse]
 	addEventToCommunity            *connect.Client[v1.AddEventToCommunityRequest, v1.AddEventToCommunityResponse]
 	removeEventFromCommunity       *connect.Client[v1.RemoveEventFromCommunityRequest, v1.RemoveEventFromCommunityResponse]
+	createEventTemplate            *connect.Client[v1.CreateEventTemplateRequest, v1.CreateEventTemplateResponse]
+	listEventTemplates             *connect.Client[v1.ListEventTemplatesRequest, v1.ListEventTemplatesResponse]
+	deleteEventTemplate            *connect.Client[v1.DeleteEventTemplateRequest, v1.DeleteEventTemplateResponse]
 	createTeam                     *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
 	editTeam                       *connect.Client[v1.EditTeamRequest, v1.EditTeamResponse]
 	deleteTeam                     *connect.Client[v1.DeleteTeamRequest, v1.DeleteTeamResponse]
@@ -632,11 +1034,14 @@ type zenaoServiceClient struct {
 	entitiesWithRoles              *connect.Client[v1.EntitiesWithRolesRequest, v1.EntitiesWithRolesResponse]
 	getCommunity                   *connect.Client[v1.GetCommunityRequest, v1.GetCommunityResponse]
 	listCommunities                *connect.Client[v1.ListCommunitiesRequest, v1.ListCommunitiesResponse]
+	listTags                       *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
 	listCommunitiesByEvent         *connect.Client[v1.ListCommunitiesByEventRequest, v1.ListCommunitiesByEventResponse]
 	listCommunitiesByUserRoles     *connect.Client[v1.ListCommunitiesByUserRolesRequest, v1.ListCommunitiesByUserRolesResponse]
 	getEvent                       *connect.Client[v1.GetEventRequest, v1.GetEventResponse]
+	getEventSeries                 *connect.Client[v1.GetEventSeriesRequest, v1.GetEventSeriesResponse]
 	listEvents                     *connect.Client[v1.ListEventsRequest, v1.ListEventsResponse]
 	listEventsByUserRoles          *connect.Client[v1.ListEventsByUserRolesRequest, v1.ListEventsByUserRolesResponse]
+	search                         *connect.Client[v1.SearchRequest, v1.SearchResponse]
 	getPost                        *connect.Client[v1.GetPostRequest, v1.GetPostResponse]
 	getFeedPosts                   *connect.Client[v1.GetFeedPostsRequest, v1.GetFeedPostsResponse]
 	getChildrenPosts               *connect.Client[v1.GetChildrenPostsRequest, v1.GetChildrenPostsResponse]
@@ -662,6 +1067,11 @@ func (c *zenaoServiceClient) GetUserInfo(ctx context.Context, req *connect.Reque
 	return c.getUserInfo.CallUnary(ctx, req)
 }
 
+// GetUserCalendarFeed calls zenao.v1.ZenaoService.GetUserCalendarFeed.
+func (c *zenaoServiceClient) GetUserCalendarFeed(ctx context.Context, req *connect.Request[v1.GetUserCalendarFeedRequest]) (*connect.Response[v1.GetUserCalendarFeedResponse], error) {
+	return c.getUserCalendarFeed.CallUnary(ctx, req)
+}
+
 // CreateEvent calls zenao.v1.ZenaoService.CreateEvent.
 func (c *zenaoServiceClient) CreateEvent(ctx context.Context, req *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error) {
 	return c.createEvent.CallUnary(ctx, req)
@@ -677,6 +1087,16 @@ func (c *zenaoServiceClient) EditEvent(ctx context.Context, req *connect.Request
 	return c.editEvent.CallUnary(ctx, req)
 }
 
+// DuplicateEvent calls zenao.v1.ZenaoService.DuplicateEvent.
+func (c *zenaoServiceClient) DuplicateEvent(ctx context.Context, req *connect.Request[v1.DuplicateEventRequest]) (*connect.Response[v1.DuplicateEventResponse], error) {
+	return c.duplicateEvent.CallUnary(ctx, req)
+}
+
+// PublishEvent calls zenao.v1.ZenaoService.PublishEvent.
+func (c *zenaoServiceClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return c.publishEvent.CallUnary(ctx, req)
+}
+
 // GetEventGatekeepers calls zenao.v1.ZenaoService.GetEventGatekeepers.
 func (c *zenaoServiceClient) GetEventGatekeepers(ctx context.Context, req *connect.Request[v1.GetEventGatekeepersRequest]) (*connect.Response[v1.GetEventGatekeepersResponse], error) {
 	return c.getEventGatekeepers.CallUnary(ctx, req)
@@ -712,6 +1132,11 @@ func (c *zenaoServiceClient) CancelParticipation(ctx context.Context, req *conne
 	return c.cancelParticipation.CallUnary(ctx, req)
 }
 
+// TransferTicket calls zenao.v1.ZenaoService.TransferTicket.
+func (c *zenaoServiceClient) TransferTicket(ctx context.Context, req *connect.Request[v1.TransferTicketRequest]) (*connect.Response[v1.TransferTicketResponse], error) {
+	return c.transferTicket.CallUnary(ctx, req)
+}
+
 // GetEventTickets calls zenao.v1.ZenaoService.GetEventTickets.
 func (c *zenaoServiceClient) GetEventTickets(ctx context.Context, req *connect.Request[v1.GetEventTicketsRequest]) (*connect.Response[v1.GetEventTicketsResponse], error) {
 	return c.getEventTickets.CallUnary(ctx, req)
@@ -727,6 +1152,121 @@ func (c *zenaoServiceClient) GetOrderDetails(ctx context.Context, req *connect.R
 	return c.getOrderDetails.CallUnary(ctx, req)
 }
 
+// GetOrderInvoice calls zenao.v1.ZenaoService.GetOrderInvoice.
+func (c *zenaoServiceClient) GetOrderInvoice(ctx context.Context, req *connect.Request[v1.GetOrderInvoiceRequest]) (*connect.Response[v1.GetOrderInvoiceResponse], error) {
+	return c.getOrderInvoice.CallUnary(ctx, req)
+}
+
+// RefundOrder calls zenao.v1.ZenaoService.RefundOrder.
+func (c *zenaoServiceClient) RefundOrder(ctx context.Context, req *connect.Request[v1.RefundOrderRequest]) (*connect.Response[v1.RefundOrderResponse], error) {
+	return c.refundOrder.CallUnary(ctx, req)
+}
+
+// CreatePromoCode calls zenao.v1.ZenaoService.CreatePromoCode.
+func (c *zenaoServiceClient) CreatePromoCode(ctx context.Context, req *connect.Request[v1.CreatePromoCodeRequest]) (*connect.Response[v1.CreatePromoCodeResponse], error) {
+	return c.createPromoCode.CallUnary(ctx, req)
+}
+
+// ListPromoCodes calls zenao.v1.ZenaoService.ListPromoCodes.
+func (c *zenaoServiceClient) ListPromoCodes(ctx context.Context, req *connect.Request[v1.ListPromoCodesRequest]) (*connect.Response[v1.ListPromoCodesResponse], error) {
+	return c.listPromoCodes.CallUnary(ctx, req)
+}
+
+// DeletePromoCode calls zenao.v1.ZenaoService.DeletePromoCode.
+func (c *zenaoServiceClient) DeletePromoCode(ctx context.Context, req *connect.Request[v1.DeletePromoCodeRequest]) (*connect.Response[v1.DeletePromoCodeResponse], error) {
+	return c.deletePromoCode.CallUnary(ctx, req)
+}
+
+// JoinWaitlist calls zenao.v1.ZenaoService.JoinWaitlist.
+func (c *zenaoServiceClient) JoinWaitlist(ctx context.Context, req *connect.Request[v1.JoinWaitlistRequest]) (*connect.Response[v1.JoinWaitlistResponse], error) {
+	return c.joinWaitlist.CallUnary(ctx, req)
+}
+
+// LeaveWaitlist calls zenao.v1.ZenaoService.LeaveWaitlist.
+func (c *zenaoServiceClient) LeaveWaitlist(ctx context.Context, req *connect.Request[v1.LeaveWaitlistRequest]) (*connect.Response[v1.LeaveWaitlistResponse], error) {
+	return c.leaveWaitlist.CallUnary(ctx, req)
+}
+
+// GetEventWaitlist calls zenao.v1.ZenaoService.GetEventWaitlist.
+func (c *zenaoServiceClient) GetEventWaitlist(ctx context.Context, req *connect.Request[v1.GetEventWaitlistRequest]) (*connect.Response[v1.GetEventWaitlistResponse], error) {
+	return c.getEventWaitlist.CallUnary(ctx, req)
+}
+
+// ReorderWaitlist calls zenao.v1.ZenaoService.ReorderWaitlist.
+func (c *zenaoServiceClient) ReorderWaitlist(ctx context.Context, req *connect.Request[v1.ReorderWaitlistRequest]) (*connect.Response[v1.ReorderWaitlistResponse], error) {
+	return c.reorderWaitlist.CallUnary(ctx, req)
+}
+
+// ListEventApplications calls zenao.v1.ZenaoService.ListEventApplications.
+func (c *zenaoServiceClient) ListEventApplications(ctx context.Context, req *connect.Request[v1.ListEventApplicationsRequest]) (*connect.Response[v1.ListEventApplicationsResponse], error) {
+	return c.listEventApplications.CallUnary(ctx, req)
+}
+
+// ApproveEventApplication calls zenao.v1.ZenaoService.ApproveEventApplication.
+func (c *zenaoServiceClient) ApproveEventApplication(ctx context.Context, req *connect.Request[v1.ApproveEventApplicationRequest]) (*connect.Response[v1.ApproveEventApplicationResponse], error) {
+	return c.approveEventApplication.CallUnary(ctx, req)
+}
+
+// RejectEventApplication calls zenao.v1.ZenaoService.RejectEventApplication.
+func (c *zenaoServiceClient) RejectEventApplication(ctx context.Context, req *connect.Request[v1.RejectEventApplicationRequest]) (*connect.Response[v1.RejectEventApplicationResponse], error) {
+	return c.rejectEventApplication.CallUnary(ctx, req)
+}
+
+// InviteToEvent calls zenao.v1.ZenaoService.InviteToEvent.
+func (c *zenaoServiceClient) InviteToEvent(ctx context.Context, req *connect.Request[v1.InviteToEventRequest]) (*connect.Response[v1.InviteToEventResponse], error) {
+	return c.inviteToEvent.CallUnary(ctx, req)
+}
+
+// ListEventInvitations calls zenao.v1.ZenaoService.ListEventInvitations.
+func (c *zenaoServiceClient) ListEventInvitations(ctx context.Context, req *connect.Request[v1.ListEventInvitationsRequest]) (*connect.Response[v1.ListEventInvitationsResponse], error) {
+	return c.listEventInvitations.CallUnary(ctx, req)
+}
+
+// ResendEventInvitations calls zenao.v1.ZenaoService.ResendEventInvitations.
+func (c *zenaoServiceClient) ResendEventInvitations(ctx context.Context, req *connect.Request[v1.ResendEventInvitationsRequest]) (*connect.Response[v1.ResendEventInvitationsResponse], error) {
+	return c.resendEventInvitations.CallUnary(ctx, req)
+}
+
+// GetInvitation calls zenao.v1.ZenaoService.GetInvitation.
+func (c *zenaoServiceClient) GetInvitation(ctx context.Context, req *connect.Request[v1.GetInvitationRequest]) (*connect.Response[v1.GetInvitationResponse], error) {
+	return c.getInvitation.CallUnary(ctx, req)
+}
+
+// DeclineInvitation calls zenao.v1.ZenaoService.DeclineInvitation.
+func (c *zenaoServiceClient) DeclineInvitation(ctx context.Context, req *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return c.declineInvitation.CallUnary(ctx, req)
+}
+
+// CreateEventSession calls zenao.v1.ZenaoService.CreateEventSession.
+func (c *zenaoServiceClient) CreateEventSession(ctx context.Context, req *connect.Request[v1.CreateEventSessionRequest]) (*connect.Response[v1.CreateEventSessionResponse], error) {
+	return c.createEventSession.CallUnary(ctx, req)
+}
+
+// EditEventSession calls zenao.v1.ZenaoService.EditEventSession.
+func (c *zenaoServiceClient) EditEventSession(ctx context.Context, req *connect.Request[v1.EditEventSessionRequest]) (*connect.Response[v1.EditEventSessionResponse], error) {
+	return c.editEventSession.CallUnary(ctx, req)
+}
+
+// DeleteEventSession calls zenao.v1.ZenaoService.DeleteEventSession.
+func (c *zenaoServiceClient) DeleteEventSession(ctx context.Context, req *connect.Request[v1.DeleteEventSessionRequest]) (*connect.Response[v1.DeleteEventSessionResponse], error) {
+	return c.deleteEventSession.CallUnary(ctx, req)
+}
+
+// ListEventSessions calls zenao.v1.ZenaoService.ListEventSessions.
+func (c *zenaoServiceClient) ListEventSessions(ctx context.Context, req *connect.Request[v1.ListEventSessionsRequest]) (*connect.Response[v1.ListEventSessionsResponse], error) {
+	return c.listEventSessions.CallUnary(ctx, req)
+}
+
+// RegisterForSession calls zenao.v1.ZenaoService.RegisterForSession.
+func (c *zenaoServiceClient) RegisterForSession(ctx context.Context, req *connect.Request[v1.RegisterForSessionRequest]) (*connect.Response[v1.RegisterForSessionResponse], error) {
+	return c.registerForSession.CallUnary(ctx, req)
+}
+
+// UnregisterFromSession calls zenao.v1.ZenaoService.UnregisterFromSession.
+func (c *zenaoServiceClient) UnregisterFromSession(ctx context.Context, req *connect.Request[v1.UnregisterFromSessionRequest]) (*connect.Response[v1.UnregisterFromSessionResponse], error) {
+	return c.unregisterFromSession.CallUnary(ctx, req)
+}
+
 // Checkin calls zenao.v1.ZenaoService.Checkin.
 func (c *zenaoServiceClient) Checkin(ctx context.Context, req *connect.Request[v1.CheckinRequest]) (*connect.Response[v1.CheckinResponse], error) {
 	return c.checkin.CallUnary(ctx, req)
@@ -762,6 +1302,26 @@ func (c *zenaoServiceClient) GetCommunityPayoutStatus(ctx context.Context, req *
 	return c.getCommunityPayoutStatus.CallUnary(ctx, req)
 }
 
+// GetCommunityLegalDetails calls zenao.v1.ZenaoService.GetCommunityLegalDetails.
+func (c *zenaoServiceClient) GetCommunityLegalDetails(ctx context.Context, req *connect.Request[v1.GetCommunityLegalDetailsRequest]) (*connect.Response[v1.GetCommunityLegalDetailsResponse], error) {
+	return c.getCommunityLegalDetails.CallUnary(ctx, req)
+}
+
+// EditCommunityLegalDetails calls zenao.v1.ZenaoService.EditCommunityLegalDetails.
+func (c *zenaoServiceClient) EditCommunityLegalDetails(ctx context.Context, req *connect.Request[v1.EditCommunityLegalDetailsRequest]) (*connect.Response[v1.EditCommunityLegalDetailsResponse], error) {
+	return c.editCommunityLegalDetails.CallUnary(ctx, req)
+}
+
+// GetCommunitySalesReport calls zenao.v1.ZenaoService.GetCommunitySalesReport.
+func (c *zenaoServiceClient) GetCommunitySalesReport(ctx context.Context, req *connect.Request[v1.GetCommunitySalesReportRequest]) (*connect.Response[v1.GetCommunitySalesReportResponse], error) {
+	return c.getCommunitySalesReport.CallUnary(ctx, req)
+}
+
+// ExportCommunitySalesReport calls zenao.v1.ZenaoService.ExportCommunitySalesReport.
+func (c *zenaoServiceClient) ExportCommunitySalesReport(ctx context.Context, req *connect.Request[v1.ExportCommunitySalesReportRequest]) (*connect.Response[v1.ExportCommunitySalesReportResponse], error) {
+	return c.exportCommunitySalesReport.CallUnary(ctx, req)
+}
+
 // GetCommunityAdministrators calls zenao.v1.ZenaoService.GetCommunityAdministrators.
 func (c *zenaoServiceClient) GetCommunityAdministrators(ctx context.Context, req *connect.Request[v1.GetCommunityAdministratorsRequest]) (*connect.Response[v1.GetCommunityAdministratorsResponse], error) {
 	return c.getCommunityAdministrators.CallUnary(ctx, req)
@@ -792,6 +1352,21 @@ func (c *zenaoServiceClient) RemoveEventFromCommunity(ctx context.Context, req *
 	return c.removeEventFromCommunity.CallUnary(ctx, req)
 }
 
+// CreateEventTemplate calls zenao.v1.ZenaoService.CreateEventTemplate.
+func (c *zenaoServiceClient) CreateEventTemplate(ctx context.Context, req *connect.Request[v1.CreateEventTemplateRequest]) (*connect.Response[v1.CreateEventTemplateResponse], error) {
+	return c.createEventTemplate.CallUnary(ctx, req)
+}
+
+// ListEventTemplates calls zenao.v1.ZenaoService.ListEventTemplates.
+func (c *zenaoServiceClient) ListEventTemplates(ctx context.Context, req *connect.Request[v1.ListEventTemplatesRequest]) (*connect.Response[v1.ListEventTemplatesResponse], error) {
+	return c.listEventTemplates.CallUnary(ctx, req)
+}
+
+// DeleteEventTemplate calls zenao.v1.ZenaoService.DeleteEventTemplate.
+func (c *zenaoServiceClient) DeleteEventTemplate(ctx context.Context, req *connect.Request[v1.DeleteEventTemplateRequest]) (*connect.Response[v1.DeleteEventTemplateResponse], error) {
+	return c.deleteEventTemplate.CallUnary(ctx, req)
+}
+
 // CreateTeam calls zenao.v1.ZenaoService.CreateTeam.
 func (c *zenaoServiceClient) CreateTeam(ctx context.Context, req *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
 	return c.createTeam.CallUnary(ctx, req)
@@ -837,6 +1412,11 @@ func (c *zenaoServiceClient) ListCommunities(ctx context.Context, req *connect.R
 	return c.listCommunities.CallUnary(ctx, req)
 }
 
+// ListTags calls zenao.v1.ZenaoService.ListTags.
+func (c *zenaoServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
 // ListCommunitiesByEvent calls zenao.v1.ZenaoService.ListCommunitiesByEvent.
 func (c *zenaoServiceClient) ListCommunitiesByEvent(ctx context.Context, req *connect.Request[v1.ListCommunitiesByEventRequest]) (*connect.Response[v1.ListCommunitiesByEventResponse], error) {
 	return c.listCommunitiesByEvent.CallUnary(ctx, req)
@@ -852,6 +1432,11 @@ func (c *zenaoServiceClient) GetEvent(ctx context.Context, req *connect.Request[
 	return c.getEvent.CallUnary(ctx, req)
 }
 
+// GetEventSeries calls zenao.v1.ZenaoService.GetEventSeries.
+func (c *zenaoServiceClient) GetEventSeries(ctx context.Context, req *connect.Request[v1.GetEventSeriesRequest]) (*connect.Response[v1.GetEventSeriesResponse], error) {
+	return c.getEventSeries.CallUnary(ctx, req)
+}
+
 // ListEvents calls zenao.v1.ZenaoService.ListEvents.
 func (c *zenaoServiceClient) ListEvents(ctx context.Context, req *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
 	return c.listEvents.CallUnary(ctx, req)
@@ -862,6 +1447,11 @@ func (c *zenaoServiceClient) ListEventsByUserRoles(ctx context.Context, req *con
 	return c.listEventsByUserRoles.CallUnary(ctx, req)
 }
 
+// Search calls zenao.v1.ZenaoService.Search.
+func (c *zenaoServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
 // GetPost calls zenao.v1.ZenaoService.GetPost.
 func (c *zenaoServiceClient) GetPost(ctx context.Context, req *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostResponse], error) {
 	return c.getPost.CallUnary(ctx, req)
@@ -932,10 +1522,13 @@ type ZenaoServiceHandler interface {
 	// USER
 	EditUser(context.Context, *connect.Request[v1.EditUserRequest]) (*connect.Response[v1.EditUserResponse], error)
 	GetUserInfo(context.Context, *connect.Request[v1.GetUserInfoRequest]) (*connect.Response[v1.GetUserInfoResponse], error)
+	GetUserCalendarFeed(context.Context, *connect.Request[v1.GetUserCalendarFeedRequest]) (*connect.Response[v1.GetUserCalendarFeedResponse], error)
 	// EVENT
 	CreateEvent(context.Context, *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error)
 	CancelEvent(context.Context, *connect.Request[v1.CancelEventRequest]) (*connect.Response[v1.CancelEventResponse], error)
 	EditEvent(context.Context, *connect.Request[v1.EditEventRequest]) (*connect.Response[v1.EditEventResponse], error)
+	DuplicateEvent(context.Context, *connect.Request[v1.DuplicateEventRequest]) (*connect.Response[v1.DuplicateEventResponse], error)
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
 	GetEventGatekeepers(context.Context, *connect.Request[v1.GetEventGatekeepersRequest]) (*connect.Response[v1.GetEventGatekeepersResponse], error)
 	ValidatePassword(context.Context, *connect.Request[v1.ValidatePasswordRequest]) (*connect.Response[v1.ValidatePasswordResponse], error)
 	BroadcastEvent(context.Context, *connect.Request[v1.BroadcastEventRequest]) (*connect.Response[v1.BroadcastEventResponse], error)
@@ -943,9 +1536,33 @@ type ZenaoServiceHandler interface {
 	StartTicketPayment(context.Context, *connect.Request[v1.StartTicketPaymentRequest]) (*connect.Response[v1.StartTicketPaymentResponse], error)
 	ConfirmTicketPayment(context.Context, *connect.Request[v1.ConfirmTicketPaymentRequest]) (*connect.Response[v1.ConfirmTicketPaymentResponse], error)
 	CancelParticipation(context.Context, *connect.Request[v1.CancelParticipationRequest]) (*connect.Response[v1.CancelParticipationResponse], error)
+	TransferTicket(context.Context, *connect.Request[v1.TransferTicketRequest]) (*connect.Response[v1.TransferTicketResponse], error)
 	GetEventTickets(context.Context, *connect.Request[v1.GetEventTicketsRequest]) (*connect.Response[v1.GetEventTicketsResponse], error)
 	GetUserOrders(context.Context, *connect.Request[v1.GetUserOrdersRequest]) (*connect.Response[v1.GetUserOrdersResponse], error)
 	GetOrderDetails(context.Context, *connect.Request[v1.GetOrderDetailsRequest]) (*connect.Response[v1.GetOrderDetailsResponse], error)
+	GetOrderInvoice(context.Context, *connect.Request[v1.GetOrderInvoiceRequest]) (*connect.Response[v1.GetOrderInvoiceResponse], error)
+	RefundOrder(context.Context, *connect.Request[v1.RefundOrderRequest]) (*connect.Response[v1.RefundOrderResponse], error)
+	CreatePromoCode(context.Context, *connect.Request[v1.CreatePromoCodeRequest]) (*connect.Response[v1.CreatePromoCodeResponse], error)
+	ListPromoCodes(context.Context, *connect.Request[v1.ListPromoCodesRequest]) (*connect.Response[v1.ListPromoCodesResponse], error)
+	DeletePromoCode(context.Context, *connect.Request[v1.DeletePromoCodeRequest]) (*connect.Response[v1.DeletePromoCodeResponse], error)
+	JoinWaitlist(context.Context, *connect.Request[v1.JoinWaitlistRequest]) (*connect.Response[v1.JoinWaitlistResponse], error)
+	LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistRequest]) (*connect.Response[v1.LeaveWaitlistResponse], error)
+	GetEventWaitlist(context.Context, *connect.Request[v1.GetEventWaitlistRequest]) (*connect.Response[v1.GetEventWaitlistResponse], error)
+	ReorderWaitlist(context.Context, *connect.Request[v1.ReorderWaitlistRequest]) (*connect.Response[v1.ReorderWaitlistResponse], error)
+	ListEventApplications(context.Context, *connect.Request[v1.ListEventApplicationsRequest]) (*connect.Response[v1.ListEventApplicationsResponse], error)
+	ApproveEventApplication(context.Context, *connect.Request[v1.ApproveEventApplicationRequest]) (*connect.Response[v1.ApproveEventApplicationResponse], error)
+	RejectEventApplication(context.Context, *connect.Request[v1.RejectEventApplicationRequest]) (*connect.Response[v1.RejectEventApplicationResponse], error)
+	InviteToEvent(context.Context, *connect.Request[v1.InviteToEventRequest]) (*connect.Response[v1.InviteToEventResponse], error)
+	ListEventInvitations(context.Context, *connect.Request[v1.ListEventInvitationsRequest]) (*connect.Response[v1.ListEventInvitationsResponse], error)
+	ResendEventInvitations(context.Context, *connect.Request[v1.ResendEventInvitationsRequest]) (*connect.Response[v1.ResendEventInvitationsResponse], error)
+	GetInvitation(context.Context, *connect.Request[v1.GetInvitationRequest]) (*connect.Response[v1.GetInvitationResponse], error)
+	DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error)
+	CreateEventSession(context.Context, *connect.Request[v1.CreateEventSessionRequest]) (*connect.Response[v1.CreateEventSessionResponse], error)
+	EditEventSession(context.Context, *connect.Request[v1.EditEventSessionRequest]) (*connect.Response[v1.EditEventSessionResponse], error)
+	DeleteEventSession(context.Context, *connect.Request[v1.DeleteEventSessionRequest]) (*connect.Response[v1.DeleteEventSessionResponse], error)
+	ListEventSessions(context.Context, *connect.Request[v1.ListEventSessionsRequest]) (*connect.Response[v1.ListEventSessionsResponse], error)
+	RegisterForSession(context.Context, *connect.Request[v1.RegisterForSessionRequest]) (*connect.Response[v1.RegisterForSessionResponse], error)
+	UnregisterFromSession(context.Context, *connect.Request[v1.UnregisterFromSessionRequest]) (*connect.Response[v1.UnregisterFromSessionResponse], error)
 	Checkin(context.Context, *connect.Request[v1.CheckinRequest]) (*connect.Response[v1.CheckinResponse], error)
 	ExportParticipants(context.Context, *connect.Request[v1.ExportParticipantsRequest]) (*connect.Response[v1.ExportParticipantsResponse], error)
 	RemoveParticipant(context.Context, *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error)
@@ -954,12 +1571,19 @@ type ZenaoServiceHandler interface {
 	EditCommunity(context.Context, *connect.Request[v1.EditCommunityRequest]) (*connect.Response[v1.EditCommunityResponse], error)
 	StartCommunityStripeOnboarding(context.Context, *connect.Request[v1.StartCommunityStripeOnboardingRequest]) (*connect.Response[v1.StartCommunityStripeOnboardingResponse], error)
 	GetCommunityPayoutStatus(context.Context, *connect.Request[v1.GetCommunityPayoutStatusRequest]) (*connect.Response[v1.GetCommunityPayoutStatusResponse], error)
+	GetCommunityLegalDetails(context.Context, *connect.Request[v1.GetCommunityLegalDetailsRequest]) (*connect.Response[v1.GetCommunityLegalDetailsResponse], error)
+	EditCommunityLegalDetails(context.Context, *connect.Request[v1.EditCommunityLegalDetailsRequest]) (*connect.Response[v1.EditCommunityLegalDetailsResponse], error)
+	GetCommunitySalesReport(context.Context, *connect.Request[v1.GetCommunitySalesReportRequest]) (*connect.Response[v1.GetCommunitySalesReportResponse], error)
+	ExportCommunitySalesReport(context.Context, *connect.Request[v1.ExportCommunitySalesReportRequest]) (*connect.Response[v1.ExportCommunitySalesReportResponse], error)
 	GetCommunityAdministrators(context.Context, *connect.Request[v1.GetCommunityAdministratorsRequest]) (*connect.Response[v1.GetCommunityAdministratorsResponse], error)
 	JoinCommunity(context.Context, *connect.Request[v1.JoinCommunityRequest]) (*connect.Response[v1.JoinCommunityResponse], error)
 	LeaveCommunity(context.Context, *connect.Request[v1.LeaveCommunityRequest]) (*connect.Response[v1.LeaveCommunityResponse], error)
 	RemoveCommunityMember(context.Context, *connect.Request[v1.RemoveCommunityMemberRequest]) (*connect.Response[v1.RemoveCommunityMemberResponse], error)
 	AddEventToCommunity(context.Context, *connect.Request[v1.AddEventToCommunityRequest]) (*connect.Response[v1.AddEventToCommunityResponse], error)
 	RemoveEventFromCommunity(context.Context, *connect.Request[v1.RemoveEventFromCommunityRequest]) (*connect.Response[v1.RemoveEventFromCommunityResponse], error)
+	CreateEventTemplate(context.Context, *connect.Request[v1.CreateEventTemplateRequest]) (*connect.Response[v1.CreateEventTemplateResponse], error)
+	ListEventTemplates(context.Context, *connect.Request[v1.ListEventTemplatesRequest]) (*connect.Response[v1.ListEventTemplatesResponse], error)
+	DeleteEventTemplate(context.Context, *connect.Request[v1.DeleteEventTemplateRequest]) (*connect.Response[v1.DeleteEventTemplateResponse], error)
 	// TEAM
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
 	EditTeam(context.Context, *connect.Request[v1.EditTeamRequest]) (*connect.Response[v1.EditTeamResponse], error)
@@ -971,11 +1595,14 @@ type ZenaoServiceHandler interface {
 	EntitiesWithRoles(context.Context, *connect.Request[v1.EntitiesWithRolesRequest]) (*connect.Response[v1.EntitiesWithRolesResponse], error)
 	GetCommunity(context.Context, *connect.Request[v1.GetCommunityRequest]) (*connect.Response[v1.GetCommunityResponse], error)
 	ListCommunities(context.Context, *connect.Request[v1.ListCommunitiesRequest]) (*connect.Response[v1.ListCommunitiesResponse], error)
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
 	ListCommunitiesByEvent(context.Context, *connect.Request[v1.ListCommunitiesByEventRequest]) (*connect.Response[v1.ListCommunitiesByEventResponse], error)
 	ListCommunitiesByUserRoles(context.Context, *connect.Request[v1.ListCommunitiesByUserRolesRequest]) (*connect.Response[v1.ListCommunitiesByUserRolesResponse], error)
 	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
+	GetEventSeries(context.Context, *connect.Request[v1.GetEventSeriesRequest]) (*connect.Response[v1.GetEventSeriesResponse], error)
 	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
 	ListEventsByUserRoles(context.Context, *connect.Request[v1.ListEventsByUserRolesRequest]) (*connect.Response[v1.ListEventsByUserRolesResponse], error)
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
 	GetPost(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostResponse], error)
 	GetFeedPosts(context.Context, *connect.Request[v1.GetFeedPostsRequest]) (*connect.Response[v1.GetFeedPostsResponse], error)
 	GetChildrenPosts(context.Context, *connect.Request[v1.GetChildrenPostsRequest]) (*connect.Response[v1.GetChildrenPostsResponse], error)
@@ -1012,6 +1639,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("GetUserInfo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceGetUserCalendarFeedHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetUserCalendarFeedProcedure,
+		svc.GetUserCalendarFeed,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetUserCalendarFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceCreateEventHandler := connect.NewUnaryHandler(
 		ZenaoServiceCreateEventProcedure,
 		svc.CreateEvent,
@@ -1030,6 +1663,18 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("EditEvent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceDuplicateEventHandler := connect.NewUnaryHandler(
+		ZenaoServiceDuplicateEventProcedure,
+		svc.DuplicateEvent,
+		connect.WithSchema(zenaoServiceMethods.ByName("DuplicateEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServicePublishEventHandler := connect.NewUnaryHandler(
+		ZenaoServicePublishEventProcedure,
+		svc.PublishEvent,
+		connect.WithSchema(zenaoServiceMethods.ByName("PublishEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceGetEventGatekeepersHandler := connect.NewUnaryHandler(
 		ZenaoServiceGetEventGatekeepersProcedure,
 		svc.GetEventGatekeepers,
@@ -1072,6 +1717,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("CancelParticipation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceTransferTicketHandler := connect.NewUnaryHandler(
+		ZenaoServiceTransferTicketProcedure,
+		svc.TransferTicket,
+		connect.WithSchema(zenaoServiceMethods.ByName("TransferTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceGetEventTicketsHandler := connect.NewUnaryHandler(
 		ZenaoServiceGetEventTicketsProcedure,
 		svc.GetEventTickets,
@@ -1090,6 +1741,144 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("GetOrderDetails")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceGetOrderInvoiceHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetOrderInvoiceProcedure,
+		svc.GetOrderInvoice,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetOrderInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceRefundOrderHandler := connect.NewUnaryHandler(
+		ZenaoServiceRefundOrderProcedure,
+		svc.RefundOrder,
+		connect.WithSchema(zenaoServiceMethods.ByName("RefundOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceCreatePromoCodeHandler := connect.NewUnaryHandler(
+		ZenaoServiceCreatePromoCodeProcedure,
+		svc.CreatePromoCode,
+		connect.WithSchema(zenaoServiceMethods.ByName("CreatePromoCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceListPromoCodesHandler := connect.NewUnaryHandler(
+		ZenaoServiceListPromoCodesProcedure,
+		svc.ListPromoCodes,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListPromoCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceDeletePromoCodeHandler := connect.NewUnaryHandler(
+		ZenaoServiceDeletePromoCodeProcedure,
+		svc.DeletePromoCode,
+		connect.WithSchema(zenaoServiceMethods.ByName("DeletePromoCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceJoinWaitlistHandler := connect.NewUnaryHandler(
+		ZenaoServiceJoinWaitlistProcedure,
+		svc.JoinWaitlist,
+		connect.WithSchema(zenaoServiceMethods.ByName("JoinWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceLeaveWaitlistHandler := connect.NewUnaryHandler(
+		ZenaoServiceLeaveWaitlistProcedure,
+		svc.LeaveWaitlist,
+		connect.WithSchema(zenaoServiceMethods.ByName("LeaveWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceGetEventWaitlistHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetEventWaitlistProcedure,
+		svc.GetEventWaitlist,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetEventWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceReorderWaitlistHandler := connect.NewUnaryHandler(
+		ZenaoServiceReorderWaitlistProcedure,
+		svc.ReorderWaitlist,
+		connect.WithSchema(zenaoServiceMethods.ByName("ReorderWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceListEventApplicationsHandler := connect.NewUnaryHandler(
+		ZenaoServiceListEventApplicationsProcedure,
+		svc.ListEventApplications,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListEventApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceApproveEventApplicationHandler := connect.NewUnaryHandler(
+		ZenaoServiceApproveEventApplicationProcedure,
+		svc.ApproveEventApplication,
+		connect.WithSchema(zenaoServiceMethods.ByName("ApproveEventApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceRejectEventApplicationHandler := connect.NewUnaryHandler(
+		ZenaoServiceRejectEventApplicationProcedure,
+		svc.RejectEventApplication,
+		connect.WithSchema(zenaoServiceMethods.ByName("RejectEventApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceInviteToEventHandler := connect.NewUnaryHandler(
+		ZenaoServiceInviteToEventProcedure,
+		svc.InviteToEvent,
+		connect.WithSchema(zenaoServiceMethods.ByName("InviteToEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceListEventInvitationsHandler := connect.NewUnaryHandler(
+		ZenaoServiceListEventInvitationsProcedure,
+		svc.ListEventInvitations,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListEventInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceResendEventInvitationsHandler := connect.NewUnaryHandler(
+		ZenaoServiceResendEventInvitationsProcedure,
+		svc.ResendEventInvitations,
+		connect.WithSchema(zenaoServiceMethods.ByName("ResendEventInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceGetInvitationHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetInvitationProcedure,
+		svc.GetInvitation,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceDeclineInvitationHandler := connect.NewUnaryHandler(
+		ZenaoServiceDeclineInvitationProcedure,
+		svc.DeclineInvitation,
+		connect.WithSchema(zenaoServiceMethods.ByName("DeclineInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceCreateEventSessionHandler := connect.NewUnaryHandler(
+		ZenaoServiceCreateEventSessionProcedure,
+		svc.CreateEventSession,
+		connect.WithSchema(zenaoServiceMethods.ByName("CreateEventSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceEditEventSessionHandler := connect.NewUnaryHandler(
+		ZenaoServiceEditEventSessionProcedure,
+		svc.EditEventSession,
+		connect.WithSchema(zenaoServiceMethods.ByName("EditEventSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceDeleteEventSessionHandler := connect.NewUnaryHandler(
+		ZenaoServiceDeleteEventSessionProcedure,
+		svc.DeleteEventSession,
+		connect.WithSchema(zenaoServiceMethods.ByName("DeleteEventSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceListEventSessionsHandler := connect.NewUnaryHandler(
+		ZenaoServiceListEventSessionsProcedure,
+		svc.ListEventSessions,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListEventSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceRegisterForSessionHandler := connect.NewUnaryHandler(
+		ZenaoServiceRegisterForSessionProcedure,
+		svc.RegisterForSession,
+		connect.WithSchema(zenaoServiceMethods.ByName("RegisterForSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceUnregisterFromSessionHandler := connect.NewUnaryHandler(
+		ZenaoServiceUnregisterFromSessionProcedure,
+		svc.UnregisterFromSession,
+		connect.WithSchema(zenaoServiceMethods.ByName("UnregisterFromSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceCheckinHandler := connect.NewUnaryHandler(
 		ZenaoServiceCheckinProcedure,
 		svc.Checkin,
@@ -1132,6 +1921,30 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("GetCommunityPayoutStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceGetCommunityLegalDetailsHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetCommunityLegalDetailsProcedure,
+		svc.GetCommunityLegalDetails,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetCommunityLegalDetails")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceEditCommunityLegalDetailsHandler := connect.NewUnaryHandler(
+		ZenaoServiceEditCommunityLegalDetailsProcedure,
+		svc.EditCommunityLegalDetails,
+		connect.WithSchema(zenaoServiceMethods.ByName("EditCommunityLegalDetails")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceGetCommunitySalesReportHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetCommunitySalesReportProcedure,
+		svc.GetCommunitySalesReport,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetCommunitySalesReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceExportCommunitySalesReportHandler := connect.NewUnaryHandler(
+		ZenaoServiceExportCommunitySalesReportProcedure,
+		svc.ExportCommunitySalesReport,
+		connect.WithSchema(zenaoServiceMethods.ByName("ExportCommunitySalesReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceGetCommunityAdministratorsHandler := connect.NewUnaryHandler(
 		ZenaoServiceGetCommunityAdministratorsProcedure,
 		svc.GetCommunityAdministrators,
@@ -1168,6 +1981,24 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("RemoveEventFromCommunity")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceCreateEventTemplateHandler := connect.NewUnaryHandler(
+		ZenaoServiceCreateEventTemplateProcedure,
+		svc.CreateEventTemplate,
+		connect.WithSchema(zenaoServiceMethods.ByName("CreateEventTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceListEventTemplatesHandler := connect.NewUnaryHandler(
+		ZenaoServiceListEventTemplatesProcedure,
+		svc.ListEventTemplates,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListEventTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zenaoServiceDeleteEventTemplateHandler := connect.NewUnaryHandler(
+		ZenaoServiceDeleteEventTemplateProcedure,
+		svc.DeleteEventTemplate,
+		connect.WithSchema(zenaoServiceMethods.ByName("DeleteEventTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceCreateTeamHandler := connect.NewUnaryHandler(
 		ZenaoServiceCreateTeamProcedure,
 		svc.CreateTeam,
@@ -1222,6 +2053,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("ListCommunities")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceListTagsHandler := connect.NewUnaryHandler(
+		ZenaoServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(zenaoServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceListCommunitiesByEventHandler := connect.NewUnaryHandler(
 		ZenaoServiceListCommunitiesByEventProcedure,
 		svc.ListCommunitiesByEvent,
@@ -1240,6 +2077,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("GetEvent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceGetEventSeriesHandler := connect.NewUnaryHandler(
+		ZenaoServiceGetEventSeriesProcedure,
+		svc.GetEventSeries,
+		connect.WithSchema(zenaoServiceMethods.ByName("GetEventSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceListEventsHandler := connect.NewUnaryHandler(
 		ZenaoServiceListEventsProcedure,
 		svc.ListEvents,
@@ -1252,6 +2095,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(zenaoServiceMethods.ByName("ListEventsByUserRoles")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zenaoServiceSearchHandler := connect.NewUnaryHandler(
+		ZenaoServiceSearchProcedure,
+		svc.Search,
+		connect.WithSchema(zenaoServiceMethods.ByName("Search")),
+		connect.WithHandlerOptions(opts...),
+	)
 	zenaoServiceGetPostHandler := connect.NewUnaryHandler(
 		ZenaoServiceGetPostProcedure,
 		svc.GetPost,
@@ -1336,12 +2185,18 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 			zenaoServiceEditUserHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetUserInfoProcedure:
 			zenaoServiceGetUserInfoHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetUserCalendarFeedProcedure:
+			zenaoServiceGetUserCalendarFeedHandler.ServeHTTP(w, r)
 		case ZenaoServiceCreateEventProcedure:
 			zenaoServiceCreateEventHandler.ServeHTTP(w, r)
 		case ZenaoServiceCancelEventProcedure:
 			zenaoServiceCancelEventHandler.ServeHTTP(w, r)
 		case ZenaoServiceEditEventProcedure:
 			zenaoServiceEditEventHandler.ServeHTTP(w, r)
+		case ZenaoServiceDuplicateEventProcedure:
+			zenaoServiceDuplicateEventHandler.ServeHTTP(w, r)
+		case ZenaoServicePublishEventProcedure:
+			zenaoServicePublishEventHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetEventGatekeepersProcedure:
 			zenaoServiceGetEventGatekeepersHandler.ServeHTTP(w, r)
 		case ZenaoServiceValidatePasswordProcedure:
@@ -1356,12 +2211,60 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 			zenaoServiceConfirmTicketPaymentHandler.ServeHTTP(w, r)
 		case ZenaoServiceCancelParticipationProcedure:
 			zenaoServiceCancelParticipationHandler.ServeHTTP(w, r)
+		case ZenaoServiceTransferTicketProcedure:
+			zenaoServiceTransferTicketHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetEventTicketsProcedure:
 			zenaoServiceGetEventTicketsHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetUserOrdersProcedure:
 			zenaoServiceGetUserOrdersHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetOrderDetailsProcedure:
 			zenaoServiceGetOrderDetailsHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetOrderInvoiceProcedure:
+			zenaoServiceGetOrderInvoiceHandler.ServeHTTP(w, r)
+		case ZenaoServiceRefundOrderProcedure:
+			zenaoServiceRefundOrderHandler.ServeHTTP(w, r)
+		case ZenaoServiceCreatePromoCodeProcedure:
+			zenaoServiceCreatePromoCodeHandler.ServeHTTP(w, r)
+		case ZenaoServiceListPromoCodesProcedure:
+			zenaoServiceListPromoCodesHandler.ServeHTTP(w, r)
+		case ZenaoServiceDeletePromoCodeProcedure:
+			zenaoServiceDeletePromoCodeHandler.ServeHTTP(w, r)
+		case ZenaoServiceJoinWaitlistProcedure:
+			zenaoServiceJoinWaitlistHandler.ServeHTTP(w, r)
+		case ZenaoServiceLeaveWaitlistProcedure:
+			zenaoServiceLeaveWaitlistHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetEventWaitlistProcedure:
+			zenaoServiceGetEventWaitlistHandler.ServeHTTP(w, r)
+		case ZenaoServiceReorderWaitlistProcedure:
+			zenaoServiceReorderWaitlistHandler.ServeHTTP(w, r)
+		case ZenaoServiceListEventApplicationsProcedure:
+			zenaoServiceListEventApplicationsHandler.ServeHTTP(w, r)
+		case ZenaoServiceApproveEventApplicationProcedure:
+			zenaoServiceApproveEventApplicationHandler.ServeHTTP(w, r)
+		case ZenaoServiceRejectEventApplicationProcedure:
+			zenaoServiceRejectEventApplicationHandler.ServeHTTP(w, r)
+		case ZenaoServiceInviteToEventProcedure:
+			zenaoServiceInviteToEventHandler.ServeHTTP(w, r)
+		case ZenaoServiceListEventInvitationsProcedure:
+			zenaoServiceListEventInvitationsHandler.ServeHTTP(w, r)
+		case ZenaoServiceResendEventInvitationsProcedure:
+			zenaoServiceResendEventInvitationsHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetInvitationProcedure:
+			zenaoServiceGetInvitationHandler.ServeHTTP(w, r)
+		case ZenaoServiceDeclineInvitationProcedure:
+			zenaoServiceDeclineInvitationHandler.ServeHTTP(w, r)
+		case ZenaoServiceCreateEventSessionProcedure:
+			zenaoServiceCreateEventSessionHandler.ServeHTTP(w, r)
+		case ZenaoServiceEditEventSessionProcedure:
+			zenaoServiceEditEventSessionHandler.ServeHTTP(w, r)
+		case ZenaoServiceDeleteEventSessionProcedure:
+			zenaoServiceDeleteEventSessionHandler.ServeHTTP(w, r)
+		case ZenaoServiceListEventSessionsProcedure:
+			zenaoServiceListEventSessionsHandler.ServeHTTP(w, r)
+		case ZenaoServiceRegisterForSessionProcedure:
+			zenaoServiceRegisterForSessionHandler.ServeHTTP(w, r)
+		case ZenaoServiceUnregisterFromSessionProcedure:
+			zenaoServiceUnregisterFromSessionHandler.ServeHTTP(w, r)
 		case ZenaoServiceCheckinProcedure:
 			zenaoServiceCheckinHandler.ServeHTTP(w, r)
 		case ZenaoServiceExportParticipantsProcedure:
@@ -1376,6 +2279,14 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 			zenaoServiceStartCommunityStripeOnboardingHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetCommunityPayoutStatusProcedure:
 			zenaoServiceGetCommunityPayoutStatusHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetCommunityLegalDetailsProcedure:
+			zenaoServiceGetCommunityLegalDetailsHandler.ServeHTTP(w, r)
+		case ZenaoServiceEditCommunityLegalDetailsProcedure:
+			zenaoServiceEditCommunityLegalDetailsHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetCommunitySalesReportProcedure:
+			zenaoServiceGetCommunitySalesReportHandler.ServeHTTP(w, r)
+		case ZenaoServiceExportCommunitySalesReportProcedure:
+			zenaoServiceExportCommunitySalesReportHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetCommunityAdministratorsProcedure:
 			zenaoServiceGetCommunityAdministratorsHandler.ServeHTTP(w, r)
 		case ZenaoServiceJoinCommunityProcedure:
@@ -1388,6 +2299,12 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 			zenaoServiceAddEventToCommunityHandler.ServeHTTP(w, r)
 		case ZenaoServiceRemoveEventFromCommunityProcedure:
 			zenaoServiceRemoveEventFromCommunityHandler.ServeHTTP(w, r)
+		case ZenaoServiceCreateEventTemplateProcedure:
+			zenaoServiceCreateEventTemplateHandler.ServeHTTP(w, r)
+		case ZenaoServiceListEventTemplatesProcedure:
+			zenaoServiceListEventTemplatesHandler.ServeHTTP(w, r)
+		case ZenaoServiceDeleteEventTemplateProcedure:
+			zenaoServiceDeleteEventTemplateHandler.ServeHTTP(w, r)
 		case ZenaoServiceCreateTeamProcedure:
 			zenaoServiceCreateTeamHandler.ServeHTTP(w, r)
 		case ZenaoServiceEditTeamProcedure:
@@ -1406,16 +2323,22 @@ func NewZenaoServiceHandler(svc ZenaoServiceHandler, opts ...connect.HandlerOpti
 			zenaoServiceGetCommunityHandler.ServeHTTP(w, r)
 		case ZenaoServiceListCommunitiesProcedure:
 			zenaoServiceListCommunitiesHandler.ServeHTTP(w, r)
+		case ZenaoServiceListTagsProcedure:
+			zenaoServiceListTagsHandler.ServeHTTP(w, r)
 		case ZenaoServiceListCommunitiesByEventProcedure:
 			zenaoServiceListCommunitiesByEventHandler.ServeHTTP(w, r)
 		case ZenaoServiceListCommunitiesByUserRolesProcedure:
 			zenaoServiceListCommunitiesByUserRolesHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetEventProcedure:
 			zenaoServiceGetEventHandler.ServeHTTP(w, r)
+		case ZenaoServiceGetEventSeriesProcedure:
+			zenaoServiceGetEventSeriesHandler.ServeHTTP(w, r)
 		case ZenaoServiceListEventsProcedure:
 			zenaoServiceListEventsHandler.ServeHTTP(w, r)
 		case ZenaoServiceListEventsByUserRolesProcedure:
 			zenaoServiceListEventsByUserRolesHandler.ServeHTTP(w, r)
+		case ZenaoServiceSearchProcedure:
+			zenaoServiceSearchHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetPostProcedure:
 			zenaoServiceGetPostHandler.ServeHTTP(w, r)
 		case ZenaoServiceGetFeedPostsProcedure:
@@ -1459,6 +2382,10 @@ func (UnimplementedZenaoServiceHandler) GetUserInfo(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetUserInfo is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) GetUserCalendarFeed(context.Context, *connect.Request[v1.GetUserCalendarFeedRequest]) (*connect.Response[v1.GetUserCalendarFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetUserCalendarFeed is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) CreateEvent(context.Context, *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CreateEvent is not implemented"))
 }
@@ -1471,6 +2398,14 @@ func (UnimplementedZenaoServiceHandler) EditEvent(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.EditEvent is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) DuplicateEvent(context.Context, *connect.Request[v1.DuplicateEventRequest]) (*connect.Response[v1.DuplicateEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.DuplicateEvent is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.PublishEvent is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) GetEventGatekeepers(context.Context, *connect.Request[v1.GetEventGatekeepersRequest]) (*connect.Response[v1.GetEventGatekeepersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetEventGatekeepers is not implemented"))
 }
@@ -1499,6 +2434,10 @@ func (UnimplementedZenaoServiceHandler) CancelParticipation(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CancelParticipation is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) TransferTicket(context.Context, *connect.Request[v1.TransferTicketRequest]) (*connect.Response[v1.TransferTicketResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.TransferTicket is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) GetEventTickets(context.Context, *connect.Request[v1.GetEventTicketsRequest]) (*connect.Response[v1.GetEventTicketsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetEventTickets is not implemented"))
 }
@@ -1511,6 +2450,98 @@ func (UnimplementedZenaoServiceHandler) GetOrderDetails(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetOrderDetails is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) GetOrderInvoice(context.Context, *connect.Request[v1.GetOrderInvoiceRequest]) (*connect.Response[v1.GetOrderInvoiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetOrderInvoice is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) RefundOrder(context.Context, *connect.Request[v1.RefundOrderRequest]) (*connect.Response[v1.RefundOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.RefundOrder is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) CreatePromoCode(context.Context, *connect.Request[v1.CreatePromoCodeRequest]) (*connect.Response[v1.CreatePromoCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CreatePromoCode is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ListPromoCodes(context.Context, *connect.Request[v1.ListPromoCodesRequest]) (*connect.Response[v1.ListPromoCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListPromoCodes is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) DeletePromoCode(context.Context, *connect.Request[v1.DeletePromoCodeRequest]) (*connect.Response[v1.DeletePromoCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.DeletePromoCode is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) JoinWaitlist(context.Context, *connect.Request[v1.JoinWaitlistRequest]) (*connect.Response[v1.JoinWaitlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.JoinWaitlist is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistRequest]) (*connect.Response[v1.LeaveWaitlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.LeaveWaitlist is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) GetEventWaitlist(context.Context, *connect.Request[v1.GetEventWaitlistRequest]) (*connect.Response[v1.GetEventWaitlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetEventWaitlist is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ReorderWaitlist(context.Context, *connect.Request[v1.ReorderWaitlistRequest]) (*connect.Response[v1.ReorderWaitlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ReorderWaitlist is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ListEventApplications(context.Context, *connect.Request[v1.ListEventApplicationsRequest]) (*connect.Response[v1.ListEventApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEventApplications is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ApproveEventApplication(context.Context, *connect.Request[v1.ApproveEventApplicationRequest]) (*connect.Response[v1.ApproveEventApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ApproveEventApplication is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) RejectEventApplication(context.Context, *connect.Request[v1.RejectEventApplicationRequest]) (*connect.Response[v1.RejectEventApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.RejectEventApplication is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) InviteToEvent(context.Context, *connect.Request[v1.InviteToEventRequest]) (*connect.Response[v1.InviteToEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.InviteToEvent is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ListEventInvitations(context.Context, *connect.Request[v1.ListEventInvitationsRequest]) (*connect.Response[v1.ListEventInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEventInvitations is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ResendEventInvitations(context.Context, *connect.Request[v1.ResendEventInvitationsRequest]) (*connect.Response[v1.ResendEventInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ResendEventInvitations is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) GetInvitation(context.Context, *connect.Request[v1.GetInvitationRequest]) (*connect.Response[v1.GetInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetInvitation is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) DeclineInvitation(context.Context, *connect.Request[v1.DeclineInvitationRequest]) (*connect.Response[v1.DeclineInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.DeclineInvitation is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) CreateEventSession(context.Context, *connect.Request[v1.CreateEventSessionRequest]) (*connect.Response[v1.CreateEventSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CreateEventSession is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) EditEventSession(context.Context, *connect.Request[v1.EditEventSessionRequest]) (*connect.Response[v1.EditEventSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.EditEventSession is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) DeleteEventSession(context.Context, *connect.Request[v1.DeleteEventSessionRequest]) (*connect.Response[v1.DeleteEventSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.DeleteEventSession is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ListEventSessions(context.Context, *connect.Request[v1.ListEventSessionsRequest]) (*connect.Response[v1.ListEventSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEventSessions is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) RegisterForSession(context.Context, *connect.Request[v1.RegisterForSessionRequest]) (*connect.Response[v1.RegisterForSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.RegisterForSession is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) UnregisterFromSession(context.Context, *connect.Request[v1.UnregisterFromSessionRequest]) (*connect.Response[v1.UnregisterFromSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.UnregisterFromSession is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) Checkin(context.Context, *connect.Request[v1.CheckinRequest]) (*connect.Response[v1.CheckinResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.Checkin is not implemented"))
 }
@@ -1539,6 +2570,22 @@ func (UnimplementedZenaoServiceHandler) GetCommunityPayoutStatus(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetCommunityPayoutStatus is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) GetCommunityLegalDetails(context.Context, *connect.Request[v1.GetCommunityLegalDetailsRequest]) (*connect.Response[v1.GetCommunityLegalDetailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetCommunityLegalDetails is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) EditCommunityLegalDetails(context.Context, *connect.Request[v1.EditCommunityLegalDetailsRequest]) (*connect.Response[v1.EditCommunityLegalDetailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.EditCommunityLegalDetails is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) GetCommunitySalesReport(context.Context, *connect.Request[v1.GetCommunitySalesReportRequest]) (*connect.Response[v1.GetCommunitySalesReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetCommunitySalesReport is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ExportCommunitySalesReport(context.Context, *connect.Request[v1.ExportCommunitySalesReportRequest]) (*connect.Response[v1.ExportCommunitySalesReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ExportCommunitySalesReport is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) GetCommunityAdministrators(context.Context, *connect.Request[v1.GetCommunityAdministratorsRequest]) (*connect.Response[v1.GetCommunityAdministratorsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetCommunityAdministrators is not implemented"))
 }
@@ -1563,6 +2610,18 @@ func (UnimplementedZenaoServiceHandler) RemoveEventFromCommunity(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.RemoveEventFromCommunity is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) CreateEventTemplate(context.Context, *connect.Request[v1.CreateEventTemplateRequest]) (*connect.Response[v1.CreateEventTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CreateEventTemplate is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) ListEventTemplates(context.Context, *connect.Request[v1.ListEventTemplatesRequest]) (*connect.Response[v1.ListEventTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEventTemplates is not implemented"))
+}
+
+func (UnimplementedZenaoServiceHandler) DeleteEventTemplate(context.Context, *connect.Request[v1.DeleteEventTemplateRequest]) (*connect.Response[v1.DeleteEventTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.DeleteEventTemplate is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.CreateTeam is not implemented"))
 }
@@ -1599,6 +2658,10 @@ func (UnimplementedZenaoServiceHandler) ListCommunities(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListCommunities is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListTags is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) ListCommunitiesByEvent(context.Context, *connect.Request[v1.ListCommunitiesByEventRequest]) (*connect.Response[v1.ListCommunitiesByEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListCommunitiesByEvent is not implemented"))
 }
@@ -1611,6 +2674,10 @@ func (UnimplementedZenaoServiceHandler) GetEvent(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetEvent is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) GetEventSeries(context.Context, *connect.Request[v1.GetEventSeriesRequest]) (*connect.Response[v1.GetEventSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetEventSeries is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEvents is not implemented"))
 }
@@ -1619,6 +2686,10 @@ func (UnimplementedZenaoServiceHandler) ListEventsByUserRoles(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.ListEventsByUserRoles is not implemented"))
 }
 
+func (UnimplementedZenaoServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.Search is not implemented"))
+}
+
 func (UnimplementedZenaoServiceHandler) GetPost(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zenao.v1.ZenaoService.GetPost is not implemented"))
 }
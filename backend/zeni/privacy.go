@@ -34,6 +34,14 @@ func EventPrivacyFromSK(sk ed25519.PrivateKey) (*zenaov1.EventPrivacy, error) {
 	}}}, nil
 }
 
+// EventPrivacyOf returns the privacy of the event, invite-only events can't be guarded by a password.
+func EventPrivacyOf(evt *Event) (*zenaov1.EventPrivacy, error) {
+	if evt.InviteOnly {
+		return &zenaov1.EventPrivacy{EventPrivacy: &zenaov1.EventPrivacy_InviteOnly{InviteOnly: &zenaov1.EventPrivacyInviteOnly{}}}, nil
+	}
+	return EventPrivacyFromPasswordHash(evt.PasswordHash)
+}
+
 func EventPrivacyFromPasswordHash(passwordHash string) (*zenaov1.EventPrivacy, error) {
 	sk, err := EventSKFromPasswordHash(passwordHash)
 	if err != nil {
@@ -0,0 +1,318 @@
+package zeni
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxSeriesOccurrences bounds the number of events a recurrence rule can generate.
+const MaxSeriesOccurrences = 100
+
+type RRuleFreq string
+
+const (
+	RRuleFreqDaily   RRuleFreq = "DAILY"
+	RRuleFreqWeekly  RRuleFreq = "WEEKLY"
+	RRuleFreqMonthly RRuleFreq = "MONTHLY"
+	RRuleFreqYearly  RRuleFreq = "YEARLY"
+)
+
+// RRuleWeekday is a BYDAY entry, N is the ordinal of the weekday in the month (e.g. -1 for the last one)
+// and is 0 when every such weekday matches.
+type RRuleWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// RRule is the subset of RFC 5545 recurrence rules supported by event series:
+// FREQ, INTERVAL, COUNT, UNTIL, BYDAY and BYMONTHDAY, weeks start on monday.
+// A rule must be bounded by COUNT or UNTIL.
+type RRule struct {
+	Freq       RRuleFreq
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []RRuleWeekday
+	ByMonthDay []int
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRRule parses a recurrence rule, floating and date-only UNTIL values are read in loc,
+// the timezone of the events of the series.
+// see: https://datatracker.ietf.org/doc/html/rfc5545#section-3.3.10
+func ParseRRule(s string, loc *time.Location) (*RRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, errors.New("empty recurrence rule")
+	}
+
+	rule := &RRule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate recurrence rule part %s", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			switch freq := RRuleFreq(value); freq {
+			case RRuleFreqDaily, RRuleFreqWeekly, RRuleFreqMonthly, RRuleFreqYearly:
+				rule.Freq = freq
+			default:
+				return nil, fmt.Errorf("unsupported recurrence frequency %s", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, errors.New("recurrence interval must be a positive integer")
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, errors.New("recurrence count must be a positive integer")
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRRuleUntil(value, loc)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				if len(day) < 2 {
+					return nil, fmt.Errorf("invalid recurrence weekday %q", day)
+				}
+				weekday, ok := rruleWeekdays[day[len(day)-2:]]
+				if !ok {
+					return nil, fmt.Errorf("invalid recurrence weekday %q", day)
+				}
+				n := 0
+				if ordinal := day[:len(day)-2]; ordinal != "" {
+					var err error
+					n, err = strconv.Atoi(ordinal)
+					if err != nil || n == 0 || n < -5 || n > 5 {
+						return nil, fmt.Errorf("invalid recurrence weekday %q", day)
+					}
+				}
+				rule.ByDay = append(rule.ByDay, RRuleWeekday{Weekday: weekday, N: n})
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(day)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid recurrence month day %q", day)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %s", name)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("recurrence frequency is required")
+	}
+	if rule.Count != 0 && rule.Until != nil {
+		return nil, errors.New("recurrence count and until cannot be combined")
+	}
+	if rule.Count == 0 && rule.Until == nil {
+		return nil, errors.New("recurrence rule must have a count or an until date")
+	}
+	if rule.Count > MaxSeriesOccurrences {
+		return nil, fmt.Errorf("recurrence rule cannot have more than %d occurrences", MaxSeriesOccurrences)
+	}
+	if len(rule.ByMonthDay) != 0 && rule.Freq != RRuleFreqMonthly {
+		return nil, errors.New("BYMONTHDAY is only supported with a monthly frequency")
+	}
+	if len(rule.ByMonthDay) != 0 && len(rule.ByDay) != 0 {
+		return nil, errors.New("BYDAY and BYMONTHDAY cannot be combined")
+	}
+	for _, day := range rule.ByDay {
+		if rule.Freq == RRuleFreqDaily || rule.Freq == RRuleFreqYearly {
+			return nil, fmt.Errorf("BYDAY is not supported with a %s frequency", strings.ToLower(string(rule.Freq)))
+		}
+		if day.N != 0 && rule.Freq != RRuleFreqMonthly {
+			return nil, errors.New("BYDAY ordinals are only supported with a monthly frequency")
+		}
+	}
+
+	return rule, nil
+}
+
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		// the whole day is included, whatever the daylight saving changes
+		return time.Date(until.Year(), until.Month(), until.Day(), 23, 59, 59, 0, loc), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid recurrence until date %q", value)
+}
+
+// String serializes the rule without the RRULE: prefix, UNTIL is written in UTC.
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if r.Count != 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) != 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			name := strings.ToUpper(day.Weekday.String()[:2])
+			if day.N != 0 {
+				name = strconv.Itoa(day.N) + name
+			}
+			days = append(days, name)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) != 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, day := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands the rule from start, which is always the first occurrence.
+// The wall clock time of start is kept in its location so occurrences follow daylight saving changes.
+// It returns an error when the rule yields more than MaxSeriesOccurrences occurrences.
+func (r *RRule) Occurrences(start time.Time) ([]time.Time, error) {
+	res := []time.Time{start}
+	// bounds the periods scanned, e.g. a rule asking for the 5th monday of every february never matches
+	const maxPeriods = 10000
+	for period := 0; period < maxPeriods; period++ {
+		candidates := r.periodCandidates(start, period*r.Interval)
+		if len(candidates) == 0 {
+			continue
+		}
+		for _, candidate := range candidates {
+			if !candidate.After(start) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return res, nil
+			}
+			if len(res) == MaxSeriesOccurrences {
+				return nil, fmt.Errorf("recurrence rule cannot have more than %d occurrences", MaxSeriesOccurrences)
+			}
+			res = append(res, candidate)
+			if r.Count != 0 && len(res) == r.Count {
+				return res, nil
+			}
+		}
+	}
+	return res, nil
+}
+
+// periodCandidates returns the sorted occurrences of the period that is offset periods after the one of start.
+func (r *RRule) periodCandidates(start time.Time, offset int) []time.Time {
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+	}
+
+	var candidates []time.Time
+	switch r.Freq {
+	case RRuleFreqDaily:
+		candidates = append(candidates, at(start.Year(), start.Month(), start.Day()+offset))
+
+	case RRuleFreqWeekly:
+		// weeks start on monday
+		mondayOffset := (int(start.Weekday()) + 6) % 7
+		weekStart := start.Day() - mondayOffset + offset*7
+		days := []time.Weekday{start.Weekday()}
+		if len(r.ByDay) != 0 {
+			days = days[:0]
+			for _, day := range r.ByDay {
+				days = append(days, day.Weekday)
+			}
+		}
+		for _, weekday := range days {
+			candidates = append(candidates, at(start.Year(), start.Month(), weekStart+(int(weekday)+6)%7))
+		}
+
+	case RRuleFreqMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(offset), 1, 0, 0, 0, 0, start.Location())
+		year, month := first.Year(), first.Month()
+		daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, start.Location()).Day()
+		var days []int
+		switch {
+		case len(r.ByMonthDay) != 0:
+			for _, day := range r.ByMonthDay {
+				if day < 0 {
+					day = daysInMonth + day + 1
+				}
+				days = append(days, day)
+			}
+		case len(r.ByDay) != 0:
+			for _, byDay := range r.ByDay {
+				var matching []int
+				for day := 1; day <= daysInMonth; day++ {
+					if time.Date(year, month, day, 0, 0, 0, 0, start.Location()).Weekday() == byDay.Weekday {
+						matching = append(matching, day)
+					}
+				}
+				switch {
+				case byDay.N == 0:
+					days = append(days, matching...)
+				case byDay.N > 0 && byDay.N <= len(matching):
+					days = append(days, matching[byDay.N-1])
+				case byDay.N < 0 && -byDay.N <= len(matching):
+					days = append(days, matching[len(matching)+byDay.N])
+				}
+			}
+		default:
+			days = append(days, start.Day())
+		}
+		for _, day := range days {
+			// invalid dates like the 31st of a 30 days month are skipped
+			if day >= 1 && day <= daysInMonth {
+				candidates = append(candidates, at(year, month, day))
+			}
+		}
+
+	case RRuleFreqYearly:
+		candidate := at(start.Year()+offset, start.Month(), start.Day())
+		// the 29th of february only happens on leap years
+		if candidate.Month() == start.Month() {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(candidates, func(a, b time.Time) bool { return a.Equal(b) })
+}
@@ -0,0 +1,129 @@
+package zeni_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samouraiworld/zenao/backend/zeni"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRRuleOccurrences(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rrule    string
+		start    time.Time
+		expected []string
+	}{
+		{
+			name:     "byday_ordinals",
+			rrule:    "FREQ=MONTHLY;COUNT=4;BYDAY=2TU,-1FR",
+			start:    time.Date(2026, 1, 13, 19, 0, 0, 0, paris),
+			expected: []string{"2026-01-13 19:00", "2026-01-30 19:00", "2026-02-10 19:00", "2026-02-27 19:00"},
+		},
+		{
+			name:     "byday_fifth_weekday_skips_short_months",
+			rrule:    "FREQ=MONTHLY;COUNT=3;BYDAY=5TH",
+			start:    time.Date(2026, 1, 29, 19, 0, 0, 0, paris),
+			expected: []string{"2026-01-29 19:00", "2026-04-30 19:00", "2026-07-30 19:00"},
+		},
+		{
+			name:     "negative_bymonthday",
+			rrule:    "FREQ=MONTHLY;COUNT=3;BYMONTHDAY=-1",
+			start:    time.Date(2026, 1, 31, 19, 0, 0, 0, paris),
+			expected: []string{"2026-01-31 19:00", "2026-02-28 19:00", "2026-03-31 19:00"},
+		},
+		{
+			name:     "monthly_skips_the_31st",
+			rrule:    "FREQ=MONTHLY;COUNT=3",
+			start:    time.Date(2026, 1, 31, 19, 0, 0, 0, paris),
+			expected: []string{"2026-01-31 19:00", "2026-03-31 19:00", "2026-05-31 19:00"},
+		},
+		{
+			name:     "yearly_skips_february_29",
+			rrule:    "FREQ=YEARLY;COUNT=3",
+			start:    time.Date(2024, 2, 29, 19, 0, 0, 0, paris),
+			expected: []string{"2024-02-29 19:00", "2028-02-29 19:00", "2032-02-29 19:00"},
+		},
+		{
+			name:     "weekly_keeps_wall_clock_across_daylight_saving",
+			rrule:    "FREQ=WEEKLY;COUNT=2",
+			start:    time.Date(2026, 3, 24, 19, 0, 0, 0, paris),
+			expected: []string{"2026-03-24 19:00", "2026-03-31 19:00"},
+		},
+		{
+			name:     "until_utc",
+			rrule:    "FREQ=DAILY;UNTIL=20260303T093000Z",
+			start:    time.Date(2026, 3, 1, 10, 0, 0, 0, paris),
+			expected: []string{"2026-03-01 10:00", "2026-03-02 10:00", "2026-03-03 10:00"},
+		},
+		{
+			name:     "until_floating_in_event_timezone",
+			rrule:    "FREQ=DAILY;UNTIL=20260303T093000",
+			start:    time.Date(2026, 3, 1, 10, 0, 0, 0, paris),
+			expected: []string{"2026-03-01 10:00", "2026-03-02 10:00"},
+		},
+		{
+			name:     "until_date_includes_the_whole_day_in_event_timezone",
+			rrule:    "FREQ=DAILY;UNTIL=20260303",
+			start:    time.Date(2026, 3, 1, 23, 30, 0, 0, newYork),
+			expected: []string{"2026-03-01 23:30", "2026-03-02 23:30", "2026-03-03 23:30"},
+		},
+		{
+			name:     "count_at_the_cap",
+			rrule:    fmt.Sprintf("FREQ=DAILY;COUNT=%d", zeni.MaxSeriesOccurrences),
+			start:    time.Date(2026, 1, 1, 19, 0, 0, 0, paris),
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := zeni.ParseRRule(test.rrule, test.start.Location())
+			require.NoError(t, err)
+			occurrences, err := rule.Occurrences(test.start)
+			require.NoError(t, err)
+			if test.expected == nil {
+				require.Len(t, occurrences, zeni.MaxSeriesOccurrences)
+				return
+			}
+			formatted := make([]string, len(occurrences))
+			for i, occurrence := range occurrences {
+				require.Equal(t, test.start.Location(), occurrence.Location())
+				formatted[i] = occurrence.Format("2006-01-02 15:04")
+			}
+			require.Equal(t, test.expected, formatted)
+		})
+	}
+}
+
+func TestRRuleMaxSeriesOccurrences(t *testing.T) {
+	_, err := zeni.ParseRRule(fmt.Sprintf("FREQ=DAILY;COUNT=%d", zeni.MaxSeriesOccurrences+1), time.UTC)
+	require.ErrorContains(t, err, "more than")
+
+	rule, err := zeni.ParseRRule("FREQ=DAILY;UNTIL=20300101", time.UTC)
+	require.NoError(t, err)
+	_, err = rule.Occurrences(time.Date(2026, 1, 1, 19, 0, 0, 0, time.UTC))
+	require.ErrorContains(t, err, "more than")
+}
+
+func TestParseRRuleUntilRoundTrip(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	rule, err := zeni.ParseRRule("RRULE:FREQ=WEEKLY;UNTIL=20260705T200000;BYDAY=MO,WE", paris)
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=WEEKLY;UNTIL=20260705T180000Z;BYDAY=MO,WE", rule.String())
+
+	// a stored rule is always in UTC, its timezone does not matter anymore
+	reparsed, err := zeni.ParseRRule(rule.String(), time.UTC)
+	require.NoError(t, err)
+	require.True(t, rule.Until.Equal(*reparsed.Until))
+}
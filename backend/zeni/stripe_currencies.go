@@ -15,6 +15,17 @@ var supportedStripeCurrencies = map[string]struct{}{
 	string(stripe.CurrencyJPY): {},
 }
 
+// stripeMinimumChargeAmounts is the lowest amount Stripe can charge in each supported currency, in minor units.
+// See https://docs.stripe.com/currencies#minimum-and-maximum-charge-amounts
+var stripeMinimumChargeAmounts = map[string]int64{
+	string(stripe.CurrencyAUD): 50,
+	string(stripe.CurrencyEUR): 50,
+	string(stripe.CurrencyGBP): 30,
+	string(stripe.CurrencyNZD): 50,
+	string(stripe.CurrencyUSD): 50,
+	string(stripe.CurrencyJPY): 50,
+}
+
 func IsSupportedStripeCurrency(code string) bool {
 	_, ok := supportedStripeCurrencies[strings.ToLower(code)]
 	return ok
@@ -28,3 +39,9 @@ func ListSupportedStripeCurrencies() []string {
 
 	return currencies
 }
+
+// StripeMinimumChargeAmount returns the lowest amount Stripe can charge in the currency, in minor units.
+// It returns 0 for unsupported currencies.
+func StripeMinimumChargeAmount(code string) int64 {
+	return stripeMinimumChargeAmounts[strings.ToLower(code)]
+}
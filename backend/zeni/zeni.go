@@ -83,6 +83,57 @@ type TicketIssueStatus string
 const (
 	TicketIssueStatusIssued TicketIssueStatus = "issued"
 	TicketIssueStatusFailed TicketIssueStatus = "failed"
+	// TicketIssueStatusNeedsAttention is set once the retries gave up, an operator has to issue or refund the tickets
+	TicketIssueStatusNeedsAttention TicketIssueStatus = "needs_attention"
+)
+
+type OrderRefundStatus string
+
+const (
+	OrderRefundStatusPartial  OrderRefundStatus = "partially_refunded"
+	OrderRefundStatusRefunded OrderRefundStatus = "refunded"
+)
+
+type PromoCodeDiscountType string
+
+const (
+	PromoCodeDiscountPercent PromoCodeDiscountType = "percent"
+	PromoCodeDiscountFixed   PromoCodeDiscountType = "fixed"
+)
+
+// PriceKind tells how the amount of a price is set, an empty kind is a fixed price.
+type PriceKind string
+
+const (
+	PriceKindFixed PriceKind = ""
+	// PriceKindPayWhatYouWant lets buyers choose an amount above the minimum, the price amount is the suggested one.
+	PriceKindPayWhatYouWant PriceKind = "pay_what_you_want"
+)
+
+type WaitlistEntryStatus string
+
+const (
+	WaitlistEntryStatusWaiting WaitlistEntryStatus = "waiting"
+	WaitlistEntryStatusOffered WaitlistEntryStatus = "offered"
+	WaitlistEntryStatusClaimed WaitlistEntryStatus = "claimed"
+	WaitlistEntryStatusExpired WaitlistEntryStatus = "expired"
+)
+
+type EventApplicationStatus string
+
+const (
+	EventApplicationStatusPending  EventApplicationStatus = "pending"
+	EventApplicationStatusApproved EventApplicationStatus = "approved"
+	EventApplicationStatusRejected EventApplicationStatus = "rejected"
+)
+
+type EventInvitationStatus string
+
+const (
+	EventInvitationStatusInvited  EventInvitationStatus = "invited"
+	EventInvitationStatusOpened   EventInvitationStatus = "opened"
+	EventInvitationStatusAccepted EventInvitationStatus = "accepted"
+	EventInvitationStatusDeclined EventInvitationStatus = "declined"
 )
 
 func IsValidEventRole(role string) bool {
@@ -142,6 +193,65 @@ type Event struct {
 	PasswordHash      string
 	ICSSequenceNumber uint32
 	Discoverable      bool
+	// TicketTransfersDisabled prevents participants from handing their ticket to someone else
+	TicketTransfersDisabled bool
+	// SeriesID is set when the event is an occurrence of a recurring series
+	SeriesID string
+	// SeriesRecurrenceAt is the start date the series rule gave to this occurrence, it identifies
+	// the occurrence in calendars even after its start date is edited
+	SeriesRecurrenceAt time.Time
+	// ApprovalRequired makes participants apply, organizers review applications before tickets are issued
+	ApprovalRequired bool
+	// InviteOnly events can only be joined with an invitation sent by their organizers
+	InviteOnly bool
+	// ReminderOffsets are the seconds before StartDate at which participants get a reminder email
+	ReminderOffsets []uint32
+	// Draft events are only visible to their organizers, PublishAt is set when their publication is scheduled
+	Draft     bool
+	PublishAt time.Time
+	// CommunityEmail tells the community members about the event when the draft is published
+	CommunityEmail bool
+
+	Category string // one of Categories, empty if unclassified
+}
+
+// EventSeries is a recurring event, each occurrence of its rule is an Event.
+// StartDate and EndDate are the dates of the first occurrence, RRule is an RFC 5545 recurrence rule.
+type EventSeries struct {
+	CreatedAt time.Time
+	ID        string
+	RRule     string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type RegistrationQuestionKind string
+
+const (
+	RegistrationQuestionKindText         RegistrationQuestionKind = "text"
+	RegistrationQuestionKindSingleChoice RegistrationQuestionKind = "single_choice"
+	RegistrationQuestionKindMultiChoice  RegistrationQuestionKind = "multi_choice"
+	RegistrationQuestionKindCheckbox     RegistrationQuestionKind = "checkbox"
+)
+
+// RegistrationQuestion is a question of the registration form of an event, asked to each attendee.
+type RegistrationQuestion struct {
+	ID       string
+	EventID  string
+	Kind     RegistrationQuestionKind
+	Label    string
+	Options  []string
+	Required bool
+}
+
+// RegistrationAnswer is the answer of an attendee to a registration question.
+// OrderAttendeeID is set when the answer was given at checkout.
+type RegistrationAnswer struct {
+	EventID         string
+	UserID          string
+	OrderAttendeeID string
+	QuestionID      string
+	Values          []string
 }
 
 type PriceGroup struct {
@@ -164,48 +274,208 @@ type Price struct {
 	CurrencyCode     string
 	PaymentAccount   *PaymentAccount
 	PaymentAccountID string
+	// SaleStartsAt and SaleEndsAt bound the window in which the price can be bought, nil when unbounded.
+	SaleStartsAt       *time.Time
+	SaleEndsAt         *time.Time
+	Kind               PriceKind
+	MinimumAmountMinor int64
+}
+
+// OnSaleAt reports whether the price can be bought at the given time.
+func (p *Price) OnSaleAt(t time.Time) bool {
+	if p.SaleStartsAt != nil && t.Before(*p.SaleStartsAt) {
+		return false
+	}
+	if p.SaleEndsAt != nil && !t.Before(*p.SaleEndsAt) {
+		return false
+	}
+	return true
 }
 
 type Order struct {
-	CreatedAt         int64
-	ID                string
-	EventID           string
-	BuyerID           string
-	CurrencyCode      string
-	AmountMinor       int64
-	Status            OrderStatus
-	PaymentStatus     string
-	PaymentAccountID  string
-	PaymentAccount    *PaymentAccount
-	PaymentProvider   string
-	PaymentSessionID  string
-	PaymentIntentID   string
-	ConfirmedAt       *int64
-	InvoiceID         string
-	InvoiceURL        string
-	TicketIssueStatus TicketIssueStatus
-	TicketIssueError  string
+	CreatedAt           int64
+	ID                  string
+	EventID             string
+	BuyerID             string
+	CurrencyCode        string
+	AmountMinor         int64
+	Status              OrderStatus
+	PaymentStatus       string
+	PaymentAccountID    string
+	PaymentAccount      *PaymentAccount
+	PaymentProvider     string
+	PaymentSessionID    string
+	PaymentIntentID     string
+	ConfirmedAt         *int64
+	InvoiceID           string
+	InvoiceURL          string
+	InvoiceIssuedAt     *int64
+	InvoiceTaxRateBps   uint32
+	TicketIssueStatus   TicketIssueStatus
+	TicketIssueError    string
+	TicketIssueAttempts uint32
+	RefundStatus        OrderRefundStatus
+	RefundedAmountMinor int64
+	RefundedAt          *int64
+	PromoCodeID         string
+	PromoCode           string
+	DiscountAmountMinor int64
+	DonationAmountMinor int64
 }
 
 type OrderAttendee struct {
-	ID           string
-	CreatedAt    int64
-	OrderID      string
-	PriceID      string
-	PriceGroupID string
-	UserID       string
-	AmountMinor  int64
-	CurrencyCode string
+	ID              string
+	CreatedAt       int64
+	OrderID         string
+	PriceID         string
+	PriceGroupID    string
+	UserID          string
+	AmountMinor     int64
+	CurrencyCode    string
+	RefundID        string
+	RefundedAt      *int64
+	RefundPendingAt *int64
+}
+
+// OrderRefundClaim is the part of an order taken over by a refund in progress.
+type OrderRefundClaim struct {
+	AttendeeIDs         []string
+	AmountMinor         int64 // amount of the attendees
+	DonationAmountMinor int64 // donation refunded along with the last attendees, 0 if not part of the claim
+}
+
+// SalesReportRow aggregates the paid tickets of an event for a price group and currency.
+// Amounts are in minor units and already include promo code discounts.
+// Donations are not tied to a price group, they are aggregated on a row with an empty PriceGroupID.
+type SalesReportRow struct {
+	EventID                     string
+	EventTitle                  string
+	PriceGroupID                string
+	CurrencyCode                string
+	TicketsSold                 int64
+	TicketsRefunded             int64
+	GrossAmountMinor            int64
+	RefundedAmountMinor         int64
+	DonationAmountMinor         int64
+	RefundedDonationAmountMinor int64
+}
+
+// PromoCode is a discount code attached to an event.
+// Fixed discounts are taken off each ticket, MaxRedemptions counts orders.
+type PromoCode struct {
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ID             string
+	EventID        string
+	Code           string
+	DiscountType   PromoCodeDiscountType
+	PercentOff     uint32
+	AmountOffMinor int64
+	CurrencyCode   string
+	PriceID        string
+	MaxRedemptions uint32
+	StartsAt       *int64
+	EndsAt         *int64
+}
+
+// WaitlistEntry is a user waiting for a spot in a sold-out event or price group.
+// An empty PriceGroupID means the entry waits for any spot of the event.
+type WaitlistEntry struct {
+	CreatedAt      time.Time
+	ID             string
+	EventID        string
+	PriceGroupID   string
+	UserID         string
+	Position       uint32
+	Status         WaitlistEntryStatus
+	OfferedAt      *int64
+	OfferExpiresAt *int64
+	// OrderID is the pending order whose holds took over the offered spot
+	OrderID string
+}
+
+// EventApplication is the request of a user to attend an event requiring approval.
+// BuyerID is the user who applied, it differs from UserID for guests.
+type EventApplication struct {
+	CreatedAt  time.Time
+	ID         string
+	EventID    string
+	UserID     string
+	BuyerID    string
+	Status     EventApplicationStatus
+	Message    string
+	ReviewedAt *int64
+}
+
+// EventInvitation lets the owner of Email participate in an invite-only event.
+// Nonce is the random part of the signed token mailed to the recipient, UserID is set once the invitation is accepted.
+type EventInvitation struct {
+	CreatedAt   time.Time
+	ID          string
+	EventID     string
+	Email       string
+	Nonce       string
+	Status      EventInvitationStatus
+	InviterID   string
+	UserID      string
+	SentCount   uint32
+	LastSentAt  *int64
+	OpenedAt    *int64
+	RespondedAt *int64
+}
+
+// EventSession is a time slot of an event agenda, e.g. a talk or a workshop.
+// A zero Capacity means registrations are not limited, Registrations counts the registered users.
+type EventSession struct {
+	CreatedAt     time.Time
+	ID            string
+	EventID       string
+	Title         string
+	Description   string
+	Speakers      []string
+	Room          string
+	Track         string
+	StartDate     time.Time
+	EndDate       time.Time
+	Capacity      uint32
+	Registrations uint32
+}
+
+// EventTemplate is a set of event settings saved by a community that new events can start from.
+// Event holds the settings without dates nor password, Duration is the default length of the events.
+type EventTemplate struct {
+	CreatedAt   time.Time
+	ID          string
+	CommunityID string
+	CreatorID   string
+	Name        string
+	Event       *zenaov1.CreateEventRequest
+	Duration    time.Duration
 }
 
+// TicketHold reserves spots either for a pending order or for a waitlist offer.
 type TicketHold struct {
-	CreatedAt    int64
-	ID           string
-	EventID      string
-	PriceGroupID string
-	OrderID      string
-	Quantity     uint32
-	ExpiresAt    int64
+	CreatedAt       int64
+	ID              string
+	EventID         string
+	PriceGroupID    string
+	OrderID         string
+	WaitlistEntryID string
+	Quantity        uint32
+	ExpiresAt       int64
+}
+
+// IdempotencyKey records a mutating call made with an Idempotency-Key header.
+// Keys are scoped to the auth user, Response is nil while the first call is still running.
+type IdempotencyKey struct {
+	CreatedAt   int64
+	ID          string
+	AuthUserID  string
+	Key         string
+	Procedure   string
+	RequestHash string
+	Response    []byte
+	CompletedAt *int64
 }
 
 // LocationFilter is used to filter events by geographical location.
@@ -215,6 +485,56 @@ type LocationFilter struct {
 	RadiusKm float64 // radius in kilometers
 }
 
+// Search highlights and snippets wrap the matched text between these markers.
+const (
+	SearchMatchStart = "\x02"
+	SearchMatchEnd   = "\x03"
+)
+
+type EventSearchResult struct {
+	Event              *Event
+	TitleHighlight     string
+	DescriptionSnippet string
+	VenueHighlight     string
+	Rank               float64 // lower is better
+}
+
+type CommunitySearchResult struct {
+	Community          *Community
+	NameHighlight      string
+	DescriptionSnippet string
+	Rank               float64 // lower is better
+}
+
+// Categories is the curated list of top-level categories of the events and communities, in display order.
+// Anything more specific goes in the free-form tags.
+var Categories = []string{
+	"tech",
+	"business",
+	"workshop",
+	"conference",
+	"meetup",
+	"music",
+	"art",
+	"education",
+	"sport",
+	"wellness",
+	"food",
+	"social",
+	"other",
+}
+
+// TagFilter restricts a listing to a category and to the entities having all the tags.
+type TagFilter struct {
+	Category string
+	Tags     []string
+}
+
+type TagCount struct {
+	Name  string
+	Count uint32
+}
+
 type EventWithRoles struct {
 	Event *Event
 	Roles []string
@@ -228,6 +548,13 @@ type Community struct {
 	AvatarURI   string
 	BannerURI   string
 	CreatorID   string
+	Category    string // one of Categories, empty if unclassified
+
+	// Legal details printed on the invoices of the community, prices are tax inclusive.
+	LegalName    string
+	LegalAddress string
+	TaxID        string
+	TaxRateBps   uint32
 }
 
 type CommunityWithRoles struct {
@@ -370,17 +697,63 @@ type DB interface {
 	EntitiesWithRoles(orgType string, orgID string, roles []string) ([]*EntityRole, error)
 	CountEntities(orgType string, orgID string, entityType string, role string) (uint32, error)
 	GetAllUsers() ([]*User, error)
+	// GetCalendarFeedToken returns the token of the user calendar feed, empty if the user never asked for one
+	GetCalendarFeedToken(userID string) (string, error)
+	// SetCalendarFeedToken replaces the token of the user calendar feed
+	SetCalendarFeedToken(userID string, token string) error
+	// GetUserByCalendarFeedToken returns nil if no user has this token
+	GetUserByCalendarFeedToken(token string) (*User, error)
+	// ListUserCalendarEvents returns the events where the user has one of the roles ending after since,
+	// cancelled events are included with their DeletedAt set
+	ListUserCalendarEvents(userID string, roles []string, since time.Time) ([]*Event, error)
+	// ListCommunityCalendarEvents returns the discoverable events of the community ending after since, without the guarded
+	// and invite-only ones, events cancelled while in the community are included with their DeletedAt set
+	ListCommunityCalendarEvents(communityID string, since time.Time) ([]*Event, error)
 
 	CreateEvent(creatorID string, organizersIDs []string, gatekeepersIDs []string, req *zenaov1.CreateEventRequest) (*Event, error)
 	CancelEvent(eventID string) error
 	EditEvent(eventID string, organizersIDs []string, gatekeepersIDs []string, req *zenaov1.EditEventRequest) (*Event, error)
 	ValidatePassword(req *zenaov1.ValidatePasswordRequest) (bool, error)
 	GetEvent(eventID string) (*Event, error)
-	ListEvents(limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, locationFilter *LocationFilter) ([]*Event, error)
-	ListEventsByUserRoles(userID string, roles []string, limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter) ([]*EventWithRoles, error)
+	CreateEventSeries(series *EventSeries) (*EventSeries, error)
+	GetEventSeries(seriesID string) (*EventSeries, error)
+	UpdateEventSeries(series *EventSeries) error
+	// SetEventSeries links the event to the series occurrence recurring at recurrenceAt
+	SetEventSeries(eventID string, seriesID string, recurrenceAt time.Time) error
+	// ListSeriesEvents returns the live occurrences of the series ordered by recurrence date
+	ListSeriesEvents(seriesID string) ([]*Event, error)
+	// SetRegistrationQuestions replaces the registration form of the event, questions with an ID are updated
+	SetRegistrationQuestions(eventID string, questions []*RegistrationQuestion) error
+	GetRegistrationQuestions(eventID string) ([]*RegistrationQuestion, error)
+	// SaveRegistrationAnswers replaces the answers of the user for the event
+	SaveRegistrationAnswers(eventID string, userID string, orderAttendeeID string, answers []*RegistrationAnswer) error
+	GetEventRegistrationAnswers(eventID string) ([]*RegistrationAnswer, error)
+	// ScheduleEventPublication sets when the draft is published, a zero publishAt unschedules it
+	ScheduleEventPublication(eventID string, publishAt time.Time) error
+	// PublishEvent makes the draft visible, it returns false if the event was not a draft
+	PublishEvent(eventID string) (bool, error)
+	// ListEventsToPublish returns the live drafts scheduled to be published at or before now
+	ListEventsToPublish(now time.Time) ([]*Event, error)
+	// SetEventPasswordHash replaces the password of the event with an already hashed one
+	SetEventPasswordHash(eventID string, passwordHash string) error
+	// ListEventsWithReminders returns the live published events with reminders starting in (from, to]
+	ListEventsWithReminders(from time.Time, to time.Time) ([]*Event, error)
+	// ClaimEventReminder records the reminder at offset as sent to the user, it returns false if it already was
+	ClaimEventReminder(eventID string, userID string, offset uint32, nowUnix int64) (bool, error)
+	// ReleaseEventReminder forgets a claimed reminder so it is retried
+	ReleaseEventReminder(eventID string, userID string, offset uint32) error
+	ListEvents(limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, locationFilter *LocationFilter, tagFilter *TagFilter) ([]*Event, error)
+	// ListEventsByUserRoles lists the drafts the user organizes only if withDrafts is set
+	ListEventsByUserRoles(userID string, roles []string, limit int, offset int, from int64, to int64, discoverable zenaov1.DiscoverableFilter, withDrafts bool) ([]*EventWithRoles, error)
 	CountCheckedIn(eventID string) (uint32, error)
+	// SearchEvents lists the discoverable published events matching the query, best matches first
+	SearchEvents(query string, limit int, offset int, from int64, to int64, locationFilter *LocationFilter) ([]*EventSearchResult, error)
+	SearchCommunities(query string, limit int, offset int) ([]*CommunitySearchResult, error)
 	Participate(eventID string, buyerID string, userID string, ticketSecret string, password string, needPassword bool) error
 	CancelParticipation(eventID string, userID string) error
+	// TransferTicket re-issues the user's ticket of the event to another user with a new secret,
+	// the previous ticket is deleted so its pubkey can't be checked in anymore
+	TransferTicket(eventID string, fromUserID string, toUserID string, ticketSecret string) (*SoldTicket, error)
 	GetAllEvents() ([]*Event, error)
 	GetEventTickets(eventID string) ([]*SoldTicket, error)
 	CreatePriceGroup(eventID string, capacity uint32) (*PriceGroup, error)
@@ -388,9 +761,20 @@ type DB interface {
 	UpdatePriceGroupCapacity(priceGroupID string, capacity uint32) error
 	CreatePrice(paymentAccount *PaymentAccount, price *Price) (*Price, error)
 	UpdatePrice(paymentAccount *PaymentAccount, price *Price) error
+	CreatePromoCode(promoCode *PromoCode) (*PromoCode, error)
+	GetPromoCode(promoCodeID string) (*PromoCode, error)
+	// GetPromoCodeByCode returns nil if the event has no such code, codes are case-insensitive
+	GetPromoCodeByCode(eventID string, code string) (*PromoCode, error)
+	ListPromoCodesByEvent(eventID string) ([]*PromoCode, error)
+	DeletePromoCode(promoCodeID string) error
+	// CountPromoCodeRedemptions counts the pending and successful orders using the promo code
+	CountPromoCodeRedemptions(promoCodeID string) (uint32, error)
 	CreateOrder(order *Order, attendees []*OrderAttendee) (*Order, error)
 	GetOrder(orderID string) (*Order, error)
 	ListOrdersByBuyer(buyerID string) ([]*Order, error)
+	ListOrdersByEvent(eventID string, status OrderStatus) ([]*Order, error)
+	ListPendingOrders(createdBefore int64) ([]*Order, error)
+	ListOrdersByTicketIssueStatus(status TicketIssueStatus) ([]*Order, error)
 	GetOrderAttendees(orderID string) ([]*OrderAttendee, error)
 	GetOrderTickets(orderID string) ([]*SoldTicket, error)
 	GetOrderPaymentAccount(orderID string) (*PaymentAccount, error)
@@ -398,13 +782,113 @@ type DB interface {
 	UpdateOrderSetStatus(orderID string, status OrderStatus) error
 	UpdateOrderConfirmation(orderID string, status OrderStatus, paymentIntentID string, confirmedAt int64) error
 	UpdateOrderConfirmationOnce(orderID string, status OrderStatus, paymentIntentID string, confirmedAt int64) (bool, error)
+	// UpdateOrderTicketIssue records the outcome of an issuance, unsuccessful ones are counted in TicketIssueAttempts
 	UpdateOrderTicketIssue(orderID string, status TicketIssueStatus, errMsg string) error
+	AssignOrderInvoice(orderID string, communityID string, invoiceURL string, issuedAt int64) (*Order, error)
+	// ClaimOrderAttendeesRefund marks the given attendees, or every attendee not refunded yet when attendeeIDs is empty,
+	// as being refunded so that concurrent refunds cannot take them over, the donation is claimed with the last attendees
+	ClaimOrderAttendeesRefund(orderID string, attendeeIDs []string, claimedAt int64) (*OrderRefundClaim, error)
+	// ReleaseOrderAttendeesRefund gives back the part of the order claimed by a refund that failed
+	ReleaseOrderAttendeesRefund(orderID string, claim *OrderRefundClaim) error
+	// RefundOrderAttendees records a refund for the given attendees and invalidates their tickets,
+	// the donation of the order is counted as refunded with its last attendees
+	RefundOrderAttendees(orderID string, attendeeIDs []string, refundID string, refundedAt int64) (*Order, error)
 	CreateTicketHold(hold *TicketHold) (*TicketHold, error)
 	DeleteTicketHoldsByOrderID(orderID string) error
 	DeleteExpiredTicketHolds(eventID string, nowUnix int64) error
+	// DeleteAllExpiredTicketHolds sweeps expired holds of every event and returns the number of deleted holds
+	DeleteAllExpiredTicketHolds(nowUnix int64) (int64, error)
+	// CountEventSoldTickets counts the tickets of a price group, or of the whole event when priceGroupID is empty
 	CountEventSoldTickets(eventID string, priceGroupID string) (uint32, error)
 	CountActiveTicketHolds(eventID string, priceGroupID string, nowUnix int64) (uint32, error)
+	// CountActiveWaitlistHolds counts the spots of the event reserved by pending waitlist offers
+	CountActiveWaitlistHolds(eventID string, nowUnix int64) (uint32, error)
+
+	// CreateWaitlistEntry appends the user at the end of the event waitlist
+	CreateWaitlistEntry(eventID string, priceGroupID string, userID string) (*WaitlistEntry, error)
+	GetWaitlistEntry(entryID string) (*WaitlistEntry, error)
+	// GetUserWaitlistEntry returns the waiting or offered entry of the user, nil if there is none
+	GetUserWaitlistEntry(eventID string, userID string) (*WaitlistEntry, error)
+	// ListWaitlistEntries returns the entries of the event ordered by position
+	ListWaitlistEntries(eventID string) ([]*WaitlistEntry, error)
+	// ListWaitlistEventIDs returns the events that have waiting entries
+	ListWaitlistEventIDs() ([]string, error)
+	// DeleteWaitlistEntry removes the entry and releases its offered hold if any
+	DeleteWaitlistEntry(entryID string) error
+	// ReorderWaitlist sets the positions of the waiting entries of the event, entryIDs must list all of them
+	ReorderWaitlist(eventID string, entryIDs []string) error
+	// OfferWaitlistEntry reserves a spot for a waiting entry with a ticket hold that expires at expiresAt
+	OfferWaitlistEntry(entryID string, nowUnix int64, expiresAt int64) (*WaitlistEntry, error)
+	// ClaimWaitlistOffer releases the spot offered to the user so their registration can take it,
+	// it returns false when the user has no active offer for the event
+	ClaimWaitlistOffer(eventID string, userID string, nowUnix int64) (bool, error)
+	// TakeWaitlistOfferHold releases the hold of the user's active offer for the price group so their checkout
+	// can take the spot, the offer stays open until the order settles. It returns the entry id, empty if there is none
+	TakeWaitlistOfferHold(eventID string, priceGroupID string, userID string, nowUnix int64) (string, error)
+	// LinkWaitlistOffersToOrder records the order whose holds took over the offered spots
+	LinkWaitlistOffersToOrder(entryIDs []string, orderID string) error
+	// ClaimOrderWaitlistOffers marks the offers taken over by the confirmed order as claimed
+	ClaimOrderWaitlistOffers(orderID string) error
+	// RestoreOrderWaitlistOffers gives the offers taken over by the failed order their hold back,
+	// the offers past their expiry are expired instead
+	RestoreOrderWaitlistOffers(orderID string, nowUnix int64) error
+	// ExpireWaitlistOffers marks the offers past their expiry as expired and returns how many expired
+	ExpireWaitlistOffers(nowUnix int64) (int64, error)
+	// CreateEventApplication adds a pending application, it fails if the user already applied to the event
+	CreateEventApplication(eventID string, buyerID string, userID string) (*EventApplication, error)
+	GetEventApplication(applicationID string) (*EventApplication, error)
+	// GetUserEventApplication returns the latest application of the user to the event, nil if there is none
+	GetUserEventApplication(eventID string, userID string) (*EventApplication, error)
+	// ListEventApplications returns the applications of the event by creation order, an empty status lists all of them
+	ListEventApplications(eventID string, status EventApplicationStatus) ([]*EventApplication, error)
+	// ReviewEventApplication approves or rejects a pending application
+	ReviewEventApplication(applicationID string, status EventApplicationStatus, message string, nowUnix int64) (*EventApplication, error)
+	// CreateEventInvitations invites the emails to the event and returns the new invitations,
+	// emails already invited are skipped
+	CreateEventInvitations(eventID string, inviterID string, emails []string) ([]*EventInvitation, error)
+	// GetEventInvitation returns nil if the invitation does not exist
+	GetEventInvitation(invitationID string) (*EventInvitation, error)
+	// ListEventInvitations returns the invitations of the event by creation order
+	ListEventInvitations(eventID string) ([]*EventInvitation, error)
+	// MarkEventInvitationOpened records the first time the recipient opened an invitation they did not answer yet
+	MarkEventInvitationOpened(invitationID string, nowUnix int64) error
+	// RespondEventInvitation accepts or declines an unanswered invitation, it returns false when the invitation
+	// was already answered so a token cannot be used twice
+	RespondEventInvitation(invitationID string, status EventInvitationStatus, userID string, nowUnix int64) (bool, error)
+	// RecordEventInvitationSent counts an email sent for the invitation
+	RecordEventInvitationSent(invitationID string, nowUnix int64) error
+	CreateEventSession(session *EventSession) (*EventSession, error)
+	// GetEventSession returns nil if the session does not exist
+	GetEventSession(sessionID string) (*EventSession, error)
+	// UpdateEventSession replaces the details of the session, it fails if the capacity is below the registrations
+	UpdateEventSession(session *EventSession) (*EventSession, error)
+	// DeleteEventSession removes the session and its registrations
+	DeleteEventSession(sessionID string) error
+	// ListEventSessions returns the sessions of the event ordered by start date
+	ListEventSessions(eventID string) ([]*EventSession, error)
+	CreateEventTemplate(template *EventTemplate) (*EventTemplate, error)
+	// GetEventTemplate returns nil if the template does not exist
+	GetEventTemplate(templateID string) (*EventTemplate, error)
+	// ListEventTemplates returns the templates of the community ordered by name
+	ListEventTemplates(communityID string) ([]*EventTemplate, error)
+	DeleteEventTemplate(templateID string) error
+	// RegisterForSession registers the user if the session is not full
+	RegisterForSession(sessionID string, userID string, nowUnix int64) error
+	UnregisterFromSession(sessionID string, userID string) error
+	// ListUserSessionIDs returns the sessions of the event the user registered to
+	ListUserSessionIDs(eventID string, userID string) ([]string, error)
 	ListOrderAttendeeTicketIDs(orderID string) ([]string, error)
+	// GetCommunitySalesReport aggregates the paid orders received by the community, confirmed in [from, to).
+	// A zero bound is ignored.
+	GetCommunitySalesReport(communityID string, from int64, to int64) ([]*SalesReportRow, error)
+	// ReserveIdempotencyKey stores the key unless the auth user already used it since expiredBefore,
+	// in which case the stored key is returned with created set to false.
+	// A key still not completed since abandonedBefore is taken over as if it had expired
+	ReserveIdempotencyKey(key *IdempotencyKey, expiredBefore int64, abandonedBefore int64) (stored *IdempotencyKey, created bool, err error)
+	CompleteIdempotencyKey(keyID string, response []byte, nowUnix int64) error
+	DeleteIdempotencyKey(keyID string) error
+	// DeleteExpiredIdempotencyKeys removes the keys created before createdBefore and returns how many were removed
+	DeleteExpiredIdempotencyKeys(createdBefore int64) (int64, error)
 	CreateSoldTickets(tickets []*SoldTicket) error
 	GetEventCommunity(eventID string) (*Community, error)
 	GetEventUserTicket(eventID string, userID string) (*SoldTicket, error)
@@ -420,7 +904,14 @@ type DB interface {
 	CreateCommunity(creatorID string, administratorsIDs []string, membersIDs []string, eventsIDs []string, req *zenaov1.CreateCommunityRequest) (*Community, error)
 	EditCommunity(communityID string, administratorsIDs []string, req *zenaov1.EditCommunityRequest) (*Community, error)
 	GetCommunity(communityID string) (*Community, error)
-	ListCommunities(entityType string, entityID string, role string, limit int, offset int) ([]*Community, error)
+	EditCommunityLegalDetails(communityID string, legalName string, legalAddress string, taxID string, taxRateBps uint32) error
+	ListCommunities(entityType string, entityID string, role string, limit int, offset int, tagFilter *TagFilter) ([]*Community, error)
+	// GetEntityTags returns the tags of an event or a community, sorted
+	GetEntityTags(entityType string, entityID string) ([]string, error)
+	// CountCategories counts the discoverable published events or the communities per category
+	CountCategories(entityType string) ([]*TagCount, error)
+	// CountTags counts the discoverable published events or the communities per tag, most used first
+	CountTags(entityType string, limit int) ([]*TagCount, error)
 	AddMemberToCommunity(communityID string, userID string) error
 	RemoveMemberFromCommunity(communityID string, userID string) error
 	GetAllCommunities() ([]*Community, error)
@@ -0,0 +1,138 @@
+import {
+  Body,
+  Button,
+  Column,
+  Container,
+  Head,
+  Html,
+  Preview,
+  Row,
+  Section,
+  Text,
+} from "@react-email/components";
+import React from "react";
+import { EmailEventImg } from "./email-event-img";
+
+// To generate an example: make generate && go run ./backend mail > event-invitation.html
+
+export const EventInvitationEmail = () => (
+  <Html>
+    <Head />
+    <Body style={main}>
+      <Preview>{"{{.Title}}"}</Preview>
+      <Container style={container}>
+        <EmailEventImg src="{{.ImageURL}}" />
+        <Section style={welcome.section}>
+          <Text style={welcome.text}>{"{{.Title}}"}</Text>
+        </Section>
+        <Section style={details.section}>
+          <Row>
+            <Column>
+              <Section style={messageBox}>
+                <Text style={messageText}>{"{{.Message}}"}</Text>
+              </Section>
+            </Column>
+          </Row>
+          <Row>
+            <Column>
+              <Button href="{{.InvitationURL}}" style={details.seeEventButton}>
+                Answer the invitation
+              </Button>
+            </Column>
+          </Row>
+        </Section>
+        <Section style={footer}>
+          <Text style={footerText}>
+            You're receiving this email because the organizers of{" "}
+            {"{{.EventName}}"} invited you. This invitation is personal, please
+            don't forward it.
+          </Text>
+        </Section>
+      </Container>
+    </Body>
+  </Html>
+);
+
+export default EventInvitationEmail;
+
+// Styles
+
+const main = {
+  backgroundColor: "#ffffff",
+  color: "#000000",
+  fontFamily:
+    '"Helvetica Neue",-apple-system,BlinkMacSystemFont,"Segoe UI",Roboto,Oxygen-Sans,Ubuntu,Cantarell,sans-serif',
+};
+
+const container = {
+  margin: "10px auto",
+  maxWidth: 800,
+  border: "1px solid #F5F5F5",
+};
+
+const welcome = {
+  section: {
+    padding: "48px 20px",
+    height: 220,
+    backgroundColor: "#000000",
+    wordBreak: "break-word",
+  },
+  text: {
+    color: "#FFFFFF",
+    textAlign: "center",
+    fontWeight: 500,
+    margin: 0,
+    fontSize: 48,
+    lineHeight: 1.1,
+    letterSpacing: -1.2,
+  },
+} as const;
+
+const details = {
+  section: {
+    padding: "48px 20px",
+  },
+  seeEventButton: {
+    backgroundColor: "#000000",
+    color: "#FFFFFF",
+    fontSize: 16,
+    lineHeight: 1.3,
+    width: "100%",
+    borderRadius: 4,
+    marginTop: 16,
+    textAlign: "center",
+    paddingTop: 14,
+    paddingBottom: 14,
+    fontWeight: 500,
+  },
+} as const;
+
+const messageBox = {
+  backgroundColor: "#F5F5F5",
+  borderRadius: 8,
+  padding: "20px 20px 20px 20px",
+  marginBottom: 24,
+  borderLeft: "4px solid #000000",
+} as const;
+
+const messageText = {
+  fontSize: 16,
+  lineHeight: 1.6,
+  margin: 0,
+  color: "#333333",
+  whiteSpace: "pre-line",
+} as const;
+
+const footer = {
+  padding: "20px",
+  backgroundColor: "#F5F5F5",
+  borderBottomLeftRadius: 4,
+  borderBottomRightRadius: 4,
+} as const;
+
+const footerText = {
+  fontSize: 12,
+  color: "#666666",
+  textAlign: "center",
+  margin: 0,
+} as const;
@@ -0,0 +1,7 @@
+-- Add refund state to orders and order attendees
+
+ALTER TABLE `orders` ADD COLUMN `refund_status` text NULL;
+ALTER TABLE `orders` ADD COLUMN `refunded_amount_minor` integer NULL;
+ALTER TABLE `orders` ADD COLUMN `refunded_at` integer NULL;
+ALTER TABLE `order_attendees` ADD COLUMN `refund_id` text NULL;
+ALTER TABLE `order_attendees` ADD COLUMN `refunded_at` integer NULL;
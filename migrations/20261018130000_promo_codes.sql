@@ -0,0 +1,30 @@
+-- Add promo codes and their redemption on orders
+
+-- Create "promo_codes" table
+CREATE TABLE `promo_codes` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NOT NULL,
+  `code` text NOT NULL,
+  `discount_type` text NOT NULL,
+  `percent_off` integer NULL,
+  `amount_off_minor` integer NULL,
+  `currency_code` text NULL,
+  `price_id` integer NULL,
+  `max_redemptions` integer NULL,
+  `starts_at` integer NULL,
+  `ends_at` integer NULL,
+  CONSTRAINT `fk_promo_codes_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_promo_codes_deleted_at" to table: "promo_codes"
+CREATE INDEX `idx_promo_codes_deleted_at` ON `promo_codes` (`deleted_at`);
+-- Create index "idx_promo_codes_event_id" to table: "promo_codes"
+CREATE INDEX `idx_promo_codes_event_id` ON `promo_codes` (`event_id`);
+
+ALTER TABLE `orders` ADD COLUMN `promo_code_id` integer NULL;
+ALTER TABLE `orders` ADD COLUMN `promo_code` text NULL;
+ALTER TABLE `orders` ADD COLUMN `discount_amount_minor` integer NULL;
+-- Create index "idx_orders_promo_code_id" to table: "orders"
+CREATE INDEX `idx_orders_promo_code_id` ON `orders` (`promo_code_id`);
@@ -0,0 +1,63 @@
+-- Add event waitlists, offered spots are reserved with ticket holds that are not tied to an order
+
+-- Create "waitlist_entries" table
+CREATE TABLE `waitlist_entries` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NOT NULL,
+  `price_group_id` integer NULL,
+  `user_id` integer NOT NULL,
+  `position` integer NOT NULL,
+  `status` text NOT NULL,
+  `offered_at` integer NULL,
+  `offer_expires_at` integer NULL,
+  CONSTRAINT `fk_waitlist_entries_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_waitlist_entries_price_group` FOREIGN KEY (`price_group_id`) REFERENCES `price_groups` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_waitlist_entries_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_waitlist_entries_deleted_at" to table: "waitlist_entries"
+CREATE INDEX `idx_waitlist_entries_deleted_at` ON `waitlist_entries` (`deleted_at`);
+-- Create index "idx_waitlist_entries_event_id" to table: "waitlist_entries"
+CREATE INDEX `idx_waitlist_entries_event_id` ON `waitlist_entries` (`event_id`);
+-- Create index "idx_waitlist_entries_user_id" to table: "waitlist_entries"
+CREATE INDEX `idx_waitlist_entries_user_id` ON `waitlist_entries` (`user_id`);
+-- Create index "idx_waitlist_entries_status" to table: "waitlist_entries"
+CREATE INDEX `idx_waitlist_entries_status` ON `waitlist_entries` (`status`);
+
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_ticket_holds" table
+CREATE TABLE `new_ticket_holds` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL,
+  `event_id` integer NOT NULL,
+  `price_group_id` integer NULL,
+  `order_id` text NULL,
+  `waitlist_entry_id` integer NULL,
+  `quantity` integer NOT NULL,
+  `expires_at` integer NOT NULL,
+  CONSTRAINT `fk_ticket_holds_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_ticket_holds_price_group` FOREIGN KEY (`price_group_id`) REFERENCES `price_groups` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_ticket_holds_order` FOREIGN KEY (`order_id`) REFERENCES `orders` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_ticket_holds_waitlist_entry` FOREIGN KEY (`waitlist_entry_id`) REFERENCES `waitlist_entries` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Copy rows from old table "ticket_holds" to new temporary table "new_ticket_holds"
+INSERT INTO `new_ticket_holds` (`id`, `created_at`, `event_id`, `price_group_id`, `order_id`, `quantity`, `expires_at`) SELECT `id`, `created_at`, `event_id`, `price_group_id`, `order_id`, `quantity`, `expires_at` FROM `ticket_holds`;
+-- Drop "ticket_holds" table after copying rows
+DROP TABLE `ticket_holds`;
+-- Rename temporary table "new_ticket_holds" to "ticket_holds"
+ALTER TABLE `new_ticket_holds` RENAME TO `ticket_holds`;
+-- Create index "idx_ticket_holds_event_id" to table: "ticket_holds"
+CREATE INDEX `idx_ticket_holds_event_id` ON `ticket_holds` (`event_id`);
+-- Create index "idx_ticket_holds_price_group_id" to table: "ticket_holds"
+CREATE INDEX `idx_ticket_holds_price_group_id` ON `ticket_holds` (`price_group_id`);
+-- Create index "idx_ticket_holds_order_id" to table: "ticket_holds"
+CREATE INDEX `idx_ticket_holds_order_id` ON `ticket_holds` (`order_id`);
+-- Create index "idx_ticket_holds_waitlist_entry_id" to table: "ticket_holds"
+CREATE INDEX `idx_ticket_holds_waitlist_entry_id` ON `ticket_holds` (`waitlist_entry_id`);
+-- Create index "idx_ticket_holds_expires_at" to table: "ticket_holds"
+CREATE INDEX `idx_ticket_holds_expires_at` ON `ticket_holds` (`expires_at`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- Add column "ticket_transfers_disabled" to table: "events"
+ALTER TABLE `events` ADD COLUMN `ticket_transfers_disabled` numeric NULL;
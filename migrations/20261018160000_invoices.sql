@@ -0,0 +1,9 @@
+-- Add community legal details and invoice numbering
+ALTER TABLE `communities` ADD COLUMN `legal_name` text NULL;
+ALTER TABLE `communities` ADD COLUMN `legal_address` text NULL;
+ALTER TABLE `communities` ADD COLUMN `tax_id` text NULL;
+ALTER TABLE `communities` ADD COLUMN `tax_rate_bps` integer NULL;
+ALTER TABLE `communities` ADD COLUMN `invoice_sequence` integer NULL;
+-- Add invoice columns to table: "orders"
+ALTER TABLE `orders` ADD COLUMN `invoice_issued_at` integer NULL;
+ALTER TABLE `orders` ADD COLUMN `invoice_tax_rate_bps` integer NULL;
@@ -0,0 +1,3 @@
+-- Add column "sale_starts_at" and "sale_ends_at" to table: "prices"
+ALTER TABLE `prices` ADD COLUMN `sale_starts_at` datetime NULL;
+ALTER TABLE `prices` ADD COLUMN `sale_ends_at` datetime NULL;
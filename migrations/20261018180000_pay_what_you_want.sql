@@ -0,0 +1,5 @@
+-- Add pay-what-you-want columns to table: "prices"
+ALTER TABLE `prices` ADD COLUMN `kind` text NULL;
+ALTER TABLE `prices` ADD COLUMN `minimum_amount_minor` integer NULL;
+-- Add column "donation_amount_minor" to table: "orders"
+ALTER TABLE `orders` ADD COLUMN `donation_amount_minor` integer NULL;
@@ -0,0 +1,17 @@
+-- Store idempotency keys of mutating RPCs so retried calls replay the first response
+
+-- Create "idempotency_keys" table
+CREATE TABLE `idempotency_keys` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL,
+  `auth_user_id` text NOT NULL,
+  `key` text NOT NULL,
+  `procedure` text NOT NULL,
+  `request_hash` text NOT NULL,
+  `response` blob NULL,
+  `completed_at` integer NULL
+);
+-- Create index "idx_idempotency_keys_auth_user_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX `idx_idempotency_keys_auth_user_key` ON `idempotency_keys` (`auth_user_id`, `key`);
+-- Create index "idx_idempotency_keys_created_at" to table: "idempotency_keys"
+CREATE INDEX `idx_idempotency_keys_created_at` ON `idempotency_keys` (`created_at`);
@@ -0,0 +1,20 @@
+-- Add recurring event series, each occurrence is an event linked to its series
+
+-- Create "event_series" table
+CREATE TABLE `event_series` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `rrule` text NULL,
+  `start_date` datetime NULL,
+  `end_date` datetime NULL
+);
+-- Create index "idx_event_series_deleted_at" to table: "event_series"
+CREATE INDEX `idx_event_series_deleted_at` ON `event_series` (`deleted_at`);
+-- Add column "series_id" to table: "events"
+ALTER TABLE `events` ADD COLUMN `series_id` integer NULL;
+-- Add column "series_recurrence_at" to table: "events"
+ALTER TABLE `events` ADD COLUMN `series_recurrence_at` datetime NULL;
+-- Create index "idx_events_series_id" to table: "events"
+CREATE INDEX `idx_events_series_id` ON `events` (`series_id`);
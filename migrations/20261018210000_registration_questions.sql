@@ -0,0 +1,33 @@
+-- Add per event registration forms and the answers of attendees
+
+-- Create "registration_questions" table
+CREATE TABLE `registration_questions` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NULL,
+  `position` integer NULL,
+  `kind` text NULL,
+  `label` text NULL,
+  `options` text NULL,
+  `required` numeric NULL
+);
+-- Create index "idx_registration_questions_event_id" to table: "registration_questions"
+CREATE INDEX `idx_registration_questions_event_id` ON `registration_questions` (`event_id`);
+-- Create index "idx_registration_questions_deleted_at" to table: "registration_questions"
+CREATE INDEX `idx_registration_questions_deleted_at` ON `registration_questions` (`deleted_at`);
+-- Create "registration_answers" table
+CREATE TABLE `registration_answers` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL,
+  `event_id` integer NOT NULL,
+  `user_id` integer NOT NULL,
+  `order_attendee_id` text NULL,
+  `question_id` integer NOT NULL,
+  `value` text NOT NULL
+);
+-- Create index "idx_registration_answers_event_user" to table: "registration_answers"
+CREATE INDEX `idx_registration_answers_event_user` ON `registration_answers` (`event_id`, `user_id`);
+-- Create index "idx_registration_answers_order_attendee_id" to table: "registration_answers"
+CREATE INDEX `idx_registration_answers_order_attendee_id` ON `registration_answers` (`order_attendee_id`);
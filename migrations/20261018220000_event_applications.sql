@@ -0,0 +1,28 @@
+-- Add approval-required registrations, participants of such events apply and are reviewed by organizers
+
+-- Add column "approval_required" to table: "events"
+ALTER TABLE `events` ADD COLUMN `approval_required` numeric NULL;
+-- Create "event_applications" table
+CREATE TABLE `event_applications` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NOT NULL,
+  `user_id` integer NOT NULL,
+  `buyer_id` integer NOT NULL,
+  `status` text NOT NULL,
+  `message` text NULL,
+  `reviewed_at` integer NULL,
+  CONSTRAINT `fk_event_applications_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_event_applications_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_event_applications_buyer` FOREIGN KEY (`buyer_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_event_applications_deleted_at" to table: "event_applications"
+CREATE INDEX `idx_event_applications_deleted_at` ON `event_applications` (`deleted_at`);
+-- Create index "idx_event_applications_event_id" to table: "event_applications"
+CREATE INDEX `idx_event_applications_event_id` ON `event_applications` (`event_id`);
+-- Create index "idx_event_applications_user_id" to table: "event_applications"
+CREATE INDEX `idx_event_applications_user_id` ON `event_applications` (`user_id`);
+-- Create index "idx_event_applications_status" to table: "event_applications"
+CREATE INDEX `idx_event_applications_status` ON `event_applications` (`status`);
@@ -0,0 +1,36 @@
+-- Add event agendas, sessions are time slots of an event with their own optional registration
+
+-- Create "event_sessions" table
+CREATE TABLE `event_sessions` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NOT NULL,
+  `title` text NOT NULL,
+  `description` text NULL,
+  `speakers` text NULL,
+  `room` text NULL,
+  `track` text NULL,
+  `start_date` datetime NOT NULL,
+  `end_date` datetime NOT NULL,
+  `capacity` integer NOT NULL DEFAULT 0,
+  CONSTRAINT `fk_event_sessions_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_event_sessions_deleted_at" to table: "event_sessions"
+CREATE INDEX `idx_event_sessions_deleted_at` ON `event_sessions` (`deleted_at`);
+-- Create index "idx_event_sessions_event_id" to table: "event_sessions"
+CREATE INDEX `idx_event_sessions_event_id` ON `event_sessions` (`event_id`);
+-- Create "session_registrations" table
+CREATE TABLE `session_registrations` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL,
+  `session_id` integer NOT NULL,
+  `user_id` integer NOT NULL,
+  CONSTRAINT `fk_session_registrations_session` FOREIGN KEY (`session_id`) REFERENCES `event_sessions` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_session_registrations_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_session_registrations_session_user" to table: "session_registrations"
+CREATE UNIQUE INDEX `idx_session_registrations_session_user` ON `session_registrations` (`session_id`, `user_id`);
+-- Create index "idx_session_registrations_user_id" to table: "session_registrations"
+CREATE INDEX `idx_session_registrations_user_id` ON `session_registrations` (`user_id`);
@@ -0,0 +1,6 @@
+-- Add the token authenticating the subscribable calendar feed of each user
+
+-- Add column "calendar_feed_token" to table: "users"
+ALTER TABLE `users` ADD COLUMN `calendar_feed_token` text NULL;
+-- Create index "idx_users_calendar_feed_token" to table: "users"
+CREATE UNIQUE INDEX `idx_users_calendar_feed_token` ON `users` (`calendar_feed_token`);
@@ -0,0 +1,16 @@
+-- Add scheduled reminder emails, sent reminders are recorded so restarts don't send them twice
+
+-- Add column "reminder_offsets" to table: "events"
+ALTER TABLE `events` ADD COLUMN `reminder_offsets` text NULL;
+-- Create "sent_reminders" table
+CREATE TABLE `sent_reminders` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL,
+  `event_id` integer NOT NULL,
+  `user_id` integer NOT NULL,
+  `offset_seconds` integer NOT NULL,
+  CONSTRAINT `fk_sent_reminders_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_sent_reminders_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_sent_reminders_event_user_offset" to table: "sent_reminders"
+CREATE UNIQUE INDEX `idx_sent_reminders_event_user_offset` ON `sent_reminders` (`event_id`, `user_id`, `offset_seconds`);
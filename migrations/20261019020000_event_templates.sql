@@ -0,0 +1,20 @@
+-- Add community event templates, their settings are stored as a CreateEventRequest message
+
+-- Create "event_templates" table
+CREATE TABLE `event_templates` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `community_id` integer NOT NULL,
+  `creator_id` integer NOT NULL,
+  `name` text NOT NULL,
+  `settings` blob NOT NULL,
+  `duration_seconds` integer NOT NULL DEFAULT 0,
+  CONSTRAINT `fk_event_templates_community` FOREIGN KEY (`community_id`) REFERENCES `communities` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_event_templates_creator` FOREIGN KEY (`creator_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_event_templates_deleted_at" to table: "event_templates"
+CREATE INDEX `idx_event_templates_deleted_at` ON `event_templates` (`deleted_at`);
+-- Create index "idx_event_templates_community_id" to table: "event_templates"
+CREATE INDEX `idx_event_templates_community_id` ON `event_templates` (`community_id`);
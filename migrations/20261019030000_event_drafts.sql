@@ -0,0 +1,10 @@
+-- Add draft events, they are hidden to all but their organizers until published
+
+-- Add column "draft" to table: "events"
+ALTER TABLE `events` ADD COLUMN `draft` numeric NOT NULL DEFAULT false;
+-- Add column "publish_at" to table: "events"
+ALTER TABLE `events` ADD COLUMN `publish_at` datetime NULL;
+-- Add column "community_email" to table: "events"
+ALTER TABLE `events` ADD COLUMN `community_email` numeric NULL;
+-- Create index "idx_events_publish_at" to table: "events"
+CREATE INDEX `idx_events_publish_at` ON `events` (`publish_at`);
@@ -0,0 +1,20 @@
+-- Add a curated category and free-form tags to events and communities
+
+-- Add column "category" to table: "events"
+ALTER TABLE `events` ADD COLUMN `category` text NULL;
+-- Create index "idx_events_category" to table: "events"
+CREATE INDEX `idx_events_category` ON `events` (`category`);
+-- Add column "category" to table: "communities"
+ALTER TABLE `communities` ADD COLUMN `category` text NULL;
+-- Create index "idx_communities_category" to table: "communities"
+CREATE INDEX `idx_communities_category` ON `communities` (`category`);
+-- Create "entity_tags" table
+CREATE TABLE `entity_tags` (
+  `created_at` datetime NULL,
+  `entity_type` text NULL,
+  `entity_id` integer NULL,
+  `tag` text NULL,
+  PRIMARY KEY (`entity_type`, `entity_id`, `tag`)
+);
+-- Create index "idx_entity_tags_tag" to table: "entity_tags"
+CREATE INDEX `idx_entity_tags_tag` ON `entity_tags` (`tag`);
@@ -0,0 +1,30 @@
+-- Add invite-only events, organizers invite emails that each receive a signed token and RSVP through it
+
+-- Add column "invite_only" to table: "events"
+ALTER TABLE `events` ADD COLUMN `invite_only` numeric NULL;
+-- Create "event_invitations" table
+CREATE TABLE `event_invitations` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime NULL,
+  `updated_at` datetime NULL,
+  `deleted_at` datetime NULL,
+  `event_id` integer NOT NULL,
+  `email` text NOT NULL,
+  `nonce` text NOT NULL,
+  `status` text NOT NULL,
+  `inviter_id` integer NOT NULL,
+  `user_id` integer NULL,
+  `sent_count` integer NOT NULL DEFAULT 0,
+  `last_sent_at` integer NULL,
+  `opened_at` integer NULL,
+  `responded_at` integer NULL,
+  CONSTRAINT `fk_event_invitations_event` FOREIGN KEY (`event_id`) REFERENCES `events` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_event_invitations_inviter` FOREIGN KEY (`inviter_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT `fk_event_invitations_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_event_invitations_deleted_at" to table: "event_invitations"
+CREATE INDEX `idx_event_invitations_deleted_at` ON `event_invitations` (`deleted_at`);
+-- Create index "idx_event_invitation_email" to table: "event_invitations"
+CREATE UNIQUE INDEX `idx_event_invitation_email` ON `event_invitations` (`event_id`, `email`);
+-- Create index "idx_event_invitations_status" to table: "event_invitations"
+CREATE INDEX `idx_event_invitations_status` ON `event_invitations` (`status`);
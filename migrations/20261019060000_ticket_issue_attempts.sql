@@ -0,0 +1,4 @@
+-- Count the ticket issuance attempts of orders, the reconciler gives up after a few failures
+
+-- Add column "ticket_issue_attempts" to table: "orders"
+ALTER TABLE `orders` ADD COLUMN `ticket_issue_attempts` integer NOT NULL DEFAULT 0;
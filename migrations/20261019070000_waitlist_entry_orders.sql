@@ -0,0 +1,6 @@
+-- Link waitlist offers to the pending order that took over their spot, the offer is claimed once the order is paid
+
+-- Add column "order_id" to table: "waitlist_entries"
+ALTER TABLE `waitlist_entries` ADD COLUMN `order_id` text NULL;
+-- Create index "idx_waitlist_entries_order_id" to table: "waitlist_entries"
+CREATE INDEX `idx_waitlist_entries_order_id` ON `waitlist_entries` (`order_id`);
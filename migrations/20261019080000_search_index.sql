@@ -0,0 +1,45 @@
+-- Full-text index over the events and communities, it needs sqlite built with FTS5
+-- External content tables keep no copy of the text, the triggers only maintain the index
+
+-- Create virtual table "events_search"
+CREATE VIRTUAL TABLE `events_search` USING fts5 (
+  `title`, `description`, `loc_venue_name`,
+  content='events', content_rowid='id',
+  tokenize='unicode61 remove_diacritics 2', prefix='2 3'
+);
+-- Create trigger "events_search_ai"
+CREATE TRIGGER `events_search_ai` AFTER INSERT ON `events` BEGIN
+  INSERT INTO `events_search` (`rowid`, `title`, `description`, `loc_venue_name`) VALUES (new.`id`, new.`title`, new.`description`, new.`loc_venue_name`);
+END;
+-- Create trigger "events_search_ad"
+CREATE TRIGGER `events_search_ad` AFTER DELETE ON `events` BEGIN
+  INSERT INTO `events_search` (`events_search`, `rowid`, `title`, `description`, `loc_venue_name`) VALUES ('delete', old.`id`, old.`title`, old.`description`, old.`loc_venue_name`);
+END;
+-- Create trigger "events_search_au"
+CREATE TRIGGER `events_search_au` AFTER UPDATE OF `title`, `description`, `loc_venue_name` ON `events` BEGIN
+  INSERT INTO `events_search` (`events_search`, `rowid`, `title`, `description`, `loc_venue_name`) VALUES ('delete', old.`id`, old.`title`, old.`description`, old.`loc_venue_name`);
+  INSERT INTO `events_search` (`rowid`, `title`, `description`, `loc_venue_name`) VALUES (new.`id`, new.`title`, new.`description`, new.`loc_venue_name`);
+END;
+-- Index the existing events
+INSERT INTO `events_search` (`events_search`) VALUES ('rebuild');
+-- Create virtual table "communities_search"
+CREATE VIRTUAL TABLE `communities_search` USING fts5 (
+  `display_name`, `description`,
+  content='communities', content_rowid='id',
+  tokenize='unicode61 remove_diacritics 2', prefix='2 3'
+);
+-- Create trigger "communities_search_ai"
+CREATE TRIGGER `communities_search_ai` AFTER INSERT ON `communities` BEGIN
+  INSERT INTO `communities_search` (`rowid`, `display_name`, `description`) VALUES (new.`id`, new.`display_name`, new.`description`);
+END;
+-- Create trigger "communities_search_ad"
+CREATE TRIGGER `communities_search_ad` AFTER DELETE ON `communities` BEGIN
+  INSERT INTO `communities_search` (`communities_search`, `rowid`, `display_name`, `description`) VALUES ('delete', old.`id`, old.`display_name`, old.`description`);
+END;
+-- Create trigger "communities_search_au"
+CREATE TRIGGER `communities_search_au` AFTER UPDATE OF `display_name`, `description` ON `communities` BEGIN
+  INSERT INTO `communities_search` (`communities_search`, `rowid`, `display_name`, `description`) VALUES ('delete', old.`id`, old.`display_name`, old.`description`);
+  INSERT INTO `communities_search` (`rowid`, `display_name`, `description`) VALUES (new.`id`, new.`display_name`, new.`description`);
+END;
+-- Index the existing communities
+INSERT INTO `communities_search` (`communities_search`) VALUES ('rebuild');
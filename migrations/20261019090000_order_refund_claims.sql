@@ -0,0 +1,8 @@
+-- Mark the attendees and donations taken over by a refund in progress, concurrent refunds cannot refund them twice
+
+-- Add column "refund_pending_at" to table: "order_attendees"
+ALTER TABLE `order_attendees` ADD COLUMN `refund_pending_at` integer NULL;
+-- Add column "donation_refund_claimed_at" to table: "orders"
+ALTER TABLE `orders` ADD COLUMN `donation_refund_claimed_at` integer NULL;
+-- Mark the donations of the fully refunded orders as claimed
+UPDATE `orders` SET `donation_refund_claimed_at` = `refunded_at` WHERE `refund_status` = 'refunded' AND `donation_amount_minor` > 0;
@@ -83,6 +83,10 @@ table "users" {
     type    = numeric
     default = false
   }
+  column "calendar_feed_token" {
+    null = true
+    type = text
+  }
   primary_key {
     columns = [column.id]
   }
@@ -93,6 +97,10 @@ table "users" {
   index "idx_users_deleted_at" {
     columns = [column.deleted_at]
   }
+  index "idx_users_calendar_feed_token" {
+    unique  = true
+    columns = [column.calendar_feed_token]
+  }
 }
 table "communities" {
   schema = schema.main
@@ -133,6 +141,30 @@ table "communities" {
     null = true
     type = integer
   }
+  column "legal_name" {
+    null = true
+    type = text
+  }
+  column "legal_address" {
+    null = true
+    type = text
+  }
+  column "tax_id" {
+    null = true
+    type = text
+  }
+  column "tax_rate_bps" {
+    null = true
+    type = integer
+  }
+  column "invoice_sequence" {
+    null = true
+    type = integer
+  }
+  column "category" {
+    null = true
+    type = text
+  }
   primary_key {
     columns = [column.id]
   }
@@ -145,6 +177,9 @@ table "communities" {
   index "idx_communities_deleted_at" {
     columns = [column.deleted_at]
   }
+  index "idx_communities_category" {
+    columns = [column.category]
+  }
 }
 table "entity_roles" {
   schema = schema.main
@@ -279,6 +314,47 @@ table "events" {
     type    = integer
     default = 0
   }
+  column "ticket_transfers_disabled" {
+    null = true
+    type = numeric
+  }
+  column "series_id" {
+    null = true
+    type = integer
+  }
+  column "series_recurrence_at" {
+    null = true
+    type = datetime
+  }
+  column "approval_required" {
+    null = true
+    type = numeric
+  }
+  column "reminder_offsets" {
+    null = true
+    type = text
+  }
+  column "draft" {
+    null    = false
+    type    = numeric
+    default = false
+  }
+  column "publish_at" {
+    null = true
+    type = datetime
+  }
+  column "community_email" {
+    null = true
+    type = numeric
+  }
+  column "category" {
+    null = true
+    type = text
+  }
+  column "invite_only" {
+    null = true
+    type = numeric
+  }
   primary_key {
     columns = [column.id]
   }
@@ -291,6 +367,15 @@ table "events" {
   index "idx_events_deleted_at" {
     columns = [column.deleted_at]
   }
+  index "idx_events_series_id" {
+    columns = [column.series_id]
+  }
+  index "idx_events_publish_at" {
+    columns = [column.publish_at]
+  }
+  index "idx_events_category" {
+    columns = [column.category]
+  }
 }
 table "feeds" {
   schema = schema.main
@@ -461,6 +546,51 @@ table "orders" {
     null = true
     type = text
   }
+  column "ticket_issue_attempts" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "refund_status" {
+    null = true
+    type = text
+  }
+  column "refunded_amount_minor" {
+    null = true
+    type = integer
+  }
+  column "refunded_at" {
+    null = true
+    type = integer
+  }
+  column "promo_code_id" {
+    null = true
+    type = integer
+  }
+  column "promo_code" {
+    null = true
+    type = text
+  }
+  column "discount_amount_minor" {
+    null = true
+    type = integer
+  }
+  column "invoice_issued_at" {
+    null = true
+    type = integer
+  }
+  column "invoice_tax_rate_bps" {
+    null = true
+    type = integer
+  }
+  column "donation_amount_minor" {
+    null = true
+    type = integer
+  }
+  column "donation_refund_claimed_at" {
+    null = true
+    type = integer
+  }
   primary_key {
     columns = [column.id]
   }
@@ -485,6 +615,9 @@ table "orders" {
   index "idx_orders_event_id" {
     columns = [column.event_id]
   }
+  index "idx_orders_promo_code_id" {
+    columns = [column.promo_code_id]
+  }
 }
 table "price_groups" {
   schema = schema.main
@@ -558,6 +691,22 @@ table "prices" {
     null = true
     type = integer
   }
+  column "sale_starts_at" {
+    null = true
+    type = datetime
+  }
+  column "sale_ends_at" {
+    null = true
+    type = datetime
+  }
+  column "kind" {
+    null = true
+    type = text
+  }
+  column "minimum_amount_minor" {
+    null = true
+    type = integer
+  }
   primary_key {
     columns = [column.id]
   }
@@ -617,6 +766,18 @@ table "order_attendees" {
     null = false
     type = text
   }
+  column "refund_id" {
+    null = true
+    type = text
+  }
+  column "refunded_at" {
+    null = true
+    type = integer
+  }
+  column "refund_pending_at" {
+    null = true
+    type = integer
+  }
   primary_key {
     columns = [column.id]
   }
@@ -1060,13 +1221,17 @@ table "ticket_holds" {
     type = integer
   }
   column "price_group_id" {
-    null = false
+    null = true
     type = integer
   }
   column "order_id" {
-    null = false
+    null = true
     type = text
   }
+  column "waitlist_entry_id" {
+    null = true
+    type = integer
+  }
   column "quantity" {
     null = false
     type = integer
@@ -1096,12 +1261,21 @@ table "ticket_holds" {
     on_update   = NO_ACTION
     on_delete   = NO_ACTION
   }
+  foreign_key "fk_ticket_holds_waitlist_entry" {
+    columns     = [column.waitlist_entry_id]
+    ref_columns = [table.waitlist_entries.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
   index "idx_ticket_holds_expires_at" {
     columns = [column.expires_at]
   }
   index "idx_ticket_holds_order_id" {
     columns = [column.order_id]
   }
+  index "idx_ticket_holds_waitlist_entry_id" {
+    columns = [column.waitlist_entry_id]
+  }
   index "idx_ticket_holds_price_group_id" {
     columns = [column.price_group_id]
   }
@@ -1109,5 +1283,759 @@ table "ticket_holds" {
     columns = [column.event_id]
   }
 }
+table "promo_codes" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "code" {
+    null = false
+    type = text
+  }
+  column "discount_type" {
+    null = false
+    type = text
+  }
+  column "percent_off" {
+    null = true
+    type = integer
+  }
+  column "amount_off_minor" {
+    null = true
+    type = integer
+  }
+  column "currency_code" {
+    null = true
+    type = text
+  }
+  column "price_id" {
+    null = true
+    type = integer
+  }
+  column "max_redemptions" {
+    null = true
+    type = integer
+  }
+  column "starts_at" {
+    null = true
+    type = integer
+  }
+  column "ends_at" {
+    null = true
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_promo_codes_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_promo_codes_event_id" {
+    columns = [column.event_id]
+  }
+  index "idx_promo_codes_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "waitlist_entries" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "price_group_id" {
+    null = true
+    type = integer
+  }
+  column "user_id" {
+    null = false
+    type = integer
+  }
+  column "position" {
+    null = false
+    type = integer
+  }
+  column "status" {
+    null = false
+    type = text
+  }
+  column "offered_at" {
+    null = true
+    type = integer
+  }
+  column "offer_expires_at" {
+    null = true
+    type = integer
+  }
+  column "order_id" {
+    null = true
+    type = text
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_waitlist_entries_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_waitlist_entries_price_group" {
+    columns     = [column.price_group_id]
+    ref_columns = [table.price_groups.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_waitlist_entries_user" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_waitlist_entries_order_id" {
+    columns = [column.order_id]
+  }
+  index "idx_waitlist_entries_status" {
+    columns = [column.status]
+  }
+  index "idx_waitlist_entries_user_id" {
+    columns = [column.user_id]
+  }
+  index "idx_waitlist_entries_event_id" {
+    columns = [column.event_id]
+  }
+  index "idx_waitlist_entries_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "idempotency_keys" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = false
+    type = integer
+  }
+  column "auth_user_id" {
+    null = false
+    type = text
+  }
+  column "key" {
+    null = false
+    type = text
+  }
+  column "procedure" {
+    null = false
+    type = text
+  }
+  column "request_hash" {
+    null = false
+    type = text
+  }
+  column "response" {
+    null = true
+    type = blob
+  }
+  column "completed_at" {
+    null = true
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_idempotency_keys_auth_user_key" {
+    unique  = true
+    columns = [column.auth_user_id, column.key]
+  }
+  index "idx_idempotency_keys_created_at" {
+    columns = [column.created_at]
+  }
+}
+table "event_series" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "rrule" {
+    null = true
+    type = text
+  }
+  column "start_date" {
+    null = true
+    type = datetime
+  }
+  column "end_date" {
+    null = true
+    type = datetime
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_event_series_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "registration_questions" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = true
+    type = integer
+  }
+  column "position" {
+    null = true
+    type = integer
+  }
+  column "kind" {
+    null = true
+    type = text
+  }
+  column "label" {
+    null = true
+    type = text
+  }
+  column "options" {
+    null = true
+    type = text
+  }
+  column "required" {
+    null = true
+    type = numeric
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_registration_questions_event_id" {
+    columns = [column.event_id]
+  }
+  index "idx_registration_questions_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "registration_answers" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = false
+    type = integer
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "user_id" {
+    null = false
+    type = integer
+  }
+  column "order_attendee_id" {
+    null = true
+    type = text
+  }
+  column "question_id" {
+    null = false
+    type = integer
+  }
+  column "value" {
+    null = false
+    type = text
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_registration_answers_event_user" {
+    columns = [column.event_id, column.user_id]
+  }
+  index "idx_registration_answers_order_attendee_id" {
+    columns = [column.order_attendee_id]
+  }
+}
+table "event_applications" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "user_id" {
+    null = false
+    type = integer
+  }
+  column "buyer_id" {
+    null = false
+    type = integer
+  }
+  column "status" {
+    null = false
+    type = text
+  }
+  column "message" {
+    null = true
+    type = text
+  }
+  column "reviewed_at" {
+    null = true
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_event_applications_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_event_applications_user" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_event_applications_buyer" {
+    columns     = [column.buyer_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_event_applications_status" {
+    columns = [column.status]
+  }
+  index "idx_event_applications_user_id" {
+    columns = [column.user_id]
+  }
+  index "idx_event_applications_event_id" {
+    columns = [column.event_id]
+  }
+  index "idx_event_applications_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "event_sessions" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "title" {
+    null = false
+    type = text
+  }
+  column "description" {
+    null = true
+    type = text
+  }
+  column "speakers" {
+    null = true
+    type = text
+  }
+  column "room" {
+    null = true
+    type = text
+  }
+  column "track" {
+    null = true
+    type = text
+  }
+  column "start_date" {
+    null = false
+    type = datetime
+  }
+  column "end_date" {
+    null = false
+    type = datetime
+  }
+  column "capacity" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_event_sessions_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_event_sessions_event_id" {
+    columns = [column.event_id]
+  }
+  index "idx_event_sessions_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
+table "session_registrations" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = false
+    type = integer
+  }
+  column "session_id" {
+    null = false
+    type = integer
+  }
+  column "user_id" {
+    null = false
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_session_registrations_session" {
+    columns     = [column.session_id]
+    ref_columns = [table.event_sessions.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_session_registrations_user" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_session_registrations_session_user" {
+    unique  = true
+    columns = [column.session_id, column.user_id]
+  }
+  index "idx_session_registrations_user_id" {
+    columns = [column.user_id]
+  }
+}
+table "sent_reminders" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = false
+    type = integer
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "user_id" {
+    null = false
+    type = integer
+  }
+  column "offset_seconds" {
+    null = false
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_sent_reminders_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_sent_reminders_user" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_sent_reminders_event_user_offset" {
+    unique  = true
+    columns = [column.event_id, column.user_id, column.offset_seconds]
+  }
+}
+table "event_templates" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "community_id" {
+    null = false
+    type = integer
+  }
+  column "creator_id" {
+    null = false
+    type = integer
+  }
+  column "name" {
+    null = false
+    type = text
+  }
+  column "settings" {
+    null = false
+    type = blob
+  }
+  column "duration_seconds" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_event_templates_community" {
+    columns     = [column.community_id]
+    ref_columns = [table.communities.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_event_templates_creator" {
+    columns     = [column.creator_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_event_templates_deleted_at" {
+    columns = [column.deleted_at]
+  }
+  index "idx_event_templates_community_id" {
+    columns = [column.community_id]
+  }
+}
+table "entity_tags" {
+  schema = schema.main
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "entity_type" {
+    null = true
+    type = text
+  }
+  column "entity_id" {
+    null = true
+    type = integer
+  }
+  column "tag" {
+    null = true
+    type = text
+  }
+  primary_key {
+    columns = [column.entity_type, column.entity_id, column.tag]
+  }
+  index "idx_entity_tags_tag" {
+    columns = [column.tag]
+  }
+}
+table "event_invitations" {
+  schema = schema.main
+  column "id" {
+    null           = true
+    type           = integer
+    auto_increment = true
+  }
+  column "created_at" {
+    null = true
+    type = datetime
+  }
+  column "updated_at" {
+    null = true
+    type = datetime
+  }
+  column "deleted_at" {
+    null = true
+    type = datetime
+  }
+  column "event_id" {
+    null = false
+    type = integer
+  }
+  column "email" {
+    null = false
+    type = text
+  }
+  column "nonce" {
+    null = false
+    type = text
+  }
+  column "status" {
+    null = false
+    type = text
+  }
+  column "inviter_id" {
+    null = false
+    type = integer
+  }
+  column "user_id" {
+    null = true
+    type = integer
+  }
+  column "sent_count" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "last_sent_at" {
+    null = true
+    type = integer
+  }
+  column "opened_at" {
+    null = true
+    type = integer
+  }
+  column "responded_at" {
+    null = true
+    type = integer
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "fk_event_invitations_event" {
+    columns     = [column.event_id]
+    ref_columns = [table.events.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_event_invitations_inviter" {
+    columns     = [column.inviter_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  foreign_key "fk_event_invitations_user" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_update   = NO_ACTION
+    on_delete   = NO_ACTION
+  }
+  index "idx_event_invitations_status" {
+    columns = [column.status]
+  }
+  index "idx_event_invitation_email" {
+    unique  = true
+    columns = [column.event_id, column.email]
+  }
+  index "idx_event_invitations_deleted_at" {
+    columns = [column.deleted_at]
+  }
+}
 schema "main" {
 }